@@ -0,0 +1,16 @@
+// Command chainlink-median is a reference median LOOP plugin, serving the libocr numerical median reporting plugin via
+// [loop.ServeMedian]. It is launched by the host, and is not intended to be run directly.
+package main
+
+import (
+	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop"
+	"github.com/smartcontractkit/chainlink-relay/pkg/reportingplugins/median"
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+func main() {
+	loop.ServeMedian(func(lggr logger.Logger) types.PluginMedian {
+		return median.NewPlugin(lggr)
+	})
+}
@@ -0,0 +1,264 @@
+// Command loopcheck launches a LOOP plugin executable, performs the handshake for each supported plugin type, and
+// prints a health summary. It is intended for validating plugin builds in CI/CD and on nodes.
+//
+// Usage:
+//
+//	loopcheck [flags] <plugin> [args...]
+//
+// With -dial, each plugin which completed the handshake is also constructed with stub dependencies, and its
+// Ready and HealthReport results are included in the summary. The exit code is non-zero if no handshake
+// succeeded, or if any check failed.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-plugin"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop"
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run checks the plugin named by args, prints the summary to stdout, and returns the exit code.
+func run(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("loopcheck", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	var (
+		kind       = flags.String("type", "", "plugin type to check: relayer or median (default all)")
+		dial       = flags.Bool("dial", false, "construct each plugin with stub dependencies and check its health")
+		configPath = flags.String("config", "", "relayer config TOML file, used with -dial")
+		timeout    = flags.Duration("timeout", 30*time.Second, "overall timeout")
+		verbose    = flags.Bool("v", false, "log plugin output at debug level")
+	)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: loopcheck [flags] <plugin> [args...]\n")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() < 1 {
+		flags.Usage()
+		return 2
+	}
+
+	kinds := pluginKinds
+	if *kind != "" {
+		k, ok := pluginKindByName(*kind)
+		if !ok {
+			fmt.Fprintf(stderr, "unknown plugin type: %s\n", *kind)
+			return 2
+		}
+		kinds = []pluginKind{k}
+	}
+
+	var config string
+	if *configPath != "" {
+		b, err := os.ReadFile(*configPath)
+		if err != nil {
+			fmt.Fprintf(stderr, "failed to read config: %v\n", err)
+			return 2
+		}
+		config = string(b)
+	}
+
+	lvl := zapcore.WarnLevel
+	if *verbose {
+		lvl = zapcore.DebugLevel
+	}
+	lggr, err := (&logger.Config{Level: lvl}).New()
+	if err != nil {
+		fmt.Fprintf(stderr, "failed to create logger: %v\n", err)
+		return 1
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	p := prober{lggr: lggr, path: flags.Arg(0), args: flags.Args()[1:], dial: *dial, config: config}
+	var handshakes, failures int
+	for _, k := range kinds {
+		r := p.probe(ctx, k)
+		r.print(stdout)
+		if r.err == nil {
+			handshakes++
+		} else if *kind != "" {
+			failures++
+		}
+		for _, c := range r.checks {
+			if c.err != nil {
+				failures++
+			}
+		}
+	}
+	if handshakes == 0 || failures > 0 {
+		return 1
+	}
+	return 0
+}
+
+// pluginKind describes a supported plugin type.
+type pluginKind struct {
+	name         string
+	clientConfig func(loop.BrokerConfig) *plugin.ClientConfig
+	// dial constructs the dispensed plugin with stub dependencies.
+	dial func(ctx context.Context, instance any, config string) []check
+}
+
+var pluginKinds = []pluginKind{
+	{
+		name: loop.PluginRelayerName,
+		clientConfig: func(cfg loop.BrokerConfig) *plugin.ClientConfig {
+			return (&loop.GRPCPluginRelayer{BrokerConfig: cfg}).ClientConfig()
+		},
+		dial: dialRelayer,
+	},
+	{
+		name: loop.PluginMedianName,
+		clientConfig: func(cfg loop.BrokerConfig) *plugin.ClientConfig {
+			return (&loop.GRPCPluginMedian{BrokerConfig: cfg}).ClientConfig()
+		},
+		dial: dialMedian,
+	},
+}
+
+func pluginKindByName(name string) (pluginKind, bool) {
+	for _, k := range pluginKinds {
+		if k.name == name {
+			return k, true
+		}
+	}
+	return pluginKind{}, false
+}
+
+type prober struct {
+	lggr   logger.Logger
+	path   string
+	args   []string
+	dial   bool
+	config string
+}
+
+// probe launches a new plugin process, and attempts the handshake for kind.
+func (p *prober) probe(ctx context.Context, kind pluginKind) (r result) {
+	r.kind = kind.name
+
+	// Clients retry until stopped, so bound them by ctx. The process is killed rather than closed, since Close would
+	// retry the same failures we are reporting.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stopCh := make(chan struct{})
+	go func() {
+		<-ctx.Done()
+		close(stopCh)
+	}()
+	cc := kind.clientConfig(loop.BrokerConfig{StopCh: stopCh, Logger: logger.Named(p.lggr, kind.name)})
+	cc.Cmd = exec.CommandContext(ctx, p.path, p.args...)
+	if d, ok := ctx.Deadline(); ok {
+		cc.StartTimeout = time.Until(d)
+	}
+	c := plugin.NewClient(cc)
+	defer c.Kill()
+
+	cp, err := c.Client()
+	if err != nil {
+		r.err = fmt.Errorf("handshake failed: %w", err)
+		return
+	}
+	defer cp.Close()
+	if err = cp.Ping(); err != nil {
+		r.err = fmt.Errorf("ping failed: %w", err)
+		return
+	}
+	r.protocol, r.version = string(c.Protocol()), c.NegotiatedVersion()
+	instance, err := cp.Dispense(kind.name)
+	if err != nil {
+		r.err = fmt.Errorf("dispense failed: %w", err)
+		return
+	}
+	if p.dial {
+		r.checks = kind.dial(ctx, instance, p.config)
+	}
+	return
+}
+
+type check struct {
+	name string
+	err  error
+}
+
+type result struct {
+	kind     string
+	protocol string
+	version  int
+	err      error
+	checks   []check
+}
+
+func (r *result) print(w io.Writer) {
+	if r.err != nil {
+		fmt.Fprintf(w, "%s: %v\n", r.kind, r.err)
+		return
+	}
+	fmt.Fprintf(w, "%s: ok (protocol=%s version=%d)\n", r.kind, r.protocol, r.version)
+	for _, c := range r.checks {
+		status := "ok"
+		if c.err != nil {
+			status = c.err.Error()
+		}
+		fmt.Fprintf(w, "  %s: %s\n", c.name, status)
+	}
+}
+
+func dialRelayer(ctx context.Context, instance any, config string) (checks []check) {
+	pr, ok := instance.(loop.PluginRelayer)
+	if !ok {
+		return []check{{"NewRelayer", fmt.Errorf("expected PluginRelayer but got %T", instance)}}
+	}
+	r, err := pr.NewRelayer(ctx, config, stub{})
+	checks = append(checks, check{"NewRelayer", err})
+	if err != nil {
+		return
+	}
+	return append(checks, serviceChecks(ctx, r)...)
+}
+
+func dialMedian(ctx context.Context, instance any, _ string) (checks []check) {
+	pm, ok := instance.(types.PluginMedian)
+	if !ok {
+		return []check{{"NewMedianFactory", fmt.Errorf("expected PluginMedian but got %T", instance)}}
+	}
+	f, err := pm.NewMedianFactory(ctx, stub{}, stub{}, stub{}, stub{})
+	checks = append(checks, check{"NewMedianFactory", err})
+	if err != nil {
+		return
+	}
+	return append(checks, serviceChecks(ctx, f)...)
+}
+
+func serviceChecks(ctx context.Context, s types.Service) []check {
+	checks := []check{{"Start", s.Start(ctx)}, {"Ready", s.Ready()}}
+	hr := s.HealthReport()
+	names := make([]string, 0, len(hr))
+	for name := range hr {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		checks = append(checks, check{"HealthReport " + name, hr[name]})
+	}
+	return checks
+}
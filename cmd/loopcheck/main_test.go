@@ -0,0 +1,122 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop"
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+func TestRun(t *testing.T) {
+	t.Setenv("GO_WANT_HELPER_PROCESS", "1")
+	helper := func(cmd string) []string {
+		return []string{os.Args[0], "-test.run=TestHelperProcess", "--", cmd}
+	}
+	missingConfig := filepath.Join(t.TempDir(), "missing.toml")
+
+	for _, tt := range []struct {
+		name   string
+		args   []string
+		code   int
+		output []string
+	}{
+		{name: "no plugin", args: nil, code: 2},
+		{name: "unknown type", args: append([]string{"-type", "bogus"}, helper("relayer")...), code: 2},
+		{name: "missing config", args: append([]string{"-config", missingConfig}, helper("relayer")...), code: 2},
+		{name: "no handshake", args: helper("exit"), code: 1,
+			output: []string{"relayer: handshake failed", "median: handshake failed"}},
+		{name: "any type", args: helper("relayer"), code: 0,
+			output: []string{"relayer: ok (protocol=grpc", "median: handshake failed"}},
+		{name: "wrong type", args: append([]string{"-type", "median"}, helper("relayer")...), code: 1,
+			output: []string{"median: handshake failed"}},
+		{name: "dial", args: append([]string{"-dial", "-type", "relayer"}, helper("relayer")...), code: 0,
+			output: []string{"relayer: ok", "  NewRelayer: ok", "  Start: ok", "  Ready: ok", "  HealthReport loopcheck.test: ok"}},
+		{name: "dial unhealthy", args: append([]string{"-dial", "-type", "relayer"}, helper("unhealthy-relayer")...), code: 1,
+			output: []string{"relayer: ok", "  Ready: ok", "  HealthReport loopcheck.test: unhealthy"}},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			code := run(tt.args, &stdout, &stderr)
+			assert.Equal(t, tt.code, code, "stdout: %s\nstderr: %s", stdout.String(), stderr.String())
+			for _, o := range tt.output {
+				assert.Contains(t, stdout.String(), o)
+			}
+		})
+	}
+}
+
+// This is not a real test. This is just a helper process kicked off by
+// tests.
+func TestHelperProcess(t *testing.T) {
+	if os.Getenv("GO_WANT_HELPER_PROCESS") != "1" {
+		return
+	}
+
+	defer os.Exit(0)
+
+	args := os.Args
+	for len(args) > 0 {
+		if args[0] == "--" {
+			args = args[1:]
+			break
+		}
+
+		args = args[1:]
+	}
+
+	if len(args) == 0 {
+		fmt.Fprintf(os.Stderr, "No command\n")
+		os.Exit(2)
+	}
+
+	switch cmd := args[0]; cmd {
+	case "exit":
+		os.Exit(1)
+
+	case "relayer":
+		loop.ServeRelayer(func(logger.Logger) loop.PluginRelayer { return testPluginRelayer{} })
+
+	case "unhealthy-relayer":
+		loop.ServeRelayer(func(logger.Logger) loop.PluginRelayer {
+			return testPluginRelayer{health: errors.New("unhealthy")}
+		})
+
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown command: %q\n", cmd)
+		os.Exit(2)
+	}
+}
+
+type testPluginRelayer struct {
+	health error
+}
+
+func (p testPluginRelayer) NewRelayer(context.Context, string, types.Keystore) (loop.Relayer, error) {
+	return testRelayer{health: p.health}, nil
+}
+
+// testRelayer only implements [types.Service], which is all that loopcheck calls.
+type testRelayer struct {
+	loop.Relayer
+	health error
+}
+
+func (testRelayer) Start(context.Context) error { return nil }
+
+func (testRelayer) Close() error { return nil }
+
+func (testRelayer) Ready() error { return nil }
+
+func (testRelayer) Name() string { return "loopcheck.test" }
+
+func (r testRelayer) HealthReport() map[string]error { return map[string]error{r.Name(): r.health} }
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	libocr "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+var errStub = errors.New("loopcheck: stub dependency")
+
+var (
+	_ types.Keystore       = stub{}
+	_ types.MedianProvider = stub{}
+	_ median.DataSource    = stub{}
+	_ types.ErrorLog       = stub{}
+)
+
+// stub implements every dependency a plugin may be constructed with. Calls return errStub, so that plugins can be
+// constructed and health checked without a node.
+type stub struct{}
+
+func (stub) Start(context.Context) error { return nil }
+
+func (stub) Close() error { return nil }
+
+func (stub) Ready() error { return nil }
+
+func (stub) Name() string { return "loopcheck.stub" }
+
+func (stub) HealthReport() map[string]error { return map[string]error{"loopcheck.stub": nil} }
+
+func (stub) Accounts(context.Context) ([]string, error) { return nil, nil }
+
+func (stub) Sign(context.Context, string, []byte) ([]byte, error) { return nil, errStub }
+
+func (stub) SaveError(context.Context, string) error { return nil }
+
+func (stub) Observe(context.Context, libocr.ReportTimestamp) (*big.Int, error) { return nil, errStub }
+
+func (s stub) OffchainConfigDigester() libocr.OffchainConfigDigester { return s }
+
+func (s stub) ContractConfigTracker() libocr.ContractConfigTracker { return s }
+
+func (s stub) ContractTransmitter() libocr.ContractTransmitter { return s }
+
+func (s stub) ReportCodec() median.ReportCodec { return s }
+
+func (s stub) MedianContract() median.MedianContract { return s }
+
+func (s stub) OnchainConfigCodec() median.OnchainConfigCodec { return s }
+
+func (stub) ConfigDigest(libocr.ContractConfig) (libocr.ConfigDigest, error) {
+	return libocr.ConfigDigest{}, errStub
+}
+
+func (stub) ConfigDigestPrefix() (libocr.ConfigDigestPrefix, error) { return 0, errStub }
+
+func (stub) Notify() <-chan struct{} { return nil }
+
+func (stub) LatestConfigDetails(context.Context) (uint64, libocr.ConfigDigest, error) {
+	return 0, libocr.ConfigDigest{}, errStub
+}
+
+func (stub) LatestConfig(context.Context, uint64) (libocr.ContractConfig, error) {
+	return libocr.ContractConfig{}, errStub
+}
+
+func (stub) LatestBlockHeight(context.Context) (uint64, error) { return 0, errStub }
+
+func (stub) Transmit(context.Context, libocr.ReportContext, libocr.Report, []libocr.AttributedOnchainSignature) error {
+	return errStub
+}
+
+func (stub) LatestConfigDigestAndEpoch(context.Context) (libocr.ConfigDigest, uint32, error) {
+	return libocr.ConfigDigest{}, 0, errStub
+}
+
+func (stub) FromAccount() (libocr.Account, error) { return "", errStub }
+
+func (stub) BuildReport([]median.ParsedAttributedObservation) (libocr.Report, error) {
+	return nil, errStub
+}
+
+func (stub) MedianFromReport(libocr.Report) (*big.Int, error) { return nil, errStub }
+
+func (stub) MaxReportLength(int) (int, error) { return 0, errStub }
+
+func (stub) LatestTransmissionDetails(context.Context) (libocr.ConfigDigest, uint32, uint8, *big.Int, time.Time, error) {
+	return libocr.ConfigDigest{}, 0, 0, nil, time.Time{}, errStub
+}
+
+func (stub) LatestRoundRequested(context.Context, time.Duration) (libocr.ConfigDigest, uint32, uint8, error) {
+	return libocr.ConfigDigest{}, 0, 0, errStub
+}
+
+func (stub) Encode(median.OnchainConfig) ([]byte, error) { return nil, errStub }
+
+func (stub) Decode([]byte) (median.OnchainConfig, error) { return median.OnchainConfig{}, errStub }
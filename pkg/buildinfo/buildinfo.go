@@ -0,0 +1,68 @@
+// Package buildinfo holds the version of the running binary, which is set at build time via ldflags, e.g.:
+//
+//	go build -ldflags "-X github.com/smartcontractkit/chainlink-relay/pkg/buildinfo.Sha=$(git rev-parse HEAD)" ./cmd/chainlink-median
+//
+// LOOP plugins report it to the host, which logs it and includes it in health reports.
+package buildinfo
+
+import (
+	"fmt"
+	"runtime/debug"
+)
+
+const (
+	pkgPath = "github.com/smartcontractkit/chainlink-relay/pkg/buildinfo"
+	unset   = "unset"
+)
+
+// Set at build time via ldflags. See [LDFlags].
+var (
+	// Version is the semver of the build.
+	Version = unset
+	// Sha is the git commit of the build.
+	Sha = unset
+	// Date is the build time, in RFC3339 format.
+	Date = unset
+)
+
+// Info describes a build.
+type Info struct {
+	Version string
+	Sha     string
+	Date    string
+}
+
+func (i Info) String() string {
+	return fmt.Sprintf("%s@%s (built %s)", i.Version, i.Sha, i.Date)
+}
+
+// Get returns the build info set via ldflags. Unset fields fall back to the module version and vcs info embedded by
+// the go command, when available.
+func Get() Info {
+	info := Info{Version, Sha, Date}
+	bi, ok := debug.ReadBuildInfo()
+	if !ok {
+		return info
+	}
+	if info.Version == unset && bi.Main.Version != "" && bi.Main.Version != "(devel)" {
+		info.Version = bi.Main.Version
+	}
+	for _, s := range bi.Settings {
+		switch s.Key {
+		case "vcs.revision":
+			if info.Sha == unset {
+				info.Sha = s.Value
+			}
+		case "vcs.time":
+			if info.Date == unset {
+				info.Date = s.Value
+			}
+		}
+	}
+	return info
+}
+
+// LDFlags returns the -ldflags value which sets the build info, for build scripts.
+func LDFlags(version, sha, date string) string {
+	return fmt.Sprintf("-X %[1]s.Version=%[2]s -X %[1]s.Sha=%[3]s -X %[1]s.Date=%[4]s", pkgPath, version, sha, date)
+}
@@ -0,0 +1,24 @@
+package buildinfo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGet(t *testing.T) {
+	orig := Info{Version, Sha, Date}
+	t.Cleanup(func() { Version, Sha, Date = orig.Version, orig.Sha, orig.Date })
+
+	Version, Sha, Date = "v1.2.3", "abcdef", "2023-08-01T00:00:00Z"
+	info := Get()
+	assert.Equal(t, Info{"v1.2.3", "abcdef", "2023-08-01T00:00:00Z"}, info)
+	assert.Equal(t, "v1.2.3@abcdef (built 2023-08-01T00:00:00Z)", info.String())
+}
+
+func TestLDFlags(t *testing.T) {
+	assert.Equal(t, "-X github.com/smartcontractkit/chainlink-relay/pkg/buildinfo.Version=v1.2.3"+
+		" -X github.com/smartcontractkit/chainlink-relay/pkg/buildinfo.Sha=abcdef"+
+		" -X github.com/smartcontractkit/chainlink-relay/pkg/buildinfo.Date=2023-08-01T00:00:00Z",
+		LDFlags("v1.2.3", "abcdef", "2023-08-01T00:00:00Z"))
+}
@@ -40,3 +40,18 @@ type KeyNotFoundError struct {
 func (e KeyNotFoundError) Error() string {
 	return fmt.Sprintf("unable to find %s key with id %s", e.KeyType, e.ID)
 }
+
+// KeyError prefixes Err with the config Key it applies to. Nested keys are joined with dots.
+type KeyError struct {
+	Key string
+	Err error
+}
+
+func (e KeyError) Error() string {
+	if ke, ok := e.Err.(KeyError); ok {
+		return e.Key + "." + ke.Error()
+	}
+	return fmt.Sprintf("%s: %v", e.Key, e.Err)
+}
+
+func (e KeyError) Unwrap() error { return e.Err }
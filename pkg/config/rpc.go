@@ -0,0 +1,89 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// RPCNode configures one RPC endpoint of a chain. Relayers with multiple nodes send requests to the healthy nodes with
+// the lowest Priority, balanced by Weight, and fail over to higher priorities when none are healthy.
+type RPCNode struct {
+	// Name identifies the node in statuses and health reports, and must be unique per chain.
+	Name string
+	// URL often includes credentials, so it is redacted by String and GoString, but encoded as is, so that hosts can
+	// pass it on to relayers.
+	URL string
+	// Priority orders nodes for failover. Lower is preferred, so 0 is the highest priority.
+	Priority uint32
+	// Weight is the relative share of requests among healthy nodes with the same Priority. Defaults to 1.
+	Weight uint32
+}
+
+func (n *RPCNode) SetDefaults() {
+	if n.Weight == 0 {
+		n.Weight = 1
+	}
+}
+
+// String implements [fmt.Stringer], and redacts the URL.
+func (n RPCNode) String() string {
+	return fmt.Sprintf("{Name:%s URL:%s Priority:%d Weight:%d}", n.Name, Secret(n.URL), n.Priority, n.Weight)
+}
+
+// GoString implements [fmt.GoStringer], and redacts the URL.
+func (n RPCNode) GoString() string {
+	return fmt.Sprintf("config.RPCNode{Name:%q, URL:%q, Priority:%d, Weight:%d}", n.Name, Secret(n.URL), n.Priority, n.Weight)
+}
+
+func (n *RPCNode) ValidateConfig() (err error) {
+	if n.Name == "" {
+		err = errors.Join(err, ErrEmpty{Name: "Name", Msg: "required"})
+	}
+	if n.URL == "" {
+		err = errors.Join(err, ErrEmpty{Name: "URL", Msg: "required"})
+	} else if u, perr := url.Parse(n.URL); perr != nil {
+		// The url.Error would include the URL itself.
+		var uerr *url.Error
+		if errors.As(perr, &uerr) {
+			perr = uerr.Err
+		}
+		err = errors.Join(err, ErrInvalid{Name: "URL", Value: Secret(n.URL), Msg: perr.Error()})
+	} else if u.Scheme == "" {
+		err = errors.Join(err, ErrInvalid{Name: "URL", Value: Secret(n.URL), Msg: "missing scheme"})
+	} else if u.Host == "" {
+		err = errors.Join(err, ErrInvalid{Name: "URL", Value: Secret(n.URL), Msg: "missing host"})
+	}
+	return
+}
+
+// RPCNodes configures the RPC endpoints of a chain, e.g. as the Nodes field of a relayer's chain config, whose
+// SetDefaults must call SetDefaults on it.
+type RPCNodes []RPCNode
+
+// String implements [fmt.Stringer], and redacts the URLs, e.g. for loggers which would otherwise encode the fields.
+func (ns RPCNodes) String() string { return fmt.Sprint([]RPCNode(ns)) }
+
+func (ns RPCNodes) SetDefaults() {
+	for i := range ns {
+		ns[i].SetDefaults()
+	}
+}
+
+func (ns RPCNodes) ValidateConfig() (err error) {
+	if len(ns) == 0 {
+		return errors.New("at least one node is required")
+	}
+	names := make(map[string]int, len(ns))
+	for i, n := range ns {
+		if n.Name == "" {
+			continue // reported by RPCNode
+		}
+		if j, ok := names[n.Name]; ok {
+			err = errors.Join(err, ErrInvalid{Name: fmt.Sprintf("%d.Name", i), Value: n.Name, Msg: fmt.Sprintf("duplicate of node %d", j)})
+			continue
+		}
+		names[n.Name] = i
+	}
+	return
+}
@@ -0,0 +1,22 @@
+package config
+
+import (
+	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+)
+
+// Secret is a string which is redacted when printed, logged, or encoded, e.g. by [EncodeTOML].
+// Use string(s) to access the value.
+type Secret string
+
+func (s Secret) String() string { return logger.Redacted }
+
+func (s Secret) GoString() string { return logger.Redacted }
+
+// MarshalText implements [encoding.TextMarshaler] and always returns the redacted value.
+func (s Secret) MarshalText() ([]byte, error) { return []byte(logger.Redacted), nil }
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (s *Secret) UnmarshalText(text []byte) error {
+	*s = Secret(text)
+	return nil
+}
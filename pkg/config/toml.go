@@ -0,0 +1,54 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+)
+
+// ErrInvalidConfig is wrapped by all errors returned from [DecodeTOML].
+var ErrInvalidConfig = errors.New("invalid config")
+
+// Defaulter configurations fill in default values for unset fields.
+type Defaulter interface {
+	// SetDefaults is called after decoding, so it must only set fields which are still unset.
+	SetDefaults()
+}
+
+// DecodeTOML decodes the TOML document s into v, which must be a pointer, e.g. from the config string passed to
+// NewRelayer. Unknown fields are rejected. If v implements [Defaulter] then defaults are applied, and finally v is
+// checked via [Validate].
+func DecodeTOML(s string, v any) error {
+	d := toml.NewDecoder(strings.NewReader(s))
+	d.DisallowUnknownFields()
+	if err := d.Decode(v); err != nil {
+		var strict *toml.StrictMissingError
+		if errors.As(err, &strict) {
+			return fmt.Errorf("%w: unknown fields:\n%s", ErrInvalidConfig, strict.String())
+		}
+		var decode *toml.DecodeError
+		if errors.As(err, &decode) {
+			row, col := decode.Position()
+			return fmt.Errorf("%w: line %d column %d: %w", ErrInvalidConfig, row, col, err)
+		}
+		return fmt.Errorf("%w: %w", ErrInvalidConfig, err)
+	}
+	if d, ok := v.(Defaulter); ok {
+		d.SetDefaults()
+	}
+	if err := Validate(v); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidConfig, err)
+	}
+	return nil
+}
+
+// EncodeTOML returns v encoded as TOML, with [Secret]s redacted. It is suitable for implementing String().
+func EncodeTOML(v any) (string, error) {
+	b, err := toml.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
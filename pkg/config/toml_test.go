@@ -0,0 +1,101 @@
+package config
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testConfig struct {
+	ChainID *string
+	Enabled *bool
+	APIKey  Secret
+	Nodes   []testNode
+}
+
+func (c *testConfig) SetDefaults() {
+	if c.Enabled == nil {
+		t := true
+		c.Enabled = &t
+	}
+}
+
+func (c *testConfig) ValidateConfig() error {
+	if c.ChainID == nil {
+		return ErrMissing{Name: "ChainID", Msg: "required"}
+	}
+	return nil
+}
+
+type testNode struct {
+	Name string
+	URL  string
+}
+
+func (n testNode) ValidateConfig() (err error) {
+	if n.Name == "" {
+		err = errors.Join(err, ErrEmpty{Name: "Name", Msg: "required"})
+	}
+	if n.URL == "" {
+		err = errors.Join(err, ErrEmpty{Name: "URL", Msg: "required"})
+	}
+	return
+}
+
+func TestDecodeTOML(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		var c testConfig
+		require.NoError(t, DecodeTOML(`ChainID = "1"
+APIKey = "hunter2"
+[[Nodes]]
+Name = "primary"
+URL = "ws://localhost"
+`, &c))
+		assert.Equal(t, "1", *c.ChainID)
+		assert.True(t, *c.Enabled, "default")
+		assert.Equal(t, "hunter2", string(c.APIKey))
+
+		s, err := EncodeTOML(c)
+		require.NoError(t, err)
+		assert.NotContains(t, s, "hunter2")
+		assert.Contains(t, s, "[redacted]")
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		var c testConfig
+		err := DecodeTOML(`ChainID = "1"
+Foo = "bar"
+`, &c)
+		require.ErrorIs(t, err, ErrInvalidConfig)
+		assert.Contains(t, err.Error(), "Foo")
+	})
+
+	t.Run("syntax", func(t *testing.T) {
+		var c testConfig
+		err := DecodeTOML(`ChainID = `, &c)
+		require.ErrorIs(t, err, ErrInvalidConfig)
+		assert.Contains(t, err.Error(), "line 1")
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		var c testConfig
+		err := DecodeTOML(`[[Nodes]]
+Name = "primary"
+URL = "ws://localhost"
+[[Nodes]]
+`, &c)
+		require.ErrorIs(t, err, ErrInvalidConfig)
+		assert.Contains(t, err.Error(), "ChainID: missing: required")
+		assert.Contains(t, err.Error(), "Nodes.1: Name: empty: required")
+		assert.Contains(t, err.Error(), "Nodes.1: URL: empty: required")
+		assert.NotContains(t, err.Error(), "Nodes.0")
+	})
+}
+
+func TestSecret(t *testing.T) {
+	s := Secret("hunter2")
+	assert.Equal(t, "[redacted]", s.String())
+	assert.Equal(t, "[redacted]", s.GoString())
+}
@@ -0,0 +1,102 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+)
+
+// Validated configurations impose constraints that must be checked.
+type Validated interface {
+	// ValidateConfig returns nil if the config is valid, otherwise an error describing why it is invalid.
+	// Nested fields are validated separately by [Validate], so only local constraints need to be checked.
+	ValidateConfig() error
+}
+
+// Validate returns the aggregated errors from calling ValidateConfig on cfg and every nested field which implements
+// [Validated]. Errors from nested fields are wrapped in [KeyError]s.
+func Validate(cfg any) error {
+	return errors.Join(validate(reflect.ValueOf(cfg), true)...)
+}
+
+func validate(v reflect.Value, checkInterface bool) (errs []error) {
+	if !v.IsValid() {
+		return nil
+	}
+	if checkInterface {
+		if vc, ok := validated(v); ok {
+			if err := vc.ValidateConfig(); err != nil {
+				errs = append(errs, unjoin(err)...)
+			}
+		}
+	}
+
+	if v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			ft := t.Field(i)
+			if !ft.IsExported() {
+				continue
+			}
+			if ft.Anonymous {
+				// promoted ValidateConfig, if any, was already called for the parent
+				errs = append(errs, validate(v.Field(i), false)...)
+				continue
+			}
+			for _, err := range validate(v.Field(i), true) {
+				errs = append(errs, KeyError{Key: ft.Name, Err: err})
+			}
+		}
+	case reflect.Map:
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j]) })
+		for _, k := range keys {
+			for _, err := range validate(v.MapIndex(k), true) {
+				errs = append(errs, KeyError{Key: fmt.Sprint(k), Err: err})
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			for _, err := range validate(v.Index(i), true) {
+				errs = append(errs, KeyError{Key: strconv.Itoa(i), Err: err})
+			}
+		}
+	}
+	return
+}
+
+// validated returns v as a Validated, including via pointer receiver when v is addressable.
+func validated(v reflect.Value) (Validated, bool) {
+	if v.Kind() == reflect.Pointer && v.IsNil() {
+		return nil, false
+	}
+	if v.CanInterface() {
+		if vc, ok := v.Interface().(Validated); ok {
+			return vc, true
+		}
+	}
+	if v.CanAddr() && v.Addr().CanInterface() {
+		if vc, ok := v.Addr().Interface().(Validated); ok {
+			return vc, true
+		}
+	}
+	return nil, false
+}
+
+// unjoin splits errors created by [errors.Join], so that each one is prefixed separately.
+func unjoin(err error) []error {
+	if j, ok := err.(interface{ Unwrap() []error }); ok {
+		return j.Unwrap()
+	}
+	return []error{err}
+}
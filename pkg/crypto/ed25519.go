@@ -0,0 +1,47 @@
+package crypto
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/binary"
+
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+)
+
+// ED25519Verifier is a [Verifier] for ED25519 onchain keys. Signatures are over [ReportDigest], which is a standalone
+// scheme of this package: it is not the signing format of any chain's keyring, so it only verifies reports signed by
+// [SignED25519]. Reports signed by a chain's keyring must be verified with that keyring as the [Verifier].
+type ED25519Verifier struct{}
+
+var _ Verifier = ED25519Verifier{}
+
+func (ED25519Verifier) Verify(key ocrtypes.OnchainPublicKey, reportContext ocrtypes.ReportContext, report ocrtypes.Report, signature []byte) bool {
+	if len(key) != ed25519.PublicKeySize || len(signature) != ed25519.SignatureSize {
+		return false
+	}
+	return ed25519.Verify(ed25519.PublicKey(key), ReportDigest(reportContext, report), signature)
+}
+
+// SignED25519 returns the signature of report for reportContext by key, to be verified by [ED25519Verifier].
+func SignED25519(key ed25519.PrivateKey, reportContext ocrtypes.ReportContext, report ocrtypes.Report) []byte {
+	return ed25519.Sign(key, ReportDigest(reportContext, report))
+}
+
+// ReportDigest returns the SHA-256 hash of the raw report context and report, which is signed by [SignED25519]:
+//
+//	configDigest (32 bytes) || 27 zero bytes || epoch (4 bytes, big endian) || round (1 byte) || extraHash (32 bytes) || report
+//
+// The raw report context is the same as in OCR2 EVM contracts, but the digest itself is specific to this package, and
+// does not match the signatures of any chain's keyring.
+func ReportDigest(reportContext ocrtypes.ReportContext, report ocrtypes.Report) []byte {
+	var raw [3 * 32]byte
+	copy(raw[:32], reportContext.ConfigDigest[:])
+	binary.BigEndian.PutUint32(raw[59:63], reportContext.Epoch)
+	raw[63] = reportContext.Round
+	copy(raw[64:], reportContext.ExtraHash[:])
+
+	h := sha256.New()
+	h.Write(raw[:])
+	h.Write(report)
+	return h.Sum(nil)
+}
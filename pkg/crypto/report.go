@@ -0,0 +1,52 @@
+// Package crypto verifies the oracle signatures of OCR2 reports, independently of the key type of a chain. It only
+// depends on libocr types, so monitoring and other off-chain consumers of reports, including LOOP plugins, can check
+// reports without chain specific code.
+package crypto
+
+import (
+	"errors"
+	"fmt"
+
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+)
+
+// Verifier verifies a signature of a report by a single oracle, for one key type. It is implemented by
+// [ocrtypes.OnchainKeyring], so a chain's keyring can be used directly, e.g. for SECP256K1 keys, and by
+// [ED25519Verifier].
+type Verifier interface {
+	Verify(_ ocrtypes.OnchainPublicKey, _ ocrtypes.ReportContext, _ ocrtypes.Report, signature []byte) bool
+}
+
+// VerifyReport returns an error unless report was signed for reportContext by more than config.F distinct signers of
+// config, as required on chain. Signatures from unknown or repeated signers, and invalid signatures, are errors too,
+// since chains reject reports which include them.
+func VerifyReport(v Verifier, config ocrtypes.ContractConfig, reportContext ocrtypes.ReportContext, report ocrtypes.Report, sigs []ocrtypes.AttributedOnchainSignature) error {
+	if reportContext.ConfigDigest != config.ConfigDigest {
+		return fmt.Errorf("report has config digest %s, but config has %s", reportContext.ConfigDigest, config.ConfigDigest)
+	}
+	var errs []error
+	signed := make(map[int]struct{}, len(sigs))
+	for _, sig := range sigs {
+		signer := int(sig.Signer)
+		if signer >= len(config.Signers) {
+			errs = append(errs, fmt.Errorf("unknown signer %d: config has %d signers", signer, len(config.Signers)))
+			continue
+		}
+		if _, ok := signed[signer]; ok {
+			errs = append(errs, fmt.Errorf("repeated signer %d", signer))
+			continue
+		}
+		if !v.Verify(config.Signers[signer], reportContext, report, sig.Signature) {
+			errs = append(errs, fmt.Errorf("invalid signature from signer %d", signer))
+			continue
+		}
+		signed[signer] = struct{}{}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+	if len(signed) <= int(config.F) {
+		return fmt.Errorf("report has %d signatures, but at least %d are required", len(signed), int(config.F)+1)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package crypto_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/libocr/commontypes"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/crypto"
+)
+
+func TestVerifyReport(t *testing.T) {
+	const n, f = 4, 1
+	keys := make([]ed25519.PrivateKey, n)
+	config := ocrtypes.ContractConfig{ConfigDigest: ocrtypes.ConfigDigest{1, 2, 3}, F: f}
+	for i := range keys {
+		pub, priv, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		keys[i] = priv
+		config.Signers = append(config.Signers, ocrtypes.OnchainPublicKey(pub))
+	}
+	repctx := ocrtypes.ReportContext{
+		ReportTimestamp: ocrtypes.ReportTimestamp{ConfigDigest: config.ConfigDigest, Epoch: 7, Round: 3},
+		ExtraHash:       [32]byte{42},
+	}
+	report := ocrtypes.Report("report")
+	sign := func(signers ...int) (sigs []ocrtypes.AttributedOnchainSignature) {
+		for _, s := range signers {
+			sigs = append(sigs, ocrtypes.AttributedOnchainSignature{
+				Signature: crypto.SignED25519(keys[s], repctx, report),
+				Signer:    commontypes.OracleID(s),
+			})
+		}
+		return
+	}
+	v := crypto.ED25519Verifier{}
+
+	require.NoError(t, crypto.VerifyReport(v, config, repctx, report, sign(0, 3)))
+	require.NoError(t, crypto.VerifyReport(v, config, repctx, report, sign(0, 1, 2, 3)))
+
+	err := crypto.VerifyReport(v, config, repctx, report, sign(2))
+	assert.ErrorContains(t, err, "report has 1 signatures, but at least 2 are required")
+
+	err = crypto.VerifyReport(v, config, repctx, report, sign(1, 1))
+	assert.ErrorContains(t, err, "repeated signer 1")
+
+	sigs := sign(0, 1)
+	sigs[1].Signer = n
+	err = crypto.VerifyReport(v, config, repctx, report, sigs)
+	assert.ErrorContains(t, err, "unknown signer 4: config has 4 signers")
+
+	sigs = sign(0, 1, 2)
+	sigs[2].Signer = 3
+	err = crypto.VerifyReport(v, config, repctx, report, sigs)
+	assert.ErrorContains(t, err, "invalid signature from signer 3")
+
+	err = crypto.VerifyReport(v, config, repctx, ocrtypes.Report("other"), sign(0, 1))
+	assert.ErrorContains(t, err, "invalid signature from signer 0")
+	assert.ErrorContains(t, err, "invalid signature from signer 1")
+
+	other := repctx
+	other.Round++
+	err = crypto.VerifyReport(v, config, other, report, sign(0, 1))
+	assert.ErrorContains(t, err, "invalid signature from signer 0")
+
+	other = repctx
+	other.ConfigDigest = ocrtypes.ConfigDigest{9}
+	err = crypto.VerifyReport(v, config, other, report, sign(0, 1))
+	assert.ErrorContains(t, err, "report has config digest")
+}
+
+func TestED25519Verifier(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	var repctx ocrtypes.ReportContext
+	report := ocrtypes.Report("report")
+	sig := crypto.SignED25519(priv, repctx, report)
+
+	v := crypto.ED25519Verifier{}
+	assert.True(t, v.Verify(ocrtypes.OnchainPublicKey(pub), repctx, report, sig))
+	assert.False(t, v.Verify(ocrtypes.OnchainPublicKey(pub[1:]), repctx, report, sig), "short key")
+	assert.False(t, v.Verify(ocrtypes.OnchainPublicKey(pub), repctx, report, sig[1:]), "short signature")
+	assert.False(t, v.Verify(nil, repctx, report, sig))
+}
+
+func TestReportDigest(t *testing.T) {
+	repctx := ocrtypes.ReportContext{
+		ReportTimestamp: ocrtypes.ReportTimestamp{ConfigDigest: ocrtypes.ConfigDigest{1}, Epoch: 0x01020304, Round: 5},
+		ExtraHash:       [32]byte{6},
+	}
+	// sha256 of 01 00..00 | 00..00 01020304 05 | 06 00..00 | "report"
+	assert.Equal(t, "4948a273c45979bad5c90dce22088a3b75b142eef6d2d4f3482bfad57aa9fba8",
+		hex.EncodeToString(crypto.ReportDigest(repctx, ocrtypes.Report("report"))))
+}
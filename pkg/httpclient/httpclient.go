@@ -0,0 +1,275 @@
+// Package httpclient builds instrumented [*http.Client]s with sane defaults for relayers and monitors: bounded
+// timeouts, optional proxying, retries with backoff for idempotent requests, and response size limits.
+package httpclient
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/jpillora/backoff"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+)
+
+var (
+	requestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_client_requests_total",
+		Help: "Number of HTTP client request attempts, by host, method, and status code. Code is empty for transport errors.",
+	}, []string{"host", "method", "code"})
+	requestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_client_request_duration_seconds",
+		Help:    "Duration of HTTP client request attempts, until response headers are received.",
+		Buckets: []float64{0.01, 0.05, 0.1, 0.3, 0.6, 1, 3, 6, 10, 30},
+	}, []string{"host", "method"})
+	requestRetries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_client_request_retries_total",
+		Help: "Number of HTTP client request attempts which were retried.",
+	}, []string{"host", "method"})
+)
+
+// ErrResponseTooLarge is returned when reading a response body which exceeds [Config.MaxResponseBytes].
+var ErrResponseTooLarge = errors.New("http response too large")
+
+// Config configures clients built by [New].
+type Config struct {
+	// Timeout bounds each request, including retries and reading the response body. Zero is unlimited.
+	Timeout time.Duration
+	// DialTimeout bounds establishing each connection.
+	DialTimeout time.Duration
+	// TLSHandshakeTimeout bounds each TLS handshake.
+	TLSHandshakeTimeout time.Duration
+	// ResponseHeaderTimeout bounds waiting for response headers, after the request is written. Zero is unlimited.
+	ResponseHeaderTimeout time.Duration
+	// IdleConnTimeout is how long idle connections are kept open for reuse.
+	IdleConnTimeout time.Duration
+
+	// ProxyURL is the URL of an http, https, or socks5 proxy. If nil, the HTTP_PROXY, HTTPS_PROXY, and NO_PROXY
+	// environment variables are used instead.
+	ProxyURL *url.URL
+
+	// MaxResponseBytes limits the size of response bodies. Zero is unlimited.
+	MaxResponseBytes int64
+
+	// Retry configures retries of idempotent requests.
+	Retry RetryConfig
+}
+
+// RetryConfig configures retries of idempotent requests which fail with a transport error, or with a 429, 502, 503,
+// or 504 status. Requests with a body are only retried if [http.Request.GetBody] is set.
+type RetryConfig struct {
+	// MaxAttempts is the maximum number of attempts per request. Zero or one disables retries.
+	MaxAttempts int
+	// MinBackoff and MaxBackoff bound the exponential backoff between attempts.
+	MinBackoff, MaxBackoff time.Duration
+}
+
+// DefaultConfig is a reasonable Config for polling JSON APIs.
+var DefaultConfig = Config{
+	Timeout:               30 * time.Second,
+	DialTimeout:           10 * time.Second,
+	TLSHandshakeTimeout:   10 * time.Second,
+	ResponseHeaderTimeout: 20 * time.Second,
+	IdleConnTimeout:       90 * time.Second,
+	MaxResponseBytes:      10 << 20,
+	Retry: RetryConfig{
+		MaxAttempts: 3,
+		MinBackoff:  100 * time.Millisecond,
+		MaxBackoff:  2 * time.Second,
+	},
+}
+
+// Validate returns an error if c is invalid.
+func (c Config) Validate() (err error) {
+	for name, d := range map[string]time.Duration{
+		"Timeout":               c.Timeout,
+		"DialTimeout":           c.DialTimeout,
+		"TLSHandshakeTimeout":   c.TLSHandshakeTimeout,
+		"ResponseHeaderTimeout": c.ResponseHeaderTimeout,
+		"IdleConnTimeout":       c.IdleConnTimeout,
+		"Retry.MinBackoff":      c.Retry.MinBackoff,
+		"Retry.MaxBackoff":      c.Retry.MaxBackoff,
+	} {
+		if d < 0 {
+			err = errors.Join(err, fmt.Errorf("%s must not be negative: %s", name, d))
+		}
+	}
+	if c.ProxyURL != nil {
+		switch c.ProxyURL.Scheme {
+		case "http", "https", "socks5":
+		default:
+			err = errors.Join(err, fmt.Errorf("ProxyURL scheme must be http, https, or socks5: %q", c.ProxyURL.Scheme))
+		}
+	}
+	if c.MaxResponseBytes < 0 {
+		err = errors.Join(err, fmt.Errorf("MaxResponseBytes must not be negative: %d", c.MaxResponseBytes))
+	}
+	if c.Retry.MaxAttempts < 0 {
+		err = errors.Join(err, fmt.Errorf("Retry.MaxAttempts must not be negative: %d", c.Retry.MaxAttempts))
+	}
+	if c.Retry.MaxBackoff < c.Retry.MinBackoff {
+		err = errors.Join(err, fmt.Errorf("Retry.MaxBackoff must not be less than Retry.MinBackoff: %s < %s",
+			c.Retry.MaxBackoff, c.Retry.MinBackoff))
+	}
+	return
+}
+
+// New returns a client configured by cfg, which logs retries to lggr.
+func New(cfg Config, lggr logger.Logger) (*http.Client, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid http client config: %w", err)
+	}
+	proxy := http.ProxyFromEnvironment
+	if cfg.ProxyURL != nil {
+		proxy = http.ProxyURL(cfg.ProxyURL)
+	}
+	var rt http.RoundTripper = &http.Transport{
+		Proxy:                 proxy,
+		DialContext:           (&net.Dialer{Timeout: cfg.DialTimeout, KeepAlive: 30 * time.Second}).DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       cfg.IdleConnTimeout,
+		TLSHandshakeTimeout:   cfg.TLSHandshakeTimeout,
+		ResponseHeaderTimeout: cfg.ResponseHeaderTimeout,
+		ExpectContinueTimeout: time.Second,
+	}
+	rt = &instrumentedTransport{rt}
+	if cfg.MaxResponseBytes > 0 {
+		rt = &limitedTransport{rt, cfg.MaxResponseBytes}
+	}
+	if cfg.Retry.MaxAttempts > 1 {
+		rt = &retryTransport{rt: rt, cfg: cfg.Retry, lggr: logger.Named(lggr, "HTTPClient")}
+	}
+	return &http.Client{Transport: rt, Timeout: cfg.Timeout}, nil
+}
+
+// instrumentedTransport records metrics for each request attempt.
+type instrumentedTransport struct {
+	rt http.RoundTripper
+}
+
+func (t *instrumentedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.rt.RoundTrip(req)
+	requestDuration.WithLabelValues(req.URL.Host, req.Method).Observe(time.Since(start).Seconds())
+	var code string
+	if err == nil {
+		code = strconv.Itoa(resp.StatusCode)
+	}
+	requestsTotal.WithLabelValues(req.URL.Host, req.Method, code).Inc()
+	return resp, err
+}
+
+// limitedTransport fails responses with bodies larger than max.
+type limitedTransport struct {
+	rt  http.RoundTripper
+	max int64
+}
+
+func (t *limitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.rt.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.ContentLength > t.max {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("%w: content length %d exceeds limit %d", ErrResponseTooLarge, resp.ContentLength, t.max)
+	}
+	resp.Body = &limitedBody{rc: resp.Body, remaining: t.max}
+	return resp, nil
+}
+
+// limitedBody returns ErrResponseTooLarge instead of silently truncating the body.
+type limitedBody struct {
+	rc        io.ReadCloser
+	remaining int64
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	if b.remaining < 0 {
+		return 0, ErrResponseTooLarge
+	}
+	// Read one byte past the limit, to distinguish a body of exactly the limit from a larger one.
+	if int64(len(p)) > b.remaining+1 {
+		p = p[:b.remaining+1]
+	}
+	n, err := b.rc.Read(p)
+	b.remaining -= int64(n)
+	if b.remaining < 0 {
+		return n + int(b.remaining), ErrResponseTooLarge
+	}
+	return n, err
+}
+
+func (b *limitedBody) Close() error { return b.rc.Close() }
+
+// retryTransport retries idempotent requests with exponential backoff.
+type retryTransport struct {
+	rt   http.RoundTripper
+	cfg  RetryConfig
+	lggr logger.Logger
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isRetryable(req) {
+		return t.rt.RoundTrip(req)
+	}
+	b := backoff.Backoff{Min: t.cfg.MinBackoff, Max: t.cfg.MaxBackoff, Factor: 2, Jitter: true}
+	for attempt := 1; ; attempt++ {
+		resp, err := t.rt.RoundTrip(req)
+		if attempt >= t.cfg.MaxAttempts || !shouldRetry(resp, err) || req.Context().Err() != nil {
+			return resp, err
+		}
+		wait := b.Duration()
+		if err != nil {
+			t.lggr.Debugw("Retrying request after error", "method", req.Method, "host", req.URL.Host,
+				"attempt", attempt, "wait", wait, "err", err)
+		} else {
+			t.lggr.Debugw("Retrying request after status", "method", req.Method, "host", req.URL.Host,
+				"attempt", attempt, "wait", wait, "status", resp.StatusCode)
+			_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4<<10)) // drain to reuse the connection
+			_ = resp.Body.Close()
+		}
+		requestRetries.WithLabelValues(req.URL.Host, req.Method).Inc()
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(wait):
+		}
+		if req.Body != nil && req.Body != http.NoBody {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("failed to rewind request body: %w", err)
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+func isRetryable(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+	default:
+		return false
+	}
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+func shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		return !errors.Is(err, ErrResponseTooLarge)
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,184 @@
+package httpclient
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+)
+
+func testConfig() Config {
+	cfg := DefaultConfig
+	cfg.Retry.MinBackoff = time.Millisecond
+	cfg.Retry.MaxBackoff = 10 * time.Millisecond
+	return cfg
+}
+
+func newClient(t *testing.T, cfg Config) *http.Client {
+	c, err := New(cfg, logger.Test(t))
+	require.NoError(t, err)
+	return c
+}
+
+func get(t *testing.T, c *http.Client, u string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, u, nil)
+	require.NoError(t, err)
+	return c.Do(req)
+}
+
+func TestConfig_Validate(t *testing.T) {
+	require.NoError(t, DefaultConfig.Validate())
+	require.NoError(t, Config{}.Validate())
+
+	for _, tt := range []struct {
+		name   string
+		modify func(*Config)
+	}{
+		{"timeout", func(c *Config) { c.Timeout = -1 }},
+		{"proxy", func(c *Config) { c.ProxyURL = &url.URL{Scheme: "ftp", Host: "localhost"} }},
+		{"max-response-bytes", func(c *Config) { c.MaxResponseBytes = -1 }},
+		{"max-attempts", func(c *Config) { c.Retry.MaxAttempts = -1 }},
+		{"backoff", func(c *Config) { c.Retry.MaxBackoff = c.Retry.MinBackoff - 1 }},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultConfig
+			tt.modify(&cfg)
+			require.Error(t, cfg.Validate())
+			_, err := New(cfg, logger.Test(t))
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestNew_retry(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = io.WriteString(w, "ok")
+	}))
+	t.Cleanup(srv.Close)
+
+	t.Run("idempotent", func(t *testing.T) {
+		calls.Store(0)
+		resp, err := get(t, newClient(t, testConfig()), srv.URL)
+		require.NoError(t, err)
+		t.Cleanup(func() { assert.NoError(t, resp.Body.Close()) })
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, int32(3), calls.Load())
+	})
+
+	t.Run("exhausted", func(t *testing.T) {
+		calls.Store(0)
+		cfg := testConfig()
+		cfg.Retry.MaxAttempts = 2
+		resp, err := get(t, newClient(t, cfg), srv.URL)
+		require.NoError(t, err)
+		t.Cleanup(func() { assert.NoError(t, resp.Body.Close()) })
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		assert.Equal(t, int32(2), calls.Load())
+	})
+
+	t.Run("not idempotent", func(t *testing.T) {
+		calls.Store(0)
+		resp, err := newClient(t, testConfig()).Post(srv.URL, "text/plain", strings.NewReader("body"))
+		require.NoError(t, err)
+		t.Cleanup(func() { assert.NoError(t, resp.Body.Close()) })
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+}
+
+func TestNew_maxResponseBytes(t *testing.T) {
+	const body = "0123456789"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Has("chunked") {
+			w.(http.Flusher).Flush() // omit Content-Length
+		}
+		_, _ = io.WriteString(w, body)
+	}))
+	t.Cleanup(srv.Close)
+
+	for _, tt := range []struct {
+		name  string
+		limit int64
+		query string
+		err   bool
+	}{
+		{"exact", int64(len(body)), "", false},
+		{"exact-chunked", int64(len(body)), "?chunked", false},
+		{"content-length", int64(len(body) - 1), "", true},
+		{"chunked", int64(len(body) - 1), "?chunked", true},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := testConfig()
+			cfg.MaxResponseBytes = tt.limit
+			resp, err := get(t, newClient(t, cfg), srv.URL+tt.query)
+			if err == nil {
+				t.Cleanup(func() { assert.NoError(t, resp.Body.Close()) })
+				var b []byte
+				b, err = io.ReadAll(resp.Body)
+				if !tt.err {
+					require.Equal(t, body, string(b))
+				}
+			}
+			if tt.err {
+				require.ErrorIs(t, err, ErrResponseTooLarge)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNew_timeout(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	t.Cleanup(srv.Close)
+	t.Cleanup(func() { close(release) })
+
+	cfg := testConfig()
+	cfg.Timeout = 50 * time.Millisecond
+	_, err := get(t, newClient(t, cfg), srv.URL)
+	require.ErrorContains(t, err, "Client.Timeout exceeded")
+}
+
+func TestNew_proxy(t *testing.T) {
+	proxied := make(chan string, 1)
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied <- r.URL.String()
+		_, _ = io.WriteString(w, "proxied")
+	}))
+	t.Cleanup(proxy.Close)
+
+	cfg := testConfig()
+	var err error
+	cfg.ProxyURL, err = url.Parse(proxy.URL)
+	require.NoError(t, err)
+	resp, err := get(t, newClient(t, cfg), "http://example.invalid/feeds")
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, resp.Body.Close()) })
+	b, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "proxied", string(b))
+	assert.Equal(t, "http://example.invalid/feeds", <-proxied)
+}
@@ -0,0 +1,85 @@
+package logger
+
+import (
+	"strings"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// levelOverrides sets the level of named loggers, by the longest matching name prefix.
+type levelOverrides struct {
+	root     zapcore.Level
+	byPrefix map[string]zapcore.Level
+}
+
+func newLevelOverrides(root zapcore.Level, byPrefix map[string]zapcore.Level) *levelOverrides {
+	o := &levelOverrides{root: root, byPrefix: make(map[string]zapcore.Level, len(byPrefix))}
+	for p, l := range byPrefix {
+		o.byPrefix[p] = l
+	}
+	return o
+}
+
+// min returns the lowest level of any logger, which the underlying core must enable.
+func (o *levelOverrides) min() zapcore.Level {
+	lvl := o.root
+	for _, l := range o.byPrefix {
+		if l < lvl {
+			lvl = l
+		}
+	}
+	return lvl
+}
+
+// level returns the level for the logger named name.
+func (o *levelOverrides) level(name string) zapcore.Level {
+	lvl, n := o.root, -1
+	for p, l := range o.byPrefix {
+		if len(p) > n && strings.HasPrefix(name, p) {
+			lvl, n = l, len(p)
+		}
+	}
+	return lvl
+}
+
+// apply returns sl filtered to the level for name, replacing any level applied previously.
+func (o *levelOverrides) apply(sl *zap.SugaredLogger, name string) *zap.SugaredLogger {
+	lvl := o.level(name)
+	return sl.WithOptions(zap.WrapCore(func(c zapcore.Core) zapcore.Core {
+		if lc, ok := c.(*levelCore); ok {
+			c = lc.Core
+		}
+		return &levelCore{Core: c, lvl: lvl}
+	}))
+}
+
+var _ zapcore.Core = (*levelCore)(nil)
+
+// levelCore is a zapcore.Core which only passes entries enabled by lvl.
+type levelCore struct {
+	zapcore.Core
+	lvl zapcore.Level
+}
+
+func (c *levelCore) Enabled(l zapcore.Level) bool {
+	return c.lvl.Enabled(l) && c.Core.Enabled(l)
+}
+
+func (c *levelCore) Level() zapcore.Level {
+	if inner := zapcore.LevelOf(c.Core); inner > c.lvl {
+		return inner
+	}
+	return c.lvl
+}
+
+func (c *levelCore) With(fields []zapcore.Field) zapcore.Core {
+	return &levelCore{Core: c.Core.With(fields), lvl: c.lvl}
+}
+
+func (c *levelCore) Check(e zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if !c.lvl.Enabled(e.Level) {
+		return ce
+	}
+	return c.Core.Check(e, ce)
+}
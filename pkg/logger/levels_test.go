@@ -0,0 +1,53 @@
+package logger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestConfig_Levels(t *testing.T) {
+	var sink testSink
+	cfg := Config{Level: zapcore.InfoLevel, RemoteSink: &sink, Levels: map[string]zapcore.Level{
+		"PluginMedianClient":          zapcore.DebugLevel,
+		"PluginMedianClient.Provider": zapcore.WarnLevel,
+	}}
+	lggr, err := cfg.New()
+	require.NoError(t, err)
+
+	logAll := func(l Logger) {
+		l.Debug("debug")
+		l.Info("info")
+		l.Warn("warn")
+	}
+	logAll(lggr)
+	median := Named(lggr, "PluginMedianClient")
+	logAll(median)
+	logAll(Named(With(median, "foo", "bar"), "Factory"))
+	logAll(Named(median, "Provider"))
+	logAll(Named(lggr, "PluginRelayerClient"))
+
+	type entry struct {
+		Name  string
+		Level zapcore.Level
+	}
+	var got []entry
+	for _, r := range sink.take() {
+		got = append(got, entry{r.Name, r.Level})
+	}
+	assert.Equal(t, []entry{
+		{"", zapcore.InfoLevel},
+		{"", zapcore.WarnLevel},
+		{"PluginMedianClient", zapcore.DebugLevel},
+		{"PluginMedianClient", zapcore.InfoLevel},
+		{"PluginMedianClient", zapcore.WarnLevel},
+		{"PluginMedianClient.Factory", zapcore.DebugLevel},
+		{"PluginMedianClient.Factory", zapcore.InfoLevel},
+		{"PluginMedianClient.Factory", zapcore.WarnLevel},
+		{"PluginMedianClient.Provider", zapcore.WarnLevel},
+		{"PluginRelayerClient", zapcore.InfoLevel},
+		{"PluginRelayerClient", zapcore.WarnLevel},
+	}, got)
+}
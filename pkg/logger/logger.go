@@ -40,6 +40,13 @@ type Logger interface {
 
 type Config struct {
 	Level zapcore.Level
+	// RemoteSink optionally receives a copy of each record, in addition to the standard output.
+	RemoteSink RemoteSink
+	// RedactKeys are patterns for keys of sensitive values to mask. See [NewRedactCore] and [DefaultRedactKeys].
+	RedactKeys []string
+	// Levels optionally overrides Level for named loggers, by name prefix (e.g. "PluginMedianClient": debug), so that
+	// one component can be debugged without debug logs from all the others. The longest matching prefix applies.
+	Levels map[string]zapcore.Level
 }
 
 var defaultConfig Config
@@ -49,31 +56,60 @@ func New() (Logger, error) { return defaultConfig.New() }
 
 // New returns a new Logger for Config.
 func (c *Config) New() (Logger, error) {
-	return NewWith(func(cfg *zap.Config) {
-		cfg.Level.SetLevel(c.Level)
-	})
+	if len(c.Levels) == 0 {
+		return NewWith(func(cfg *zap.Config) {
+			cfg.Level.SetLevel(c.Level)
+		}, c.options(c.Level)...)
+	}
+	levels := newLevelOverrides(c.Level, c.Levels)
+	// the core must enable the lowest level, and each logger filters down to its own
+	l, err := NewWith(func(cfg *zap.Config) {
+		cfg.Level.SetLevel(levels.min())
+	}, c.options(levels.min())...)
+	if err != nil {
+		return nil, err
+	}
+	sl := l.(*logger)
+	return &logger{levels.apply(sl.SugaredLogger, ""), "", levels}, nil
 }
 
-// NewWith returns a new Logger from a modified [zap.Config].
-func NewWith(cfgFn func(*zap.Config)) (Logger, error) {
+func (c *Config) options(lvl zapcore.Level) (opts []zap.Option) {
+	if c.RemoteSink != nil {
+		remote := NewRemoteCore(c.RemoteSink, lvl)
+		opts = append(opts, zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+			return zapcore.NewTee(core, remote)
+		}))
+	}
+	if len(c.RedactKeys) > 0 {
+		keys := c.RedactKeys
+		// applied last, to wrap any RemoteSink as well
+		opts = append(opts, zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+			return NewRedactCore(core, keys...)
+		}))
+	}
+	return
+}
+
+// NewWith returns a new Logger from a modified [zap.Config], built with any additional opts.
+func NewWith(cfgFn func(*zap.Config), opts ...zap.Option) (Logger, error) {
 	cfg := zap.NewProductionConfig()
 	cfgFn(&cfg)
-	core, err := cfg.Build()
+	core, err := cfg.Build(opts...)
 	if err != nil {
 		return nil, err
 	}
-	return &logger{core.Sugar(), ""}, nil
+	return &logger{core.Sugar(), "", nil}, nil
 }
 
 // Test returns a new test Logger for tb.
 func Test(tb testing.TB) Logger {
-	return &logger{zaptest.NewLogger(tb).Sugar(), ""}
+	return &logger{zaptest.NewLogger(tb).Sugar(), "", nil}
 }
 
 // TestObserved returns a new test Logger for tb and ObservedLogs at the given Level.
 func TestObserved(tb testing.TB, lvl zapcore.Level) (Logger, *observer.ObservedLogs) {
 	sl, logs := testObserved(tb, lvl)
-	return &logger{sl, ""}, logs
+	return &logger{sl, "", nil}, logs
 }
 
 func testObserved(tb testing.TB, lvl zapcore.Level) (*zap.SugaredLogger, *observer.ObservedLogs) {
@@ -86,16 +122,17 @@ func testObserved(tb testing.TB, lvl zapcore.Level) (*zap.SugaredLogger, *observ
 
 // Nop returns a no-op Logger.
 func Nop() Logger {
-	return &logger{zap.New(zapcore.NewNopCore()).Sugar(), ""}
+	return &logger{zap.New(zapcore.NewNopCore()).Sugar(), "", nil}
 }
 
 type logger struct {
 	*zap.SugaredLogger
-	name string
+	name   string
+	levels *levelOverrides // optional
 }
 
 func (l *logger) with(args ...interface{}) Logger {
-	return &logger{l.SugaredLogger.With(args...), ""}
+	return &logger{l.SugaredLogger.With(args...), l.name, l.levels}
 }
 
 func joinName(old, new string) string {
@@ -109,6 +146,9 @@ func (l *logger) named(name string) Logger {
 	newLogger := *l
 	newLogger.name = joinName(l.name, name)
 	newLogger.SugaredLogger = l.SugaredLogger.Named(name)
+	if l.levels != nil {
+		newLogger.SugaredLogger = l.levels.apply(newLogger.SugaredLogger, newLogger.name)
+	}
 	return &newLogger
 }
 
@@ -117,7 +157,7 @@ func (l *logger) Name() string {
 }
 
 func (l *logger) helper(skip int) Logger {
-	return &logger{l.sugaredHelper(skip), l.name}
+	return &logger{l.sugaredHelper(skip), l.name, l.levels}
 }
 
 func (l *logger) sugaredHelper(skip int) *zap.SugaredLogger {
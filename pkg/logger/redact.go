@@ -0,0 +1,97 @@
+package logger
+
+import (
+	"strings"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// Redacted replaces the values of redacted fields.
+const Redacted = "[redacted]"
+
+// DefaultRedactKeys are common patterns for keys of sensitive values.
+var DefaultRedactKeys = []string{"privateKey", "mnemonic", "apiKey", "secret", "password"}
+
+// NewRedactCore returns a [zapcore.Core] which masks the values of fields with keys matching any of keys before passing
+// them along to core. Keys match case-insensitively, ignoring '_' and '-', on any substring (e.g. "apiKey" matches
+// "api_key" and "X-API-KEY").
+// Since the sugared methods convert key/value pairs to fields before they reach the core, both the sugared and
+// structured paths are covered.
+func NewRedactCore(core zapcore.Core, keys ...string) zapcore.Core {
+	if len(keys) == 0 {
+		return core
+	}
+	patterns := make([]string, len(keys))
+	for i, k := range keys {
+		patterns[i] = normalizeKey(k)
+	}
+	return &redactCore{Core: core, patterns: patterns}
+}
+
+var _ zapcore.Core = (*redactCore)(nil)
+
+type redactCore struct {
+	zapcore.Core
+	patterns []string
+}
+
+func (c *redactCore) With(fields []zapcore.Field) zapcore.Core {
+	return &redactCore{Core: c.Core.With(c.redact(fields)), patterns: c.patterns}
+}
+
+func (c *redactCore) Check(e zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(e.Level) {
+		return ce.AddCore(e, c)
+	}
+	return ce
+}
+
+func (c *redactCore) Write(e zapcore.Entry, fields []zapcore.Field) error {
+	return c.Core.Write(e, c.redact(fields))
+}
+
+// redact returns fields with matching values replaced. The original slice is not modified.
+func (c *redactCore) redact(fields []zapcore.Field) []zapcore.Field {
+	var out []zapcore.Field
+	for i, f := range fields {
+		if !c.match(f.Key) {
+			continue
+		}
+		if out == nil {
+			out = make([]zapcore.Field, len(fields))
+			copy(out, fields)
+		}
+		out[i] = zap.String(f.Key, Redacted)
+	}
+	if out == nil {
+		return fields
+	}
+	return out
+}
+
+func (c *redactCore) match(key string) bool {
+	return matchNormalized(normalizeKey(key), c.patterns)
+}
+
+// MatchKey returns true if key matches any of the patterns, in the same way as [NewRedactCore].
+func MatchKey(key string, patterns ...string) bool {
+	normalized := make([]string, len(patterns))
+	for i, p := range patterns {
+		normalized[i] = normalizeKey(p)
+	}
+	return matchNormalized(normalizeKey(key), normalized)
+}
+
+func matchNormalized(key string, patterns []string) bool {
+	for _, p := range patterns {
+		if strings.Contains(key, p) {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeKey(k string) string {
+	return strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(k))
+}
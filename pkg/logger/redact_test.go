@@ -0,0 +1,48 @@
+package logger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestNewRedactCore(t *testing.T) {
+	oCore, observed := observer.New(zapcore.DebugLevel)
+	lggr := &logger{zap.New(NewRedactCore(oCore, DefaultRedactKeys...)).Sugar(), "", nil}
+
+	lggr = With(lggr, "ocr_private_key", "abc", "chainID", 1).(*logger)
+	lggr.Infow("sugared", "API-KEY", "def", "name", "foo")
+	lggr.Desugar().Info("structured", zap.String("mnemonic", "ghi"), zap.Int("n", 2))
+
+	all := observed.TakeAll()
+	require.Len(t, all, 2)
+	assert.Equal(t, map[string]interface{}{
+		"ocr_private_key": Redacted,
+		"chainID":         int64(1),
+		"API-KEY":         Redacted,
+		"name":            "foo",
+	}, all[0].ContextMap())
+	assert.Equal(t, map[string]interface{}{
+		"ocr_private_key": Redacted,
+		"chainID":         int64(1),
+		"mnemonic":        Redacted,
+		"n":               int64(2),
+	}, all[1].ContextMap())
+}
+
+func TestConfig_RedactKeys(t *testing.T) {
+	var sink testSink
+	cfg := Config{Level: zapcore.InfoLevel, RemoteSink: &sink, RedactKeys: []string{"password"}}
+	lggr, err := cfg.New()
+	require.NoError(t, err)
+
+	lggr.Infow("msg", "dbPassword", "hunter2")
+
+	records := sink.take()
+	require.Len(t, records, 1)
+	assert.Equal(t, Redacted, records[0].Fields["dbPassword"])
+}
@@ -0,0 +1,78 @@
+package logger
+
+import (
+	"time"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// Record is a single log entry, as delivered to a [RemoteSink].
+type Record struct {
+	Time    time.Time
+	Level   zapcore.Level
+	Name    string
+	Message string
+	Caller  string // empty if undefined
+	Stack   string // empty if undefined
+	Fields  map[string]interface{}
+}
+
+// RemoteSink receives log records to ship to a remote destination, like an OpenTelemetry log exporter or collector.
+// This is useful for LOOP plugins when the host is not scraping stderr.
+// Implementations must be safe for concurrent use, and should buffer internally rather than block, since Export is
+// called inline with logging.
+type RemoteSink interface {
+	Export(Record) error
+	// Sync flushes any buffered records.
+	Sync() error
+}
+
+// NewRemoteCore returns a [zapcore.Core] which forwards entries enabled by lvl to sink.
+func NewRemoteCore(sink RemoteSink, lvl zapcore.LevelEnabler) zapcore.Core {
+	return &remoteCore{LevelEnabler: lvl, sink: sink}
+}
+
+var _ zapcore.Core = (*remoteCore)(nil)
+
+type remoteCore struct {
+	zapcore.LevelEnabler
+	sink   RemoteSink
+	fields []zapcore.Field
+}
+
+func (c *remoteCore) With(fields []zapcore.Field) zapcore.Core {
+	clone := *c
+	clone.fields = append(c.fields[:len(c.fields):len(c.fields)], fields...)
+	return &clone
+}
+
+func (c *remoteCore) Check(e zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(e.Level) {
+		return ce.AddCore(e, c)
+	}
+	return ce
+}
+
+func (c *remoteCore) Write(e zapcore.Entry, fields []zapcore.Field) error {
+	enc := zapcore.NewMapObjectEncoder()
+	for _, f := range c.fields {
+		f.AddTo(enc)
+	}
+	for _, f := range fields {
+		f.AddTo(enc)
+	}
+	r := Record{
+		Time:    e.Time,
+		Level:   e.Level,
+		Name:    e.LoggerName,
+		Message: e.Message,
+		Stack:   e.Stack,
+		Fields:  enc.Fields,
+	}
+	if e.Caller.Defined {
+		r.Caller = e.Caller.TrimmedPath()
+	}
+	return c.sink.Export(r)
+}
+
+func (c *remoteCore) Sync() error { return c.sink.Sync() }
@@ -0,0 +1,60 @@
+package logger
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestConfig_RemoteSink(t *testing.T) {
+	var sink testSink
+	cfg := Config{Level: zapcore.InfoLevel, RemoteSink: &sink}
+	lggr, err := cfg.New()
+	require.NoError(t, err)
+
+	lggr = Named(With(lggr, "foo", "bar"), "test")
+	lggr.Debugw("hidden", "a", 1)
+	lggr.Infow("visible", "a", 1)
+	_ = lggr.Sync() // stderr may not support sync
+
+	records := sink.take()
+	require.Len(t, records, 1)
+	r := records[0]
+	assert.Equal(t, zapcore.InfoLevel, r.Level)
+	assert.Equal(t, "test", r.Name)
+	assert.Equal(t, "visible", r.Message)
+	assert.NotEmpty(t, r.Caller)
+	assert.Equal(t, map[string]interface{}{"foo": "bar", "a": int64(1)}, r.Fields)
+	assert.Equal(t, 1, sink.syncs)
+}
+
+type testSink struct {
+	mu      sync.Mutex
+	records []Record
+	syncs   int
+}
+
+func (s *testSink) Export(r Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records = append(s.records, r)
+	return nil
+}
+
+func (s *testSink) Sync() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.syncs++
+	return nil
+}
+
+func (s *testSink) take() []Record {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r := s.records
+	s.records = nil
+	return r
+}
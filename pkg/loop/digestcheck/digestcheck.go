@@ -0,0 +1,81 @@
+// Package digestcheck cross-checks the config digests on chain against those computed locally by an
+// [libocr.OffchainConfigDigester], to catch misconfigured relay args (e.g. chain ID, or contract address) before they
+// surface as rejected reports.
+package digestcheck
+
+import (
+	"context"
+	"fmt"
+
+	libocr "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+// Mismatch is returned when the digest of a [libocr.ContractConfig] differs from the one computed locally.
+type Mismatch struct {
+	ConfigCount uint64
+	// OnChain is the digest of the contract config, as set on chain.
+	OnChain libocr.ConfigDigest
+	// Computed is the digest computed locally from the rest of the contract config.
+	Computed libocr.ConfigDigest
+	// Prefixes are accepted by the digester. Empty if they could not be determined.
+	Prefixes []libocr.ConfigDigestPrefix
+}
+
+// PrefixMismatch returns true if the on chain digest has a prefix which is not accepted by the digester, i.e. the
+// digester targets a different chain or contract type than the one which set the config.
+func (m *Mismatch) PrefixMismatch() bool {
+	if len(m.Prefixes) == 0 {
+		return false
+	}
+	for _, p := range m.Prefixes {
+		if p.IsPrefixOf(m.OnChain) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *Mismatch) Error() string {
+	msg := fmt.Sprintf("config digest mismatch for config %d: on chain %s but computed %s", m.ConfigCount, m.OnChain, m.Computed)
+	if m.PrefixMismatch() {
+		return msg + fmt.Sprintf(": on chain prefix %x is not one of %v; check that the relay args select the right chain and contract type", m.OnChain[:2], m.Prefixes)
+	}
+	return msg + ": check the relay args, e.g. chain ID and contract address"
+}
+
+// Check returns a [*Mismatch] if the digest of config differs from the one computed by digester.
+func Check(digester libocr.OffchainConfigDigester, config libocr.ContractConfig) error {
+	computed, err := digester.ConfigDigest(config)
+	if err != nil {
+		return fmt.Errorf("failed to compute config digest: %w", err)
+	}
+	if computed == config.ConfigDigest {
+		return nil
+	}
+	m := &Mismatch{ConfigCount: config.ConfigCount, OnChain: config.ConfigDigest, Computed: computed}
+	// The prefixes only refine the error, so failures are ignored.
+	if mp, ok := digester.(types.MultiPrefixConfigDigester); ok {
+		m.Prefixes, _ = mp.ConfigDigestPrefixes()
+	} else if prefix, err := digester.ConfigDigestPrefix(); err == nil {
+		m.Prefixes = []libocr.ConfigDigestPrefix{prefix}
+	}
+	return m
+}
+
+// CheckLatest is like [Check], for the latest config from tracker. It returns nil if no config has been set yet.
+func CheckLatest(ctx context.Context, tracker libocr.ContractConfigTracker, digester libocr.OffchainConfigDigester) error {
+	changedInBlock, digest, err := tracker.LatestConfigDetails(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get latest config details: %w", err)
+	}
+	if digest == (libocr.ConfigDigest{}) {
+		return nil
+	}
+	config, err := tracker.LatestConfig(ctx, changedInBlock)
+	if err != nil {
+		return fmt.Errorf("failed to get latest config: %w", err)
+	}
+	return Check(digester, config)
+}
@@ -0,0 +1,79 @@
+package digestcheck_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	libocr "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/digestcheck"
+)
+
+type fakeDigester struct {
+	digest libocr.ConfigDigest
+	prefix libocr.ConfigDigestPrefix
+}
+
+func (f fakeDigester) ConfigDigest(libocr.ContractConfig) (libocr.ConfigDigest, error) {
+	return f.digest, nil
+}
+
+func (f fakeDigester) ConfigDigestPrefix() (libocr.ConfigDigestPrefix, error) { return f.prefix, nil }
+
+type fakeTracker struct {
+	libocr.ContractConfigTracker // only LatestConfigDetails and LatestConfig are implemented
+	config                       libocr.ContractConfig
+}
+
+func (f fakeTracker) LatestConfigDetails(context.Context) (uint64, libocr.ConfigDigest, error) {
+	return 10, f.config.ConfigDigest, nil
+}
+
+func (f fakeTracker) LatestConfig(_ context.Context, changedInBlock uint64) (libocr.ContractConfig, error) {
+	if changedInBlock != 10 {
+		return libocr.ContractConfig{}, errors.New("unexpected block")
+	}
+	return f.config, nil
+}
+
+func TestCheckLatest(t *testing.T) {
+	ctx := context.Background()
+	evmDigest := libocr.ConfigDigest{0: 0, 1: 1, 31: 1}
+	otherEVMDigest := libocr.ConfigDigest{0: 0, 1: 1, 31: 2}
+	solanaDigest := libocr.ConfigDigest{0: 0, 1: 3, 31: 1}
+	evm := fakeDigester{evmDigest, libocr.ConfigDigestPrefixEVM}
+
+	t.Run("match", func(t *testing.T) {
+		require.NoError(t, digestcheck.CheckLatest(ctx, fakeTracker{config: libocr.ContractConfig{ConfigDigest: evmDigest}}, evm))
+	})
+
+	t.Run("no config", func(t *testing.T) {
+		require.NoError(t, digestcheck.CheckLatest(ctx, fakeTracker{}, evm))
+	})
+
+	t.Run("mismatch", func(t *testing.T) {
+		err := digestcheck.CheckLatest(ctx, fakeTracker{config: libocr.ContractConfig{ConfigDigest: otherEVMDigest, ConfigCount: 3}}, evm)
+		var mismatch *digestcheck.Mismatch
+		require.ErrorAs(t, err, &mismatch)
+		assert.Equal(t, &digestcheck.Mismatch{
+			ConfigCount: 3,
+			OnChain:     otherEVMDigest,
+			Computed:    evmDigest,
+			Prefixes:    []libocr.ConfigDigestPrefix{libocr.ConfigDigestPrefixEVM},
+		}, mismatch)
+		assert.False(t, mismatch.PrefixMismatch())
+		assert.ErrorContains(t, err, "chain ID and contract address")
+	})
+
+	t.Run("prefix mismatch", func(t *testing.T) {
+		err := digestcheck.CheckLatest(ctx, fakeTracker{config: libocr.ContractConfig{ConfigDigest: solanaDigest}}, evm)
+		var mismatch *digestcheck.Mismatch
+		require.ErrorAs(t, err, &mismatch)
+		assert.True(t, mismatch.PrefixMismatch())
+		assert.ErrorContains(t, err, "on chain prefix 0003 is not one of [0001]")
+	})
+}
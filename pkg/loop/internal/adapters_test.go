@@ -0,0 +1,253 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	libocr "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/pb"
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+// emptyReplyConn replies to every unary RPC with an empty message, i.e. with every field nil or missing, as a buggy or
+// older server might.
+type emptyReplyConn struct{}
+
+func (emptyReplyConn) Invoke(context.Context, string, interface{}, interface{}, ...grpc.CallOption) error {
+	return nil
+}
+
+func (emptyReplyConn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, errors.New("streams not supported")
+}
+
+// TestClientAdapters_emptyReplies checks that every client adapter handles a reply without any fields set, by
+// returning an error or zero values, instead of panicking.
+func TestClientAdapters_emptyReplies(t *testing.T) {
+	ctx := context.Background()
+	var cc emptyReplyConn
+	b := &brokerExt{BrokerConfig: BrokerConfig{StopCh: make(chan struct{}), Logger: logger.Test(t)}}
+	obs := []median.ParsedAttributedObservation{{Value: big.NewInt(1), JuelsPerFeeCoin: big.NewInt(1)}}
+
+	configDigester := &offchainConfigDigesterClient{b, pb.NewOffchainConfigDigesterClient(cc)}
+	configTracker := newContractConfigTrackerClient(b, cc)
+	dataSource := newDataSourceClient(b.Logger, cc)
+	errorLog := errorLogClient{pb.NewErrorLogClient(cc)}
+	eventQuerier := &eventQuerierClient{b.Logger, pb.NewEventQuerierClient(cc)}
+	gasEstimator := &gasEstimatorClient{b.Logger, pb.NewGasEstimatorClient(cc)}
+	headTracker := &headTrackerClient{b.Logger, pb.NewHeadTrackerClient(cc)}
+	keyValueStore := newKeyValueStoreClient(b.Logger, cc)
+	reportCodec := &reportCodecClient{brokerExt: b, grpc: pb.NewReportCodecClient(cc)}
+	medianContract := &medianContractClient{b.Logger, pb.NewMedianContractClient(cc)}
+	onchainConfigCodec := &onchainConfigCodecClient{b, pb.NewOnchainConfigCodecClient(cc)}
+	contractTransmitter := newContractTransmitterClient(b, cc)
+	keystore := &keystoreClient{b.Logger, pb.NewKeystoreClient(cc)}
+	relayer := &relayerClient{brokerExt: b, serviceClient: newServiceClient(b, cc), transactorClient: newTransactorClient(b.Logger, cc), relayer: pb.NewRelayerClient(cc)}
+	reportingPluginFactory := &reportingPluginFactoryClient{brokerExt: b, serviceClient: newServiceClient(b, cc), grpc: pb.NewReportingPluginFactoryClient(cc)}
+	reportingPlugin := &reportingPluginClient{brokerExt: b, grpc: pb.NewReportingPluginClient(cc)}
+	service := newServiceClient(b, cc)
+
+	for _, tt := range []struct {
+		name string
+		call func() error
+	}{
+		{"OffchainConfigDigester.ConfigDigest", func() error {
+			_, err := configDigester.ConfigDigest(libocr.ContractConfig{})
+			return err
+		}},
+		{"OffchainConfigDigester.ConfigDigestPrefix", func() error {
+			_, err := configDigester.ConfigDigestPrefix()
+			return err
+		}},
+		{"OffchainConfigDigester.ConfigDigestPrefixes", func() error {
+			_, err := configDigester.ConfigDigestPrefixes()
+			return err
+		}},
+		{"ContractConfigTracker.LatestConfigDetails", func() error {
+			_, _, err := configTracker.LatestConfigDetails(ctx)
+			return err
+		}},
+		{"ContractConfigTracker.LatestConfig", func() error {
+			_, err := configTracker.LatestConfig(ctx, 1)
+			return err
+		}},
+		{"ContractConfigTracker.LatestBlockHeight", func() error {
+			_, err := configTracker.LatestBlockHeight(ctx)
+			return err
+		}},
+		{"DataSource.Observe", func() error {
+			_, err := dataSource.Observe(ctx, libocr.ReportTimestamp{})
+			return err
+		}},
+		{"ErrorLog.SaveError", func() error {
+			return errorLog.SaveError(ctx, "msg")
+		}},
+		{"EventQuerier.FilteredLogs", func() error {
+			_, err := eventQuerier.FilteredLogs(ctx, types.EventFilter{})
+			return err
+		}},
+		{"GasEstimator.GetFee", func() error {
+			_, err := gasEstimator.GetFee(ctx, nil, 1, big.NewInt(1))
+			return err
+		}},
+		{"GasEstimator.BumpFee", func() error {
+			_, err := gasEstimator.BumpFee(ctx, types.Fee{}, big.NewInt(1))
+			return err
+		}},
+		{"HeadTracker.LatestHead", func() error {
+			_, err := headTracker.LatestHead(ctx)
+			return err
+		}},
+		{"KeyValueStore.Get", func() error {
+			_, _, err := keyValueStore.Get(ctx, "ns", "key")
+			return err
+		}},
+		{"ReportCodec.BuildReport", func() error {
+			_, err := reportCodec.BuildReport(obs)
+			return err
+		}},
+		{"ReportCodec.BuildReportExt", func() error {
+			_, err := reportCodec.BuildReportExt(ctx, []types.ParsedAttributedObservationExt{{ParsedAttributedObservation: obs[0]}})
+			return err
+		}},
+		{"ReportCodec.MedianFromReport", func() error {
+			_, err := reportCodec.MedianFromReport(nil)
+			return err
+		}},
+		{"ReportCodec.MaxReportLength", func() error {
+			_, err := reportCodec.MaxReportLength(4)
+			return err
+		}},
+		{"MedianContract.LatestTransmissionDetails", func() error {
+			_, _, _, _, _, err := medianContract.LatestTransmissionDetails(ctx)
+			return err
+		}},
+		{"MedianContract.LatestRoundRequested", func() error {
+			_, _, _, err := medianContract.LatestRoundRequested(ctx, time.Minute)
+			return err
+		}},
+		{"OnchainConfigCodec.Encode", func() error {
+			_, err := onchainConfigCodec.Encode(median.OnchainConfig{Min: big.NewInt(0), Max: big.NewInt(1)})
+			return err
+		}},
+		{"OnchainConfigCodec.Decode", func() error {
+			_, err := onchainConfigCodec.Decode(nil)
+			return err
+		}},
+		{"ContractTransmitter.LatestConfigDigestAndEpoch", func() error {
+			_, _, err := contractTransmitter.LatestConfigDigestAndEpoch(ctx)
+			return err
+		}},
+		{"ContractTransmitter.FromAccount", func() error {
+			_, err := contractTransmitter.FromAccount()
+			return err
+		}},
+		{"ContractTransmitter.FromAccountForReport", func() error {
+			_, err := contractTransmitter.FromAccountForReport(ctx, libocr.ReportContext{})
+			return err
+		}},
+		{"Keystore.Accounts", func() error {
+			_, err := keystore.Accounts(ctx)
+			return err
+		}},
+		{"Keystore.Sign", func() error {
+			_, err := keystore.Sign(ctx, "account", nil)
+			return err
+		}},
+		{"Relayer.ChainStatus", func() error {
+			_, err := relayer.ChainStatus(ctx, "id")
+			return err
+		}},
+		{"Relayer.ChainStatuses", func() error {
+			_, _, err := relayer.ChainStatuses(ctx, 0, 10)
+			return err
+		}},
+		{"Relayer.NodeStatuses", func() error {
+			_, _, err := relayer.NodeStatuses(ctx, 0, 10)
+			return err
+		}},
+		{"Relayer.RPCStatuses", func() error {
+			_, err := relayer.RPCStatuses(ctx)
+			return err
+		}},
+		{"Transactor.Transact", func() error {
+			_, err := relayer.Transact(ctx, types.TransactRequest{})
+			return err
+		}},
+		{"Transactor.TxStatus", func() error {
+			_, err := relayer.TxStatus(ctx, "id")
+			return err
+		}},
+		{"ReportingPluginFactory.NewReportingPlugin", func() error {
+			_, _, err := reportingPluginFactory.NewReportingPlugin(libocr.ReportingPluginConfig{})
+			return err
+		}},
+		{"ReportingPlugin.Query", func() error {
+			_, err := reportingPlugin.Query(ctx, libocr.ReportTimestamp{})
+			return err
+		}},
+		{"ReportingPlugin.Observation", func() error {
+			_, err := reportingPlugin.Observation(ctx, libocr.ReportTimestamp{}, nil)
+			return err
+		}},
+		{"ReportingPlugin.Report", func() error {
+			_, _, err := reportingPlugin.Report(ctx, libocr.ReportTimestamp{}, nil, nil)
+			return err
+		}},
+		{"ReportingPlugin.ShouldAcceptFinalizedReport", func() error {
+			_, err := reportingPlugin.ShouldAcceptFinalizedReport(ctx, libocr.ReportTimestamp{}, nil)
+			return err
+		}},
+		{"ReportingPlugin.ShouldTransmitAcceptedReport", func() error {
+			_, err := reportingPlugin.ShouldTransmitAcceptedReport(ctx, libocr.ReportTimestamp{}, nil)
+			return err
+		}},
+		{"Service.HealthReport", func() error {
+			service.HealthReport()
+			return nil
+		}},
+		{"Service.Version", func() error {
+			_, err := service.Version(ctx)
+			return err
+		}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NotPanics(t, func() {
+				err := tt.call()
+				var panicErr ErrPanic
+				assert.False(t, errors.As(err, &panicErr), "conversion panicked: %v", err)
+			})
+		})
+	}
+}
+
+func TestRecoverPanic(t *testing.T) {
+	lggr, logs := logger.TestObserved(t, zapcore.ErrorLevel)
+	convert := func(reply *pb.LatestHeadReply) (n uint64, err error) {
+		defer recoverPanic(lggr, "LatestHead", &err)
+		return reply.Head.Height, nil // nil deref
+	}
+	n, err := convert(&pb.LatestHeadReply{})
+	assert.Zero(t, n)
+	var panicErr ErrPanic
+	require.ErrorAs(t, err, &panicErr)
+	assert.Equal(t, "LatestHead", panicErr.Method)
+	assert.ErrorContains(t, err, "LatestHead")
+	assert.NotEmpty(t, panicErr.Stack)
+
+	entries := logs.FilterMessage("Recovered from panic").All()
+	require.Len(t, entries, 1)
+	assert.Equal(t, "LatestHead", entries[0].ContextMap()["method"])
+	assert.Equal(t, string(panicErr.Stack), entries[0].ContextMap()["stack"])
+}
@@ -3,14 +3,17 @@ package internal
 import (
 	"context"
 	"fmt"
-	"io"
+	"hash/fnv"
 	"net"
 	"sync"
 	"sync/atomic"
+	"time"
 
+	"github.com/google/uuid"
 	"google.golang.org/grpc"
 
 	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/pb"
 	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
 )
 
@@ -51,16 +54,130 @@ type GRPCOpts struct {
 	// Optionally override the default *grpc.Server constructor.
 	// Normally aligned with [plugin.ServeConfig.GRPCServer].
 	NewServer func([]grpc.ServerOption) *grpc.Server
+	// Optionally override the default maximum message size (4MiB) for sending and receiving on brokered connections.
+	// Must be aligned between host and plugin.
+	MaxMsgSize int
+	// Optionally override the size above which large payloads (e.g. observations, reports, and transmissions) are
+	// split into a stream of chunks of at most ChunkSize bytes. Defaults to the max message size, less framing.
+	ChunkSize int
+	// Optionally set a default gRPC service config (JSON) for brokered client connections, to configure method-level
+	// timeouts and retry policies, e.g. for "loop.DataSource" method "Observe".
+	// See https://github.com/grpc/grpc/blob/master/doc/service_config.md. Hedging policies are not yet supported by
+	// grpc-go, and are ignored.
+	ServiceConfig string
+	// Optionally compress the messages of brokered client connections, and their replies, with the named compressor,
+	// e.g. [CompressorGzip] or [CompressorSnappy], to trade CPU for bandwidth on large payloads. Must be registered by
+	// both host and plugin, so older peers may only use gzip.
+	Compression string
+	// Optionally override Compression for connections to resources by name, e.g. "MedianProvider" or
+	// "ReportingPlugin". An empty value disables compression.
+	CompressionByName map[string]string
+	// Optionally override how long brokered servers wait for in-flight RPCs to complete once stopped by StopCh or a
+	// Close RPC, before they are stopped forcibly, which cancels the RPCs' contexts. Defaults to one second.
+	DrainTimeout time.Duration
+}
+
+// maxMsgSize returns MaxMsgSize, or the gRPC default if unset.
+func (o GRPCOpts) maxMsgSize() int {
+	if o.MaxMsgSize > 0 {
+		return o.MaxMsgSize
+	}
+	return defaultMaxMsgSize
+}
+
+const defaultMaxMsgSize = 4 * 1024 * 1024 // from grpc
+
+const defaultDrainTimeout = time.Second
+
+// drainTimeout returns DrainTimeout, or the default if unset.
+func (o GRPCOpts) drainTimeout() time.Duration {
+	if o.DrainTimeout > 0 {
+		return o.DrainTimeout
+	}
+	return defaultDrainTimeout
+}
+
+// chunkSize returns ChunkSize, or the largest which fits in the max message size.
+func (o GRPCOpts) chunkSize() int {
+	if o.ChunkSize > 0 {
+		return o.ChunkSize
+	}
+	return o.maxMsgSize() - chunkOverhead
+}
+
+// IDAllocator allocates the IDs of brokered connections.
+type IDAllocator interface {
+	// NextID returns a new ID for serving the named resource. IDs must never be reused.
+	NextID(name string) uint32
+}
+
+// DeterministicIDs is an [IDAllocator] for tests, which derives each ID from the resource name and the number of
+// previous allocations for that name, rather than from the order of all allocations. IDs are stable across runs, even
+// when resources are served concurrently, so long as each name is allocated in a consistent order.
+// The zero value is ready to use.
+type DeterministicIDs struct {
+	mu   sync.Mutex
+	seq  map[string]uint32
+	used map[uint32]struct{}
+}
+
+func (d *DeterministicIDs) NextID(name string) uint32 {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.seq == nil {
+		d.seq = make(map[string]uint32)
+		d.used = make(map[uint32]struct{})
+	}
+	n := d.seq[name]
+	d.seq[name] = n + 1
+
+	h := fnv.New32a()
+	_, _ = fmt.Fprintf(h, "%s/%d", name, n)
+	id := h.Sum32()
+	for {
+		if _, ok := d.used[id]; !ok && id != 0 {
+			break
+		}
+		id++ // collision
+	}
+	d.used[id] = struct{}{}
+	return id
 }
 
 // BrokerConfig holds Broker configuration fields.
 type BrokerConfig struct {
-	StopCh <-chan struct{}
+	StopCh utils.StopRChan
 	Logger logger.Logger
 
+	// IDs optionally overrides the broker's internal counter for allocating connection IDs, e.g. with
+	// [DeterministicIDs] to record and replay RPC sequences.
+	IDs IDAllocator
+	// Resources optionally counts the servers and dialed connections of this broker, e.g. to report them per plugin, or
+	// to check for leaks in tests.
+	Resources *ResourceCounts
+	// AccountFamily optionally validates the accounts of ContractTransmitters received over this broker, with the
+	// validator registered for it by [types.RegisterAccountValidator].
+	AccountFamily string
+	// ObserveLimiter optionally limits the rate of Observe calls from plugins, per DataSource served to them. Calls
+	// over the limit fail with [codes.ResourceExhausted].
+	ObserveLimiter *utils.RateLimiter
+	// ErrorLogLimiter optionally limits the rate of SaveError calls from plugins, per ErrorLog served to them. Calls
+	// over the limit fail with [codes.ResourceExhausted].
+	ErrorLogLimiter *utils.RateLimiter
+
 	GRPCOpts // optional
 }
 
+// rateLimitKey returns a new key for a server named name to take from limiter, and a Resource which removes it once
+// the server stops, or nothing if limiter is nil.
+func rateLimitKey(name string, limiter *utils.RateLimiter) (string, []*Resource) {
+	if limiter == nil {
+		return "", nil
+	}
+	key := name + "/" + uuid.NewString()
+	return key, []*Resource{NewResource(name+"RateLimit", fnCloser(func() { limiter.Remove(key) }))}
+}
+
 // brokerExt extends a Broker with various helper methods.
 type brokerExt struct {
 	broker Broker
@@ -84,38 +201,122 @@ func (b *brokerExt) newClientConn(name string, newClient newClientFn) *clientCon
 }
 
 func (b *brokerExt) stopCtx() (context.Context, context.CancelFunc) {
-	return utils.ContextFromChan(b.StopCh)
+	return b.StopCh.NewCtx()
+}
+
+// dial dials the named resource served on connection id.
+func (b *brokerExt) dial(name string, id uint32) (*brokerConn, error) {
+	compressor, err := b.compressor(name)
+	if err != nil {
+		return nil, err
+	}
+	opts := b.DialOpts[:len(b.DialOpts):len(b.DialOpts)]
+	if compressor != "" {
+		opts = append(opts, grpc.WithDefaultCallOptions(grpc.UseCompressor(compressor)))
+	}
+	if b.MaxMsgSize > 0 {
+		opts = append(opts, grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(b.MaxMsgSize), grpc.MaxCallSendMsgSize(b.MaxMsgSize)))
+	}
+	if b.ServiceConfig != "" {
+		opts = append(opts, grpc.WithDefaultServiceConfig(b.ServiceConfig))
+	}
+	conn, err := b.broker.DialWithOptions(id, opts...)
+	if err != nil {
+		return nil, err
+	}
+	b.Resources.addDialed(1)
+	return &brokerConn{ClientConn: conn, resources: b.Resources}, nil
 }
 
-func (b *brokerExt) dial(id uint32) (conn *grpc.ClientConn, err error) {
-	return b.broker.DialWithOptions(id, b.DialOpts...)
+// brokerConn is a [*grpc.ClientConn] dialed via a broker, which is counted by its [BrokerConfig.Resources] until closed.
+type brokerConn struct {
+	*grpc.ClientConn
+	resources *ResourceCounts
+	closeOnce sync.Once
 }
 
-func (b *brokerExt) serveNew(name string, register func(*grpc.Server), deps ...resource) (uint32, resource, error) {
+func (c *brokerConn) Close() error {
+	c.closeOnce.Do(func() { c.resources.addDialed(-1) })
+	return c.ClientConn.Close()
+}
+
+func (b *brokerExt) nextID(name string) uint32 {
+	if b.IDs != nil {
+		return b.IDs.NextID(name)
+	}
+	return b.broker.NextId()
+}
+
+// serveNew serves a new server with the services added by register, until the returned Resource is closed, the StopCh
+// is closed, or a Service or ReportingPlugin is closed via RPC, which leaves nothing else worth serving.
+func (b *brokerExt) serveNew(name string, register func(*grpc.Server), deps ...*Resource) (uint32, *Resource, error) {
+	closed := make(chan struct{})
+	var closeOnce sync.Once
+	opts := []grpc.ServerOption{grpc.ChainUnaryInterceptor(closeInterceptor(func() { closeOnce.Do(func() { close(closed) }) }))}
+	if b.MaxMsgSize > 0 {
+		opts = append(opts, grpc.MaxRecvMsgSize(b.MaxMsgSize), grpc.MaxSendMsgSize(b.MaxMsgSize))
+	}
 	var server *grpc.Server
 	if b.NewServer == nil {
-		server = grpc.NewServer()
+		server = grpc.NewServer(opts...)
 	} else {
-		server = b.NewServer(nil)
+		server = b.NewServer(opts)
 	}
 	register(server)
-	return b.serve(name, server, deps...)
+	return b.serveUntil(name, server, closed, deps...)
 }
 
-func (b *brokerExt) serve(name string, server *grpc.Server, deps ...resource) (uint32, resource, error) {
-	id := b.broker.NextId()
+// closeInterceptor calls closed after each Close RPC.
+func closeInterceptor(closed func()) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		switch info.FullMethod {
+		case pb.Service_Close_FullMethodName, pb.ReportingPlugin_Close_FullMethodName:
+			closed()
+		}
+		return resp, err
+	}
+}
+
+// gracefulStop stops server after in-flight RPCs complete, or forcibly after timeout.
+func (b *brokerExt) gracefulStop(name string, server *grpc.Server, timeout time.Duration) {
+	t := time.AfterFunc(timeout, func() {
+		b.Logger.Warnw(fmt.Sprintf("Forcing stop of %s after drain timeout", name), "timeout", timeout)
+		server.Stop()
+	})
+	defer t.Stop()
+	server.GracefulStop()
+}
+
+func (b *brokerExt) serve(name string, server *grpc.Server, deps ...*Resource) (uint32, *Resource, error) {
+	return b.serveUntil(name, server, nil, deps...)
+}
+
+// serveUntil serves server like serve, and also stops it gracefully once closed is closed.
+//
+// Servers stop accepting new RPCs as soon as StopCh or closed is closed, then wait up to [GRPCOpts.DrainTimeout] for
+// in-flight RPCs to complete before stopping forcibly. Closing the returned Resource stops the server forcibly. Either
+// way, deps are only closed once the server has stopped, so they remain available to in-flight RPCs.
+func (b *brokerExt) serveUntil(name string, server *grpc.Server, closed <-chan struct{}, deps ...*Resource) (uint32, *Resource, error) {
+	id := b.nextID(name)
 	b.Logger.Debugf("Serving %s on connection %d", name, id)
 	lis, err := b.broker.Accept(id)
 	if err != nil {
 		b.closeAll(deps...)
-		return 0, resource{}, ErrConnAccept{Name: name, ID: id, Err: err}
+		return 0, nil, ErrConnAccept{Name: name, ID: id, Err: err}
 	}
 
+	b.Resources.add(name, 1)
+	served := make(chan struct{})  // closed once Serve returns
+	stopped := make(chan struct{}) // closed once the server has stopped
 	var wg sync.WaitGroup
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
+		defer b.Resources.add(name, -1)
 		defer b.closeAll(deps...)
+		defer func() { <-stopped }() // Serve returns before in-flight RPCs complete
+		defer close(served)
 		if err := server.Serve(lis); err != nil {
 			b.Logger.Errorw(fmt.Sprintf("Failed to serve %s on connection %d", name, id), "err", err)
 		}
@@ -125,51 +326,96 @@ func (b *brokerExt) serve(name string, server *grpc.Server, deps ...resource) (u
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
+		defer close(stopped)
 		select {
 		case <-b.StopCh:
-			server.Stop()
+			b.gracefulStop(name, server, b.drainTimeout())
+		case <-closed:
+			b.gracefulStop(name, server, b.drainTimeout())
 		case <-done:
+			server.Stop()
+		case <-served:
+			server.Stop()
 		}
 	}()
 
-	return id, resource{fnCloser(func() {
-		server.Stop()
+	return id, NewResource(name, fnCloser(func() {
+		server.Stop() // also interrupts draining
 		close(done)
 		wg.Wait()
-	}), name}, nil
+	})), nil
 }
 
-func (b *brokerExt) closeAll(deps ...resource) {
-	for _, d := range deps {
-		if err := d.Close(); err != nil {
-			b.Logger.Error(fmt.Sprintf("Error closing %s", d.name), "err", err)
-		}
+// counter counts by name, and is safe for concurrent use.
+type counter struct {
+	mu     sync.Mutex
+	counts map[string]int
+}
+
+func (c *counter) add(name string, delta int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.counts == nil {
+		c.counts = make(map[string]int)
+	}
+	c.counts[name] += delta
+	if c.counts[name] == 0 {
+		delete(c.counts, name)
 	}
 }
 
-type resource struct {
-	io.Closer
-	name string
+func (c *counter) get() map[string]int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	counts := make(map[string]int, len(c.counts))
+	for n, i := range c.counts {
+		counts[n] = i
+	}
+	return counts
 }
 
-type resources []resource
+// ResourceCounts counts the servers of a broker accepting brokered connections, by name, and the connections dialed
+// via the broker which have not been closed. The zero value is ready to use, and a nil *ResourceCounts counts nothing.
+type ResourceCounts struct {
+	counter counter
+	dialed  atomic.Int64
+}
 
-func (rs *resources) Add(r resource) {
-	*rs = append(*rs, r)
+func (r *ResourceCounts) add(name string, delta int) {
+	if r != nil {
+		r.counter.add(name, delta)
+	}
 }
 
-func (rs *resources) Stop(s interface{ Stop() }, name string) {
-	rs.Add(resource{fnCloser(s.Stop), name})
+func (r *ResourceCounts) addDialed(delta int64) {
+	if r != nil {
+		r.dialed.Add(delta)
+	}
 }
 
-func (rs *resources) Close(c io.Closer, name string) {
-	rs.Add(resource{c, name})
+// Get returns the current counts of servers.
+func (r *ResourceCounts) Get() map[string]int {
+	if r == nil {
+		return nil
+	}
+	return r.counter.get()
 }
 
-// fnCloser implements io.Closer with a func().
-type fnCloser func()
+// Dialed returns the number of dialed connections which have not been closed.
+func (r *ResourceCounts) Dialed() int {
+	if r == nil {
+		return 0
+	}
+	return int(r.dialed.Load())
+}
 
-func (s fnCloser) Close() error {
-	s()
-	return nil
+// closeAll closes deps, waiting up to closeTimeout, and logs any errors.
+func (b *brokerExt) closeAll(deps ...*Resource) {
+	ctx, cancel := context.WithTimeout(context.Background(), closeTimeout)
+	defer cancel()
+	for _, d := range deps {
+		if err := d.Close(ctx); err != nil {
+			b.Logger.Errorw("Error closing resource", "err", err)
+		}
+	}
 }
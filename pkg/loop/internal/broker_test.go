@@ -0,0 +1,109 @@
+package internal
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/pb"
+)
+
+// testBroker serves a single connection in memory.
+type testBroker struct {
+	lis *bufconn.Listener
+}
+
+func (b *testBroker) Accept(uint32) (net.Listener, error) { return b.lis, nil }
+
+func (b *testBroker) DialWithOptions(_ uint32, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	return grpc.Dial("bufconn", append(opts,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return b.lis.DialContext(ctx) }),
+	)...)
+}
+
+func (b *testBroker) NextId() uint32 { return 1 }
+
+// blockingService blocks Ready until released, or cancelled.
+type blockingService struct {
+	pb.UnimplementedServiceServer
+	started  chan struct{}
+	release  chan struct{}
+	canceled atomic.Bool
+}
+
+func (s *blockingService) Ready(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	close(s.started)
+	select {
+	case <-s.release:
+		return &emptypb.Empty{}, nil
+	case <-ctx.Done():
+		s.canceled.Store(true)
+		return nil, ctx.Err()
+	}
+}
+
+func TestBrokerExt_serveUntil_stop(t *testing.T) {
+	for _, test := range []struct {
+		name         string
+		drainTimeout time.Duration
+		release      bool
+	}{
+		{"drained", time.Minute, true},
+		{"forced", 100 * time.Millisecond, false},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			stopCh := make(chan struct{})
+			b := &brokerExt{
+				broker: &testBroker{bufconn.Listen(1 << 20)},
+				BrokerConfig: BrokerConfig{
+					StopCh:   stopCh,
+					Logger:   logger.Test(t),
+					GRPCOpts: GRPCOpts{DrainTimeout: test.drainTimeout},
+				},
+			}
+			svc := &blockingService{started: make(chan struct{}), release: make(chan struct{})}
+			var depClosed atomic.Bool
+			dep := NewResource("dep", fnCloser(func() { depClosed.Store(true) }))
+			id, res, err := b.serveNew("Test", func(s *grpc.Server) { pb.RegisterServiceServer(s, svc) }, dep)
+			require.NoError(t, err)
+			conn, err := b.dial("Test", id)
+			require.NoError(t, err)
+			t.Cleanup(func() {
+				assert.NoError(t, conn.Close())
+				assert.NoError(t, res.Close(context.Background()))
+			})
+
+			errCh := make(chan error, 1)
+			go func() {
+				_, err := pb.NewServiceClient(conn).Ready(context.Background(), &emptypb.Empty{})
+				errCh <- err
+			}()
+			<-svc.started
+			close(stopCh)
+
+			time.Sleep(50 * time.Millisecond)
+			assert.False(t, depClosed.Load(), "dependencies remain open while draining")
+			if test.release {
+				close(svc.release)
+				require.NoError(t, <-errCh)
+				assert.False(t, svc.canceled.Load())
+			} else {
+				require.Error(t, <-errCh)
+				require.Eventually(t, svc.canceled.Load, time.Second, 10*time.Millisecond, "forced stop cancels RPCs")
+			}
+			require.Eventually(t, depClosed.Load, time.Second, 10*time.Millisecond)
+		})
+	}
+}
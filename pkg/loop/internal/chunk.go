@@ -0,0 +1,61 @@
+package internal
+
+import (
+	"errors"
+	"io"
+
+	"google.golang.org/protobuf/proto"
+
+	libocr "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/pb"
+)
+
+const (
+	chunkOverhead = 16 // Chunk field tag and length
+
+	// maxChunkedMsgSize limits the size of messages reassembled from chunks, to the largest libocr permits plus
+	// generous room for signatures and other fields.
+	maxChunkedMsgSize = libocr.MaxMaxReportLength + 1024*1024
+)
+
+type chunkSender interface {
+	Send(*pb.Chunk) error
+}
+
+// sendChunked marshals m and sends it in chunks of at most size bytes. At least one chunk is always sent.
+func sendChunked(s chunkSender, m proto.Message, size int) error {
+	b, err := proto.Marshal(m)
+	if err != nil {
+		return err
+	}
+	for len(b) > size {
+		if err = s.Send(&pb.Chunk{Data: b[:size]}); err != nil {
+			return err
+		}
+		b = b[size:]
+	}
+	return s.Send(&pb.Chunk{Data: b})
+}
+
+type chunkReceiver interface {
+	Recv() (*pb.Chunk, error)
+}
+
+// recvChunked receives chunks until the end of the stream, and unmarshals them into m.
+func recvChunked(r chunkReceiver, m proto.Message) error {
+	var b []byte
+	for {
+		c, err := r.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return err
+		}
+		if size := len(b) + len(c.Data); size > maxChunkedMsgSize {
+			return ErrMaxChunkedMsgSize{Size: size, MaxSize: maxChunkedMsgSize}
+		}
+		b = append(b, c.Data...)
+	}
+	return proto.Unmarshal(b, m)
+}
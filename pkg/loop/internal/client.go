@@ -2,6 +2,9 @@ package internal
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -33,8 +36,18 @@ func (a *atomicClient) NewStream(ctx context.Context, desc *grpc.StreamDesc, met
 
 var _ grpc.ClientConnInterface = (*clientConn)(nil)
 
-// newClientFn returns a new client connection id to dial, and a set of resource dependencies to close.
-type newClientFn func(context.Context) (id uint32, deps resources, err error)
+// newClientFn returns a new client connection id to dial, and a set of resource dependencies to close. To keep deps
+// open for the next attempt instead, e.g. because the remote side may still use them, return an [errPendingDeps].
+type newClientFn func(context.Context) (id uint32, deps Resources, err error)
+
+// errPendingDeps is returned by a newClientFn along with dependencies to keep open for the next attempt, which must
+// return them again. They are closed along with the clientConn, if it is closed first.
+type errPendingDeps struct {
+	error
+	deps Resources
+}
+
+func (e errPendingDeps) Unwrap() error { return e.error }
 
 // clientConn is a [grpc.ClientConnInterface] backed by a [*grpc.ClientConn] which can be recreated and swapped out
 // via the provided [newClientFn].
@@ -43,80 +56,197 @@ type clientConn struct {
 	*brokerExt
 	newClient newClientFn
 	name      string
+	idle      *idleTracker // optional, see withIdleTimeout
 
-	mu   sync.RWMutex
-	deps resources
-	cc   *grpc.ClientConn
+	mu      sync.RWMutex
+	deps    Resources
+	pending Resources // from errPendingDeps
+	cc      *brokerConn
+	stale   bool          // cc had a stream fail with a terminal error, and must be refreshed before next use
+	closed  bool          // never refresh again
+	dormant bool          // with idle tracking: the remote service has not been created yet, or was shut down for idleness
+	awake   chan struct{} // with idle tracking: closed once no longer dormant
+}
+
+// Close closes the connection and its dependencies. Later calls fail with [net.ErrClosed].
+func (c *clientConn) Close() (err error) {
+	if c.idle != nil {
+		c.idle.stop()
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return nil
+	}
+	c.closed = true
+	if c.cc != nil {
+		err = c.cc.Close()
+		c.cc = nil
+	}
+	c.closeAll(c.deps...)
+	c.deps = nil
+	c.closeAll(c.pending...)
+	c.pending = nil
+	return
+}
+
+// errClosed returns the error for calls which found no connection, due to ctx or Close.
+func (c *clientConn) errClosed(ctx context.Context) error {
+	if err := context.Cause(ctx); err != nil {
+		return err
+	}
+	return fmt.Errorf("%s: %w", c.name, net.ErrClosed)
 }
 
 func (c *clientConn) Invoke(ctx context.Context, method string, args interface{}, reply interface{}, opts ...grpc.CallOption) error {
+	if c.idle != nil {
+		if passiveMethods[method] {
+			if c.isDormant() {
+				return nil // answered locally, with an empty reply
+			}
+		} else {
+			c.idle.acquire()
+			defer c.idle.release()
+		}
+	}
+
 	c.mu.RLock()
-	cc := c.cc
+	cc, stale := c.cc, c.stale
 	c.mu.RUnlock()
 
-	if cc == nil {
-		cc = c.refresh(ctx, nil)
+	if cc == nil || stale {
+		cc = c.refresh(ctx, cc)
 	}
 	for cc != nil {
 		err := cc.Invoke(ctx, method, args, reply, opts...)
-		if isErrTerminal(err) {
+		// Canceled calls are only terminal for the connection if the caller didn't cancel them.
+		if isErrTerminal(err) && ctx.Err() == nil {
 			c.Logger.Warnw("clientConn: Invoke: terminal error, refreshing connection", "err", err)
 			cc = c.refresh(ctx, cc)
 			continue
 		}
 		return err
 	}
-	return context.Cause(ctx)
+	return c.errClosed(ctx)
 }
 
 func (c *clientConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	release := func() {}
+	if c.idle != nil {
+		if passiveMethods[method] {
+			if awake, dormant := c.dormantAwake(); dormant {
+				return &dormantStream{ctx: ctx, awake: awake}, nil
+			}
+		} else {
+			release = c.idle.acquireStream(ctx)
+		}
+	}
+
 	c.mu.RLock()
-	cc := c.cc
+	cc, stale := c.cc, c.stale
 	c.mu.RUnlock()
 
-	if cc == nil {
-		cc = c.refresh(ctx, nil)
+	if cc == nil || stale {
+		cc = c.refresh(ctx, cc)
 	}
 	for cc != nil {
 		s, err := cc.NewStream(ctx, desc, method, opts...)
-		if isErrTerminal(err) {
+		if isErrTerminal(err) && ctx.Err() == nil {
 			c.Logger.Warnw("clientConn: NewStream: terminal error, refreshing connection", "err", err)
 			cc = c.refresh(ctx, cc)
 			continue
 		}
-		return s, err
+		if err != nil {
+			release()
+			return nil, err
+		}
+		return &clientStream{ClientStream: s, c: c, cc: cc, serverStreams: desc.ServerStreams, release: release}, nil
 	}
-	return nil, context.Cause(ctx)
+	release()
+	return nil, c.errClosed(ctx)
+}
+
+// markStale flags cc to be refreshed before next use, unless it has already been replaced.
+func (c *clientConn) markStale(cc *brokerConn) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.cc == cc && !c.stale {
+		c.Logger.Warn("clientConn: stream terminated, connection will be refreshed")
+		c.stale = true
+	}
+}
+
+// clientStream is a [grpc.ClientStream] which marks its clientConn as stale when the stream fails with a terminal
+// error. This matters for proxied connections, where the peer may restart in between calls on long-lived streams.
+type clientStream struct {
+	grpc.ClientStream
+	c             *clientConn
+	cc            *brokerConn
+	serverStreams bool   // otherwise the first reply ends the stream
+	release       func() // ends idle tracking of the stream
 }
 
-// refresh replaces c.cc with a new (different from orig) *grpc.ClientConn, and returns it as well.
+func (s *clientStream) SendMsg(m interface{}) error {
+	err := s.ClientStream.SendMsg(m)
+	s.check(err)
+	return err
+}
+
+func (s *clientStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	s.check(err)
+	if err != nil || !s.serverStreams {
+		s.release() // the stream is over
+	}
+	return err
+}
+
+func (s *clientStream) check(err error) {
+	// Canceled streams are only terminal for the connection if the caller didn't cancel them.
+	if isErrTerminal(err) && s.Context().Err() == nil {
+		s.c.markStale(s.cc)
+	}
+}
+
+// refresh replaces c.cc with a new (different from orig) *brokerConn, and returns it as well.
 // It will block until a new connection is successfully dialed, or return nil if the context expires.
-func (c *clientConn) refresh(ctx context.Context, orig *grpc.ClientConn) *grpc.ClientConn {
+func (c *clientConn) refresh(ctx context.Context, orig *brokerConn) *brokerConn {
 	c.mu.Lock()
 	defer c.mu.Unlock()
+	if c.closed {
+		return nil
+	}
 	if c.cc != orig {
 		return c.cc
 	}
+	c.stale = false
 	if c.cc != nil {
 		if err := c.cc.Close(); err != nil {
 			c.Logger.Errorw("Client close failed", "err", err)
 		}
+		c.cc = nil
 		c.closeAll(c.deps...)
 	}
 
 	try := func() bool {
 		c.Logger.Debug("Client refresh")
 		id, deps, err := c.newClient(ctx)
+		c.pending = nil
 		if err != nil {
 			c.Logger.Errorw("Client refresh attempt failed", "err", err)
+			var pending errPendingDeps
+			if errors.As(err, &pending) {
+				c.pending = pending.deps
+			}
 			c.closeAll(deps...)
 			return false
 		}
 		c.deps = deps
+		c.setDormant(false)
 
 		lggr := logger.With(c.Logger, "id", id)
 		lggr.Debug("Client dial")
-		c.cc, err = c.dial(id)
+		c.cc, err = c.dial(c.name, id)
 		if err != nil {
 			if ctx.Err() != nil {
 				lggr.Errorw("Client dial failed", "err", ErrConnDial{Name: c.name, ID: id, Err: err})
@@ -0,0 +1,211 @@
+package internal
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/pb"
+)
+
+type providerIdleTimeoutKey struct{}
+
+// ContextWithProviderIdleTimeout returns a copy of ctx carrying timeout, for PluginRelayerClient.NewRelayer. Providers
+// created by the relayer are then shut down after being unused for timeout, and transparently restarted on next use.
+func ContextWithProviderIdleTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, providerIdleTimeoutKey{}, timeout)
+}
+
+// providerIdleTimeoutFromContext returns the timeout set on ctx by [ContextWithProviderIdleTimeout], or 0 if none was set.
+func providerIdleTimeoutFromContext(ctx context.Context) time.Duration {
+	timeout, _ := ctx.Value(providerIdleTimeoutKey{}).(time.Duration)
+	return timeout
+}
+
+// idleShutdownTimeout bounds closing the remote service of an idle connection.
+const idleShutdownTimeout = time.Second
+
+// passiveMethods do not count as use of a connection, and are answered locally with an empty reply while the remote
+// service is dormant, so that health checks neither keep an idle service running, nor start it again. Streams wait
+// instead, see [dormantStream], so that subscriptions like config notifications resume once the service is in use.
+var passiveMethods = map[string]bool{
+	pb.Service_Close_FullMethodName:                         true,
+	pb.Service_Ready_FullMethodName:                         true,
+	pb.Service_HealthReport_FullMethodName:                  true,
+	pb.ContractConfigTracker_NotifyNewConfig_FullMethodName: true,
+}
+
+// idleTracker calls onIdle once nothing has been in use for timeout.
+type idleTracker struct {
+	timeout time.Duration
+	onIdle  func()
+
+	mu       sync.Mutex
+	inUse    int
+	gen      uint64 // incremented to invalidate pending timers
+	timer    *time.Timer
+	disabled bool
+}
+
+func newIdleTracker(timeout time.Duration, onIdle func()) *idleTracker {
+	return &idleTracker{timeout: timeout, onIdle: onIdle}
+}
+
+// acquire marks a call as in use, until release is called.
+func (t *idleTracker) acquire() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.inUse++
+	t.gen++
+}
+
+func (t *idleTracker) release() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.inUse--
+	if t.inUse > 0 || t.disabled {
+		return
+	}
+	t.gen++
+	gen := t.gen
+	if t.timer != nil {
+		t.timer.Stop()
+	}
+	t.timer = time.AfterFunc(t.timeout, func() {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		if t.gen != gen || t.inUse > 0 || t.disabled {
+			return
+		}
+		// onIdle runs with t.mu held, so that new calls wait for it to complete
+		t.onIdle()
+	})
+}
+
+// acquireStream marks a stream as in use, until the returned func is called, or ctx is done.
+func (t *idleTracker) acquireStream(ctx context.Context) (release func()) {
+	t.acquire()
+	done := make(chan struct{})
+	var once sync.Once
+	release = func() {
+		once.Do(func() {
+			close(done)
+			t.release()
+		})
+	}
+	go func() {
+		select {
+		case <-ctx.Done():
+			release()
+		case <-done:
+		}
+	}()
+	return
+}
+
+// stop disables the tracker, and any pending timer.
+func (t *idleTracker) stop() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.disabled = true
+	t.gen++
+	if t.timer != nil {
+		t.timer.Stop()
+	}
+}
+
+// withIdleTimeout enables shutting down the remote service of c after being unused for timeout, if greater than 0.
+// The service is not started until first used, and is transparently restarted on next use after a shutdown.
+func (c *clientConn) withIdleTimeout(timeout time.Duration) *clientConn {
+	if timeout > 0 {
+		c.idle = newIdleTracker(timeout, c.shutdownIdle)
+		c.setDormant(true)
+	}
+	return c
+}
+
+// setDormant updates c.dormant, and c.awake to be closed once the remote service is running again. c.mu must be held,
+// unless c is not in use yet.
+func (c *clientConn) setDormant(dormant bool) {
+	if dormant == c.dormant {
+		return
+	}
+	c.dormant = dormant
+	if dormant {
+		c.awake = make(chan struct{})
+	} else {
+		close(c.awake)
+		c.awake = nil
+	}
+}
+
+// dormantAwake returns true if the remote service is dormant, along with a channel which is closed once it is running.
+func (c *clientConn) dormantAwake() (<-chan struct{}, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.awake, c.dormant
+}
+
+// isDormant returns true if the remote service is not running, because it has not been used yet, or was idle.
+func (c *clientConn) isDormant() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.dormant
+}
+
+// shutdownIdle closes the remote service and the connection, so that they are recreated on next use.
+func (c *clientConn) shutdownIdle() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed || c.cc == nil {
+		return
+	}
+	c.Logger.Infow("Shutting down idle client", "idleTimeout", c.idle.timeout)
+	ctx, cancel := c.StopCh.CtxCancel(context.WithTimeout(context.Background(), idleShutdownTimeout))
+	defer cancel()
+	if _, err := pb.NewServiceClient(c.cc).Close(ctx, &emptypb.Empty{}); err != nil {
+		c.Logger.Errorw("Failed to close idle service", "err", err)
+	}
+	if err := c.cc.Close(); err != nil {
+		c.Logger.Errorw("Client close failed", "err", err)
+	}
+	c.cc = nil
+	c.stale = false
+	c.closeAll(c.deps...)
+	c.deps = nil
+	c.setDormant(true)
+}
+
+var _ grpc.ClientStream = (*dormantStream)(nil)
+
+// dormantStream is a passive stream of a dormant clientConn. It receives nothing until the remote service is started by
+// other use, and then ends with [io.EOF], so that the caller subscribes again to the running service.
+type dormantStream struct {
+	ctx   context.Context
+	awake <-chan struct{}
+}
+
+func (s *dormantStream) Header() (metadata.MD, error) { return nil, nil }
+
+func (s *dormantStream) Trailer() metadata.MD { return nil }
+
+func (s *dormantStream) CloseSend() error { return nil }
+
+func (s *dormantStream) Context() context.Context { return s.ctx }
+
+func (s *dormantStream) SendMsg(interface{}) error { return nil }
+
+func (s *dormantStream) RecvMsg(interface{}) error {
+	select {
+	case <-s.ctx.Done():
+		return status.FromContextError(s.ctx.Err()).Err()
+	case <-s.awake:
+		return io.EOF
+	}
+}
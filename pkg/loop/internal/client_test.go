@@ -0,0 +1,78 @@
+package internal
+
+import (
+	"context"
+	"io"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/pb"
+)
+
+func TestClientConn_pendingDeps(t *testing.T) {
+	b := &brokerExt{
+		broker:       &testBroker{bufconn.Listen(1 << 20)},
+		BrokerConfig: BrokerConfig{StopCh: make(chan struct{}), Logger: logger.Test(t)},
+	}
+	var closed atomic.Bool
+	dep := NewResource("dep", fnCloser(func() { closed.Store(true) }))
+	ctx, cancel := context.WithCancel(context.Background())
+	cc := b.newClientConn("Test", func(context.Context) (uint32, Resources, error) {
+		cancel() // give up after the first attempt
+		return 0, nil, errPendingDeps{context.DeadlineExceeded, Resources{dep}}
+	})
+
+	err := cc.Invoke(ctx, pb.Service_Ready_FullMethodName, &emptypb.Empty{}, &emptypb.Empty{})
+	require.ErrorIs(t, err, context.Canceled)
+	assert.False(t, closed.Load(), "pending dependencies must be kept for the next attempt")
+
+	require.NoError(t, cc.Close())
+	assert.True(t, closed.Load(), "pending dependencies must be closed along with the connection")
+}
+
+func TestClientConn_dormantStream(t *testing.T) {
+	b := &brokerExt{
+		broker:       &testBroker{bufconn.Listen(1 << 20)},
+		BrokerConfig: BrokerConfig{StopCh: make(chan struct{}), Logger: logger.Test(t)},
+	}
+	cc := b.newClientConn("Test", func(context.Context) (uint32, Resources, error) {
+		t.Error("passive streams must not start a dormant service")
+		return 0, nil, context.Canceled
+	}).withIdleTimeout(time.Hour)
+	t.Cleanup(func() { assert.NoError(t, cc.Close()) })
+
+	t.Run("awake", func(t *testing.T) {
+		tracker := pb.NewContractConfigTrackerClient(cc)
+		stream, err := tracker.NotifyNewConfig(context.Background(), &pb.NotifyNewConfigRequest{})
+		require.NoError(t, err)
+		recvErr := make(chan error, 1)
+		go func() {
+			_, err := stream.Recv()
+			recvErr <- err
+		}()
+		select {
+		case err := <-recvErr:
+			t.Fatalf("dormant stream ended early: %v", err)
+		case <-time.After(100 * time.Millisecond):
+		}
+
+		cc.mu.Lock()
+		cc.setDormant(false)
+		cc.setDormant(true)
+		cc.mu.Unlock()
+		require.ErrorIs(t, <-recvErr, io.EOF)
+	})
+
+	t.Run("close", func(t *testing.T) {
+		tracker := newContractConfigTrackerClient(b, cc)
+		tracker.Notify()
+		tracker.close() // waits for the subscription to end
+	})
+}
@@ -0,0 +1,87 @@
+package internal
+
+import (
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/golang/snappy"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/encoding/gzip"
+)
+
+const (
+	// CompressorGzip names the gzip compressor, for [GRPCOpts.Compression]. It has the best ratio, at a high CPU cost.
+	CompressorGzip = gzip.Name
+	// CompressorSnappy names the snappy compressor, for [GRPCOpts.Compression]. It is much cheaper than gzip, but
+	// compresses less.
+	CompressorSnappy = "snappy"
+)
+
+func init() {
+	encoding.RegisterCompressor(&snappyCompressor{})
+}
+
+// compressor returns the name of the compressor for connections to the named resource, or "" for none.
+func (o GRPCOpts) compressor(name string) (string, error) {
+	c, ok := o.CompressionByName[name]
+	if !ok {
+		c = o.Compression
+	}
+	if c != "" && encoding.GetCompressor(c) == nil {
+		return "", fmt.Errorf("unknown compressor %q", c)
+	}
+	return c, nil
+}
+
+// snappyCompressor is an [encoding.Compressor] using the snappy framing format, with pooled writers and readers.
+type snappyCompressor struct {
+	writers, readers sync.Pool
+}
+
+func (c *snappyCompressor) Name() string { return CompressorSnappy }
+
+func (c *snappyCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	sw, ok := c.writers.Get().(*snappyWriter)
+	if !ok {
+		sw = &snappyWriter{Writer: snappy.NewBufferedWriter(w), pool: &c.writers}
+	} else {
+		sw.Reset(w)
+	}
+	return sw, nil
+}
+
+func (c *snappyCompressor) Decompress(r io.Reader) (io.Reader, error) {
+	sr, ok := c.readers.Get().(*snappyReader)
+	if !ok {
+		sr = &snappyReader{Reader: snappy.NewReader(r), pool: &c.readers}
+	} else {
+		sr.Reset(r)
+	}
+	return sr, nil
+}
+
+// snappyWriter returns itself to the pool when closed.
+type snappyWriter struct {
+	*snappy.Writer
+	pool *sync.Pool
+}
+
+func (w *snappyWriter) Close() error {
+	defer w.pool.Put(w)
+	return w.Writer.Close()
+}
+
+// snappyReader returns itself to the pool once fully read.
+type snappyReader struct {
+	*snappy.Reader
+	pool *sync.Pool
+}
+
+func (r *snappyReader) Read(p []byte) (n int, err error) {
+	n, err = r.Reader.Read(p)
+	if err == io.EOF {
+		r.pool.Put(r)
+	}
+	return
+}
@@ -0,0 +1,117 @@
+package internal_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/internal"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/pb"
+)
+
+var compressors = []string{internal.CompressorGzip, internal.CompressorSnappy}
+
+func TestCompressors(t *testing.T) {
+	payload := benchmarkReports(t, 64<<10)
+	for _, name := range compressors {
+		name := name
+		t.Run(name, func(t *testing.T) {
+			c := encoding.GetCompressor(name)
+			require.NotNil(t, c, "registered")
+			for i := 0; i < 3; i++ { // reuses pooled writers and readers
+				compressed := compress(t, c, payload)
+				require.Less(t, len(compressed), len(payload))
+				require.Equal(t, payload, decompress(t, c, compressed))
+			}
+		})
+	}
+}
+
+func compress(t testing.TB, c encoding.Compressor, payload []byte) []byte {
+	var buf bytes.Buffer
+	w, err := c.Compress(&buf)
+	require.NoError(t, err)
+	_, err = w.Write(payload)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func decompress(t testing.TB, c encoding.Compressor, compressed []byte) []byte {
+	r, err := c.Decompress(bytes.NewReader(compressed))
+	require.NoError(t, err)
+	payload, err := io.ReadAll(r)
+	require.NoError(t, err)
+	return payload
+}
+
+// benchmarkObservations returns a marshaled BuildReportRequest with the observations of 31 oracles.
+func benchmarkObservations(t testing.TB) []byte {
+	var req pb.BuildReportRequest
+	for i := 0; i < 31; i++ {
+		value := new(big.Int).Mul(big.NewInt(int64(1_850_000_000+i*1013)), big.NewInt(1e10))
+		req.Observations = append(req.Observations, &pb.ParsedAttributedObservation{
+			Timestamp:       uint32(1_700_000_000 + i),
+			Value:           pb.NewBigIntFromInt(value),
+			JulesPerFeeCoin: pb.NewBigIntFromInt(big.NewInt(int64(5e15 + i*7))),
+			Observer:        uint32(i),
+		})
+	}
+	b, err := proto.Marshal(&req)
+	require.NoError(t, err)
+	return b
+}
+
+// benchmarkReports returns size bytes of bulk reports, like mercury's: fixed-width fields, with feed IDs, timestamps,
+// and prices close to each other.
+func benchmarkReports(t testing.TB, size int) []byte {
+	rnd := rand.New(rand.NewSource(1)) //nolint:gosec
+	var buf bytes.Buffer
+	for feed := uint64(0); buf.Len() < size; feed++ {
+		var word [32]byte
+		binary.BigEndian.PutUint64(word[24:], feed)
+		buf.Write(word[:]) // feed ID
+		binary.BigEndian.PutUint64(word[24:], 1_700_000_000+uint64(rnd.Intn(3)))
+		buf.Write(word[:]) // observations timestamp
+		// benchmark, bid, ask
+		for i := 0; i < 3; i++ {
+			binary.BigEndian.PutUint64(word[24:], 1_850_000_000_000+uint64(rnd.Intn(1_000_000)))
+			buf.Write(word[:])
+		}
+		_, _ = io.CopyN(&buf, rnd, 64) // signatures
+	}
+	return buf.Bytes()[:size]
+}
+
+// BenchmarkCompression measures the cost and ratio of each compressor, for small and large payloads. The ratio is the
+// compressed size relative to the original.
+func BenchmarkCompression(b *testing.B) {
+	for _, payload := range []struct {
+		name string
+		data []byte
+	}{
+		{"observations", benchmarkObservations(b)},
+		{"reports-1MiB", benchmarkReports(b, 1<<20)},
+	} {
+		for _, name := range compressors {
+			c := encoding.GetCompressor(name)
+			b.Run(payload.name+"/"+name, func(b *testing.B) {
+				b.ReportAllocs()
+				b.SetBytes(int64(len(payload.data)))
+				var compressed []byte
+				for i := 0; i < b.N; i++ {
+					compressed = compress(b, c, payload.data)
+					decompress(b, c, compressed)
+				}
+				b.ReportMetric(float64(len(compressed))/float64(len(payload.data)), "ratio")
+			})
+		}
+	}
+}
@@ -2,14 +2,24 @@ package internal
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
 	"math"
+	"net"
+	"sync"
+	"time"
 
+	"github.com/jpillora/backoff"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	libocr "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
 
-	"github.com/smartcontractkit/chainlink-relay/pkg/loop/internal/pb"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/pb"
 	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
 )
 
 var _ types.ConfigProvider = (*configProviderClient)(nil)
@@ -18,15 +28,23 @@ type configProviderClient struct {
 	*serviceClient
 	offchainDigester libocr.OffchainConfigDigester
 	contractTracker  libocr.ContractConfigTracker
+	trackerClient    *contractConfigTrackerClient // contractTracker may be wrapped
 }
 
 func newConfigProviderClient(b *brokerExt, cc grpc.ClientConnInterface) *configProviderClient {
 	c := &configProviderClient{serviceClient: newServiceClient(b, cc)}
 	c.offchainDigester = &offchainConfigDigesterClient{b, pb.NewOffchainConfigDigesterClient(cc)}
-	c.contractTracker = &contractConfigTrackerClient{pb.NewContractConfigTrackerClient(cc)}
+	c.trackerClient = newContractConfigTrackerClient(b, cc)
+	c.contractTracker = c.trackerClient
 	return c
 }
 
+// Close stops config notifications, and then closes the remote provider.
+func (c *configProviderClient) Close() error {
+	c.trackerClient.close()
+	return c.serviceClient.Close()
+}
+
 func (c *configProviderClient) OffchainConfigDigester() libocr.OffchainConfigDigester {
 	return c.offchainDigester
 }
@@ -35,7 +53,30 @@ func (c *configProviderClient) ContractConfigTracker() libocr.ContractConfigTrac
 	return c.contractTracker
 }
 
-var _ libocr.OffchainConfigDigester = (*offchainConfigDigesterClient)(nil)
+var (
+	_ types.PluginProvider = (*pluginProviderClient)(nil)
+	_ GRPCClientConn       = (*pluginProviderClient)(nil)
+)
+
+// pluginProviderClient is a [types.PluginProvider] which exposes its connection for product specific services.
+type pluginProviderClient struct {
+	*configProviderClient
+	contractTransmitter libocr.ContractTransmitter
+}
+
+func newPluginProviderClient(b *brokerExt, cc grpc.ClientConnInterface) *pluginProviderClient {
+	p := &pluginProviderClient{configProviderClient: newConfigProviderClient(b.withName("PluginProviderClient"), cc)}
+	p.contractTransmitter = newContractTransmitterClient(b, p.cc)
+	return p
+}
+
+func (p *pluginProviderClient) ClientConn() grpc.ClientConnInterface { return p.cc }
+
+func (p *pluginProviderClient) ContractTransmitter() libocr.ContractTransmitter {
+	return p.contractTransmitter
+}
+
+var _ types.MultiPrefixConfigDigester = (*offchainConfigDigesterClient)(nil)
 
 type offchainConfigDigesterClient struct {
 	*brokerExt
@@ -43,6 +84,7 @@ type offchainConfigDigesterClient struct {
 }
 
 func (o *offchainConfigDigesterClient) ConfigDigest(config libocr.ContractConfig) (digest libocr.ConfigDigest, err error) {
+	defer recoverPanic(o.Logger, "OffchainConfigDigester.ConfigDigest", &err)
 	ctx, cancel := o.stopCtx()
 	defer cancel()
 
@@ -53,15 +95,15 @@ func (o *offchainConfigDigesterClient) ConfigDigest(config libocr.ContractConfig
 	if err != nil {
 		return
 	}
-	if l := len(reply.ConfigDigest); l != 32 {
-		err = ErrConfigDigestLen(l)
+	if err = reply.Validate(); err != nil {
 		return
 	}
 	copy(digest[:], reply.ConfigDigest)
 	return
 }
 
-func (o *offchainConfigDigesterClient) ConfigDigestPrefix() (libocr.ConfigDigestPrefix, error) {
+func (o *offchainConfigDigesterClient) ConfigDigestPrefix() (_ libocr.ConfigDigestPrefix, err error) {
+	defer recoverPanic(o.Logger, "OffchainConfigDigester.ConfigDigestPrefix", &err)
 	ctx, cancel := o.stopCtx()
 	defer cancel()
 
@@ -69,7 +111,30 @@ func (o *offchainConfigDigesterClient) ConfigDigestPrefix() (libocr.ConfigDigest
 	if err != nil {
 		return 0, err
 	}
-	return libocr.ConfigDigestPrefix(reply.ConfigDigestPrefix), nil
+	return configDigestPrefix(reply.ConfigDigestPrefix)
+}
+
+// ConfigDigestPrefixes falls back to ConfigDigestPrefix for older servers.
+func (o *offchainConfigDigesterClient) ConfigDigestPrefixes() (_ []libocr.ConfigDigestPrefix, err error) {
+	defer recoverPanic(o.Logger, "OffchainConfigDigester.ConfigDigestPrefixes", &err)
+	ctx, cancel := o.stopCtx()
+	defer cancel()
+
+	reply, err := o.grpc.ConfigDigestPrefix(ctx, &pb.ConfigDigestPrefixRequest{})
+	if err != nil {
+		return nil, err
+	}
+	pbPrefixes := reply.ConfigDigestPrefixes
+	if len(pbPrefixes) == 0 {
+		pbPrefixes = []uint32{reply.ConfigDigestPrefix}
+	}
+	prefixes := make([]libocr.ConfigDigestPrefix, len(pbPrefixes))
+	for i, p := range pbPrefixes {
+		if prefixes[i], err = configDigestPrefix(p); err != nil {
+			return nil, err
+		}
+	}
+	return prefixes, nil
 }
 
 var _ pb.OffchainConfigDigesterServer = (*offchainConfigDigesterServer)(nil)
@@ -80,8 +145,8 @@ type offchainConfigDigesterServer struct {
 }
 
 func (o *offchainConfigDigesterServer) ConfigDigest(ctx context.Context, request *pb.ConfigDigestRequest) (*pb.ConfigDigestReply, error) {
-	if request.ContractConfig.F > math.MaxUint8 {
-		return nil, ErrUint8Bounds{Name: "F", U: request.ContractConfig.F}
+	if err := request.Validate(); err != nil {
+		return nil, err
 	}
 	cc := libocr.ContractConfig{
 		ConfigCount:           request.ContractConfig.ConfigCount,
@@ -101,6 +166,10 @@ func (o *offchainConfigDigesterServer) ConfigDigest(ctx context.Context, request
 	if err != nil {
 		return nil, err
 	}
+	// Catch malformed digesters here, rather than later in libocr.
+	if err = CheckConfigDigestPrefix(o.impl, cd); err != nil {
+		return nil, err
+	}
 	return &pb.ConfigDigestReply{ConfigDigest: cd[:]}, nil
 }
 
@@ -109,33 +178,169 @@ func (o *offchainConfigDigesterServer) ConfigDigestPrefix(ctx context.Context, r
 	if err != nil {
 		return nil, err
 	}
-	return &pb.ConfigDigestPrefixReply{ConfigDigestPrefix: uint32(p)}, nil
+	prefixes, err := ConfigDigestPrefixes(o.impl)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.ConfigDigestPrefixReply{ConfigDigestPrefix: uint32(p)}
+	for _, p := range prefixes {
+		reply.ConfigDigestPrefixes = append(reply.ConfigDigestPrefixes, uint32(p))
+	}
+	return reply, nil
+}
+
+// ConfigDigestPrefixes returns the prefixes declared by d, via [types.MultiPrefixConfigDigester] if implemented.
+// It returns an error if any are reserved, or if they do not include ConfigDigestPrefix.
+func ConfigDigestPrefixes(d libocr.OffchainConfigDigester) ([]libocr.ConfigDigestPrefix, error) {
+	p, err := d.ConfigDigestPrefix()
+	if err != nil {
+		return nil, err
+	}
+	prefixes := []libocr.ConfigDigestPrefix{p}
+	if mp, ok := d.(types.MultiPrefixConfigDigester); ok {
+		prefixes, err = mp.ConfigDigestPrefixes()
+		if err != nil {
+			return nil, err
+		}
+	}
+	var found bool
+	for _, prefix := range prefixes {
+		if prefix == 0 || prefix == math.MaxUint16 {
+			return nil, fmt.Errorf("invalid ConfigDigestPrefix %s: reserved", prefix)
+		}
+		found = found || prefix == p
+	}
+	if !found {
+		return nil, fmt.Errorf("invalid ConfigDigestPrefixes %v: missing ConfigDigestPrefix %s", prefixes, p)
+	}
+	return prefixes, nil
+}
+
+// CheckConfigDigestPrefix returns [ErrConfigDigestPrefix] if digest does not match one of the prefixes declared by d.
+func CheckConfigDigestPrefix(d libocr.OffchainConfigDigester, digest libocr.ConfigDigest) error {
+	prefixes, err := ConfigDigestPrefixes(d)
+	if err != nil {
+		return err
+	}
+	for _, p := range prefixes {
+		if p.IsPrefixOf(digest) {
+			return nil
+		}
+	}
+	return ErrConfigDigestPrefix{Digest: digest, Prefixes: prefixes}
+}
+
+func configDigestPrefix(p uint32) (libocr.ConfigDigestPrefix, error) {
+	if p > math.MaxUint16 {
+		return 0, fmt.Errorf("expected uint16 ConfigDigestPrefix (max %d) but got %d", math.MaxUint16, p)
+	}
+	return libocr.ConfigDigestPrefix(p), nil
 }
 
 var _ libocr.ContractConfigTracker = (*contractConfigTrackerClient)(nil)
 
 type contractConfigTrackerClient struct {
+	*brokerExt
 	grpc pb.ContractConfigTrackerClient
+
+	notifyOnce sync.Once
+	notifyCh   chan struct{}
+	stopOnce   sync.Once
+	stopCh     utils.StopChan
+	wg         sync.WaitGroup
+}
+
+func newContractConfigTrackerClient(b *brokerExt, cc grpc.ClientConnInterface) *contractConfigTrackerClient {
+	return &contractConfigTrackerClient{brokerExt: b, grpc: pb.NewContractConfigTrackerClient(cc), stopCh: make(chan struct{})}
 }
 
-func (c *contractConfigTrackerClient) Notify() <-chan struct{} { return nil }
+// close stops notifications, and waits for the subscription to end.
+func (c *contractConfigTrackerClient) close() {
+	c.stopOnce.Do(func() { close(c.stopCh) })
+	c.wg.Wait()
+}
+
+// Notify subscribes to notifications from the remote tracker on first call, and resubscribes after failures until
+// closed. The channel never fires if the remote tracker does not notify, in which case libocr relies on polling.
+// The subscription does not count as use of a connection with an idle timeout, and waits while the service is dormant.
+func (c *contractConfigTrackerClient) Notify() <-chan struct{} {
+	c.notifyOnce.Do(func() {
+		c.notifyCh = make(chan struct{}, 1)
+		c.wg.Add(1)
+		go c.notifyNewConfig()
+	})
+	return c.notifyCh
+}
+
+func (c *contractConfigTrackerClient) notifyNewConfig() {
+	defer c.wg.Done()
+	ctx, cancel := c.stopCtx()
+	defer cancel()
+	ctx, cancelNotify := c.stopCh.Ctx(ctx)
+	defer cancelNotify()
+	b := backoff.Backoff{
+		Min:    100 * time.Millisecond,
+		Max:    5 * time.Second,
+		Factor: 2,
+	}
+	for {
+		stream, err := c.grpc.NotifyNewConfig(ctx, &pb.NotifyNewConfigRequest{})
+		if errors.Is(err, net.ErrClosed) || status.Code(err) == codes.Canceled {
+			return // the connection was closed
+		}
+		if err == nil {
+			for {
+				if _, err = stream.Recv(); err != nil {
+					break
+				}
+				b.Reset()
+				select {
+				case c.notifyCh <- struct{}{}:
+				default: // a notification is already pending
+				}
+			}
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		if status.Code(err) == codes.Unimplemented {
+			c.Logger.Debugw("ContractConfigTracker does not notify, relying on polling", "err", err)
+			return
+		}
+		if errors.Is(err, io.EOF) {
+			b.Reset() // the stream ended without failing, e.g. because the service is no longer dormant
+		}
+		wait := b.Duration()
+		if errors.Is(err, io.EOF) {
+			c.Logger.Debugw("ContractConfigTracker notifications ended, resubscribing", "wait", wait)
+		} else {
+			c.Logger.Warnw("ContractConfigTracker notifications failed, resubscribing", "err", err, "wait", wait)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+	}
+}
 
 func (c *contractConfigTrackerClient) LatestConfigDetails(ctx context.Context) (changedInBlock uint64, configDigest libocr.ConfigDigest, err error) {
+	defer recoverPanic(c.Logger, "ContractConfigTracker.LatestConfigDetails", &err)
 	var reply *pb.LatestConfigDetailsReply
 	reply, err = c.grpc.LatestConfigDetails(ctx, &pb.LatestConfigDetailsRequest{})
 	if err != nil {
 		return
 	}
-	changedInBlock = reply.ChangedInBlock
-	if l := len(reply.ConfigDigest); l != 32 {
-		err = ErrConfigDigestLen(l)
+	if err = reply.Validate(); err != nil {
 		return
 	}
+	changedInBlock = reply.ChangedInBlock
 	copy(configDigest[:], reply.ConfigDigest)
 	return
 }
 
 func (c *contractConfigTrackerClient) LatestConfig(ctx context.Context, changedInBlock uint64) (cfg libocr.ContractConfig, err error) {
+	defer recoverPanic(c.Logger, "ContractConfigTracker.LatestConfig", &err)
 	var reply *pb.LatestConfigReply
 	reply, err = c.grpc.LatestConfig(ctx, &pb.LatestConfigRequest{
 		ChangedInBlock: changedInBlock,
@@ -143,8 +348,7 @@ func (c *contractConfigTrackerClient) LatestConfig(ctx context.Context, changedI
 	if err != nil {
 		return
 	}
-	if l := len(reply.ContractConfig.ConfigDigest); l != 32 {
-		err = ErrConfigDigestLen(l)
+	if err = reply.Validate(); err != nil {
 		return
 	}
 	copy(cfg.ConfigDigest[:], reply.ContractConfig.ConfigDigest)
@@ -155,10 +359,6 @@ func (c *contractConfigTrackerClient) LatestConfig(ctx context.Context, changedI
 	for _, t := range reply.ContractConfig.Transmitters {
 		cfg.Transmitters = append(cfg.Transmitters, libocr.Account(t))
 	}
-	if reply.ContractConfig.F > math.MaxUint8 {
-		err = ErrUint8Bounds{Name: "F", U: reply.ContractConfig.F}
-		return
-	}
 	cfg.F = uint8(reply.ContractConfig.F)
 	cfg.OnchainConfig = reply.ContractConfig.OnchainConfig
 	cfg.OffchainConfigVersion = reply.ContractConfig.OffchainConfigVersion
@@ -168,6 +368,7 @@ func (c *contractConfigTrackerClient) LatestConfig(ctx context.Context, changedI
 }
 
 func (c *contractConfigTrackerClient) LatestBlockHeight(ctx context.Context) (blockHeight uint64, err error) {
+	defer recoverPanic(c.Logger, "ContractConfigTracker.LatestBlockHeight", &err)
 	var reply *pb.LatestBlockHeightReply
 	reply, err = c.grpc.LatestBlockHeight(ctx, &pb.LatestBlockHeightRequest{})
 	if err != nil {
@@ -208,6 +409,29 @@ func (c *contractConfigTrackerServer) LatestBlockHeight(ctx context.Context, req
 	return &pb.LatestBlockHeightReply{BlockHeight: blockHeight}, nil
 }
 
+// NotifyNewConfig sends a reply for each notification from the tracker, or fails with codes.Unimplemented if it does
+// not notify. Notifications are not fanned out, so concurrent streams each receive a share of them.
+func (c *contractConfigTrackerServer) NotifyNewConfig(_ *pb.NotifyNewConfigRequest, stream pb.ContractConfigTracker_NotifyNewConfigServer) error {
+	notify := c.impl.Notify()
+	if notify == nil {
+		return status.Errorf(codes.Unimplemented, "%T does not notify", c.impl)
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case _, ok := <-notify:
+			if !ok {
+				notify = nil // closed: no more notifications, but keep the stream open so that clients don't resubscribe
+				continue
+			}
+			if err := stream.Send(&pb.NotifyNewConfigReply{}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func pbContractConfig(cc libocr.ContractConfig) *pb.ContractConfig {
 	r := &pb.ContractConfig{
 		ConfigDigest:          cc.ConfigDigest[:],
@@ -2,16 +2,21 @@ package internal
 
 import (
 	"context"
+	"errors"
 	"math/big"
 	"os"
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
-	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+	libocr "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
 
-	"github.com/smartcontractkit/chainlink-relay/pkg/loop/internal/pb"
+	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/pb"
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
 	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
 )
 
@@ -32,14 +37,16 @@ func init() {
 var _ median.DataSource = (*dataSourceClient)(nil)
 
 type dataSourceClient struct {
+	lggr logger.Logger
 	grpc pb.DataSourceClient
 }
 
-func newDataSourceClient(cc grpc.ClientConnInterface) *dataSourceClient {
-	return &dataSourceClient{grpc: pb.NewDataSourceClient(cc)}
+func newDataSourceClient(lggr logger.Logger, cc grpc.ClientConnInterface) *dataSourceClient {
+	return &dataSourceClient{lggr: lggr, grpc: pb.NewDataSourceClient(cc)}
 }
 
-func (d *dataSourceClient) Observe(ctx context.Context, timestamp types.ReportTimestamp) (*big.Int, error) {
+func (d *dataSourceClient) Observe(ctx context.Context, timestamp libocr.ReportTimestamp) (_ *big.Int, err error) {
+	defer recoverPanic(d.lggr, "DataSource.Observe", &err)
 	reply, err := d.grpc.Observe(ctx, &pb.ObserveRequest{
 		ReportTimestamp: pbReportTimestamp(timestamp),
 	})
@@ -54,10 +61,15 @@ var _ pb.DataSourceServer = (*dataSourceServer)(nil)
 type dataSourceServer struct {
 	pb.UnimplementedDataSourceServer
 
-	impl median.DataSource
+	impl    median.DataSource
+	limiter *utils.RateLimiter // optional
+	key     string             // of limiter
 }
 
 func (d *dataSourceServer) Observe(ctx context.Context, request *pb.ObserveRequest) (*pb.ObserveReply, error) {
+	if !d.limiter.Allow(d.key) {
+		return nil, status.Error(codes.ResourceExhausted, "Observe rate limit exceeded")
+	}
 	// Pipeline observations may return results after the context is cancelled, so we modify the
 	// deadline to give them time to return before the parent context deadline.
 	// TODO: remove with https://smartcontract-it.atlassian.net/browse/BCF-2209
@@ -75,7 +87,77 @@ func (d *dataSourceServer) Observe(ctx context.Context, request *pb.ObserveReque
 	}
 	val, err := d.impl.Observe(ctx, timestamp)
 	if err != nil {
+		if errors.As(err, &ErrObservationBounds{}) {
+			return nil, status.Error(codes.OutOfRange, err.Error())
+		}
 		return nil, err
 	}
 	return &pb.ObserveReply{Value: pb.NewBigIntFromInt(val)}, nil
 }
+
+// BoundedDataSource is a median.DataSource which rejects observations outside of its [types.ObservationBounds] with
+// [ErrObservationBounds]. When passed to [PluginMedianClient.NewMedianFactory], the bounds are enforced by the host,
+// which fails such observations with [codes.OutOfRange], and also forwarded and applied on the plugin side.
+type BoundedDataSource interface {
+	median.DataSource
+	ObservationBounds() types.ObservationBounds
+}
+
+// NewBoundedDataSource returns a BoundedDataSource which applies bounds to dataSource.
+func NewBoundedDataSource(bounds types.ObservationBounds, dataSource median.DataSource) (BoundedDataSource, error) {
+	if err := bounds.Validate(); err != nil {
+		return nil, err
+	}
+	return &boundedDataSource{dataSource: dataSource, bounds: bounds}, nil
+}
+
+var _ BoundedDataSource = (*boundedDataSource)(nil)
+
+type boundedDataSource struct {
+	dataSource median.DataSource
+	bounds     types.ObservationBounds
+}
+
+func (b *boundedDataSource) Observe(ctx context.Context, timestamp libocr.ReportTimestamp) (*big.Int, error) {
+	val, err := b.dataSource.Observe(ctx, timestamp)
+	if err != nil {
+		return nil, err
+	}
+	if !b.bounds.Contains(val) {
+		return nil, ErrObservationBounds{Value: val, Bounds: b.bounds}
+	}
+	return val, nil
+}
+
+func (b *boundedDataSource) ObservationBounds() types.ObservationBounds { return b.bounds }
+
+// boundsOf returns the bounds of dataSource to forward, if any.
+func boundsOf(dataSource median.DataSource) *pb.ObservationBounds {
+	if source, ok := dataSource.(BoundedDataSource); ok {
+		return pbObservationBounds(source.ObservationBounds())
+	}
+	return nil
+}
+
+// boundDataSource applies the forwarded bounds, if any, to dataSource.
+func boundDataSource(dataSource median.DataSource, p *pb.ObservationBounds) (median.DataSource, error) {
+	if p == nil {
+		return dataSource, nil
+	}
+	return NewBoundedDataSource(observationBounds(p), dataSource)
+}
+
+func pbObservationBounds(bounds types.ObservationBounds) *pb.ObservationBounds {
+	p := &pb.ObservationBounds{}
+	if bounds.Min != nil {
+		p.Min = pb.NewBigIntFromInt(bounds.Min)
+	}
+	if bounds.Max != nil {
+		p.Max = pb.NewBigIntFromInt(bounds.Max)
+	}
+	return p
+}
+
+func observationBounds(p *pb.ObservationBounds) types.ObservationBounds {
+	return types.ObservationBounds{Min: p.Min.Int(), Max: p.Max.Int()}
+}
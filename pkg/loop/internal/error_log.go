@@ -4,13 +4,16 @@ import (
 	"context"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
-	"github.com/smartcontractkit/chainlink-relay/pkg/loop/internal/pb"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/pb"
 	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
 )
 
-var _ types.ErrorLog = (*errorLogClient)(nil)
+var _ types.ResolvableErrorLog = (*errorLogClient)(nil)
 
 type errorLogClient struct {
 	grpc pb.ErrorLogClient
@@ -21,6 +24,21 @@ func (e errorLogClient) SaveError(ctx context.Context, msg string) error {
 	return err
 }
 
+// SaveKeyedError falls back to SaveError on older hosts, which ignore the key.
+func (e errorLogClient) SaveKeyedError(ctx context.Context, key, msg string) error {
+	_, err := e.grpc.SaveError(ctx, &pb.SaveErrorRequest{Message: msg, Key: key})
+	return err
+}
+
+// ResolveError is a no-op on older hosts, which have no keyed errors to resolve.
+func (e errorLogClient) ResolveError(ctx context.Context, key string) error {
+	_, err := e.grpc.ResolveError(ctx, &pb.ResolveErrorRequest{Key: key})
+	if status.Code(err) == codes.Unimplemented {
+		return nil
+	}
+	return err
+}
+
 func newErrorLogClient(cc grpc.ClientConnInterface) *errorLogClient {
 	return &errorLogClient{pb.NewErrorLogClient(cc)}
 }
@@ -30,9 +48,24 @@ var _ pb.ErrorLogServer = (*errorLogServer)(nil)
 type errorLogServer struct {
 	pb.UnimplementedErrorLogServer
 
-	impl types.ErrorLog
+	impl    types.ErrorLog
+	limiter *utils.RateLimiter // optional
+	key     string             // of limiter
 }
 
 func (e *errorLogServer) SaveError(ctx context.Context, request *pb.SaveErrorRequest) (*emptypb.Empty, error) {
+	if !e.limiter.Allow(e.key) {
+		return nil, status.Error(codes.ResourceExhausted, "SaveError rate limit exceeded")
+	}
+	if r, ok := e.impl.(types.ResolvableErrorLog); ok && request.Key != "" {
+		return &emptypb.Empty{}, r.SaveKeyedError(ctx, request.Key, request.Message)
+	}
 	return &emptypb.Empty{}, e.impl.SaveError(ctx, request.Message)
 }
+
+func (e *errorLogServer) ResolveError(ctx context.Context, request *pb.ResolveErrorRequest) (*emptypb.Empty, error) {
+	if r, ok := e.impl.(types.ResolvableErrorLog); ok {
+		return &emptypb.Empty{}, r.ResolveError(ctx, request.Key)
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -1,8 +1,18 @@
 package internal
 
 import (
+	"errors"
 	"fmt"
-	"math"
+	"math/big"
+	"runtime/debug"
+
+	"google.golang.org/grpc/status"
+
+	libocr "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/pb"
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
 )
 
 type ErrConnAccept struct {
@@ -33,17 +43,128 @@ func (e ErrConnDial) Unwrap() error {
 	return e.Err
 }
 
-type ErrConfigDigestLen int
+// ErrMaxMsgSize is returned when [libocr.ReportingPluginLimits] permit messages which are too large for gRPC, even if
+// sent in chunks.
+type ErrMaxMsgSize struct {
+	Limits     libocr.ReportingPluginLimits
+	N          int
+	Size       int64
+	MaxMsgSize int
+}
+
+func (e ErrMaxMsgSize) Error() string {
+	return fmt.Sprintf("ReportingPluginLimits %+v with N %d permit messages of %d bytes: exceeds max message size %d", e.Limits, e.N, e.Size, e.MaxMsgSize)
+}
 
-func (e ErrConfigDigestLen) Error() string {
-	return fmt.Sprintf("invalid ConfigDigest len %d: must be 32", e)
+// ErrMaxChunkedMsgSize is returned when a message reassembled from chunks would exceed the max size.
+type ErrMaxChunkedMsgSize struct {
+	Size    int
+	MaxSize int
 }
 
-type ErrUint8Bounds struct {
-	U    uint32
-	Name string
+func (e ErrMaxChunkedMsgSize) Error() string {
+	return fmt.Sprintf("chunked message of at least %d bytes exceeds max size %d", e.Size, e.MaxSize)
+}
+
+// ErrMaxQueryLength is returned when a query is longer than the MaxQueryLength of its [libocr.ReportingPluginLimits].
+type ErrMaxQueryLength struct {
+	Len            int
+	MaxQueryLength int
+}
+
+func (e ErrMaxQueryLength) Error() string {
+	return fmt.Sprintf("query of %d bytes exceeds MaxQueryLength %d", e.Len, e.MaxQueryLength)
+}
+
+// ErrObservationBounds is returned when a DataSource observes a value outside of its [types.ObservationBounds].
+type ErrObservationBounds struct {
+	Value  *big.Int // nil if missing
+	Bounds types.ObservationBounds
+}
+
+func (e ErrObservationBounds) Error() string {
+	if e.Value == nil {
+		return "invalid observation: missing value"
+	}
+	min, max := "-inf", "+inf"
+	if e.Bounds.Min != nil {
+		min = e.Bounds.Min.String()
+	}
+	if e.Bounds.Max != nil {
+		max = e.Bounds.Max.String()
+	}
+	return fmt.Sprintf("invalid observation %s: outside of bounds [%s, %s]", e.Value, min, max)
+}
+
+// ErrConfigDigestPrefix is returned when a ConfigDigest does not match any of the prefixes declared by its
+// OffchainConfigDigester.
+type ErrConfigDigestPrefix struct {
+	Digest   libocr.ConfigDigest
+	Prefixes []libocr.ConfigDigestPrefix
+}
+
+func (e ErrConfigDigestPrefix) Error() string {
+	return fmt.Sprintf("invalid ConfigDigest %s: does not match ConfigDigestPrefix %v", e.Digest, e.Prefixes)
+}
+
+// ErrReportContext is returned for a malformed [libocr.ReportContext] or [libocr.ReportTimestamp] received over gRPC,
+// rather than truncating or padding it.
+type ErrReportContext struct {
+	Field string // e.g. ReportTimestamp.ConfigDigest, or empty for the whole context
+	Err   error
+}
+
+func (e ErrReportContext) Error() string {
+	if e.Field == "" {
+		return fmt.Sprintf("invalid ReportContext: %s", e.Err)
+	}
+	return fmt.Sprintf("invalid ReportContext %s: %s", e.Field, e.Err)
+}
+
+func (e ErrReportContext) Unwrap() error {
+	return e.Err
+}
+
+// ErrPanic is returned by a client adapter which panicked while converting a request or reply, e.g. by dereferencing
+// a field missing from the reply, rather than crashing the node.
+type ErrPanic struct {
+	Method string
+	Value  any    // recovered
+	Stack  []byte // of the panic
+}
+
+func (e ErrPanic) Error() string {
+	return fmt.Sprintf("recovered from panic in %s: %v", e.Method, e.Value)
+}
+
+// recoverPanic recovers from a panic in method, logs it along with its stack, and returns it via err as an [ErrPanic].
+// It must be deferred directly, by a function with a named error result.
+func recoverPanic(lggr logger.Logger, method string, err *error) {
+	if r := recover(); r != nil {
+		errPanic := ErrPanic{Method: method, Value: r, Stack: debug.Stack()}
+		lggr.Errorw("Recovered from panic", "method", method, "panic", r, "stack", string(errPanic.Stack))
+		*err = errPanic
+	}
 }
 
-func (e ErrUint8Bounds) Error() string {
-	return fmt.Sprintf("expected uint8 %s (max %d) but got %d", e.Name, math.MaxUint8, e.U)
+// ErrorClass returns a coarse, low cardinality class of err for use as a metric label: Panic, InvalidReply, Dial, or
+// Limits for failures on the client side, otherwise the name of the gRPC status code, e.g. Unavailable when the
+// plugin is down or Unknown for an error returned by the plugin itself.
+func ErrorClass(err error) string {
+	switch {
+	case err == nil:
+		return "OK"
+	case errors.As(err, new(ErrPanic)):
+		return "Panic"
+	case errors.As(err, new(pb.ErrInvalid)):
+		return "InvalidReply"
+	case errors.As(err, new(ErrConnDial)):
+		return "Dial"
+	case errors.As(err, new(ErrMaxMsgSize)):
+		return "Limits"
+	}
+	if s, ok := status.FromError(err); ok {
+		return s.Code().String()
+	}
+	return status.FromContextError(err).Code().String()
 }
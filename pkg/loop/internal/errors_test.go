@@ -0,0 +1,36 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/pb"
+)
+
+func TestErrorClass(t *testing.T) {
+	for _, tt := range []struct {
+		err  error
+		want string
+	}{
+		{nil, "OK"},
+		{ErrPanic{Method: "Test", Value: "boom"}, "Panic"},
+		{fmt.Errorf("wrapped: %w", pb.ErrInvalid{Message: "NewReportingPluginReply", Err: pb.ErrMissing}), "InvalidReply"},
+		{ErrConnDial{Name: "ReportingPlugin", ID: 1, Err: errors.New("refused")}, "Dial"},
+		{ErrMaxMsgSize{MaxMsgSize: 1, Size: 2}, "Limits"},
+		{status.Error(codes.Unavailable, "plugin exited"), "Unavailable"},
+		{status.Error(codes.Unknown, "bad offchain config"), "Unknown"},
+		{context.Canceled, "Canceled"},
+		{context.DeadlineExceeded, "DeadlineExceeded"},
+		{errors.New("other"), "Unknown"},
+	} {
+		t.Run(fmt.Sprint(tt.err), func(t *testing.T) {
+			assert.Equal(t, tt.want, ErrorClass(tt.err))
+		})
+	}
+}
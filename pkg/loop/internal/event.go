@@ -0,0 +1,157 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/pb"
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+var _ types.EventQuerier = (*eventQuerierClient)(nil)
+
+type eventQuerierClient struct {
+	lggr logger.Logger
+	grpc pb.EventQuerierClient
+}
+
+func (e *eventQuerierClient) FilteredLogs(ctx context.Context, filter types.EventFilter) (_ types.EventPage, err error) {
+	defer recoverPanic(e.lggr, "EventQuerier.FilteredLogs", &err)
+	reply, err := e.grpc.FilteredLogs(ctx, &pb.FilteredLogsRequest{Filter: pbEventFilter(filter)})
+	if err != nil {
+		return types.EventPage{}, err
+	}
+	page := types.EventPage{NextCursor: reply.NextCursor}
+	for _, pe := range reply.Events {
+		page.Events = append(page.Events, event(pe))
+	}
+	return page, nil
+}
+
+func (e *eventQuerierClient) SubscribeLogs(ctx context.Context, filter types.EventFilter, fn func(types.Event) error) error {
+	subCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := e.grpc.SubscribeLogs(subCtx, &pb.SubscribeLogsRequest{Filter: pbEventFilter(filter)})
+	if err != nil {
+		return subscribeLogsErr(ctx, err)
+	}
+	for {
+		pe, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return subscribeLogsErr(ctx, err)
+		}
+		ev, err := e.event(pe)
+		if err != nil {
+			return err
+		}
+		if err = fn(ev); err != nil {
+			return err
+		}
+	}
+}
+
+// event converts pe, and recovers from any panic. Panics from the fn passed to SubscribeLogs are not recovered.
+func (e *eventQuerierClient) event(pe *pb.Event) (_ types.Event, err error) {
+	defer recoverPanic(e.lggr, "EventQuerier.SubscribeLogs", &err)
+	return event(pe), nil
+}
+
+// subscribeLogsErr returns ctx.Err() in place of the status error of a stream which ended because ctx is done.
+func subscribeLogsErr(ctx context.Context, err error) error {
+	if ctxErr := ctx.Err(); ctxErr != nil && status.Code(err) == status.FromContextError(ctxErr).Code() {
+		return ctxErr
+	}
+	return err
+}
+
+var _ pb.EventQuerierServer = (*eventQuerierServer)(nil)
+
+type eventQuerierServer struct {
+	pb.UnimplementedEventQuerierServer
+	impl types.EventQuerier
+}
+
+func (e *eventQuerierServer) FilteredLogs(ctx context.Context, request *pb.FilteredLogsRequest) (*pb.FilteredLogsReply, error) {
+	page, err := e.impl.FilteredLogs(ctx, eventFilter(request.Filter))
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.FilteredLogsReply{NextCursor: page.NextCursor}
+	for _, ev := range page.Events {
+		reply.Events = append(reply.Events, pbEvent(ev))
+	}
+	return reply, nil
+}
+
+func (e *eventQuerierServer) SubscribeLogs(request *pb.SubscribeLogsRequest, stream pb.EventQuerier_SubscribeLogsServer) error {
+	return e.impl.SubscribeLogs(stream.Context(), eventFilter(request.Filter), func(ev types.Event) error {
+		return stream.Send(pbEvent(ev))
+	})
+}
+
+// registerEventQuerierServer registers an EventQuerier server if provider implements [types.EventQuerierProvider].
+// Otherwise, clients receive codes.Unimplemented errors.
+func registerEventQuerierServer(s *grpc.Server, provider any) {
+	if ep, ok := provider.(types.EventQuerierProvider); ok {
+		pb.RegisterEventQuerierServer(s, &eventQuerierServer{impl: ep.EventQuerier()})
+	}
+}
+
+func pbEventFilter(f types.EventFilter) *pb.EventFilter {
+	return &pb.EventFilter{
+		Addresses: f.Addresses,
+		EventSigs: f.EventSigs,
+		FromBlock: f.FromBlock,
+		Cursor:    f.Cursor,
+		Limit:     int64(f.Limit),
+	}
+}
+
+func eventFilter(f *pb.EventFilter) types.EventFilter {
+	if f == nil {
+		return types.EventFilter{}
+	}
+	return types.EventFilter{
+		Addresses: f.Addresses,
+		EventSigs: f.EventSigs,
+		FromBlock: f.FromBlock,
+		Cursor:    f.Cursor,
+		Limit:     int(f.Limit),
+	}
+}
+
+func pbEvent(e types.Event) *pb.Event {
+	return &pb.Event{
+		Address:        e.Address,
+		Topics:         e.Topics,
+		Data:           e.Data,
+		BlockNumber:    e.BlockNumber,
+		BlockHash:      e.BlockHash,
+		BlockTimestamp: timestamppb.New(e.BlockTimestamp),
+		TxHash:         e.TxHash,
+		Index:          e.Index,
+		Cursor:         e.Cursor,
+	}
+}
+
+func event(e *pb.Event) types.Event {
+	return types.Event{
+		Address:        e.Address,
+		Topics:         e.Topics,
+		Data:           e.Data,
+		BlockNumber:    e.BlockNumber,
+		BlockHash:      e.BlockHash,
+		BlockTimestamp: e.BlockTimestamp.AsTime(),
+		TxHash:         e.TxHash,
+		Index:          e.Index,
+		Cursor:         e.Cursor,
+	}
+}
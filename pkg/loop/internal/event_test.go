@@ -0,0 +1,63 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/pb"
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+func TestEventQuerierClient_SubscribeLogs(t *testing.T) {
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	pb.RegisterEventQuerierServer(s, &eventQuerierServer{impl: subscribingEventQuerier{}})
+	go func() { _ = s.Serve(lis) }()
+	t.Cleanup(s.Stop)
+	conn, err := (&testBroker{lis}).DialWithOptions(0)
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, conn.Close()) })
+	client := &eventQuerierClient{logger.Test(t), pb.NewEventQuerierClient(conn)}
+
+	t.Run("cancel", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		err := client.SubscribeLogs(ctx, types.EventFilter{}, func(types.Event) error {
+			cancel()
+			return nil
+		})
+		require.Equal(t, context.Canceled, err)
+	})
+
+	t.Run("panic", func(t *testing.T) {
+		assert.PanicsWithValue(t, "test", func() {
+			_ = client.SubscribeLogs(context.Background(), types.EventFilter{}, func(types.Event) error {
+				panic("test")
+			})
+		}, "panics from fn must propagate")
+
+		_, err := client.event(nil)
+		var errPanic ErrPanic
+		require.True(t, errors.As(err, &errPanic), "expected ErrPanic but got %v", err)
+		assert.Equal(t, "EventQuerier.SubscribeLogs", errPanic.Method)
+	})
+}
+
+// subscribingEventQuerier sends a single event to subscribers, and then blocks until the subscription is cancelled.
+type subscribingEventQuerier struct {
+	types.EventQuerier // only SubscribeLogs is implemented
+}
+
+func (subscribingEventQuerier) SubscribeLogs(ctx context.Context, _ types.EventFilter, fn func(types.Event) error) error {
+	if err := fn(types.Event{Address: "0x1"}); err != nil {
+		return err
+	}
+	<-ctx.Done()
+	return ctx.Err()
+}
@@ -0,0 +1,88 @@
+package internal
+
+import (
+	"context"
+	"math/big"
+
+	"google.golang.org/grpc"
+
+	feetypes "github.com/smartcontractkit/chainlink-relay/pkg/fee/types"
+	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/pb"
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+var _ types.GasEstimator = (*gasEstimatorClient)(nil)
+
+type gasEstimatorClient struct {
+	lggr logger.Logger
+	grpc pb.GasEstimatorClient
+}
+
+func (g *gasEstimatorClient) GetFee(ctx context.Context, calldata []byte, limit uint64, maxPrice *big.Int, opts ...feetypes.Opt) (_ types.Fee, err error) {
+	defer recoverPanic(g.lggr, "GasEstimator.GetFee", &err)
+	req := &pb.GetFeeRequest{Calldata: calldata, Limit: limit, MaxPrice: pb.NewBigIntFromInt(maxPrice)}
+	for _, o := range opts {
+		req.Opts = append(req.Opts, int32(o))
+	}
+	reply, err := g.grpc.GetFee(ctx, req)
+	if err != nil {
+		return types.Fee{}, err
+	}
+	return fee(reply.Fee), nil
+}
+
+func (g *gasEstimatorClient) BumpFee(ctx context.Context, original types.Fee, maxPrice *big.Int) (_ types.Fee, err error) {
+	defer recoverPanic(g.lggr, "GasEstimator.BumpFee", &err)
+	reply, err := g.grpc.BumpFee(ctx, &pb.BumpFeeRequest{Original: pbFee(original), MaxPrice: pb.NewBigIntFromInt(maxPrice)})
+	if err != nil {
+		return types.Fee{}, err
+	}
+	return fee(reply.Fee), nil
+}
+
+var _ pb.GasEstimatorServer = (*gasEstimatorServer)(nil)
+
+type gasEstimatorServer struct {
+	pb.UnimplementedGasEstimatorServer
+	impl types.GasEstimator
+}
+
+func (g *gasEstimatorServer) GetFee(ctx context.Context, request *pb.GetFeeRequest) (*pb.GetFeeReply, error) {
+	var opts []feetypes.Opt
+	for _, o := range request.Opts {
+		opts = append(opts, feetypes.Opt(o))
+	}
+	f, err := g.impl.GetFee(ctx, request.Calldata, request.Limit, request.MaxPrice.Int(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetFeeReply{Fee: pbFee(f)}, nil
+}
+
+func (g *gasEstimatorServer) BumpFee(ctx context.Context, request *pb.BumpFeeRequest) (*pb.BumpFeeReply, error) {
+	f, err := g.impl.BumpFee(ctx, fee(request.Original), request.MaxPrice.Int())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.BumpFeeReply{Fee: pbFee(f)}, nil
+}
+
+// registerGasEstimatorServer registers a GasEstimator server if provider implements [types.GasEstimatorProvider].
+// Otherwise, clients receive codes.Unimplemented errors.
+func registerGasEstimatorServer(s *grpc.Server, provider any) {
+	if gp, ok := provider.(types.GasEstimatorProvider); ok {
+		pb.RegisterGasEstimatorServer(s, &gasEstimatorServer{impl: gp.GasEstimator()})
+	}
+}
+
+func pbFee(f types.Fee) *pb.Fee {
+	return &pb.Fee{Price: pb.NewBigIntFromInt(f.Price), Limit: f.Limit}
+}
+
+func fee(f *pb.Fee) types.Fee {
+	if f == nil {
+		return types.Fee{}
+	}
+	return types.Fee{Price: f.Price.Int(), Limit: f.Limit}
+}
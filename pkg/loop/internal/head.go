@@ -0,0 +1,101 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/pb"
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+var _ types.HeadTracker = (*headTrackerClient)(nil)
+
+type headTrackerClient struct {
+	lggr logger.Logger
+	grpc pb.HeadTrackerClient
+}
+
+func (h *headTrackerClient) LatestHead(ctx context.Context) (_ types.Head, err error) {
+	defer recoverPanic(h.lggr, "HeadTracker.LatestHead", &err)
+	reply, err := h.grpc.LatestHead(ctx, &emptypb.Empty{})
+	if err != nil {
+		return types.Head{}, err
+	}
+	return head(reply.Head), nil
+}
+
+func (h *headTrackerClient) SubscribeNewHead(ctx context.Context, fn func(types.Head) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := h.grpc.SubscribeNewHead(ctx, &emptypb.Empty{})
+	if err != nil {
+		return err
+	}
+	for {
+		ph, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if err = fn(head(ph)); err != nil {
+			return err
+		}
+	}
+}
+
+var _ pb.HeadTrackerServer = (*headTrackerServer)(nil)
+
+type headTrackerServer struct {
+	pb.UnimplementedHeadTrackerServer
+	impl types.HeadTracker
+}
+
+func (h *headTrackerServer) LatestHead(ctx context.Context, _ *emptypb.Empty) (*pb.LatestHeadReply, error) {
+	latest, err := h.impl.LatestHead(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.LatestHeadReply{Head: pbHead(latest)}, nil
+}
+
+func (h *headTrackerServer) SubscribeNewHead(_ *emptypb.Empty, stream pb.HeadTracker_SubscribeNewHeadServer) error {
+	return h.impl.SubscribeNewHead(stream.Context(), func(hd types.Head) error {
+		return stream.Send(pbHead(hd))
+	})
+}
+
+// registerHeadTrackerServer registers a HeadTracker server if provider implements [types.HeadTrackerProvider].
+// Otherwise, clients receive codes.Unimplemented errors.
+func registerHeadTrackerServer(s *grpc.Server, provider any) {
+	if hp, ok := provider.(types.HeadTrackerProvider); ok {
+		pb.RegisterHeadTrackerServer(s, &headTrackerServer{impl: hp.HeadTracker()})
+	}
+}
+
+func pbHead(h types.Head) *pb.Head {
+	return &pb.Head{
+		Height:     h.Height,
+		Hash:       h.Hash,
+		ParentHash: h.ParentHash,
+		Timestamp:  timestamppb.New(h.Timestamp),
+	}
+}
+
+func head(h *pb.Head) types.Head {
+	if h == nil {
+		return types.Head{}
+	}
+	return types.Head{
+		Height:     h.Height,
+		Hash:       h.Hash,
+		ParentHash: h.ParentHash,
+		Timestamp:  h.Timestamp.AsTime(),
+	}
+}
@@ -0,0 +1,145 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	libocr "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/pb"
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
+)
+
+// JuelsPerFeeCoinSource is a median.DataSource which applies a [types.JuelsPerFeeCoinStrategy].
+// When passed to [PluginMedianClient.NewMedianFactory], the strategy is forwarded and applied on the plugin side.
+type JuelsPerFeeCoinSource interface {
+	median.DataSource
+	JuelsPerFeeCoinConfig() types.JuelsPerFeeCoinConfig
+	// Uncached returns the DataSource to serve to the plugin, without any caching applied.
+	Uncached() median.DataSource
+}
+
+// NewJuelsPerFeeCoinSource returns a JuelsPerFeeCoinSource for cfg. The pipeline is unused for the fixed strategy, and may be nil.
+func NewJuelsPerFeeCoinSource(cfg types.JuelsPerFeeCoinConfig, pipeline median.DataSource) (JuelsPerFeeCoinSource, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	switch cfg.Strategy {
+	case types.JuelsPerFeeCoinFixed:
+		return &fixedDataSource{value: new(big.Int).Set(cfg.FixedValue)}, nil
+	case types.JuelsPerFeeCoinCached:
+		if pipeline == nil {
+			return nil, fmt.Errorf("juelsPerFeeCoin: %s strategy requires a pipeline", cfg.Strategy)
+		}
+		return &cachedDataSource{pipeline: pipeline, maxStaleness: cfg.MaxStaleness.Duration()}, nil
+	default:
+		if pipeline == nil {
+			return nil, fmt.Errorf("juelsPerFeeCoin: %s strategy requires a pipeline", types.JuelsPerFeeCoinPipeline)
+		}
+		return &pipelineDataSource{pipeline}, nil
+	}
+}
+
+var _ JuelsPerFeeCoinSource = (*pipelineDataSource)(nil)
+
+type pipelineDataSource struct {
+	median.DataSource
+}
+
+func (p *pipelineDataSource) JuelsPerFeeCoinConfig() types.JuelsPerFeeCoinConfig {
+	return types.JuelsPerFeeCoinConfig{Strategy: types.JuelsPerFeeCoinPipeline}
+}
+
+func (p *pipelineDataSource) Uncached() median.DataSource { return p.DataSource }
+
+var _ JuelsPerFeeCoinSource = (*fixedDataSource)(nil)
+
+type fixedDataSource struct {
+	value *big.Int
+}
+
+func (f *fixedDataSource) Observe(context.Context, libocr.ReportTimestamp) (*big.Int, error) {
+	return new(big.Int).Set(f.value), nil
+}
+
+func (f *fixedDataSource) JuelsPerFeeCoinConfig() types.JuelsPerFeeCoinConfig {
+	return types.JuelsPerFeeCoinConfig{Strategy: types.JuelsPerFeeCoinFixed, FixedValue: new(big.Int).Set(f.value)}
+}
+
+// Uncached returns the fixed source itself, so that plugins which do not support strategies still observe the fixed value.
+func (f *fixedDataSource) Uncached() median.DataSource { return f }
+
+var _ JuelsPerFeeCoinSource = (*cachedDataSource)(nil)
+
+// cachedDataSource re-uses the last pipeline observation until it is older than maxStaleness. mu is not held while
+// observing the pipeline, so a slow pipeline does not block other callers.
+type cachedDataSource struct {
+	pipeline     median.DataSource
+	maxStaleness time.Duration
+
+	mu       sync.Mutex
+	value    *big.Int
+	observed time.Time
+}
+
+func (c *cachedDataSource) Observe(ctx context.Context, timestamp libocr.ReportTimestamp) (*big.Int, error) {
+	c.mu.Lock()
+	value, observed := c.value, c.observed
+	c.mu.Unlock()
+	if value != nil && time.Since(observed) <= c.maxStaleness {
+		return new(big.Int).Set(value), nil
+	}
+	started := time.Now()
+	val, err := c.pipeline.Observe(ctx, timestamp)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	if started.After(c.observed) { // unless a later observation completed first
+		c.value, c.observed = new(big.Int).Set(val), started
+	}
+	c.mu.Unlock()
+	return val, nil
+}
+
+func (c *cachedDataSource) JuelsPerFeeCoinConfig() types.JuelsPerFeeCoinConfig {
+	return types.JuelsPerFeeCoinConfig{Strategy: types.JuelsPerFeeCoinCached, MaxStaleness: *utils.MustNewDuration(c.maxStaleness)}
+}
+
+func (c *cachedDataSource) Uncached() median.DataSource { return c.pipeline }
+
+func pbJuelsPerFeeCoinConfig(cfg types.JuelsPerFeeCoinConfig) *pb.JuelsPerFeeCoinConfig {
+	p := &pb.JuelsPerFeeCoinConfig{
+		Strategy:     string(cfg.Strategy),
+		MaxStaleness: cfg.MaxStaleness.Duration().Milliseconds(),
+	}
+	if cfg.FixedValue != nil {
+		p.FixedValue = pb.NewBigIntFromInt(cfg.FixedValue)
+	}
+	return p
+}
+
+func juelsPerFeeCoinConfig(p *pb.JuelsPerFeeCoinConfig) (cfg types.JuelsPerFeeCoinConfig, err error) {
+	if p == nil {
+		return types.JuelsPerFeeCoinConfig{Strategy: types.JuelsPerFeeCoinPipeline}, nil
+	}
+	cfg.Strategy = types.JuelsPerFeeCoinStrategy(p.Strategy)
+	if p.FixedValue != nil {
+		cfg.FixedValue = p.FixedValue.Int()
+	}
+	if p.MaxStaleness != 0 {
+		var d utils.Duration
+		d, err = utils.NewDuration(time.Duration(p.MaxStaleness) * time.Millisecond)
+		if err != nil {
+			return
+		}
+		cfg.MaxStaleness = d
+	}
+	err = cfg.Validate()
+	return
+}
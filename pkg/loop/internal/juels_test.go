@@ -0,0 +1,63 @@
+package internal
+
+import (
+	"context"
+	"math/big"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	libocr "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
+)
+
+func TestCachedDataSource(t *testing.T) {
+	ctx := utils.Context(t)
+	pipeline := &blockingDataSource{unblock: make(chan struct{}), started: make(chan struct{}, 1)}
+	c := &cachedDataSource{pipeline: pipeline, maxStaleness: time.Minute}
+
+	// The first observation blocks in the pipeline, without blocking others.
+	blocked := make(chan *big.Int, 1)
+	go func() {
+		val, err := c.Observe(ctx, libocr.ReportTimestamp{})
+		assert.NoError(t, err)
+		blocked <- val
+	}()
+	<-pipeline.started
+	val, err := c.Observe(ctx, libocr.ReportTimestamp{})
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(2), val)
+
+	close(pipeline.unblock)
+	assert.Equal(t, big.NewInt(1), <-blocked)
+
+	// The observation which started last is cached.
+	val, err = c.Observe(ctx, libocr.ReportTimestamp{})
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(2), val)
+	assert.Equal(t, int64(2), pipeline.calls.Load())
+}
+
+// blockingDataSource observes the number of calls so far. The first call blocks until unblock is closed.
+type blockingDataSource struct {
+	unblock chan struct{}
+	started chan struct{}
+	calls   atomic.Int64
+}
+
+func (b *blockingDataSource) Observe(ctx context.Context, _ libocr.ReportTimestamp) (*big.Int, error) {
+	n := b.calls.Add(1)
+	if n == 1 {
+		b.started <- struct{}{}
+		select {
+		case <-b.unblock:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	return big.NewInt(n), nil
+}
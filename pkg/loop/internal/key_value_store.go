@@ -0,0 +1,88 @@
+package internal
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/pb"
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+var _ types.KeyValueStore = (*keyValueStoreClient)(nil)
+
+type keyValueStoreClient struct {
+	lggr logger.Logger
+	grpc pb.KeyValueStoreClient
+}
+
+func newKeyValueStoreClient(lggr logger.Logger, cc grpc.ClientConnInterface) *keyValueStoreClient {
+	return &keyValueStoreClient{lggr, pb.NewKeyValueStoreClient(cc)}
+}
+
+func (k *keyValueStoreClient) Get(ctx context.Context, namespace, key string) (_ []byte, _ bool, err error) {
+	defer recoverPanic(k.lggr, "KeyValueStore.Get", &err)
+	reply, err := k.grpc.Get(ctx, &pb.GetValueRequest{Namespace: namespace, Key: key})
+	if err != nil {
+		return nil, false, err
+	}
+	return reply.Value, reply.Found, nil
+}
+
+func (k *keyValueStoreClient) Set(ctx context.Context, namespace, key string, value []byte) error {
+	_, err := k.grpc.Set(ctx, &pb.SetValueRequest{Namespace: namespace, Key: key, Value: value})
+	return err
+}
+
+func (k *keyValueStoreClient) Delete(ctx context.Context, namespace, key string) error {
+	_, err := k.grpc.Delete(ctx, &pb.DeleteValueRequest{Namespace: namespace, Key: key})
+	return err
+}
+
+var _ pb.KeyValueStoreServer = (*keyValueStoreServer)(nil)
+
+type keyValueStoreServer struct {
+	pb.UnimplementedKeyValueStoreServer
+	impl types.KeyValueStore
+}
+
+func (k *keyValueStoreServer) Get(ctx context.Context, request *pb.GetValueRequest) (*pb.GetValueReply, error) {
+	value, found, err := k.impl.Get(ctx, request.Namespace, request.Key)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetValueReply{Value: value, Found: found}, nil
+}
+
+func (k *keyValueStoreServer) Set(ctx context.Context, request *pb.SetValueRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, k.impl.Set(ctx, request.Namespace, request.Key, request.Value)
+}
+
+func (k *keyValueStoreServer) Delete(ctx context.Context, request *pb.DeleteValueRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, k.impl.Delete(ctx, request.Namespace, request.Key)
+}
+
+// serveKeyValueStore serves store, if not nil, and returns its ID and Resource. Otherwise, it returns 0 and nil.
+func (b *brokerExt) serveKeyValueStore(store types.KeyValueStore) (uint32, *Resource, error) {
+	if store == nil {
+		return 0, nil, nil
+	}
+	return b.serveNew("KeyValueStore", func(s *grpc.Server) {
+		pb.RegisterKeyValueStoreServer(s, &keyValueStoreServer{impl: store})
+	})
+}
+
+// dialKeyValueStore dials the store served with id, if not 0, and returns ctx carrying a client of it, and its Resource.
+// Otherwise, it returns ctx and nil.
+func (b *brokerExt) dialKeyValueStore(ctx context.Context, id uint32) (context.Context, *Resource, error) {
+	if id == 0 {
+		return ctx, nil, nil
+	}
+	conn, err := b.dial("KeyValueStore", id)
+	if err != nil {
+		return ctx, nil, ErrConnDial{Name: "KeyValueStore", ID: id, Err: err}
+	}
+	return types.ContextWithKeyValueStore(ctx, newKeyValueStoreClient(b.Logger, conn)), NewResource("KeyValueStore", conn), nil
+}
@@ -0,0 +1,116 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	reportingRequestsInFlight = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "loop_reporting_plugin_requests_in_flight",
+		Help: "Number of reporting plugin requests being served by the plugin.",
+	}, []string{"method"})
+	reportingRequestsQueued = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "loop_reporting_plugin_requests_queued",
+		Help: "Number of reporting plugin requests waiting for the concurrency limit.",
+	}, []string{"method"})
+	reportingRequestsRejected = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "loop_reporting_plugin_requests_rejected",
+		Help: "Number of reporting plugin requests which timed out waiting for the concurrency limit.",
+	}, []string{"method"})
+	reportingRequestsQueueDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "loop_reporting_plugin_requests_queue_duration_seconds",
+		Help:    "Time reporting plugin requests spent waiting for the concurrency limit.",
+		Buckets: []float64{0.001, 0.01, 0.1, 0.3, 0.6, 1, 3, 6, 10},
+	}, []string{"method"})
+)
+
+// ConcurrencyLimit configures a limit on the number of reporting plugin requests served concurrently on the plugin side.
+// The limit is shared by all of the factories served by a plugin.
+type ConcurrencyLimit struct {
+	// Max is the maximum number of concurrent requests. Zero is unlimited.
+	Max int
+	// QueueTimeout is the maximum time a request may wait for the limit, before failing with
+	// [codes.ResourceExhausted]. Zero waits as long as the request context permits.
+	QueueTimeout time.Duration
+}
+
+// Validate returns an error if c is invalid.
+func (c ConcurrencyLimit) Validate() error {
+	if c.Max < 0 {
+		return fmt.Errorf("Max must not be negative: %d", c.Max)
+	}
+	if c.QueueTimeout < 0 {
+		return fmt.Errorf("QueueTimeout must not be negative: %s", c.QueueTimeout)
+	}
+	return nil
+}
+
+// concurrencyLimiter is a semaphore which enforces a [ConcurrencyLimit]. A nil *concurrencyLimiter is unlimited, but
+// still reports metrics.
+type concurrencyLimiter struct {
+	sem          chan struct{}
+	queueTimeout time.Duration
+}
+
+func newConcurrencyLimiter(c ConcurrencyLimit) *concurrencyLimiter {
+	if c.Max <= 0 {
+		return nil
+	}
+	return &concurrencyLimiter{sem: make(chan struct{}, c.Max), queueTimeout: c.QueueTimeout}
+}
+
+// acquire waits for the limit, and returns a func to release it once the request completes.
+func (l *concurrencyLimiter) acquire(ctx context.Context, method string) (release func(), err error) {
+	inFlight := reportingRequestsInFlight.WithLabelValues(method)
+	if l == nil {
+		inFlight.Inc()
+		return inFlight.Dec, nil
+	}
+
+	select {
+	case l.sem <- struct{}{}:
+	default:
+		if err = l.wait(ctx, method); err != nil {
+			return nil, err
+		}
+	}
+	inFlight.Inc()
+	return func() {
+		inFlight.Dec()
+		<-l.sem
+	}, nil
+}
+
+// wait blocks until the limit is acquired, or fails when the queue timeout or ctx expires.
+func (l *concurrencyLimiter) wait(ctx context.Context, method string) error {
+	queued := reportingRequestsQueued.WithLabelValues(method)
+	queued.Inc()
+	defer queued.Dec()
+	start := time.Now()
+	defer func() {
+		reportingRequestsQueueDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+	}()
+
+	var timeout <-chan time.Time
+	if l.queueTimeout > 0 {
+		t := time.NewTimer(l.queueTimeout)
+		defer t.Stop()
+		timeout = t.C
+	}
+	select {
+	case l.sem <- struct{}{}:
+		return nil
+	case <-timeout:
+		reportingRequestsRejected.WithLabelValues(method).Inc()
+		return status.Errorf(codes.ResourceExhausted, "%s: timed out after %s waiting for one of %d concurrent requests", method, l.queueTimeout, cap(l.sem))
+	case <-ctx.Done():
+		return status.FromContextError(ctx.Err()).Err()
+	}
+}
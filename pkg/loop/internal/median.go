@@ -3,12 +3,16 @@ package internal
 import (
 	"context"
 	"fmt"
-	"math"
 	"math/big"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/mwitkow/grpc-proxy/proxy"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/smartcontractkit/libocr/commontypes"
@@ -16,7 +20,8 @@ import (
 	libocr "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
 
 	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
-	"github.com/smartcontractkit/chainlink-relay/pkg/loop/internal/pb"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/digestcheck"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/pb"
 	"github.com/smartcontractkit/chainlink-relay/pkg/types"
 )
 
@@ -36,26 +41,47 @@ func NewPluginMedianClient(broker Broker, brokerCfg BrokerConfig, conn *grpc.Cli
 }
 
 func (m *PluginMedianClient) NewMedianFactory(ctx context.Context, provider types.MedianProvider, dataSource, juelsPerFeeCoin median.DataSource, errorLog types.ErrorLog) (types.ReportingPluginFactory, error) {
-	cc := m.newClientConn("MedianPluginFactory", func(ctx context.Context) (id uint32, deps resources, err error) {
+	var juelsPerFeeCoinConfig *pb.JuelsPerFeeCoinConfig
+	if source, ok := juelsPerFeeCoin.(JuelsPerFeeCoinSource); ok {
+		juelsPerFeeCoinConfig = pbJuelsPerFeeCoinConfig(source.JuelsPerFeeCoinConfig())
+		juelsPerFeeCoin = source.Uncached()
+	}
+	dataSourceBounds := boundsOf(dataSource)
+	juelsPerFeeCoinDataSourceBounds := boundsOf(juelsPerFeeCoin)
+	featureFlags := types.FeatureFlagsFromContext(ctx)
+	providerCachePath := providerCachePathFromContext(ctx)
+	keyValueStore := types.KeyValueStoreFromContext(ctx)
+	var retry *medianFactoryRequest // timed out, so the plugin may have created the factory anyway
+	cc := m.newClientConn("MedianPluginFactory", func(ctx context.Context) (id uint32, deps Resources, err error) {
+		if retry != nil {
+			// Resend the same request, with the same key and dependencies, so that the plugin returns the factory it
+			// created for the first attempt, if any, rather than orphaning it.
+			req := retry
+			retry = nil
+			return m.newMedianFactory(ctx, req, &retry)
+		}
+
+		dataSourceKey, dataSourceDeps := rateLimitKey("DataSource", m.ObserveLimiter)
 		dataSourceID, dsRes, err := m.serveNew("DataSource", func(s *grpc.Server) {
-			pb.RegisterDataSourceServer(s, &dataSourceServer{impl: dataSource})
-		})
+			pb.RegisterDataSourceServer(s, &dataSourceServer{impl: dataSource, limiter: m.ObserveLimiter, key: dataSourceKey})
+		}, dataSourceDeps...)
 		if err != nil {
-			return 0, nil, err
+			return 0, deps, err
 		}
 		deps.Add(dsRes)
 
+		juelsPerFeeCoinKey, juelsPerFeeCoinDeps := rateLimitKey("JuelsPerFeeCoinDataSource", m.ObserveLimiter)
 		juelsPerFeeCoinDataSourceID, juelsPerFeeCoinDataSourceRes, err := m.serveNew("JuelsPerFeeCoinDataSource", func(s *grpc.Server) {
-			pb.RegisterDataSourceServer(s, &dataSourceServer{impl: juelsPerFeeCoin})
-		})
+			pb.RegisterDataSourceServer(s, &dataSourceServer{impl: juelsPerFeeCoin, limiter: m.ObserveLimiter, key: juelsPerFeeCoinKey})
+		}, juelsPerFeeCoinDeps...)
 		if err != nil {
-			return 0, nil, err
+			return 0, deps, err
 		}
 		deps.Add(juelsPerFeeCoinDataSourceRes)
 
 		var (
 			providerID  uint32
-			providerRes resource
+			providerRes *Resource
 		)
 		if grpcProvider, ok := provider.(GRPCClientConn); ok {
 			providerID, providerRes, err = m.serve("MedianProvider", proxy.NewProxy(grpcProvider.ClientConn()))
@@ -65,108 +91,301 @@ func (m *PluginMedianClient) NewMedianFactory(ctx context.Context, provider type
 				pb.RegisterOffchainConfigDigesterServer(s, &offchainConfigDigesterServer{impl: provider.OffchainConfigDigester()})
 				pb.RegisterContractConfigTrackerServer(s, &contractConfigTrackerServer{impl: provider.ContractConfigTracker()})
 				pb.RegisterContractTransmitterServer(s, &contractTransmitterServer{impl: provider.ContractTransmitter()})
-				pb.RegisterReportCodecServer(s, &reportCodecServer{impl: provider.ReportCodec()})
+				pb.RegisterReportCodecServer(s, &reportCodecServer{impl: provider.ReportCodec(), chunkSize: m.chunkSize()})
 				pb.RegisterMedianContractServer(s, &medianContractServer{impl: provider.MedianContract()})
 				pb.RegisterOnchainConfigCodecServer(s, &onchainConfigCodecServer{impl: provider.OnchainConfigCodec()})
+				registerGasEstimatorServer(s, provider)
+				registerEventQuerierServer(s, provider)
+				registerHeadTrackerServer(s, provider)
 			})
 		}
 		if err != nil {
-			return 0, nil, err
+			return 0, deps, err
 		}
 		deps.Add(providerRes)
 
+		errorLogKey, errorLogDeps := rateLimitKey("ErrorLog", m.ErrorLogLimiter)
 		errorLogID, errorLogRes, err := m.serveNew("ErrorLog", func(s *grpc.Server) {
-			pb.RegisterErrorLogServer(s, &errorLogServer{impl: errorLog})
-		})
+			pb.RegisterErrorLogServer(s, &errorLogServer{impl: errorLog, limiter: m.ErrorLogLimiter, key: errorLogKey})
+		}, errorLogDeps...)
 		if err != nil {
-			return 0, nil, err
+			return 0, deps, err
 		}
 		deps.Add(errorLogRes)
 
-		reply, err := m.median.NewMedianFactory(ctx, &pb.NewMedianFactoryRequest{
-			MedianProviderID:            providerID,
-			DataSourceID:                dataSourceID,
-			JuelsPerFeeCoinDataSourceID: juelsPerFeeCoinDataSourceID,
-			ErrorLogID:                  errorLogID,
-		})
+		keyValueStoreID, keyValueStoreRes, err := m.serveKeyValueStore(keyValueStore)
 		if err != nil {
-			return 0, nil, err
+			return 0, deps, err
 		}
-		return reply.ReportingPluginFactoryID, nil, nil
+		deps.Add(keyValueStoreRes)
+
+		return m.newMedianFactory(ctx, &medianFactoryRequest{deps: deps, request: &pb.NewMedianFactoryRequest{
+			MedianProviderID:                providerID,
+			DataSourceID:                    dataSourceID,
+			JuelsPerFeeCoinDataSourceID:     juelsPerFeeCoinDataSourceID,
+			ErrorLogID:                      errorLogID,
+			JuelsPerFeeCoinConfig:           juelsPerFeeCoinConfig,
+			DataSourceBounds:                dataSourceBounds,
+			JuelsPerFeeCoinDataSourceBounds: juelsPerFeeCoinDataSourceBounds,
+			FeatureFlags:                    featureFlags,
+			ProviderCachePath:               providerCachePath,
+			KeyValueStoreID:                 keyValueStoreID,
+			IdempotencyKey:                  uuid.NewString(),
+		}}, &retry)
 	})
 	return newReportingPluginFactoryClient(m.pluginClient.brokerExt, cc), nil
 }
 
+// medianFactoryRequest is a NewMedianFactory request, along with the dependencies served for it.
+type medianFactoryRequest struct {
+	deps    Resources
+	request *pb.NewMedianFactoryRequest
+}
+
+// newMedianFactory sends req. If it times out, req is stored in retry, to be sent again, and the dependencies are
+// returned in an [errPendingDeps], to be kept open until then. Otherwise, the dependencies are returned to be closed
+// along with the factory, or on error.
+func (m *PluginMedianClient) newMedianFactory(ctx context.Context, req *medianFactoryRequest, retry **medianFactoryRequest) (uint32, Resources, error) {
+	reply, err := m.median.NewMedianFactory(ctx, req.request)
+	if err != nil {
+		if status.Code(err) == codes.DeadlineExceeded {
+			*retry = req
+			return 0, nil, errPendingDeps{err, req.deps}
+		}
+		return 0, req.deps, err
+	}
+	return reply.ReportingPluginFactoryID, req.deps, nil
+}
+
 var _ pb.PluginMedianServer = (*pluginMedianServer)(nil)
 
 type pluginMedianServer struct {
 	pb.UnimplementedPluginMedianServer
 
 	*brokerExt
-	impl types.PluginMedian
+	impl              types.PluginMedian
+	limiter           *concurrencyLimiter // shared by all factories
+	checkConfigDigest bool
+	timestampSkew     types.TimestampSkew
+	factories         medianFactories
+}
+
+// PluginMedianServerOptions configures [RegisterPluginMedianServer].
+type PluginMedianServerOptions struct {
+	// ConcurrencyLimit limits the reporting plugin requests of all factories.
+	ConcurrencyLimit ConcurrencyLimit
+	// CheckConfigDigest fails new factories unless the latest config digest of their provider matches the digest
+	// computed by it, as checked by [digestcheck.CheckLatest].
+	CheckConfigDigest bool
+	// ObservationTimestampSkew checks the observation timestamps of reporting plugins, if enabled, as by
+	// [NewSkewCheckedReportingPluginFactory].
+	ObservationTimestampSkew types.TimestampSkew
+}
+
+// RegisterPluginMedianServer registers impl with server, configured by opts.
+func RegisterPluginMedianServer(server *grpc.Server, broker Broker, brokerCfg BrokerConfig, impl types.PluginMedian, opts PluginMedianServerOptions) error {
+	if err := opts.ConcurrencyLimit.Validate(); err != nil {
+		return fmt.Errorf("invalid ConcurrencyLimit: %w", err)
+	}
+	if err := opts.ObservationTimestampSkew.Validate(); err != nil {
+		return fmt.Errorf("invalid TimestampSkew: %w", err)
+	}
+	s := newPluginMedianServer(&brokerExt{broker, brokerCfg}, impl, newConcurrencyLimiter(opts.ConcurrencyLimit))
+	s.checkConfigDigest = opts.CheckConfigDigest
+	s.timestampSkew = opts.ObservationTimestampSkew
+	pb.RegisterPluginMedianServer(server, s)
+	return nil
 }
 
-func RegisterPluginMedianServer(server *grpc.Server, broker Broker, brokerCfg BrokerConfig, impl types.PluginMedian) error {
-	pb.RegisterPluginMedianServer(server, newPluginMedianServer(&brokerExt{broker, brokerCfg}, impl))
-	return nil
+func newPluginMedianServer(b *brokerExt, mp types.PluginMedian, limiter *concurrencyLimiter) *pluginMedianServer {
+	return &pluginMedianServer{brokerExt: b.withName("PluginMedian"), impl: mp, limiter: limiter}
+}
+
+// medianFactories tracks the factories being created or served, by idempotency key.
+type medianFactories struct {
+	mu    sync.Mutex
+	byKey map[string]*medianFactory
+}
+
+// medianFactory is the result of creating a factory, available once done is closed.
+type medianFactory struct {
+	done chan struct{}
+	id   uint32
+	err  error
+}
+
+// get returns the factory for key, and whether it was added by this call, in which case the caller must create it.
+func (f *medianFactories) get(key string) (factory *medianFactory, added bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if factory, ok := f.byKey[key]; ok {
+		return factory, false
+	}
+	if f.byKey == nil {
+		f.byKey = make(map[string]*medianFactory)
+	}
+	factory = &medianFactory{done: make(chan struct{})}
+	f.byKey[key] = factory
+	return factory, true
 }
 
-func newPluginMedianServer(b *brokerExt, mp types.PluginMedian) *pluginMedianServer {
-	return &pluginMedianServer{brokerExt: b.withName("PluginMedian"), impl: mp}
+// remove removes factory from key, unless it has been replaced.
+func (f *medianFactories) remove(key string, factory *medianFactory) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.byKey[key] == factory {
+		delete(f.byKey, key)
+	}
 }
 
+// NewMedianFactory creates a new factory, unless the request has the IdempotencyKey of a factory which is still being
+// created or served, in which case that factory's ID is returned instead.
 func (m *pluginMedianServer) NewMedianFactory(ctx context.Context, request *pb.NewMedianFactoryRequest) (*pb.NewMedianFactoryReply, error) {
-	dsConn, err := m.dial(request.DataSourceID)
+	key := request.IdempotencyKey
+	if key == "" {
+		id, err := m.newMedianFactory(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.NewMedianFactoryReply{ReportingPluginFactoryID: id}, nil
+	}
+
+	factory, added := m.factories.get(key)
+	if !added {
+		m.Logger.Debugw("Duplicate NewMedianFactory request", "key", key)
+		select {
+		case <-factory.done:
+		case <-ctx.Done():
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+		if factory.err != nil {
+			return nil, factory.err
+		}
+		return &pb.NewMedianFactoryReply{ReportingPluginFactoryID: factory.id}, nil
+	}
+
+	// forget the key once the factory is no longer served, or if it fails to be created
+	forget := NewResource("IdempotencyKey", fnCloser(func() { m.factories.remove(key, factory) }))
+	// The host may abandon this request after a timeout, and retry with the same key, so the factory is created
+	// independently of it.
+	newCtx, cancel := m.stopCtx()
+	defer cancel()
+	factory.id, factory.err = m.newMedianFactory(newCtx, request, forget)
+	close(factory.done)
+	if factory.err != nil {
+		return nil, factory.err
+	}
+	return &pb.NewMedianFactoryReply{ReportingPluginFactoryID: factory.id}, nil
+}
+
+// newMedianFactory creates a new factory and serves it along with deps, which are closed on error.
+func (m *pluginMedianServer) newMedianFactory(ctx context.Context, request *pb.NewMedianFactoryRequest, deps ...*Resource) (id uint32, err error) {
+	defer func() {
+		if err != nil {
+			m.closeAll(deps...)
+		}
+	}()
+	juelsCfg, err := juelsPerFeeCoinConfig(request.JuelsPerFeeCoinConfig)
 	if err != nil {
-		return nil, ErrConnDial{Name: "DataSource", ID: request.DataSourceID, Err: err}
+		return 0, err
 	}
-	dsRes := resource{dsConn, "DataSource"}
-	dataSource := newDataSourceClient(dsConn)
 
-	juelsConn, err := m.dial(request.JuelsPerFeeCoinDataSourceID)
+	dsConn, err := m.dial("DataSource", request.DataSourceID)
+	if err != nil {
+		return 0, ErrConnDial{Name: "DataSource", ID: request.DataSourceID, Err: err}
+	}
+	dsRes := NewResource("DataSource", dsConn)
+	dataSource, err := boundDataSource(newDataSourceClient(m.Logger, dsConn), request.DataSourceBounds)
 	if err != nil {
 		m.closeAll(dsRes)
-		return nil, ErrConnDial{Name: "JuelsPerFeeCoinDataSource", ID: request.JuelsPerFeeCoinDataSourceID, Err: err}
+		return 0, err
 	}
-	juelsRes := resource{juelsConn, "JuelsPerFeeCoinDataSource"}
-	juelsPerFeeCoin := newDataSourceClient(juelsConn)
 
-	providerConn, err := m.dial(request.MedianProviderID)
+	juelsConn, err := m.dial("JuelsPerFeeCoinDataSource", request.JuelsPerFeeCoinDataSourceID)
+	if err != nil {
+		m.closeAll(dsRes)
+		return 0, ErrConnDial{Name: "JuelsPerFeeCoinDataSource", ID: request.JuelsPerFeeCoinDataSourceID, Err: err}
+	}
+	juelsRes := NewResource("JuelsPerFeeCoinDataSource", juelsConn)
+	juelsPipeline, err := boundDataSource(newDataSourceClient(m.Logger, juelsConn), request.JuelsPerFeeCoinDataSourceBounds)
 	if err != nil {
 		m.closeAll(dsRes, juelsRes)
-		return nil, ErrConnDial{Name: "MedianProvider", ID: request.MedianProviderID, Err: err}
+		return 0, err
 	}
-	providerRes := resource{providerConn, "MedianProvider"}
+	juelsPerFeeCoin, err := NewJuelsPerFeeCoinSource(juelsCfg, juelsPipeline)
+	if err != nil {
+		m.closeAll(dsRes, juelsRes)
+		return 0, err
+	}
+
+	providerConn, err := m.dial("MedianProvider", request.MedianProviderID)
+	if err != nil {
+		m.closeAll(dsRes, juelsRes)
+		return 0, ErrConnDial{Name: "MedianProvider", ID: request.MedianProviderID, Err: err}
+	}
+	providerRes := NewResource("MedianProvider", providerConn)
 	provider := newMedianProviderClient(m.brokerExt, providerConn)
+	if extended, err := types.FeatureFlags(request.FeatureFlags).Bool(types.FeatureFlagExtendedObservations, false); err != nil {
+		m.Logger.Warnw("Ignoring invalid feature flag", "err", err)
+	} else if extended {
+		provider.reportCodec.(*reportCodecClient).extended = true
+	}
+	if m.checkConfigDigest {
+		if err = digestcheck.CheckLatest(ctx, provider.ContractConfigTracker(), provider.OffchainConfigDigester()); err != nil {
+			m.closeAll(dsRes, juelsRes, providerRes)
+			return 0, fmt.Errorf("MedianProvider failed config digest check: %w", err)
+		}
+	}
+	if request.ProviderCachePath != "" {
+		cache := NewMedianProviderCache(m.Logger, request.ProviderCachePath)
+		provider.contractTracker = cache.ContractConfigTracker(provider.contractTracker)
+		provider.medianContract = cache.MedianContract(provider.medianContract)
+	}
 
-	errorLogConn, err := m.dial(request.ErrorLogID)
+	errorLogConn, err := m.dial("ErrorLog", request.ErrorLogID)
 	if err != nil {
 		m.closeAll(dsRes, juelsRes, providerRes)
-		return nil, ErrConnDial{Name: "ErrorLog", ID: request.ErrorLogID, Err: err}
+		return 0, ErrConnDial{Name: "ErrorLog", ID: request.ErrorLogID, Err: err}
 	}
-	errorLogRes := resource{errorLogConn, "ErrorLog"}
+	errorLogRes := NewResource("ErrorLog", errorLogConn)
 	errorLog := newErrorLogClient(errorLogConn)
 
-	factory, err := m.impl.NewMedianFactory(ctx, provider, dataSource, juelsPerFeeCoin, errorLog)
+	ctx, keyValueStoreRes, err := m.dialKeyValueStore(ctx, request.KeyValueStoreID)
 	if err != nil {
 		m.closeAll(dsRes, juelsRes, providerRes, errorLogRes)
-		return nil, err
+		return 0, err
 	}
-
-	id, _, err := m.serveNew("ReportingPluginProvider", func(s *grpc.Server) {
-		pb.RegisterServiceServer(s, &serviceServer{srv: factory})
-		pb.RegisterReportingPluginFactoryServer(s, newReportingPluginFactoryServer(factory, m.brokerExt))
-	}, dsRes, juelsRes, providerRes, errorLogRes)
+	if request.FeatureFlags != nil {
+		ctx = types.ContextWithFeatureFlags(ctx, request.FeatureFlags)
+	}
+	factory, err := m.impl.NewMedianFactory(ctx, provider, dataSource, juelsPerFeeCoin, errorLog)
 	if err != nil {
-		return nil, err
+		m.closeAll(dsRes, juelsRes, providerRes, errorLogRes, keyValueStoreRes)
+		return 0, err
+	}
+	var rpFactory libocr.ReportingPluginFactory = factory
+	if m.timestampSkew.Enabled() {
+		rpFactory, err = NewSkewCheckedReportingPluginFactory(m.timestampSkew, factory)
+		if err != nil {
+			m.closeAll(dsRes, juelsRes, providerRes, errorLogRes, keyValueStoreRes)
+			return 0, err
+		}
 	}
 
-	return &pb.NewMedianFactoryReply{ReportingPluginFactoryID: id}, nil
+	id, _, err = m.serveNew("ReportingPluginProvider", func(s *grpc.Server) {
+		pb.RegisterServiceServer(s, &serviceServer{srv: factory})
+		pb.RegisterReportingPluginFactoryServer(s, newReportingPluginFactoryServer(rpFactory, m.brokerExt, m.limiter))
+	}, append(deps, dsRes, juelsRes, providerRes, errorLogRes, keyValueStoreRes)...)
+	return
 }
 
 var (
-	_ types.MedianProvider = (*medianProviderClient)(nil)
-	_ GRPCClientConn       = (*medianProviderClient)(nil)
+	_ types.MedianProvider       = (*medianProviderClient)(nil)
+	_ types.MedianProviderV2     = (*medianProviderClient)(nil)
+	_ types.GasEstimatorProvider = (*medianProviderClient)(nil)
+	_ types.EventQuerierProvider = (*medianProviderClient)(nil)
+	_ types.HeadTrackerProvider  = (*medianProviderClient)(nil)
+	_ GRPCClientConn             = (*medianProviderClient)(nil)
 )
 
 type medianProviderClient struct {
@@ -175,16 +394,22 @@ type medianProviderClient struct {
 	reportCodec         median.ReportCodec
 	medianContract      median.MedianContract
 	onchainConfigCodec  median.OnchainConfigCodec
+	gasEstimator        types.GasEstimator
+	eventQuerier        types.EventQuerier
+	headTracker         types.HeadTracker
 }
 
 func (m *medianProviderClient) ClientConn() grpc.ClientConnInterface { return m.cc }
 
 func newMedianProviderClient(b *brokerExt, cc grpc.ClientConnInterface) *medianProviderClient {
 	m := &medianProviderClient{configProviderClient: newConfigProviderClient(b.withName("MedianProviderClient"), cc)}
-	m.contractTransmitter = &contractTransmitterClient{b, pb.NewContractTransmitterClient(m.cc)}
-	m.reportCodec = &reportCodecClient{b, pb.NewReportCodecClient(m.cc)}
-	m.medianContract = &medianContractClient{pb.NewMedianContractClient(m.cc)}
+	m.contractTransmitter = newContractTransmitterClient(b, m.cc)
+	m.reportCodec = &reportCodecClient{brokerExt: b, grpc: pb.NewReportCodecClient(m.cc)}
+	m.medianContract = &medianContractClient{b.Logger, pb.NewMedianContractClient(m.cc)}
 	m.onchainConfigCodec = &onchainConfigCodecClient{b, pb.NewOnchainConfigCodecClient(m.cc)}
+	m.gasEstimator = &gasEstimatorClient{b.Logger, pb.NewGasEstimatorClient(m.cc)}
+	m.eventQuerier = &eventQuerierClient{b.Logger, pb.NewEventQuerierClient(m.cc)}
+	m.headTracker = &headTrackerClient{b.Logger, pb.NewHeadTrackerClient(m.cc)}
 	return m
 }
 
@@ -196,6 +421,10 @@ func (m *medianProviderClient) ReportCodec() median.ReportCodec {
 	return m.reportCodec
 }
 
+func (m *medianProviderClient) ReportCodecV2() types.ReportCodecV2 {
+	return types.UpgradeReportCodec(m.reportCodec)
+}
+
 func (m *medianProviderClient) MedianContract() median.MedianContract {
 	return m.medianContract
 }
@@ -204,28 +433,126 @@ func (m *medianProviderClient) OnchainConfigCodec() median.OnchainConfigCodec {
 	return m.onchainConfigCodec
 }
 
-var _ median.ReportCodec = (*reportCodecClient)(nil)
+func (m *medianProviderClient) OnchainConfigCodecV2() types.OnchainConfigCodecV2 {
+	return types.UpgradeOnchainConfigCodec(m.onchainConfigCodec)
+}
+
+// GasEstimator returns a client which fails with codes.Unimplemented if the remote provider does not implement
+// [types.GasEstimatorProvider].
+func (m *medianProviderClient) GasEstimator() types.GasEstimator {
+	return m.gasEstimator
+}
+
+// EventQuerier returns a client which fails with codes.Unimplemented if the remote provider does not implement
+// [types.EventQuerierProvider].
+func (m *medianProviderClient) EventQuerier() types.EventQuerier {
+	return m.eventQuerier
+}
+
+// HeadTracker returns a client which fails with codes.Unimplemented if the remote provider does not implement
+// [types.HeadTrackerProvider].
+func (m *medianProviderClient) HeadTracker() types.HeadTracker {
+	return m.headTracker
+}
+
+var (
+	_ median.ReportCodec   = (*reportCodecClient)(nil)
+	_ types.ReportCodecCtx = (*reportCodecClient)(nil)
+	_ types.ReportCodecExt = (*reportCodecClient)(nil)
+)
 
 type reportCodecClient struct {
 	*brokerExt
 	grpc pb.ReportCodecClient
+
+	unchunked atomic.Bool // the server does not implement BuildReportChunked
+	extended  bool        // the host set types.FeatureFlagExtendedObservations
 }
 
-func (r *reportCodecClient) BuildReport(observations []median.ParsedAttributedObservation) (report libocr.Report, err error) {
+func (r *reportCodecClient) BuildReport(observations []median.ParsedAttributedObservation) (libocr.Report, error) {
 	ctx, cancel := r.stopCtx()
 	defer cancel()
+	return r.BuildReportCtx(ctx, observations)
+}
 
-	var req pb.BuildReportRequest
-	for _, o := range observations {
-		req.Observations = append(req.Observations, &pb.ParsedAttributedObservation{
-			Timestamp:       o.Timestamp,
-			Value:           pb.NewBigIntFromInt(o.Value),
-			JulesPerFeeCoin: pb.NewBigIntFromInt(o.JuelsPerFeeCoin),
-			Observer:        uint32(o.Observer),
-		})
+func (r *reportCodecClient) BuildReportCtx(ctx context.Context, observations []median.ParsedAttributedObservation) (report libocr.Report, err error) {
+	defer recoverPanic(r.Logger, "ReportCodec.BuildReport", &err)
+	buf := getObservationsBuffer(len(observations))
+	defer buf.release()
+	for i, o := range observations {
+		buf.set(i, o)
+	}
+	return r.buildReport(ctx, &pb.BuildReportRequest{Observations: buf.ptrs})
+}
+
+func (r *reportCodecClient) BuildReportExt(ctx context.Context, observations []types.ParsedAttributedObservationExt) (_ libocr.Report, err error) {
+	defer recoverPanic(r.Logger, "ReportCodec.BuildReportExt", &err)
+	if !r.extended {
+		return nil, types.ErrExtendedObservationsUnsupported
+	}
+	buf := getObservationsBuffer(len(observations))
+	defer buf.release()
+	for i, o := range observations {
+		po := buf.set(i, o.ParsedAttributedObservation)
+		po.GasPriceSubunits = buf.ints.New(o.GasPriceSubunits)
+		po.Metadata = o.Metadata
+	}
+	return r.buildReport(ctx, &pb.BuildReportRequest{Observations: buf.ptrs, Extended: true})
+}
+
+// observationsBuffer holds the observations of a BuildReportRequest. Buffers are pooled, to amortize allocations
+// across requests, since large DONs report many observations, for many feeds.
+type observationsBuffer struct {
+	obs  []pb.ParsedAttributedObservation
+	ptrs []*pb.ParsedAttributedObservation
+	ints pb.BigIntBuffer
+}
+
+var observationsBuffers = sync.Pool{New: func() any { return new(observationsBuffer) }}
+
+// getObservationsBuffer returns a buffer from the pool, for n observations. It must be released once the request has
+// been sent.
+func getObservationsBuffer(n int) *observationsBuffer {
+	b := observationsBuffers.Get().(*observationsBuffer)
+	if cap(b.obs) < n {
+		b.obs = make([]pb.ParsedAttributedObservation, n)
+		b.ptrs = make([]*pb.ParsedAttributedObservation, n)
+	}
+	b.obs, b.ptrs = b.obs[:n], b.ptrs[:n]
+	return b
+}
+
+// set sets the ith observation to o, and returns it.
+func (b *observationsBuffer) set(i int, o median.ParsedAttributedObservation) *pb.ParsedAttributedObservation {
+	b.obs[i] = pb.ParsedAttributedObservation{
+		Timestamp:       o.Timestamp,
+		Value:           b.ints.New(o.Value),
+		JulesPerFeeCoin: b.ints.New(o.JuelsPerFeeCoin),
+		Observer:        uint32(o.Observer),
+	}
+	b.ptrs[i] = &b.obs[i]
+	return b.ptrs[i]
+}
+
+func (b *observationsBuffer) release() {
+	for i := range b.obs {
+		b.obs[i] = pb.ParsedAttributedObservation{} // release metadata
 	}
+	b.ints.Reset()
+	observationsBuffers.Put(b)
+}
+
+func (r *reportCodecClient) buildReport(ctx context.Context, req *pb.BuildReportRequest) (report libocr.Report, err error) {
 	var reply *pb.BuildReportReply
-	reply, err = r.grpc.BuildReport(ctx, &req)
+	if !r.unchunked.Load() {
+		reply, err = r.buildReportChunked(ctx, req)
+		if status.Code(err) == codes.Unimplemented {
+			r.unchunked.Store(true)
+		}
+	}
+	if r.unchunked.Load() {
+		reply, err = r.grpc.BuildReport(ctx, req)
+	}
 	if err != nil {
 		return
 	}
@@ -233,10 +560,29 @@ func (r *reportCodecClient) BuildReport(observations []median.ParsedAttributedOb
 	return
 }
 
+// buildReportChunked receives the reply in chunks, so that reports may exceed the max message size.
+func (r *reportCodecClient) buildReportChunked(ctx context.Context, req *pb.BuildReportRequest) (*pb.BuildReportReply, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := r.grpc.BuildReportChunked(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	var reply pb.BuildReportReply
+	if err = recvChunked(stream, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
 func (r *reportCodecClient) MedianFromReport(report libocr.Report) (*big.Int, error) {
 	ctx, cancel := r.stopCtx()
 	defer cancel()
+	return r.MedianFromReportCtx(ctx, report)
+}
 
+func (r *reportCodecClient) MedianFromReportCtx(ctx context.Context, report libocr.Report) (_ *big.Int, err error) {
+	defer recoverPanic(r.Logger, "ReportCodec.MedianFromReport", &err)
 	reply, err := r.grpc.MedianFromReport(ctx, &pb.MedianFromReportRequest{Report: report})
 	if err != nil {
 		return nil, err
@@ -247,7 +593,11 @@ func (r *reportCodecClient) MedianFromReport(report libocr.Report) (*big.Int, er
 func (r *reportCodecClient) MaxReportLength(n int) (int, error) {
 	ctx, cancel := r.stopCtx()
 	defer cancel()
+	return r.MaxReportLengthCtx(ctx, n)
+}
 
+func (r *reportCodecClient) MaxReportLengthCtx(ctx context.Context, n int) (_ int, err error) {
+	defer recoverPanic(r.Logger, "ReportCodec.MaxReportLength", &err)
 	reply, err := r.grpc.MaxReportLength(ctx, &pb.MaxReportLengthRequest{N: int64(n)})
 	if err != nil {
 		return -1, err
@@ -259,33 +609,87 @@ var _ pb.ReportCodecServer = (*reportCodecServer)(nil)
 
 type reportCodecServer struct {
 	pb.UnimplementedReportCodecServer
-	impl median.ReportCodec
+	impl      median.ReportCodec
+	chunkSize int // for BuildReportChunked replies
 }
 
 func (r *reportCodecServer) BuildReport(ctx context.Context, request *pb.BuildReportRequest) (*pb.BuildReportReply, error) {
-	var obs []median.ParsedAttributedObservation
+	if request.Extended {
+		return r.buildReportExt(ctx, request)
+	}
+	obs := make([]median.ParsedAttributedObservation, 0, len(request.Observations))
 	for _, o := range request.Observations {
+		po, err := parsedAttributedObservation(o)
+		if err != nil {
+			return nil, err
+		}
+		obs = append(obs, po)
+	}
+	var report libocr.Report
+	var err error
+	if impl, ok := r.impl.(types.ReportCodecCtx); ok {
+		report, err = impl.BuildReportCtx(ctx, obs)
+	} else {
+		report, err = r.impl.BuildReport(obs)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.BuildReportReply{Report: report}, nil
+}
 
-		val, jpfc := o.Value.Int(), o.JulesPerFeeCoin.Int()
-		if o.Observer > math.MaxUint8 {
-			return nil, fmt.Errorf("expected uint8 Observer (max %d) but got %d", math.MaxUint8, o.Observer)
+func (r *reportCodecServer) buildReportExt(ctx context.Context, request *pb.BuildReportRequest) (*pb.BuildReportReply, error) {
+	impl, ok := r.impl.(types.ReportCodecExt)
+	if !ok {
+		return nil, types.ErrExtendedObservationsUnsupported
+	}
+	obs := make([]types.ParsedAttributedObservationExt, 0, len(request.Observations))
+	for _, o := range request.Observations {
+		po, err := parsedAttributedObservation(o)
+		if err != nil {
+			return nil, err
 		}
-		obs = append(obs, median.ParsedAttributedObservation{
-			Timestamp:       o.Timestamp,
-			Value:           val,
-			JuelsPerFeeCoin: jpfc,
-			Observer:        commontypes.OracleID(o.Observer),
+		obs = append(obs, types.ParsedAttributedObservationExt{
+			ParsedAttributedObservation: po,
+			GasPriceSubunits:            o.GasPriceSubunits.Int(),
+			Metadata:                    o.Metadata,
 		})
 	}
-	report, err := r.impl.BuildReport(obs)
+	report, err := impl.BuildReportExt(ctx, obs)
 	if err != nil {
 		return nil, err
 	}
 	return &pb.BuildReportReply{Report: report}, nil
 }
 
+func parsedAttributedObservation(o *pb.ParsedAttributedObservation) (median.ParsedAttributedObservation, error) {
+	if err := o.Validate(); err != nil {
+		return median.ParsedAttributedObservation{}, err
+	}
+	return median.ParsedAttributedObservation{
+		Timestamp:       o.Timestamp,
+		Value:           o.Value.Int(),
+		JuelsPerFeeCoin: o.JulesPerFeeCoin.Int(),
+		Observer:        commontypes.OracleID(o.Observer),
+	}, nil
+}
+
+func (r *reportCodecServer) BuildReportChunked(request *pb.BuildReportRequest, stream pb.ReportCodec_BuildReportChunkedServer) error {
+	reply, err := r.BuildReport(stream.Context(), request)
+	if err != nil {
+		return err
+	}
+	return sendChunked(stream, reply, r.chunkSize)
+}
+
 func (r *reportCodecServer) MedianFromReport(ctx context.Context, request *pb.MedianFromReportRequest) (*pb.MedianFromReportReply, error) {
-	m, err := r.impl.MedianFromReport(request.Report)
+	var m *big.Int
+	var err error
+	if impl, ok := r.impl.(types.ReportCodecCtx); ok {
+		m, err = impl.MedianFromReportCtx(ctx, request.Report)
+	} else {
+		m, err = r.impl.MedianFromReport(request.Report)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -293,7 +697,13 @@ func (r *reportCodecServer) MedianFromReport(ctx context.Context, request *pb.Me
 }
 
 func (r *reportCodecServer) MaxReportLength(ctx context.Context, request *pb.MaxReportLengthRequest) (*pb.MaxReportLengthReply, error) {
-	l, err := r.impl.MaxReportLength(int(request.N))
+	var l int
+	var err error
+	if impl, ok := r.impl.(types.ReportCodecCtx); ok {
+		l, err = impl.MaxReportLengthCtx(ctx, int(request.N))
+	} else {
+		l, err = r.impl.MaxReportLength(int(request.N))
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -303,25 +713,22 @@ func (r *reportCodecServer) MaxReportLength(ctx context.Context, request *pb.Max
 var _ median.MedianContract = (*medianContractClient)(nil)
 
 type medianContractClient struct {
+	lggr logger.Logger
 	grpc pb.MedianContractClient
 }
 
 func (m *medianContractClient) LatestTransmissionDetails(ctx context.Context) (configDigest libocr.ConfigDigest, epoch uint32, round uint8, latestAnswer *big.Int, latestTimestamp time.Time, err error) {
+	defer recoverPanic(m.lggr, "MedianContract.LatestTransmissionDetails", &err)
 	var reply *pb.LatestTransmissionDetailsReply
 	reply, err = m.grpc.LatestTransmissionDetails(ctx, &pb.LatestTransmissionDetailsRequest{})
 	if err != nil {
 		return
 	}
-	if l := len(reply.ConfigDigest); l != 32 {
-		err = fmt.Errorf("expected ConfigDigest length 32 but got %d", l)
+	if err = reply.Validate(); err != nil {
 		return
 	}
 	copy(configDigest[:], reply.ConfigDigest)
 	epoch = reply.Epoch
-	if reply.Round > math.MaxUint8 {
-		err = fmt.Errorf("expected uint8 Round (max %d) but got %d", math.MaxUint8, reply.Round)
-		return
-	}
 	round = uint8(reply.Round)
 	latestAnswer = reply.LatestAnswer.Int()
 	latestTimestamp = reply.LatestTimestamp.AsTime()
@@ -329,20 +736,16 @@ func (m *medianContractClient) LatestTransmissionDetails(ctx context.Context) (c
 }
 
 func (m *medianContractClient) LatestRoundRequested(ctx context.Context, lookback time.Duration) (configDigest libocr.ConfigDigest, epoch uint32, round uint8, err error) {
+	defer recoverPanic(m.lggr, "MedianContract.LatestRoundRequested", &err)
 	reply, err := m.grpc.LatestRoundRequested(ctx, &pb.LatestRoundRequestedRequest{Lookback: int64(lookback)})
 	if err != nil {
 		return
 	}
-	if l := len(reply.ConfigDigest); l != 32 {
-		err = fmt.Errorf("expected ConfigDigest length 32 but got %d", l)
+	if err = reply.Validate(); err != nil {
 		return
 	}
 	copy(configDigest[:], reply.ConfigDigest)
 	epoch = reply.Epoch
-	if reply.Round > math.MaxUint8 {
-		err = fmt.Errorf("expected uint8 Round (max %d) but got %d", math.MaxUint8, reply.Round)
-		return
-	}
 	round = uint8(reply.Round)
 	return
 }
@@ -382,7 +785,10 @@ func (m *medianContractServer) LatestRoundRequested(ctx context.Context, request
 	}, nil
 }
 
-var _ median.OnchainConfigCodec = (*onchainConfigCodecClient)(nil)
+var (
+	_ median.OnchainConfigCodec   = (*onchainConfigCodecClient)(nil)
+	_ types.OnchainConfigCodecCtx = (*onchainConfigCodecClient)(nil)
+)
 
 type onchainConfigCodecClient struct {
 	*brokerExt
@@ -392,7 +798,11 @@ type onchainConfigCodecClient struct {
 func (o *onchainConfigCodecClient) Encode(config median.OnchainConfig) ([]byte, error) {
 	ctx, cancel := o.stopCtx()
 	defer cancel()
+	return o.EncodeCtx(ctx, config)
+}
 
+func (o *onchainConfigCodecClient) EncodeCtx(ctx context.Context, config median.OnchainConfig) (_ []byte, err error) {
+	defer recoverPanic(o.Logger, "OnchainConfigCodec.Encode", &err)
 	req := &pb.EncodeRequest{OnchainConfig: &pb.OnchainConfig{
 		Min: pb.NewBigIntFromInt(config.Min),
 		Max: pb.NewBigIntFromInt(config.Max),
@@ -404,15 +814,22 @@ func (o *onchainConfigCodecClient) Encode(config median.OnchainConfig) ([]byte,
 	return reply.Encoded, nil
 }
 
-func (o *onchainConfigCodecClient) Decode(bytes []byte) (oc median.OnchainConfig, err error) {
+func (o *onchainConfigCodecClient) Decode(bytes []byte) (median.OnchainConfig, error) {
 	ctx, cancel := o.stopCtx()
 	defer cancel()
+	return o.DecodeCtx(ctx, bytes)
+}
 
+func (o *onchainConfigCodecClient) DecodeCtx(ctx context.Context, bytes []byte) (oc median.OnchainConfig, err error) {
+	defer recoverPanic(o.Logger, "OnchainConfigCodec.Decode", &err)
 	var reply *pb.DecodeReply
 	reply, err = o.grpc.Decode(ctx, &pb.DecodeRequest{Encoded: bytes})
 	if err != nil {
 		return
 	}
+	if err = reply.Validate(); err != nil {
+		return
+	}
 	oc.Min, oc.Max = reply.OnchainConfig.Min.Int(), reply.OnchainConfig.Max.Int()
 	return
 }
@@ -425,8 +842,18 @@ type onchainConfigCodecServer struct {
 }
 
 func (o *onchainConfigCodecServer) Encode(ctx context.Context, request *pb.EncodeRequest) (*pb.EncodeReply, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
 	min, max := request.OnchainConfig.Min.Int(), request.OnchainConfig.Max.Int()
-	b, err := o.impl.Encode(median.OnchainConfig{Max: max, Min: min})
+	config := median.OnchainConfig{Max: max, Min: min}
+	var b []byte
+	var err error
+	if impl, ok := o.impl.(types.OnchainConfigCodecCtx); ok {
+		b, err = impl.EncodeCtx(ctx, config)
+	} else {
+		b, err = o.impl.Encode(config)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -434,7 +861,13 @@ func (o *onchainConfigCodecServer) Encode(ctx context.Context, request *pb.Encod
 }
 
 func (o *onchainConfigCodecServer) Decode(ctx context.Context, request *pb.DecodeRequest) (*pb.DecodeReply, error) {
-	oc, err := o.impl.Decode(request.Encoded)
+	var oc median.OnchainConfig
+	var err error
+	if impl, ok := o.impl.(types.OnchainConfigCodecCtx); ok {
+		oc, err = impl.DecodeCtx(ctx, request.Encoded)
+	} else {
+		oc, err = o.impl.Decode(request.Encoded)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,244 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	libocr "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+)
+
+type providerCachePathKey struct{}
+
+// ContextWithProviderCachePath returns a copy of ctx carrying the path of a file in which the plugin caches the latest
+// config and transmission details of the provider passed to NewMedianFactory. See [MedianProviderCache].
+func ContextWithProviderCachePath(ctx context.Context, path string) context.Context {
+	return context.WithValue(ctx, providerCachePathKey{}, path)
+}
+
+// providerCachePathFromContext returns the path set on ctx by [ContextWithProviderCachePath], or "" if none was set.
+func providerCachePathFromContext(ctx context.Context) string {
+	path, _ := ctx.Value(providerCachePathKey{}).(string)
+	return path
+}
+
+// MedianProviderCache persists the latest config details and transmission details of a median provider in a file, so
+// that a restarted plugin can serve libocr's initial queries immediately, while the connection to the relayer warms up.
+//
+// Until the relayer has answered, the first call of each method is served from the cache, and later calls fall back to
+// it on error. Afterwards, calls always go to the relayer, and update the cache. Cached transmission details are
+// dropped when the config digest changes.
+type MedianProviderCache struct {
+	lggr logger.Logger
+	path string
+
+	mu     sync.Mutex
+	state  providerCacheState
+	warm   bool // the relayer has answered, so the cache is no longer served
+	served struct{ configDetails, transmissionDetails bool }
+}
+
+type providerCacheState struct {
+	ConfigDetails       *cachedConfigDetails       `json:"configDetails,omitempty"`
+	TransmissionDetails *cachedTransmissionDetails `json:"transmissionDetails,omitempty"`
+}
+
+type cachedConfigDetails struct {
+	ChangedInBlock uint64 `json:"changedInBlock"`
+	ConfigDigest   []byte `json:"configDigest"`
+}
+
+type cachedTransmissionDetails struct {
+	ConfigDigest    []byte    `json:"configDigest"`
+	Epoch           uint32    `json:"epoch"`
+	Round           uint8     `json:"round"`
+	LatestAnswer    *big.Int  `json:"latestAnswer"`
+	LatestTimestamp time.Time `json:"latestTimestamp"`
+}
+
+// NewMedianProviderCache returns a cache backed by the file at path, loading any details persisted by an earlier
+// instance. A missing or unreadable file leaves the cache empty.
+func NewMedianProviderCache(lggr logger.Logger, path string) *MedianProviderCache {
+	c := &MedianProviderCache{lggr: logger.Named(lggr, "MedianProviderCache"), path: path}
+	b, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return c
+	} else if err != nil {
+		c.lggr.Errorw("Failed to read cache", "path", path, "err", err)
+		return c
+	}
+	var state providerCacheState
+	if err = json.Unmarshal(b, &state); err != nil {
+		c.lggr.Errorw("Ignoring invalid cache", "path", path, "err", err)
+		return c
+	}
+	if state.ConfigDetails != nil && !validDigest(state.ConfigDetails.ConfigDigest) ||
+		state.TransmissionDetails != nil && (!validDigest(state.TransmissionDetails.ConfigDigest) || state.TransmissionDetails.LatestAnswer == nil) {
+		c.lggr.Errorw("Ignoring invalid cache", "path", path, "err", "missing fields")
+		return c
+	}
+	if state.ConfigDetails != nil && state.TransmissionDetails != nil &&
+		string(state.ConfigDetails.ConfigDigest) != string(state.TransmissionDetails.ConfigDigest) {
+		state.TransmissionDetails = nil // from an earlier config
+	}
+	c.state = state
+	return c
+}
+
+func validDigest(b []byte) bool { return len(b) == len(libocr.ConfigDigest{}) }
+
+// ContractConfigTracker returns cct, with LatestConfigDetails served from the cache as necessary.
+func (c *MedianProviderCache) ContractConfigTracker(cct libocr.ContractConfigTracker) libocr.ContractConfigTracker {
+	return &cachedContractConfigTracker{cct, c}
+}
+
+// MedianContract returns mc, with LatestTransmissionDetails served from the cache as necessary.
+func (c *MedianProviderCache) MedianContract(mc median.MedianContract) median.MedianContract {
+	return &cachedMedianContract{mc, c}
+}
+
+// configDetails returns the cached details, if they should be served instead of calling the relayer. first is true
+// before the call, and false after a failed call.
+func (c *MedianProviderCache) configDetails(first bool) *cachedConfigDetails {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.warm || first && c.served.configDetails {
+		return nil
+	}
+	c.served.configDetails = true
+	return c.state.ConfigDetails
+}
+
+func (c *MedianProviderCache) setConfigDetails(changedInBlock uint64, configDigest libocr.ConfigDigest) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.warm = true
+	if old := c.state.ConfigDetails; old != nil && old.ChangedInBlock == changedInBlock && string(old.ConfigDigest) == string(configDigest[:]) {
+		return
+	}
+	c.state.ConfigDetails = &cachedConfigDetails{ChangedInBlock: changedInBlock, ConfigDigest: configDigest[:]}
+	if td := c.state.TransmissionDetails; td != nil && string(td.ConfigDigest) != string(configDigest[:]) {
+		c.state.TransmissionDetails = nil
+	}
+	c.persist()
+}
+
+// transmissionDetails returns the cached details, if they should be served instead of calling the relayer. first is
+// true before the call, and false after a failed call.
+func (c *MedianProviderCache) transmissionDetails(first bool) *cachedTransmissionDetails {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.warm || first && c.served.transmissionDetails {
+		return nil
+	}
+	c.served.transmissionDetails = true
+	return c.state.TransmissionDetails
+}
+
+func (c *MedianProviderCache) setTransmissionDetails(td cachedTransmissionDetails) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.warm = true
+	if old := c.state.TransmissionDetails; old != nil && string(old.ConfigDigest) == string(td.ConfigDigest) && old.Epoch == td.Epoch && old.Round == td.Round {
+		return
+	}
+	c.state.TransmissionDetails = &td
+	c.persist()
+}
+
+// persist writes the state to a temporary file, and renames it, so that the cache is never left partially written.
+func (c *MedianProviderCache) persist() {
+	if err := c.write(); err != nil {
+		c.lggr.Errorw("Failed to write cache", "path", c.path, "err", err)
+	}
+}
+
+func (c *MedianProviderCache) write() error {
+	b, err := json.Marshal(c.state)
+	if err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(filepath.Dir(c.path), filepath.Base(c.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err = f.Write(b); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), c.path)
+}
+
+var _ libocr.ContractConfigTracker = (*cachedContractConfigTracker)(nil)
+
+type cachedContractConfigTracker struct {
+	libocr.ContractConfigTracker
+	cache *MedianProviderCache
+}
+
+func (c *cachedContractConfigTracker) LatestConfigDetails(ctx context.Context) (changedInBlock uint64, configDigest libocr.ConfigDigest, err error) {
+	if cd := c.cache.configDetails(true); cd != nil {
+		copy(configDigest[:], cd.ConfigDigest)
+		return cd.ChangedInBlock, configDigest, nil
+	}
+	changedInBlock, configDigest, err = c.ContractConfigTracker.LatestConfigDetails(ctx)
+	if err != nil {
+		if cd := c.cache.configDetails(false); cd != nil {
+			c.cache.lggr.Warnw("Serving cached config details", "err", err)
+			copy(configDigest[:], cd.ConfigDigest)
+			return cd.ChangedInBlock, configDigest, nil
+		}
+		return
+	}
+	c.cache.setConfigDetails(changedInBlock, configDigest)
+	return
+}
+
+var _ median.MedianContract = (*cachedMedianContract)(nil)
+
+type cachedMedianContract struct {
+	median.MedianContract
+	cache *MedianProviderCache
+}
+
+func (c *cachedMedianContract) LatestTransmissionDetails(ctx context.Context) (configDigest libocr.ConfigDigest, epoch uint32, round uint8, latestAnswer *big.Int, latestTimestamp time.Time, err error) {
+	if td := c.cache.transmissionDetails(true); td != nil {
+		return td.unpack()
+	}
+	configDigest, epoch, round, latestAnswer, latestTimestamp, err = c.MedianContract.LatestTransmissionDetails(ctx)
+	if err != nil {
+		if td := c.cache.transmissionDetails(false); td != nil {
+			c.cache.lggr.Warnw("Serving cached transmission details", "err", err)
+			return td.unpack()
+		}
+		return
+	}
+	if latestAnswer != nil {
+		c.cache.setTransmissionDetails(cachedTransmissionDetails{
+			ConfigDigest:    configDigest[:],
+			Epoch:           epoch,
+			Round:           round,
+			LatestAnswer:    new(big.Int).Set(latestAnswer),
+			LatestTimestamp: latestTimestamp,
+		})
+	}
+	return
+}
+
+func (td *cachedTransmissionDetails) unpack() (configDigest libocr.ConfigDigest, epoch uint32, round uint8, latestAnswer *big.Int, latestTimestamp time.Time, err error) {
+	copy(configDigest[:], td.ConfigDigest)
+	return configDigest, td.Epoch, td.Round, new(big.Int).Set(td.LatestAnswer), td.LatestTimestamp, nil
+}
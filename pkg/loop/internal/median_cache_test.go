@@ -0,0 +1,106 @@
+package internal_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	libocr "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/internal"
+)
+
+// fakeRelayer serves config and transmission details, or err.
+type fakeRelayer struct {
+	libocr.ContractConfigTracker
+	median.MedianContract
+
+	changedInBlock uint64
+	digest         libocr.ConfigDigest
+	round          uint8
+	err            error
+}
+
+func (f *fakeRelayer) LatestConfigDetails(context.Context) (uint64, libocr.ConfigDigest, error) {
+	return f.changedInBlock, f.digest, f.err
+}
+
+func (f *fakeRelayer) LatestTransmissionDetails(context.Context) (libocr.ConfigDigest, uint32, uint8, *big.Int, time.Time, error) {
+	return f.digest, 1, f.round, big.NewInt(int64(f.round)), time.Unix(int64(f.round), 0).UTC(), f.err
+}
+
+func TestMedianProviderCache(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "cache.json")
+	relayer := &fakeRelayer{changedInBlock: 10, digest: libocr.ConfigDigest{1}, round: 3}
+	newCache := func() (libocr.ContractConfigTracker, median.MedianContract) {
+		c := internal.NewMedianProviderCache(logger.Test(t), path)
+		return c.ContractConfigTracker(relayer), c.MedianContract(relayer)
+	}
+	assertConfigDetails := func(t *testing.T, cct libocr.ContractConfigTracker, changedInBlock uint64, digest libocr.ConfigDigest) {
+		gotChangedInBlock, gotDigest, err := cct.LatestConfigDetails(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, changedInBlock, gotChangedInBlock)
+		assert.Equal(t, digest, gotDigest)
+	}
+	assertRound := func(t *testing.T, mc median.MedianContract, round uint8) {
+		_, _, gotRound, _, _, err := mc.LatestTransmissionDetails(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, round, gotRound)
+	}
+
+	// empty cache
+	cct, mc := newCache()
+	assertConfigDetails(t, cct, 10, libocr.ConfigDigest{1})
+	assertRound(t, mc, 3)
+
+	t.Run("restart", func(t *testing.T) {
+		relayer.err = errors.New("relayer unavailable")
+		cct, mc := newCache()
+		// served from the cache
+		assertConfigDetails(t, cct, 10, libocr.ConfigDigest{1})
+		assertRound(t, mc, 3)
+		// fall back to the cache
+		assertConfigDetails(t, cct, 10, libocr.ConfigDigest{1})
+		assertRound(t, mc, 3)
+
+		relayer.err = nil
+		relayer.round = 4
+		assertRound(t, mc, 4)
+
+		// served by the relayer, once it has answered
+		relayer.err = errors.New("relayer unavailable")
+		_, _, err := cct.LatestConfigDetails(ctx)
+		require.ErrorIs(t, err, relayer.err)
+		relayer.err = nil
+	})
+
+	t.Run("first call", func(t *testing.T) {
+		cct, mc := newCache()
+		relayer.round = 5
+		assertRound(t, mc, 4) // stale
+		assertRound(t, mc, 5)
+		assertConfigDetails(t, cct, 10, libocr.ConfigDigest{1})
+	})
+
+	t.Run("config digest change", func(t *testing.T) {
+		cct, _ := newCache()
+		assertConfigDetails(t, cct, 10, libocr.ConfigDigest{1}) // cached
+		relayer.changedInBlock, relayer.digest = 20, libocr.ConfigDigest{2}
+		assertConfigDetails(t, cct, 20, libocr.ConfigDigest{2})
+
+		relayer.err = errors.New("relayer unavailable")
+		cct, mc := newCache()
+		assertConfigDetails(t, cct, 20, libocr.ConfigDigest{2})
+		_, _, _, _, _, err := mc.LatestTransmissionDetails(ctx)
+		require.ErrorIs(t, err, relayer.err, "transmission details of the old config must be dropped")
+	})
+}
@@ -1,7 +1,12 @@
 package internal
 
 import (
+	"context"
+
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/pb"
 )
 
 type pluginClient struct {
@@ -28,3 +33,10 @@ type GRPCClientConn interface {
 	// ClientConn returns the underlying client connection.
 	ClientConn() grpc.ClientConnInterface
 }
+
+// PingClientConn checks the liveness of a proxied connection by calling Ready on the Service served at cc.
+// If cc is refreshable, terminal errors cause it to be re-resolved.
+func PingClientConn(ctx context.Context, cc GRPCClientConn) error {
+	_, err := pb.NewServiceClient(cc.ClientConn()).Ready(ctx, &emptypb.Empty{})
+	return err
+}
@@ -2,33 +2,52 @@ package internal
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"math"
+	"io"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/smartcontractkit/libocr/commontypes"
 	libocr "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
 
-	"github.com/smartcontractkit/chainlink-relay/pkg/loop/internal/pb"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/pb"
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
 )
 
-var _ libocr.ContractTransmitter = (*contractTransmitterClient)(nil)
+// FromAccountCache is implemented by ContractTransmitters which cache FromAccount, once resolved.
+type FromAccountCache interface {
+	// InvalidateFromAccount discards the cached account, so that the next call to FromAccount resolves it again.
+	InvalidateFromAccount()
+}
+
+var (
+	_ types.ReportAccountTransmitter  = (*contractTransmitterClient)(nil)
+	_ types.TransmitStatusTransmitter = (*contractTransmitterClient)(nil)
+	_ FromAccountCache                = (*contractTransmitterClient)(nil)
+)
 
 type contractTransmitterClient struct {
 	*brokerExt
 	grpc pb.ContractTransmitterClient
+
+	fromAccountMu sync.Mutex
+	fromAccount   libocr.Account // cached; empty until resolved
+}
+
+func newContractTransmitterClient(b *brokerExt, cc grpc.ClientConnInterface) *contractTransmitterClient {
+	return &contractTransmitterClient{brokerExt: b, grpc: pb.NewContractTransmitterClient(cc)}
 }
 
-func (c *contractTransmitterClient) Transmit(ctx context.Context, reportContext libocr.ReportContext, report libocr.Report, signatures []libocr.AttributedOnchainSignature) error {
+func (c *contractTransmitterClient) Transmit(ctx context.Context, reportContext libocr.ReportContext, report libocr.Report, signatures []libocr.AttributedOnchainSignature) (err error) {
+	defer recoverPanic(c.Logger, "ContractTransmitter.Transmit", &err)
 	req := &pb.TransmitRequest{
-		ReportContext: &pb.ReportContext{
-			ReportTimestamp: &pb.ReportTimestamp{
-				ConfigDigest: reportContext.ReportTimestamp.ConfigDigest[:],
-				Epoch:        reportContext.ReportTimestamp.Epoch,
-				Round:        uint32(reportContext.ReportTimestamp.Round),
-			},
-			ExtraHash: reportContext.ExtraHash[:],
-		},
-		Report: report,
+		ReportContext: pbReportContext(reportContext),
+		Report:        report,
 	}
 	for _, s := range signatures {
 		req.AttributedOnchainSignatures = append(req.AttributedOnchainSignatures,
@@ -37,21 +56,40 @@ func (c *contractTransmitterClient) Transmit(ctx context.Context, reportContext
 				Signer:    uint32(s.Signer),
 			})
 	}
-	_, err := c.grpc.Transmit(ctx, req)
+	if proto.Size(req) > c.chunkSize() {
+		return c.transmitChunked(ctx, req)
+	}
+	_, err = c.grpc.Transmit(ctx, req)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
+// transmitChunked sends req in chunks, for reports which would exceed the max message size.
+func (c *contractTransmitterClient) transmitChunked(ctx context.Context, req *pb.TransmitRequest) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := c.grpc.TransmitChunked(ctx)
+	if err != nil {
+		return err
+	}
+	if err = sendChunked(stream, req, c.chunkSize()); err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	// io.EOF means the server ended the stream early, and its status is returned here
+	_, err = stream.CloseAndRecv()
+	return err
+}
+
 func (c *contractTransmitterClient) LatestConfigDigestAndEpoch(ctx context.Context) (configDigest libocr.ConfigDigest, epoch uint32, err error) {
+	defer recoverPanic(c.Logger, "ContractTransmitter.LatestConfigDigestAndEpoch", &err)
 	var reply *pb.LatestConfigDigestAndEpochReply
 	reply, err = c.grpc.LatestConfigDigestAndEpoch(ctx, &pb.LatestConfigDigestAndEpochRequest{})
 	if err != nil {
 		return
 	}
-	if l := len(reply.ConfigDigest); l != 32 {
-		err = ErrConfigDigestLen(l)
+	if err = reply.Validate(); err != nil {
 		return
 	}
 	copy(configDigest[:], reply.ConfigDigest)
@@ -59,7 +97,15 @@ func (c *contractTransmitterClient) LatestConfigDigestAndEpoch(ctx context.Conte
 	return
 }
 
-func (c *contractTransmitterClient) FromAccount() (libocr.Account, error) {
+// FromAccount returns the cached account, or resolves it once.
+func (c *contractTransmitterClient) FromAccount() (_ libocr.Account, err error) {
+	defer recoverPanic(c.Logger, "ContractTransmitter.FromAccount", &err)
+	c.fromAccountMu.Lock()
+	defer c.fromAccountMu.Unlock()
+	if c.fromAccount != "" {
+		return c.fromAccount, nil
+	}
+
 	ctx, cancel := c.stopCtx()
 	defer cancel()
 
@@ -67,7 +113,66 @@ func (c *contractTransmitterClient) FromAccount() (libocr.Account, error) {
 	if err != nil {
 		return "", err
 	}
-	return libocr.Account(reply.Account), nil
+	account, err := c.account(reply.Account)
+	if err != nil {
+		return "", err
+	}
+	c.fromAccount = account
+	return c.fromAccount, nil
+}
+
+// account validates account for the AccountFamily, if configured.
+func (c *contractTransmitterClient) account(account string) (libocr.Account, error) {
+	a := types.Account(account)
+	if c.AccountFamily != "" {
+		if err := a.Validate(c.AccountFamily); err != nil {
+			return "", fmt.Errorf("ContractTransmitter returned malformed account: %w", err)
+		}
+	}
+	return a.OCR(), nil
+}
+
+func (c *contractTransmitterClient) InvalidateFromAccount() {
+	c.fromAccountMu.Lock()
+	defer c.fromAccountMu.Unlock()
+	c.fromAccount = ""
+}
+
+// FromAccountForReport is not cached. The server falls back to FromAccount if the transmitter does not implement
+// [types.ReportAccountTransmitter].
+func (c *contractTransmitterClient) FromAccountForReport(ctx context.Context, reportContext libocr.ReportContext) (_ libocr.Account, err error) {
+	defer recoverPanic(c.Logger, "ContractTransmitter.FromAccountForReport", &err)
+	reply, err := c.grpc.FromAccount(ctx, &pb.FromAccountRequest{ReportContext: pbReportContext(reportContext)})
+	if err != nil {
+		return "", err
+	}
+	return c.account(reply.Account)
+}
+
+// SubscribeTransmitStatus fails with codes.Unimplemented if the remote transmitter does not implement
+// [types.TransmitStatusTransmitter].
+func (c *contractTransmitterClient) SubscribeTransmitStatus(ctx context.Context, fn func(types.TransmitStatus) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := c.grpc.SubscribeTransmitStatus(ctx, &pb.SubscribeTransmitStatusRequest{})
+	if err != nil {
+		return err
+	}
+	for {
+		ps, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		ts, err := transmitStatus(ps)
+		if err != nil {
+			return err
+		}
+		if err = fn(ts); err != nil {
+			return err
+		}
+	}
 }
 
 var _ pb.ContractTransmitterServer = (*contractTransmitterServer)(nil)
@@ -78,24 +183,14 @@ type contractTransmitterServer struct {
 }
 
 func (c *contractTransmitterServer) Transmit(ctx context.Context, request *pb.TransmitRequest) (*pb.TransmitReply, error) {
-	var reportCtx libocr.ReportContext
-	if l := len(request.ReportContext.ReportTimestamp.ConfigDigest); l != 32 {
-		return nil, ErrConfigDigestLen(l)
-	}
-	copy(reportCtx.ConfigDigest[:], request.ReportContext.ReportTimestamp.ConfigDigest)
-	reportCtx.Epoch = request.ReportContext.ReportTimestamp.Epoch
-	if request.ReportContext.ReportTimestamp.Round > math.MaxUint8 {
-		return nil, ErrUint8Bounds{Name: "Round", U: request.ReportContext.ReportTimestamp.Round}
-	}
-	reportCtx.Round = uint8(request.ReportContext.ReportTimestamp.Round)
-	if l := len(request.ReportContext.ExtraHash); l != 32 {
-		return nil, fmt.Errorf("invalid ExtraHash len %d; must be 32", l)
+	reportCtx, err := reportContext(request.ReportContext)
+	if err != nil {
+		return nil, err
 	}
-	copy(reportCtx.ExtraHash[:], request.ReportContext.ExtraHash)
 	var sigs []libocr.AttributedOnchainSignature
 	for _, s := range request.AttributedOnchainSignatures {
-		if s.Signer > math.MaxUint8 {
-			return nil, ErrUint8Bounds{Name: "Signer", U: s.Signer}
+		if err = s.Validate(); err != nil {
+			return nil, err
 		}
 		sigs = append(sigs, libocr.AttributedOnchainSignature{
 			Signature: s.Signature,
@@ -105,6 +200,18 @@ func (c *contractTransmitterServer) Transmit(ctx context.Context, request *pb.Tr
 	return &pb.TransmitReply{}, c.impl.Transmit(ctx, reportCtx, request.Report, sigs)
 }
 
+func (c *contractTransmitterServer) TransmitChunked(stream pb.ContractTransmitter_TransmitChunkedServer) error {
+	var request pb.TransmitRequest
+	if err := recvChunked(stream, &request); err != nil {
+		return err
+	}
+	reply, err := c.Transmit(stream.Context(), &request)
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(reply)
+}
+
 func (c *contractTransmitterServer) LatestConfigDigestAndEpoch(ctx context.Context, request *pb.LatestConfigDigestAndEpochRequest) (*pb.LatestConfigDigestAndEpochReply, error) {
 	digest, epoch, err := c.impl.LatestConfigDigestAndEpoch(ctx)
 	if err != nil {
@@ -114,9 +221,156 @@ func (c *contractTransmitterServer) LatestConfigDigestAndEpoch(ctx context.Conte
 }
 
 func (c *contractTransmitterServer) FromAccount(ctx context.Context, request *pb.FromAccountRequest) (*pb.FromAccountReply, error) {
-	a, err := c.impl.FromAccount()
+	var a libocr.Account
+	var err error
+	if rat, ok := c.impl.(types.ReportAccountTransmitter); ok && request.ReportContext != nil {
+		var reportCtx libocr.ReportContext
+		reportCtx, err = reportContext(request.ReportContext)
+		if err != nil {
+			return nil, err
+		}
+		a, err = rat.FromAccountForReport(ctx, reportCtx)
+	} else {
+		a, err = c.impl.FromAccount()
+	}
 	if err != nil {
 		return nil, err
 	}
-	return &pb.FromAccountReply{Account: string(a)}, nil
+	return &pb.FromAccountReply{Account: types.AccountFromOCR(a).String()}, nil
+}
+
+func (c *contractTransmitterServer) SubscribeTransmitStatus(_ *pb.SubscribeTransmitStatusRequest, stream pb.ContractTransmitter_SubscribeTransmitStatusServer) error {
+	sst, ok := c.impl.(types.TransmitStatusTransmitter)
+	if !ok {
+		return status.Errorf(codes.Unimplemented, "%T does not implement TransmitStatusTransmitter", c.impl)
+	}
+	return sst.SubscribeTransmitStatus(stream.Context(), func(ts types.TransmitStatus) error {
+		return stream.Send(pbTransmitStatus(ts))
+	})
+}
+
+func pbTransmitStatus(ts types.TransmitStatus) *pb.TransmitStatus {
+	s := &pb.TransmitStatus{
+		ReportContext: pbReportContext(ts.ReportContext),
+		State:         int32(ts.State),
+		TxHash:        ts.TxHash,
+		BlockNumber:   ts.BlockNumber,
+	}
+	if ts.Err != nil {
+		s.Error = ts.Err.Error()
+	}
+	return s
+}
+
+func transmitStatus(s *pb.TransmitStatus) (ts types.TransmitStatus, err error) {
+	if err = s.Validate(); err != nil {
+		return
+	}
+	ts.ReportContext = validReportContext(s.ReportContext)
+	ts.State = types.TransmitState(s.State)
+	ts.TxHash = s.TxHash
+	ts.BlockNumber = s.BlockNumber
+	if s.Error != "" {
+		ts.Err = errors.New(s.Error)
+	}
+	return
+}
+
+func pbReportContext(rc libocr.ReportContext) *pb.ReportContext {
+	return &pb.ReportContext{
+		ReportTimestamp: pbReportTimestamp(rc.ReportTimestamp),
+		ExtraHash:       rc.ExtraHash[:],
+	}
+}
+
+func reportContext(rc *pb.ReportContext) (libocr.ReportContext, error) {
+	if err := rc.Validate(); err != nil {
+		return libocr.ReportContext{}, errReportContext("", err)
+	}
+	return validReportContext(rc), nil
+}
+
+// validReportContext is like reportContext, but rc must already be valid.
+func validReportContext(rc *pb.ReportContext) (r libocr.ReportContext) {
+	r.ReportTimestamp = validReportTimestamp(rc.ReportTimestamp)
+	copy(r.ExtraHash[:], rc.ExtraHash)
+	return
+}
+
+var (
+	_ types.ReportAccountTransmitter  = (*roundRobinTransmitter)(nil)
+	_ types.TransmitStatusTransmitter = (*roundRobinTransmitter)(nil)
+)
+
+// roundRobinTransmitter transmits consecutive rounds from each of its transmitters in turn.
+type roundRobinTransmitter struct {
+	transmitters []libocr.ContractTransmitter
+}
+
+// NewRoundRobinTransmitter returns a [types.ReportAccountTransmitter] which transmits each report with one of
+// transmitters, chosen by report round. FromAccount and LatestConfigDigestAndEpoch are delegated to the first.
+func NewRoundRobinTransmitter(transmitters ...libocr.ContractTransmitter) (types.ReportAccountTransmitter, error) {
+	if len(transmitters) == 0 {
+		return nil, errors.New("no transmitters")
+	}
+	return &roundRobinTransmitter{transmitters: transmitters}, nil
+}
+
+// transmitter returns the transmitter for the report with ts. The same report always maps to the same transmitter.
+func (r *roundRobinTransmitter) transmitter(ts libocr.ReportTimestamp) libocr.ContractTransmitter {
+	seq := uint64(ts.Epoch)<<8 | uint64(ts.Round)
+	return r.transmitters[seq%uint64(len(r.transmitters))]
+}
+
+func (r *roundRobinTransmitter) Transmit(ctx context.Context, reportContext libocr.ReportContext, report libocr.Report, signatures []libocr.AttributedOnchainSignature) error {
+	return r.transmitter(reportContext.ReportTimestamp).Transmit(ctx, reportContext, report, signatures)
+}
+
+func (r *roundRobinTransmitter) LatestConfigDigestAndEpoch(ctx context.Context) (libocr.ConfigDigest, uint32, error) {
+	return r.transmitters[0].LatestConfigDigestAndEpoch(ctx)
+}
+
+func (r *roundRobinTransmitter) FromAccount() (libocr.Account, error) {
+	return r.transmitters[0].FromAccount()
+}
+
+func (r *roundRobinTransmitter) FromAccountForReport(_ context.Context, reportContext libocr.ReportContext) (libocr.Account, error) {
+	return r.transmitter(reportContext.ReportTimestamp).FromAccount()
+}
+
+// SubscribeTransmitStatus merges the statuses from each transmitter implementing [types.TransmitStatusTransmitter].
+// It fails with codes.Unimplemented if none do.
+func (r *roundRobinTransmitter) SubscribeTransmitStatus(ctx context.Context, fn func(types.TransmitStatus) error) error {
+	var subs []types.TransmitStatusTransmitter
+	for _, t := range r.transmitters {
+		if sst, ok := t.(types.TransmitStatusTransmitter); ok {
+			subs = append(subs, sst)
+		}
+	}
+	if len(subs) == 0 {
+		return status.Error(codes.Unimplemented, "no transmitters implement TransmitStatusTransmitter")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var (
+		mu   sync.Mutex // serializes fn
+		wg   sync.WaitGroup
+		errs = make([]error, len(subs))
+	)
+	for i, sst := range subs {
+		wg.Add(1)
+		go func(i int, sst types.TransmitStatusTransmitter) {
+			defer wg.Done()
+			errs[i] = sst.SubscribeTransmitStatus(ctx, func(ts types.TransmitStatus) error {
+				mu.Lock()
+				defer mu.Unlock()
+				return fn(ts)
+			})
+			if errs[i] != nil {
+				cancel()
+			}
+		}(i, sst)
+	}
+	wg.Wait()
+	return errors.Join(errs...)
 }
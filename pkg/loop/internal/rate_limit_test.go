@@ -0,0 +1,85 @@
+package internal
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	libocr "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/pb"
+	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
+)
+
+type staticDataSource struct{}
+
+func (staticDataSource) Observe(context.Context, libocr.ReportTimestamp) (*big.Int, error) {
+	return big.NewInt(42), nil
+}
+
+type nopErrorLog struct{}
+
+func (nopErrorLog) SaveError(context.Context, string) error { return nil }
+
+func TestDataSourceServer_rateLimit(t *testing.T) {
+	ctx := context.Background()
+	clock := utils.NewFakeClock(time.Now())
+	limiter := utils.NewRateLimiter(utils.RateLimit{Rate: 1, Burst: 2}, clock)
+	feedA, _ := rateLimitKey("DataSource", limiter)
+	feedB, _ := rateLimitKey("DataSource", limiter)
+	a := &dataSourceServer{impl: staticDataSource{}, limiter: limiter, key: feedA}
+	b := &dataSourceServer{impl: staticDataSource{}, limiter: limiter, key: feedB}
+	req := &pb.ObserveRequest{ReportTimestamp: pbReportTimestamp(libocr.ReportTimestamp{})}
+
+	for i := 0; i < 2; i++ {
+		_, err := a.Observe(ctx, req)
+		require.NoError(t, err, "burst %d", i)
+	}
+	_, err := a.Observe(ctx, req)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err), err)
+	_, err = b.Observe(ctx, req)
+	assert.NoError(t, err, "feeds are limited independently")
+
+	clock.Advance(time.Second)
+	_, err = a.Observe(ctx, req)
+	assert.NoError(t, err)
+
+	_, err = (&dataSourceServer{impl: staticDataSource{}}).Observe(ctx, req)
+	assert.NoError(t, err, "unlimited")
+}
+
+func TestErrorLogServer_rateLimit(t *testing.T) {
+	ctx := context.Background()
+	clock := utils.NewFakeClock(time.Now())
+	limiter := utils.NewRateLimiter(utils.RateLimit{Rate: 1}, clock)
+	key, _ := rateLimitKey("ErrorLog", limiter)
+	e := &errorLogServer{impl: nopErrorLog{}, limiter: limiter, key: key}
+
+	_, err := e.SaveError(ctx, &pb.SaveErrorRequest{Message: "first"})
+	require.NoError(t, err)
+	_, err = e.SaveError(ctx, &pb.SaveErrorRequest{Message: "second"})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err), err)
+	_, err = e.ResolveError(ctx, &pb.ResolveErrorRequest{Key: "first"})
+	assert.NoError(t, err, "only SaveError is limited")
+}
+
+func TestRateLimitKey(t *testing.T) {
+	key, deps := rateLimitKey("DataSource", nil)
+	assert.Empty(t, key)
+	assert.Empty(t, deps)
+
+	clock := utils.NewFakeClock(time.Now())
+	limiter := utils.NewRateLimiter(utils.RateLimit{Rate: 1}, clock)
+	key, deps = rateLimitKey("DataSource", limiter)
+	require.Len(t, deps, 1)
+	require.True(t, limiter.Allow(key))
+	require.False(t, limiter.Allow(key))
+	require.NoError(t, deps[0].Close(context.Background()))
+	assert.True(t, limiter.Allow(key), "removed once the server stops")
+}
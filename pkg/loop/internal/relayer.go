@@ -5,13 +5,17 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"time"
 
-	"github.com/google/uuid"
+	"golang.org/x/exp/slices"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	"github.com/smartcontractkit/chainlink-relay/pkg/config"
 	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
-	"github.com/smartcontractkit/chainlink-relay/pkg/loop/internal/pb"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/pb"
 	"github.com/smartcontractkit/chainlink-relay/pkg/types"
 )
 
@@ -30,26 +34,39 @@ func NewPluginRelayerClient(broker Broker, brokerCfg BrokerConfig, conn *grpc.Cl
 }
 
 func (p *PluginRelayerClient) NewRelayer(ctx context.Context, config string, keystore types.Keystore) (Relayer, error) {
-	cc := p.newClientConn("Relayer", func(ctx context.Context) (id uint32, deps resources, err error) {
-		var ksRes resource
+	featureFlags := types.FeatureFlagsFromContext(ctx)
+	keyValueStore := types.KeyValueStoreFromContext(ctx)
+	providerIdleTimeout := providerIdleTimeoutFromContext(ctx)
+	cc := p.newClientConn("Relayer", func(ctx context.Context) (id uint32, deps Resources, err error) {
+		var ksRes *Resource
 		id, ksRes, err = p.serveNew("Keystore", func(s *grpc.Server) {
 			pb.RegisterKeystoreServer(s, &keystoreServer{impl: keystore})
 		})
 		if err != nil {
-			return 0, nil, fmt.Errorf("Failed to create relayer client: failed to serve keystore: %w", err)
+			return 0, deps, fmt.Errorf("Failed to create relayer client: failed to serve keystore: %w", err)
 		}
 		deps.Add(ksRes)
 
+		kvID, kvRes, err := p.serveKeyValueStore(keyValueStore)
+		if err != nil {
+			return 0, deps, fmt.Errorf("Failed to create relayer client: failed to serve key value store: %w", err)
+		}
+		deps.Add(kvRes)
+
 		reply, err := p.grpc.NewRelayer(ctx, &pb.NewRelayerRequest{
-			Config:     config,
-			KeystoreID: id,
+			Config:          config,
+			KeystoreID:      id,
+			FeatureFlags:    featureFlags,
+			KeyValueStoreID: kvID,
 		})
 		if err != nil {
-			return 0, nil, fmt.Errorf("Failed to create relayer client: failed request: %w", err)
+			return 0, deps, fmt.Errorf("Failed to create relayer client: failed request: %w", err)
 		}
-		return reply.RelayerID, nil, nil
+		return reply.RelayerID, deps, nil
 	})
-	return newRelayerClient(p.brokerExt, cc), nil
+	r := newRelayerClient(p.brokerExt, cc)
+	r.providerIdleTimeout = providerIdleTimeout
+	return r, nil
 }
 
 type pluginRelayerServer struct {
@@ -71,28 +88,40 @@ func newPluginRelayerServer(broker Broker, brokerCfg BrokerConfig, impl PluginRe
 }
 
 func (p *pluginRelayerServer) NewRelayer(ctx context.Context, request *pb.NewRelayerRequest) (*pb.NewRelayerReply, error) {
-	ksConn, err := p.dial(request.KeystoreID)
+	ksConn, err := p.dial("Keystore", request.KeystoreID)
 	if err != nil {
 		return nil, ErrConnDial{Name: "Keystore", ID: request.KeystoreID, Err: err}
 	}
-	ksRes := resource{ksConn, "Keystore"}
-	r, err := p.impl.NewRelayer(ctx, request.Config, newKeystoreClient(ksConn))
+	ksRes := NewResource("Keystore", ksConn)
+	ctx, kvRes, err := p.dialKeyValueStore(ctx, request.KeyValueStoreID)
 	if err != nil {
 		p.closeAll(ksRes)
 		return nil, err
 	}
+	if request.FeatureFlags != nil {
+		ctx = types.ContextWithFeatureFlags(ctx, request.FeatureFlags)
+	}
+	r, err := p.impl.NewRelayer(ctx, request.Config, newKeystoreClient(p.Logger, ksConn))
+	if err != nil {
+		p.closeAll(ksRes, kvRes)
+		if errors.Is(err, config.ErrInvalidConfig) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
 	err = r.Start(ctx)
 	if err != nil {
-		p.closeAll(ksRes)
+		p.closeAll(ksRes, kvRes)
 		return nil, err
 	}
 
 	const name = "Relayer"
-	rRes := resource{r, name}
+	rRes := NewResource(name, r)
 	id, _, err := p.serveNew(name, func(s *grpc.Server) {
-		pb.RegisterServiceServer(s, &serviceServer{srv: r})
+		pb.RegisterServiceServer(s, &serviceServer{srv: r, res: rRes})
 		pb.RegisterRelayerServer(s, newChainRelayerServer(r, p.brokerExt))
-	}, rRes, ksRes)
+		registerTransactorServer(s, r)
+	}, rRes, ksRes, kvRes)
 	if err != nil {
 		return nil, err
 	}
@@ -103,14 +132,16 @@ func (p *pluginRelayerServer) NewRelayer(ctx context.Context, request *pb.NewRel
 var _ types.Keystore = (*keystoreClient)(nil)
 
 type keystoreClient struct {
+	lggr logger.Logger
 	grpc pb.KeystoreClient
 }
 
-func newKeystoreClient(cc *grpc.ClientConn) *keystoreClient {
-	return &keystoreClient{pb.NewKeystoreClient(cc)}
+func newKeystoreClient(lggr logger.Logger, cc grpc.ClientConnInterface) *keystoreClient {
+	return &keystoreClient{lggr, pb.NewKeystoreClient(cc)}
 }
 
 func (k *keystoreClient) Accounts(ctx context.Context) (accounts []string, err error) {
+	defer recoverPanic(k.lggr, "Keystore.Accounts", &err)
 	reply, err := k.grpc.Accounts(ctx, &emptypb.Empty{})
 	if err != nil {
 		return nil, err
@@ -118,7 +149,8 @@ func (k *keystoreClient) Accounts(ctx context.Context) (accounts []string, err e
 	return reply.Accounts, nil
 }
 
-func (k *keystoreClient) Sign(ctx context.Context, account string, data []byte) ([]byte, error) {
+func (k *keystoreClient) Sign(ctx context.Context, account string, data []byte) (_ []byte, err error) {
+	defer recoverPanic(k.lggr, "Keystore.Sign", &err)
 	reply, err := k.grpc.Sign(ctx, &pb.SignRequest{Account: account, Data: data})
 	if err != nil {
 		return nil, err
@@ -150,50 +182,54 @@ func (k *keystoreServer) Sign(ctx context.Context, request *pb.SignRequest) (*pb
 	return &pb.SignReply{SignedData: signed}, nil
 }
 
-var _ Relayer = (*relayerClient)(nil)
+var (
+	_ Relayer                 = (*relayerClient)(nil)
+	_ types.Transactor        = (*relayerClient)(nil)
+	_ types.RPCStatusReporter = (*relayerClient)(nil)
+)
 
-// relayerClient adapts a GRPC [pb.RelayerClient] to implement [Relayer].
+// relayerClient adapts a GRPC [pb.RelayerClient] to implement [Relayer], and [types.Transactor] and
+// [types.RPCStatusReporter] if the remote relayer does.
 type relayerClient struct {
 	*brokerExt
 	*serviceClient
+	*transactorClient
 
 	relayer pb.RelayerClient
+
+	providerIdleTimeout time.Duration // optional, see ContextWithProviderIdleTimeout
 }
 
 func newRelayerClient(b *brokerExt, conn grpc.ClientConnInterface) *relayerClient {
 	b = b.withName("ChainRelayerClient")
-	return &relayerClient{b, newServiceClient(b, conn), pb.NewRelayerClient(conn)}
+	return &relayerClient{brokerExt: b, serviceClient: newServiceClient(b, conn), transactorClient: newTransactorClient(b.Logger, conn), relayer: pb.NewRelayerClient(conn)}
 }
 
 func (r *relayerClient) NewConfigProvider(ctx context.Context, rargs types.RelayArgs) (types.ConfigProvider, error) {
-	cc := r.newClientConn("ConfigProvider", func(ctx context.Context) (uint32, resources, error) {
+	pbRargs, err := pbRelayArgs("NewConfigProvider", rargs, configProviderTypes)
+	if err != nil {
+		return nil, err
+	}
+	cc := r.newClientConn("ConfigProvider", func(ctx context.Context) (uint32, Resources, error) {
 		reply, err := r.relayer.NewConfigProvider(ctx, &pb.NewConfigProviderRequest{
-			RelayArgs: &pb.RelayArgs{
-				ExternalJobID: rargs.ExternalJobID[:],
-				JobID:         rargs.JobID,
-				ContractID:    rargs.ContractID,
-				New:           rargs.New,
-				RelayConfig:   rargs.RelayConfig,
-			},
+			RelayArgs: pbRargs,
 		})
 		if err != nil {
 			return 0, nil, err
 		}
 		return reply.ConfigProviderID, nil, nil
-	})
+	}).withIdleTimeout(r.providerIdleTimeout)
 	return newConfigProviderClient(r.withName("ConfigProviderClient"), cc), nil
 }
 
 func (r *relayerClient) NewMedianProvider(ctx context.Context, rargs types.RelayArgs, pargs types.PluginArgs) (types.MedianProvider, error) {
-	cc := r.newClientConn("MedianProvider", func(ctx context.Context) (uint32, resources, error) {
+	pbRargs, err := pbRelayArgs("NewMedianProvider", rargs, medianProviderTypes)
+	if err != nil {
+		return nil, err
+	}
+	cc := r.newClientConn("MedianProvider", func(ctx context.Context) (uint32, Resources, error) {
 		reply, err := r.relayer.NewMedianProvider(ctx, &pb.NewMedianProviderRequest{
-			RelayArgs: &pb.RelayArgs{
-				ExternalJobID: rargs.ExternalJobID[:],
-				JobID:         rargs.JobID,
-				ContractID:    rargs.ContractID,
-				New:           rargs.New,
-				RelayConfig:   rargs.RelayConfig,
-			},
+			RelayArgs: pbRargs,
 			PluginArgs: &pb.PluginArgs{
 				TransmitterID: pargs.TransmitterID,
 				PluginConfig:  pargs.PluginConfig,
@@ -203,10 +239,31 @@ func (r *relayerClient) NewMedianProvider(ctx context.Context, rargs types.Relay
 			return 0, nil, err
 		}
 		return reply.MedianProviderID, nil, nil
-	})
+	}).withIdleTimeout(r.providerIdleTimeout)
 	return newMedianProviderClient(r.brokerExt, cc), nil
 }
 
+func (r *relayerClient) NewPluginProvider(ctx context.Context, rargs types.RelayArgs, pargs types.PluginArgs) (types.PluginProvider, error) {
+	pbRargs, err := pbRelayArgs("NewPluginProvider", rargs, pluginProviderTypes)
+	if err != nil {
+		return nil, err
+	}
+	cc := r.newClientConn("PluginProvider", func(ctx context.Context) (uint32, Resources, error) {
+		reply, err := r.relayer.NewPluginProvider(ctx, &pb.NewPluginProviderRequest{
+			RelayArgs: pbRargs,
+			PluginArgs: &pb.PluginArgs{
+				TransmitterID: pargs.TransmitterID,
+				PluginConfig:  pargs.PluginConfig,
+			},
+		})
+		if err != nil {
+			return 0, nil, err
+		}
+		return reply.PluginProviderID, nil, nil
+	}).withIdleTimeout(r.providerIdleTimeout)
+	return newPluginProviderClient(r.brokerExt, cc), nil
+}
+
 func (r *relayerClient) NewMercuryProvider(context.Context, types.RelayArgs, types.PluginArgs) (types.MercuryProvider, error) {
 	return nil, errors.New("mercury is not supported")
 }
@@ -215,13 +272,17 @@ func (r *relayerClient) NewFunctionsProvider(context.Context, types.RelayArgs, t
 	return nil, errors.New("functions are not supported")
 }
 
-func (r *relayerClient) ChainStatus(ctx context.Context, id string) (types.ChainStatus, error) {
+func (r *relayerClient) ChainStatus(ctx context.Context, id string) (_ types.ChainStatus, err error) {
+	defer recoverPanic(r.Logger, "Relayer.ChainStatus", &err)
 	reply, err := r.relayer.ChainStatus(ctx, &pb.ChainStatusRequest{
 		Id: id,
 	})
 	if err != nil {
 		return types.ChainStatus{}, err
 	}
+	if err = reply.Validate(); err != nil {
+		return types.ChainStatus{}, err
+	}
 
 	return types.ChainStatus{
 		ID:      reply.Chain.Id,
@@ -231,6 +292,7 @@ func (r *relayerClient) ChainStatus(ctx context.Context, id string) (types.Chain
 }
 
 func (r *relayerClient) ChainStatuses(ctx context.Context, offset, limit int) (chains []types.ChainStatus, count int, err error) {
+	defer recoverPanic(r.Logger, "Relayer.ChainStatuses", &err)
 	var reply *pb.ChainStatusesReply
 	reply, err = r.relayer.ChainStatuses(ctx, &pb.ChainStatusesRequest{
 		Offset: int32(offset),
@@ -252,6 +314,7 @@ func (r *relayerClient) ChainStatuses(ctx context.Context, offset, limit int) (c
 }
 
 func (r *relayerClient) NodeStatuses(ctx context.Context, offset, limit int, chainIDs ...string) (nodes []types.NodeStatus, count int, err error) {
+	defer recoverPanic(r.Logger, "Relayer.NodeStatuses", &err)
 	reply, err := r.relayer.NodeStatuses(ctx, &pb.NodeStatusesRequest{
 		Offset:   int32(offset),
 		Limit:    int32(limit),
@@ -272,6 +335,29 @@ func (r *relayerClient) NodeStatuses(ctx context.Context, offset, limit int, cha
 	return
 }
 
+// RPCStatuses fails with codes.Unimplemented if the remote relayer does not implement [types.RPCStatusReporter].
+func (r *relayerClient) RPCStatuses(ctx context.Context, chainIDs ...string) (_ []types.RPCStatus, err error) {
+	defer recoverPanic(r.Logger, "Relayer.RPCStatuses", &err)
+	reply, err := r.relayer.RPCStatuses(ctx, &pb.RPCStatusesRequest{ChainIDs: chainIDs})
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]types.RPCStatus, len(reply.Statuses))
+	for i, s := range reply.Statuses {
+		statuses[i] = types.RPCStatus{
+			ChainID:  s.ChainID,
+			Name:     s.Name,
+			Priority: s.Priority,
+			Weight:   s.Weight,
+			Active:   s.Active,
+		}
+		if s.Error != "" {
+			statuses[i].Err = errors.New(s.Error)
+		}
+	}
+	return statuses, nil
+}
+
 func (r *relayerClient) SendTx(ctx context.Context, chainID, from, to string, amount *big.Int, balanceCheck bool) error {
 	_, err := r.relayer.SendTx(ctx, &pb.SendTxRequest{
 		ChainID:      chainID,
@@ -283,6 +369,63 @@ func (r *relayerClient) SendTx(ctx context.Context, chainID, from, to string, am
 	return err
 }
 
+// The kinds of provider returned by each method of [Relayer]. The first is the default for
+// [types.ProviderTypeUnspecified].
+var (
+	configProviderTypes = []types.ProviderType{types.ProviderTypeConfig}
+	medianProviderTypes = []types.ProviderType{types.ProviderTypeMedian}
+	pluginProviderTypes = []types.ProviderType{types.ProviderTypePlugin, types.ProviderTypeMercury, types.ProviderTypeFunctions}
+)
+
+// providerType returns t, or the default of supported if unspecified, or an error if method does not support it.
+func providerType(method string, t types.ProviderType, supported []types.ProviderType) (types.ProviderType, error) {
+	if err := t.Validate(); err != nil {
+		return 0, err
+	}
+	if t == types.ProviderTypeUnspecified {
+		return supported[0], nil
+	}
+	if !slices.Contains(supported, t) {
+		return 0, types.ErrUnsupportedProviderType{Type: t, Method: method}
+	}
+	return t, nil
+}
+
+func pbRelayArgs(method string, rargs types.RelayArgs, supported []types.ProviderType) (*pb.RelayArgs, error) {
+	pt, err := providerType(method, rargs.ProviderType, supported)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RelayArgs{
+		ExternalJobID: rargs.ExternalJobID[:],
+		JobID:         rargs.JobID,
+		ContractID:    rargs.ContractID,
+		New:           rargs.New,
+		RelayConfig:   rargs.RelayConfig,
+		ProviderType:  int32(pt),
+	}, nil
+}
+
+// relayArgs returns rargs after validating it, with the ProviderType defaulted for older hosts. Errors have
+// [codes.InvalidArgument].
+func relayArgs(method string, rargs *pb.RelayArgs, supported []types.ProviderType) (r types.RelayArgs, err error) {
+	if err = rargs.Validate(); err != nil {
+		err = status.Error(codes.InvalidArgument, err.Error())
+		return
+	}
+	r.ProviderType, err = providerType(method, types.ProviderType(rargs.ProviderType), supported)
+	if err != nil {
+		err = status.Error(codes.InvalidArgument, err.Error())
+		return
+	}
+	copy(r.ExternalJobID[:], rargs.ExternalJobID)
+	r.JobID = rargs.JobID
+	r.ContractID = rargs.ContractID
+	r.New = rargs.New
+	r.RelayConfig = rargs.RelayConfig
+	return
+}
+
 var _ pb.RelayerServer = (*relayerServer)(nil)
 
 // relayerServer exposes [Relayer] as a GRPC [pb.RelayerServer].
@@ -299,17 +442,11 @@ func newChainRelayerServer(impl Relayer, b *brokerExt) *relayerServer {
 }
 
 func (r *relayerServer) NewConfigProvider(ctx context.Context, request *pb.NewConfigProviderRequest) (*pb.NewConfigProviderReply, error) {
-	exJobID, err := uuid.FromBytes(request.RelayArgs.ExternalJobID)
+	rargs, err := relayArgs("NewConfigProvider", request.RelayArgs, configProviderTypes)
 	if err != nil {
-		return nil, fmt.Errorf("invalid uuid bytes for ExternalJobID: %w", err)
+		return nil, err
 	}
-	cp, err := r.impl.NewConfigProvider(ctx, types.RelayArgs{
-		ExternalJobID: exJobID,
-		JobID:         request.RelayArgs.JobID,
-		ContractID:    request.RelayArgs.ContractID,
-		New:           request.RelayArgs.New,
-		RelayConfig:   request.RelayArgs.RelayConfig,
-	})
+	cp, err := r.impl.NewConfigProvider(ctx, rargs)
 	if err != nil {
 		return nil, err
 	}
@@ -319,11 +456,12 @@ func (r *relayerServer) NewConfigProvider(ctx context.Context, request *pb.NewCo
 	}
 
 	const name = "ConfigProvider"
+	cpRes := NewResource(name, cp)
 	id, _, err := r.serveNew(name, func(s *grpc.Server) {
-		pb.RegisterServiceServer(s, &serviceServer{srv: cp})
+		pb.RegisterServiceServer(s, &serviceServer{srv: cp, res: cpRes})
 		pb.RegisterOffchainConfigDigesterServer(s, &offchainConfigDigesterServer{impl: cp.OffchainConfigDigester()})
 		pb.RegisterContractConfigTrackerServer(s, &contractConfigTrackerServer{impl: cp.ContractConfigTracker()})
-	}, resource{cp, name})
+	}, cpRes)
 	if err != nil {
 		return nil, err
 	}
@@ -332,17 +470,11 @@ func (r *relayerServer) NewConfigProvider(ctx context.Context, request *pb.NewCo
 }
 
 func (r *relayerServer) NewMedianProvider(ctx context.Context, request *pb.NewMedianProviderRequest) (*pb.NewMedianProviderReply, error) {
-	exJobID, err := uuid.FromBytes(request.RelayArgs.ExternalJobID)
+	rargs, err := relayArgs("NewMedianProvider", request.RelayArgs, medianProviderTypes)
 	if err != nil {
-		return nil, fmt.Errorf("invalid uuid bytes for ExternalJobID: %w", err)
+		return nil, err
 	}
-	provider, err := r.impl.NewMedianProvider(ctx, types.RelayArgs{
-		ExternalJobID: exJobID,
-		JobID:         request.RelayArgs.JobID,
-		ContractID:    request.RelayArgs.ContractID,
-		New:           request.RelayArgs.New,
-		RelayConfig:   request.RelayArgs.RelayConfig,
-	}, types.PluginArgs{
+	provider, err := r.impl.NewMedianProvider(ctx, rargs, types.PluginArgs{
 		TransmitterID: request.PluginArgs.TransmitterID,
 		PluginConfig:  request.PluginArgs.PluginConfig,
 	})
@@ -354,16 +486,19 @@ func (r *relayerServer) NewMedianProvider(ctx context.Context, request *pb.NewMe
 		return nil, err
 	}
 	const name = "MedianProvider"
-	providerRes := resource{name: name, Closer: provider}
+	providerRes := NewResource(name, provider)
 
 	id, _, err := r.serveNew(name, func(s *grpc.Server) {
-		pb.RegisterServiceServer(s, &serviceServer{srv: provider})
+		pb.RegisterServiceServer(s, &serviceServer{srv: provider, res: providerRes})
 		pb.RegisterOffchainConfigDigesterServer(s, &offchainConfigDigesterServer{impl: provider.OffchainConfigDigester()})
 		pb.RegisterContractConfigTrackerServer(s, &contractConfigTrackerServer{impl: provider.ContractConfigTracker()})
 		pb.RegisterContractTransmitterServer(s, &contractTransmitterServer{impl: provider.ContractTransmitter()})
-		pb.RegisterReportCodecServer(s, &reportCodecServer{impl: provider.ReportCodec()})
+		pb.RegisterReportCodecServer(s, &reportCodecServer{impl: provider.ReportCodec(), chunkSize: r.chunkSize()})
 		pb.RegisterMedianContractServer(s, &medianContractServer{impl: provider.MedianContract()})
 		pb.RegisterOnchainConfigCodecServer(s, &onchainConfigCodecServer{impl: provider.OnchainConfigCodec()})
+		registerGasEstimatorServer(s, provider)
+		registerEventQuerierServer(s, provider)
+		registerHeadTrackerServer(s, provider)
 	}, providerRes)
 	if err != nil {
 		return nil, err
@@ -372,6 +507,41 @@ func (r *relayerServer) NewMedianProvider(ctx context.Context, request *pb.NewMe
 	return &pb.NewMedianProviderReply{MedianProviderID: id}, nil
 }
 
+func (r *relayerServer) NewPluginProvider(ctx context.Context, request *pb.NewPluginProviderRequest) (*pb.NewPluginProviderReply, error) {
+	rargs, err := relayArgs("NewPluginProvider", request.RelayArgs, pluginProviderTypes)
+	if err != nil {
+		return nil, err
+	}
+	provider, err := r.impl.NewPluginProvider(ctx, rargs, types.PluginArgs{
+		TransmitterID: request.PluginArgs.TransmitterID,
+		PluginConfig:  request.PluginArgs.PluginConfig,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = provider.Start(ctx)
+	if err != nil {
+		return nil, err
+	}
+	const name = "PluginProvider"
+	providerRes := NewResource(name, provider)
+
+	id, _, err := r.serveNew(name, func(s *grpc.Server) {
+		pb.RegisterServiceServer(s, &serviceServer{srv: provider, res: providerRes})
+		pb.RegisterOffchainConfigDigesterServer(s, &offchainConfigDigesterServer{impl: provider.OffchainConfigDigester()})
+		pb.RegisterContractConfigTrackerServer(s, &contractConfigTrackerServer{impl: provider.ContractConfigTracker()})
+		pb.RegisterContractTransmitterServer(s, &contractTransmitterServer{impl: provider.ContractTransmitter()})
+		if reg, ok := provider.(GRPCServiceRegistrar); ok {
+			reg.RegisterGRPCServices(s)
+		}
+	}, providerRes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.NewPluginProviderReply{PluginProviderID: id}, nil
+}
+
 func (r *relayerServer) NewMercuryProvider(ctx context.Context, request *pb.NewMercuryProviderRequest) (*pb.NewMercuryProviderReply, error) {
 	return nil, errors.New("mercury is not supported")
 }
@@ -420,6 +590,32 @@ func (r *relayerServer) NodeStatuses(ctx context.Context, request *pb.NodeStatus
 	}
 	return &pb.NodeStatusesReply{Nodes: nodes, Count: int32(count)}, nil
 }
+
+// RPCStatuses fails with codes.Unimplemented if the relayer does not implement [types.RPCStatusReporter].
+func (r *relayerServer) RPCStatuses(ctx context.Context, request *pb.RPCStatusesRequest) (*pb.RPCStatusesReply, error) {
+	rs, ok := r.impl.(types.RPCStatusReporter)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "%T does not implement RPCStatusReporter", r.impl)
+	}
+	statuses, err := rs.RPCStatuses(ctx, request.ChainIDs...)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.RPCStatusesReply{Statuses: make([]*pb.RPCStatus, len(statuses))}
+	for i, s := range statuses {
+		reply.Statuses[i] = &pb.RPCStatus{
+			ChainID:  s.ChainID,
+			Name:     s.Name,
+			Priority: s.Priority,
+			Weight:   s.Weight,
+			Active:   s.Active,
+		}
+		if s.Err != nil {
+			reply.Statuses[i].Error = s.Err.Error()
+		}
+	}
+	return reply, nil
+}
 func (r *relayerServer) SendTx(ctx context.Context, request *pb.SendTxRequest) (*emptypb.Empty, error) {
 	return &emptypb.Empty{}, r.impl.SendTx(ctx, request.ChainID, request.From, request.To, request.Amount.Int(), request.BalanceCheck)
 }
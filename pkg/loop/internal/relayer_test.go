@@ -0,0 +1,60 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/pb"
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+func TestRelayArgs(t *testing.T) {
+	jobID := uuid.New()
+	for _, tt := range []struct {
+		name     string
+		pt       types.ProviderType
+		supports []types.ProviderType
+		want     types.ProviderType
+		err      string
+	}{
+		{"default", types.ProviderTypeUnspecified, pluginProviderTypes, types.ProviderTypePlugin, ""},
+		{"median", types.ProviderTypeMedian, medianProviderTypes, types.ProviderTypeMedian, ""},
+		{"product", types.ProviderTypeFunctions, pluginProviderTypes, types.ProviderTypeFunctions, ""},
+		{"mismatch", types.ProviderTypeMedian, configProviderTypes, 0, "unsupported ProviderType median for Test"},
+		{"unknown", types.ProviderTypePlugin + 1, pluginProviderTypes, 0, "unknown ProviderType 6"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			rargs := types.RelayArgs{ExternalJobID: jobID, JobID: 1, ContractID: "contract", ProviderType: tt.pt}
+			pbRargs := &pb.RelayArgs{ExternalJobID: jobID[:], JobID: 1, ContractID: "contract", ProviderType: int32(tt.pt)}
+
+			got, err := relayArgs("Test", pbRargs, tt.supports)
+			if tt.err != "" {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err), err)
+				assert.ErrorContains(t, err, tt.err)
+				_, err = pbRelayArgs("Test", rargs, tt.supports)
+				assert.ErrorContains(t, err, tt.err, "rejected by the client too")
+				return
+			}
+			require.NoError(t, err)
+			rargs.ProviderType = tt.want
+			assert.Equal(t, rargs, got)
+
+			sent, err := pbRelayArgs("Test", types.RelayArgs{ExternalJobID: jobID, ProviderType: tt.pt}, tt.supports)
+			require.NoError(t, err)
+			assert.Equal(t, int32(tt.want), sent.ProviderType)
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := relayArgs("Test", nil, configProviderTypes)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), err)
+		_, err = relayArgs("Test", &pb.RelayArgs{ExternalJobID: []byte{1, 2, 3}}, configProviderTypes)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), err)
+		assert.ErrorContains(t, err, "ExternalJobID")
+	})
+}
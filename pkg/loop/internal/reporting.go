@@ -2,18 +2,49 @@ package internal
 
 import (
 	"context"
-	"math"
+	"errors"
+	"fmt"
+	"io"
+	"sync/atomic"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/smartcontractkit/libocr/commontypes"
 	libocr "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
 
-	"github.com/smartcontractkit/chainlink-relay/pkg/loop/internal/pb"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/pb"
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
 )
 
+var (
+	newReportingPluginDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "loop_reporting_plugin_factory_new_duration_seconds",
+		Help:    "Time taken by NewReportingPlugin calls to the plugin, by error class.",
+		Buckets: []float64{0.01, 0.1, 0.3, 0.6, 1, 3, 6, 10, 30, 60},
+	}, []string{"class"})
+	newReportingPluginFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "loop_reporting_plugin_factory_new_failures",
+		Help: "Number of NewReportingPlugin calls to the plugin which failed, by error class.",
+	}, []string{"class"})
+)
+
+// observeNewReportingPlugin records the duration since start and the class of err, which must be the result of a
+// NewReportingPlugin call. It must be deferred before any recovery from panics.
+func observeNewReportingPlugin(start time.Time, err *error) {
+	class := ErrorClass(*err)
+	newReportingPluginDuration.WithLabelValues(class).Observe(time.Since(start).Seconds())
+	if *err != nil {
+		newReportingPluginFailures.WithLabelValues(class).Inc()
+	}
+}
+
 type reportingPluginFactoryClient struct {
 	*brokerExt
 	*serviceClient
@@ -24,7 +55,15 @@ func newReportingPluginFactoryClient(b *brokerExt, cc grpc.ClientConnInterface)
 	return &reportingPluginFactoryClient{b.withName("ReportingPluginProviderClient"), newServiceClient(b, cc), pb.NewReportingPluginFactoryClient(cc)}
 }
 
-func (r *reportingPluginFactoryClient) NewReportingPlugin(config libocr.ReportingPluginConfig) (libocr.ReportingPlugin, libocr.ReportingPluginInfo, error) {
+// NewReportingPluginFactoryClient returns a [types.ReportingPluginFactory] backed by a server registered on cc with
+// [RegisterReportingPluginFactoryServer]. Each reporting plugin is dialed on a new connection from broker.
+func NewReportingPluginFactoryClient(broker Broker, brokerCfg BrokerConfig, cc grpc.ClientConnInterface) types.ReportingPluginFactory {
+	return newReportingPluginFactoryClient(&brokerExt{broker, brokerCfg}, cc)
+}
+
+func (r *reportingPluginFactoryClient) NewReportingPlugin(config libocr.ReportingPluginConfig) (_ libocr.ReportingPlugin, _ libocr.ReportingPluginInfo, err error) {
+	defer observeNewReportingPlugin(time.Now(), &err)
+	defer recoverPanic(r.Logger, "ReportingPluginFactory.NewReportingPlugin", &err)
 	ctx, cancel := r.stopCtx()
 	defer cancel()
 
@@ -45,6 +84,9 @@ func (r *reportingPluginFactoryClient) NewReportingPlugin(config libocr.Reportin
 	if err != nil {
 		return nil, libocr.ReportingPluginInfo{}, err
 	}
+	if err = reply.Validate(); err != nil {
+		return nil, libocr.ReportingPluginInfo{}, err
+	}
 	rpi := libocr.ReportingPluginInfo{
 		Name:          reply.ReportingPluginInfo.Name,
 		UniqueReports: reply.ReportingPluginInfo.UniqueReports,
@@ -54,11 +96,18 @@ func (r *reportingPluginFactoryClient) NewReportingPlugin(config libocr.Reportin
 			MaxReportLength:      int(reply.ReportingPluginInfo.ReportingPluginLimits.MaxReportLength),
 		},
 	}
-	cc, err := r.brokerExt.dial(reply.ReportingPluginID)
+	cc, err := r.brokerExt.dial("ReportingPlugin", reply.ReportingPluginID)
 	if err != nil {
 		return nil, libocr.ReportingPluginInfo{}, err
 	}
-	return newReportingPluginClient(r.brokerExt, cc), rpi, nil
+	rp := newReportingPluginClient(r.brokerExt, cc, rpi.Limits)
+	if err = checkReportingPluginLimits(rpi.Limits, config.N, r.brokerExt.maxMsgSize()); err != nil {
+		if cerr := rp.Close(); cerr != nil {
+			err = errors.Join(err, fmt.Errorf("failed to close ReportingPlugin: %w", cerr))
+		}
+		return nil, libocr.ReportingPluginInfo{}, err
+	}
+	return rp, rpi, nil
 }
 
 var _ pb.ReportingPluginFactoryServer = (*reportingPluginFactoryServer)(nil)
@@ -68,14 +117,46 @@ type reportingPluginFactoryServer struct {
 
 	*brokerExt
 
-	impl libocr.ReportingPluginFactory
+	impl    libocr.ReportingPluginFactory
+	limiter *concurrencyLimiter // optional
 }
 
-func newReportingPluginFactoryServer(impl libocr.ReportingPluginFactory, b *brokerExt) *reportingPluginFactoryServer {
-	return &reportingPluginFactoryServer{impl: impl, brokerExt: b.withName("ReportingPluginFactoryServer")}
+// RegisterReportingPluginFactoryServer registers impl with server, serving each reporting plugin on a new connection
+// from broker. Reporting plugin requests are subject to limit. If impl does not implement [types.Service], the Service
+// methods are no-ops.
+func RegisterReportingPluginFactoryServer(server *grpc.Server, broker Broker, brokerCfg BrokerConfig, impl libocr.ReportingPluginFactory, limit ConcurrencyLimit) error {
+	if err := limit.Validate(); err != nil {
+		return fmt.Errorf("invalid ConcurrencyLimit: %w", err)
+	}
+	b := &brokerExt{broker, brokerCfg}
+	srv, ok := impl.(types.Service)
+	if !ok {
+		srv = nopService{name: b.Logger.Name()}
+	}
+	pb.RegisterServiceServer(server, &serviceServer{srv: srv})
+	pb.RegisterReportingPluginFactoryServer(server, newReportingPluginFactoryServer(impl, b, newConcurrencyLimiter(limit)))
+	return nil
+}
+
+// nopService is a [types.Service] which is always ready and healthy.
+type nopService struct {
+	name string
+}
+
+func (n nopService) Name() string                   { return n.name }
+func (n nopService) Start(context.Context) error    { return nil }
+func (n nopService) Close() error                   { return nil }
+func (n nopService) Ready() error                   { return nil }
+func (n nopService) HealthReport() map[string]error { return map[string]error{n.name: nil} }
+
+func newReportingPluginFactoryServer(impl libocr.ReportingPluginFactory, b *brokerExt, limiter *concurrencyLimiter) *reportingPluginFactoryServer {
+	return &reportingPluginFactoryServer{impl: impl, brokerExt: b.withName("ReportingPluginFactoryServer"), limiter: limiter}
 }
 
 func (r *reportingPluginFactoryServer) NewReportingPlugin(ctx context.Context, request *pb.NewReportingPluginRequest) (*pb.NewReportingPluginReply, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
 	cfg := libocr.ReportingPluginConfig{
 		OracleID:                                commontypes.OracleID(request.ReportingPluginConfig.OracleID),
 		N:                                       int(request.ReportingPluginConfig.N),
@@ -89,20 +170,24 @@ func (r *reportingPluginFactoryServer) NewReportingPlugin(ctx context.Context, r
 		MaxDurationShouldAcceptFinalizedReport:  time.Duration(request.ReportingPluginConfig.MaxDurationShouldAcceptFinalizedReport),
 		MaxDurationShouldTransmitAcceptedReport: time.Duration(request.ReportingPluginConfig.MaxDurationShouldTransmitAcceptedReport),
 	}
-	if l := len(request.ReportingPluginConfig.ConfigDigest); l != 32 {
-		return nil, ErrConfigDigestLen(l)
-	}
 	copy(cfg.ConfigDigest[:], request.ReportingPluginConfig.ConfigDigest)
 
 	rp, rpi, err := r.impl.NewReportingPlugin(cfg)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkReportingPluginLimits(rpi.Limits, cfg.N, r.maxMsgSize()); err != nil {
+		if cerr := rp.Close(); cerr != nil {
+			err = errors.Join(err, fmt.Errorf("failed to close ReportingPlugin: %w", cerr))
+		}
+		return nil, err
+	}
 
 	const name = "ReportingPlugin"
+	rpRes := NewResource(name, rp)
 	id, _, err := r.serveNew(name, func(s *grpc.Server) {
-		pb.RegisterReportingPluginServer(s, &reportingPluginServer{impl: rp})
-	}, resource{rp, name})
+		pb.RegisterReportingPluginServer(s, &reportingPluginServer{impl: rp, res: rpRes, limits: rpi.Limits, limiter: r.limiter, chunkSize: r.chunkSize()})
+	}, rpRes)
 	if err != nil {
 		return nil, err
 	}
@@ -118,51 +203,161 @@ func (r *reportingPluginFactoryServer) NewReportingPlugin(ctx context.Context, r
 	}}, nil
 }
 
+// Upper bounds on the encoded size of reporting plugin messages, excluding queries, observations, and reports.
+const (
+	reportingMsgOverhead          = 128 // ReportTimestamp and other fields
+	attributedObservationOverhead = 32  // Observer and field framing
+)
+
+// checkReportingPluginLimits returns an error if limits are out of range, or permit reporting plugin messages larger
+// than gRPC permits with n oracles. Queries and reports are sent in single messages of at most maxMsgSize, while the
+// ReportRequest, which includes the query and an observation from each oracle, is sent in chunks if necessary, and
+// may be as large as maxChunkedMsgSize.
+func checkReportingPluginLimits(limits libocr.ReportingPluginLimits, n int, maxMsgSize int) error {
+	var err error
+	if !(0 <= limits.MaxQueryLength && limits.MaxQueryLength <= libocr.MaxMaxQueryLength) {
+		err = errors.Join(err, fmt.Errorf("MaxQueryLength (%d) out of range: must be between 0 and %d", limits.MaxQueryLength, libocr.MaxMaxQueryLength))
+	}
+	if !(0 <= limits.MaxObservationLength && limits.MaxObservationLength <= libocr.MaxMaxObservationLength) {
+		err = errors.Join(err, fmt.Errorf("MaxObservationLength (%d) out of range: must be between 0 and %d", limits.MaxObservationLength, libocr.MaxMaxObservationLength))
+	}
+	if !(0 <= limits.MaxReportLength && limits.MaxReportLength <= libocr.MaxMaxReportLength) {
+		err = errors.Join(err, fmt.Errorf("MaxReportLength (%d) out of range: must be between 0 and %d", limits.MaxReportLength, libocr.MaxMaxReportLength))
+	}
+	if err != nil {
+		return err
+	}
+	size := reportingMsgOverhead + int64(limits.MaxQueryLength)
+	if s := reportingMsgOverhead + int64(limits.MaxReportLength); s > size {
+		size = s
+	}
+	if size > int64(maxMsgSize) {
+		return ErrMaxMsgSize{Limits: limits, N: n, Size: size, MaxMsgSize: maxMsgSize}
+	}
+	size = reportingMsgOverhead + int64(limits.MaxQueryLength) + int64(n)*(int64(limits.MaxObservationLength)+attributedObservationOverhead)
+	if size > maxChunkedMsgSize {
+		return ErrMaxMsgSize{Limits: limits, N: n, Size: size, MaxMsgSize: maxChunkedMsgSize}
+	}
+	return nil
+}
+
+// checkQueryLength returns an error if query is longer than the MaxQueryLength of limits. Queries are checked on both
+// sides of the connection, since either may be the untrusted plugin.
+func checkQueryLength(query libocr.Query, limits libocr.ReportingPluginLimits) error {
+	if len(query) > limits.MaxQueryLength {
+		return ErrMaxQueryLength{Len: len(query), MaxQueryLength: limits.MaxQueryLength}
+	}
+	return nil
+}
+
 var _ libocr.ReportingPlugin = (*reportingPluginClient)(nil)
 
 type reportingPluginClient struct {
 	*brokerExt
-	grpc pb.ReportingPluginClient
+	cc     *brokerConn
+	grpc   pb.ReportingPluginClient
+	limits libocr.ReportingPluginLimits
+
+	unchunked atomic.Bool // the server does not implement ObservationChunked
 }
 
-func newReportingPluginClient(b *brokerExt, cc grpc.ClientConnInterface) *reportingPluginClient {
-	return &reportingPluginClient{b.withName("ReportingPluginClient"), pb.NewReportingPluginClient(cc)}
+func newReportingPluginClient(b *brokerExt, cc *brokerConn, limits libocr.ReportingPluginLimits) *reportingPluginClient {
+	return &reportingPluginClient{brokerExt: b.withName("ReportingPluginClient"), cc: cc, grpc: pb.NewReportingPluginClient(cc), limits: limits}
 }
 
-func (r *reportingPluginClient) Query(ctx context.Context, timestamp libocr.ReportTimestamp) (libocr.Query, error) {
+func (r *reportingPluginClient) Query(ctx context.Context, timestamp libocr.ReportTimestamp) (_ libocr.Query, err error) {
+	defer recoverPanic(r.Logger, "ReportingPlugin.Query", &err)
 	reply, err := r.grpc.Query(ctx, &pb.QueryRequest{
 		ReportTimestamp: pbReportTimestamp(timestamp),
 	})
 	if err != nil {
 		return nil, err
 	}
+	if err = checkQueryLength(reply.Query, r.limits); err != nil {
+		return nil, err
+	}
 	return reply.Query, nil
 }
 
-func (r *reportingPluginClient) Observation(ctx context.Context, timestamp libocr.ReportTimestamp, query libocr.Query) (libocr.Observation, error) {
-	reply, err := r.grpc.Observation(ctx, &pb.ObservationRequest{
+func (r *reportingPluginClient) Observation(ctx context.Context, timestamp libocr.ReportTimestamp, query libocr.Query) (_ libocr.Observation, err error) {
+	defer recoverPanic(r.Logger, "ReportingPlugin.Observation", &err)
+	if err := checkQueryLength(query, r.limits); err != nil {
+		return nil, err
+	}
+	req := &pb.ObservationRequest{
 		ReportTimestamp: pbReportTimestamp(timestamp),
 		Query:           query,
-	})
+	}
+	var reply *pb.ObservationReply
+	if !r.unchunked.Load() {
+		reply, err = r.observationChunked(ctx, req)
+		if status.Code(err) == codes.Unimplemented {
+			r.unchunked.Store(true)
+		}
+	}
+	if r.unchunked.Load() {
+		reply, err = r.grpc.Observation(ctx, req)
+	}
 	if err != nil {
 		return nil, err
 	}
 	return reply.Observation, nil
 }
 
-func (r *reportingPluginClient) Report(ctx context.Context, timestamp libocr.ReportTimestamp, query libocr.Query, obs []libocr.AttributedObservation) (bool, libocr.Report, error) {
-	reply, err := r.grpc.Report(ctx, &pb.ReportRequest{
+// observationChunked receives the reply in chunks, so that observations may exceed the max message size.
+func (r *reportingPluginClient) observationChunked(ctx context.Context, req *pb.ObservationRequest) (*pb.ObservationReply, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := r.grpc.ObservationChunked(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	var reply pb.ObservationReply
+	if err = recvChunked(stream, &reply); err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+func (r *reportingPluginClient) Report(ctx context.Context, timestamp libocr.ReportTimestamp, query libocr.Query, obs []libocr.AttributedObservation) (_ bool, _ libocr.Report, err error) {
+	defer recoverPanic(r.Logger, "ReportingPlugin.Report", &err)
+	if err := checkQueryLength(query, r.limits); err != nil {
+		return false, nil, err
+	}
+	req := &pb.ReportRequest{
 		ReportTimestamp: pbReportTimestamp(timestamp),
 		Query:           query,
 		Observations:    pbAttributedObservations(obs),
-	})
+	}
+	var reply *pb.ReportReply
+	if proto.Size(req) > r.chunkSize() {
+		reply, err = r.reportChunked(ctx, req)
+	} else {
+		reply, err = r.grpc.Report(ctx, req)
+	}
 	if err != nil {
 		return false, nil, err
 	}
 	return reply.ShouldReport, reply.Report, nil
 }
 
-func (r *reportingPluginClient) ShouldAcceptFinalizedReport(ctx context.Context, timestamp libocr.ReportTimestamp, report libocr.Report) (bool, error) {
+// reportChunked sends req in chunks, for observations which would exceed the max message size.
+func (r *reportingPluginClient) reportChunked(ctx context.Context, req *pb.ReportRequest) (*pb.ReportReply, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := r.grpc.ReportChunked(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = sendChunked(stream, req, r.chunkSize()); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	// io.EOF means the server ended the stream early, and its status is returned here
+	return stream.CloseAndRecv()
+}
+
+func (r *reportingPluginClient) ShouldAcceptFinalizedReport(ctx context.Context, timestamp libocr.ReportTimestamp, report libocr.Report) (_ bool, err error) {
+	defer recoverPanic(r.Logger, "ReportingPlugin.ShouldAcceptFinalizedReport", &err)
 	reply, err := r.grpc.ShouldAcceptFinalizedReport(ctx, &pb.ShouldAcceptFinalizedReportRequest{
 		ReportTimestamp: pbReportTimestamp(timestamp),
 		Report:          report,
@@ -173,7 +368,8 @@ func (r *reportingPluginClient) ShouldAcceptFinalizedReport(ctx context.Context,
 	return reply.ShouldAccept, nil
 }
 
-func (r *reportingPluginClient) ShouldTransmitAcceptedReport(ctx context.Context, timestamp libocr.ReportTimestamp, report libocr.Report) (bool, error) {
+func (r *reportingPluginClient) ShouldTransmitAcceptedReport(ctx context.Context, timestamp libocr.ReportTimestamp, report libocr.Report) (_ bool, err error) {
+	defer recoverPanic(r.Logger, "ReportingPlugin.ShouldTransmitAcceptedReport", &err)
 	reply, err := r.grpc.ShouldTransmitAcceptedReport(ctx, &pb.ShouldTransmitAcceptedReportRequest{
 		ReportTimestamp: pbReportTimestamp(timestamp),
 		Report:          report,
@@ -189,6 +385,9 @@ func (r *reportingPluginClient) Close() error {
 	defer cancel()
 
 	_, err := r.grpc.Close(ctx, &emptypb.Empty{})
+	if cerr := r.cc.Close(); cerr != nil {
+		err = errors.Join(err, cerr)
+	}
 	return err
 }
 
@@ -197,10 +396,20 @@ var _ pb.ReportingPluginServer = (*reportingPluginServer)(nil)
 type reportingPluginServer struct {
 	pb.UnimplementedReportingPluginServer
 
-	impl libocr.ReportingPlugin
+	impl    libocr.ReportingPlugin
+	res     *Resource // closes impl
+	limits  libocr.ReportingPluginLimits
+	limiter *concurrencyLimiter // optional
+
+	chunkSize int // for ObservationChunked replies
 }
 
 func (r *reportingPluginServer) Query(ctx context.Context, request *pb.QueryRequest) (*pb.QueryReply, error) {
+	release, err := r.limiter.acquire(ctx, "Query")
+	if err != nil {
+		return nil, err
+	}
+	defer release()
 	rts, err := reportTimestamp(request.ReportTimestamp)
 	if err != nil {
 		return nil, err
@@ -209,14 +418,25 @@ func (r *reportingPluginServer) Query(ctx context.Context, request *pb.QueryRequ
 	if err != nil {
 		return nil, err
 	}
+	if err = checkQueryLength(q, r.limits); err != nil {
+		return nil, err
+	}
 	return &pb.QueryReply{Query: q}, nil
 }
 
 func (r *reportingPluginServer) Observation(ctx context.Context, request *pb.ObservationRequest) (*pb.ObservationReply, error) {
+	release, err := r.limiter.acquire(ctx, "Observation")
+	if err != nil {
+		return nil, err
+	}
+	defer release()
 	rts, err := reportTimestamp(request.ReportTimestamp)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkQueryLength(request.Query, r.limits); err != nil {
+		return nil, err
+	}
 	o, err := r.impl.Observation(ctx, rts, request.Query)
 	if err != nil {
 		return nil, err
@@ -224,11 +444,27 @@ func (r *reportingPluginServer) Observation(ctx context.Context, request *pb.Obs
 	return &pb.ObservationReply{Observation: o}, nil
 }
 
+func (r *reportingPluginServer) ObservationChunked(request *pb.ObservationRequest, stream pb.ReportingPlugin_ObservationChunkedServer) error {
+	reply, err := r.Observation(stream.Context(), request)
+	if err != nil {
+		return err
+	}
+	return sendChunked(stream, reply, r.chunkSize)
+}
+
 func (r *reportingPluginServer) Report(ctx context.Context, request *pb.ReportRequest) (*pb.ReportReply, error) {
+	release, err := r.limiter.acquire(ctx, "Report")
+	if err != nil {
+		return nil, err
+	}
+	defer release()
 	rts, err := reportTimestamp(request.ReportTimestamp)
 	if err != nil {
 		return nil, err
 	}
+	if err = checkQueryLength(request.Query, r.limits); err != nil {
+		return nil, err
+	}
 	obs, err := attributedObservations(request.Observations)
 	if err != nil {
 		return nil, err
@@ -243,7 +479,24 @@ func (r *reportingPluginServer) Report(ctx context.Context, request *pb.ReportRe
 	}, nil
 }
 
+func (r *reportingPluginServer) ReportChunked(stream pb.ReportingPlugin_ReportChunkedServer) error {
+	var request pb.ReportRequest
+	if err := recvChunked(stream, &request); err != nil {
+		return err
+	}
+	reply, err := r.Report(stream.Context(), &request)
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(reply)
+}
+
 func (r *reportingPluginServer) ShouldAcceptFinalizedReport(ctx context.Context, request *pb.ShouldAcceptFinalizedReportRequest) (*pb.ShouldAcceptFinalizedReportReply, error) {
+	release, err := r.limiter.acquire(ctx, "ShouldAcceptFinalizedReport")
+	if err != nil {
+		return nil, err
+	}
+	defer release()
 	rts, err := reportTimestamp(request.ReportTimestamp)
 	if err != nil {
 		return nil, err
@@ -256,6 +509,11 @@ func (r *reportingPluginServer) ShouldAcceptFinalizedReport(ctx context.Context,
 }
 
 func (r *reportingPluginServer) ShouldTransmitAcceptedReport(ctx context.Context, request *pb.ShouldTransmitAcceptedReportRequest) (*pb.ShouldTransmitAcceptedReportReply, error) {
+	release, err := r.limiter.acquire(ctx, "ShouldTransmitAcceptedReport")
+	if err != nil {
+		return nil, err
+	}
+	defer release()
 	rts, err := reportTimestamp(request.ReportTimestamp)
 	if err != nil {
 		return nil, err
@@ -268,6 +526,9 @@ func (r *reportingPluginServer) ShouldTransmitAcceptedReport(ctx context.Context
 }
 
 func (r *reportingPluginServer) Close(ctx context.Context, empty *emptypb.Empty) (*emptypb.Empty, error) {
+	if r.res != nil {
+		return &emptypb.Empty{}, r.res.Close(ctx)
+	}
 	return &emptypb.Empty{}, r.impl.Close()
 }
 
@@ -279,21 +540,36 @@ func pbReportTimestamp(ts libocr.ReportTimestamp) *pb.ReportTimestamp {
 	}
 }
 
-func reportTimestamp(ts *pb.ReportTimestamp) (r libocr.ReportTimestamp, err error) {
-	if l := len(ts.ConfigDigest); l != 32 {
-		err = ErrConfigDigestLen(l)
-		return
+func reportTimestamp(ts *pb.ReportTimestamp) (libocr.ReportTimestamp, error) {
+	if err := ts.Validate(); err != nil {
+		return libocr.ReportTimestamp{}, errReportContext("ReportTimestamp", err)
 	}
+	return validReportTimestamp(ts), nil
+}
+
+// validReportTimestamp is like reportTimestamp, but ts must already be valid.
+func validReportTimestamp(ts *pb.ReportTimestamp) (r libocr.ReportTimestamp) {
 	copy(r.ConfigDigest[:], ts.ConfigDigest)
 	r.Epoch = ts.Epoch
-	if ts.Round > math.MaxUint8 {
-		err = ErrUint8Bounds{Name: "Round", U: ts.Round}
-		return
-	}
 	r.Round = uint8(ts.Round)
 	return
 }
 
+// errReportContext returns err, from validating the field of a report context, as an [ErrReportContext].
+func errReportContext(field string, err error) error {
+	var invalid pb.ErrInvalid
+	if !errors.As(err, &invalid) {
+		return ErrReportContext{Field: field, Err: err}
+	}
+	switch {
+	case field == "":
+		field = invalid.Field
+	case invalid.Field != "":
+		field += "." + invalid.Field
+	}
+	return ErrReportContext{Field: field, Err: invalid.Err}
+}
+
 func pbAttributedObservations(obs []libocr.AttributedObservation) (r []*pb.AttributedObservation) {
 	for _, o := range obs {
 		r = append(r, &pb.AttributedObservation{
@@ -316,11 +592,10 @@ func attributedObservations(pbos []*pb.AttributedObservation) (r []libocr.Attrib
 }
 
 func attributedObservation(pbo *pb.AttributedObservation) (o libocr.AttributedObservation, err error) {
-	o.Observation = pbo.Observation
-	if pbo.Observer > math.MaxUint8 {
-		err = ErrUint8Bounds{Name: "Observer", U: pbo.Observer}
+	if err = pbo.Validate(); err != nil {
 		return
 	}
+	o.Observation = pbo.Observation
 	o.Observer = commontypes.OracleID(pbo.Observer)
 	return
 }
@@ -0,0 +1,39 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	libocr "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+)
+
+func TestCheckReportingPluginLimits(t *testing.T) {
+	const maxMsgSize = 1024
+	for _, tt := range []struct {
+		name    string
+		limits  libocr.ReportingPluginLimits
+		maxSize int
+	}{
+		{"small", libocr.ReportingPluginLimits{MaxQueryLength: 10, MaxObservationLength: 10, MaxReportLength: 10}, 0},
+		{"chunked", libocr.ReportingPluginLimits{MaxQueryLength: 10, MaxObservationLength: maxMsgSize, MaxReportLength: 10}, 0},
+		{"query", libocr.ReportingPluginLimits{MaxQueryLength: maxMsgSize}, maxMsgSize},
+		{"report", libocr.ReportingPluginLimits{MaxReportLength: maxMsgSize}, maxMsgSize},
+		{"observations", libocr.ReportingPluginLimits{MaxObservationLength: libocr.MaxMaxObservationLength}, maxChunkedMsgSize},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkReportingPluginLimits(tt.limits, 4, maxMsgSize)
+			if tt.maxSize == 0 {
+				require.NoError(t, err)
+				return
+			}
+			var errSize ErrMaxMsgSize
+			require.ErrorAs(t, err, &errSize)
+			assert.Equal(t, tt.maxSize, errSize.MaxMsgSize)
+		})
+	}
+
+	err := checkReportingPluginLimits(libocr.ReportingPluginLimits{MaxReportLength: -1}, 4, maxMsgSize)
+	require.ErrorContains(t, err, "MaxReportLength (-1) out of range")
+}
@@ -0,0 +1,109 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// closeTimeout bounds closing resources when the caller has no deadline of its own, e.g. after a server stops.
+const closeTimeout = 10 * time.Second
+
+// Resource is a named handle to something which must be released, like a served or dialed connection, or a service
+// backing one. Resources are created with [NewResource] or [NewStopResource], and handed to whatever owns them next
+// (e.g. as dependencies of a served connection), which becomes responsible for closing them.
+//
+// Close is idempotent and safe for concurrent use: the underlying close runs exactly once, and every call waits for it
+// to complete. A nil *Resource is treated as already closed.
+type Resource struct {
+	name   string
+	closer io.Closer
+
+	once sync.Once
+	done chan struct{}
+	err  error
+}
+
+// NewResource returns a new Resource named name, which closes c.
+func NewResource(name string, c io.Closer) *Resource {
+	return &Resource{name: name, closer: c, done: make(chan struct{})}
+}
+
+// NewStopResource returns a new Resource named name, which calls s.Stop() when closed.
+func NewStopResource(name string, s interface{ Stop() }) *Resource {
+	return NewResource(name, fnCloser(s.Stop))
+}
+
+// Name returns the name of the resource, for logging.
+func (r *Resource) Name() string {
+	if r == nil {
+		return ""
+	}
+	return r.name
+}
+
+// Close closes the resource, unless it was already closed, and waits until it is done. If ctx is done first, Close
+// returns an error wrapping the cause while the resource continues closing in the background, and later calls will
+// wait for the same result.
+func (r *Resource) Close(ctx context.Context) error {
+	if r == nil {
+		return nil
+	}
+	r.once.Do(func() {
+		go func() {
+			defer close(r.done)
+			if err := r.closer.Close(); err != nil {
+				r.err = fmt.Errorf("failed to close %s: %w", r.name, err)
+			}
+		}()
+	})
+	select {
+	case <-r.done:
+		return r.err
+	default:
+	}
+	select {
+	case <-r.done:
+		return r.err
+	case <-ctx.Done():
+		return fmt.Errorf("failed to close %s: %w", r.name, context.Cause(ctx))
+	}
+}
+
+// Resources is a list of resources to be closed together.
+type Resources []*Resource
+
+// Add appends r.
+func (rs *Resources) Add(r *Resource) {
+	*rs = append(*rs, r)
+}
+
+// AddCloser appends a new Resource named name, which closes c.
+func (rs *Resources) AddCloser(name string, c io.Closer) {
+	rs.Add(NewResource(name, c))
+}
+
+// AddStopper appends a new Resource named name, which calls s.Stop() when closed.
+func (rs *Resources) AddStopper(name string, s interface{ Stop() }) {
+	rs.Add(NewStopResource(name, s))
+}
+
+// Close closes each resource in order, and returns the joined errors. Each resource is closed even if ctx is done,
+// but Close does not wait for those which have not finished by then.
+func (rs Resources) Close(ctx context.Context) (err error) {
+	for _, r := range rs {
+		err = errors.Join(err, r.Close(ctx))
+	}
+	return
+}
+
+// fnCloser implements io.Closer with a func().
+type fnCloser func()
+
+func (s fnCloser) Close() error {
+	s()
+	return nil
+}
@@ -0,0 +1,111 @@
+package internal_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/internal"
+)
+
+type testCloser struct {
+	calls   atomic.Int32
+	release chan struct{} // optionally blocks Close
+	err     error
+}
+
+func (c *testCloser) Close() error {
+	c.calls.Add(1)
+	if c.release != nil {
+		<-c.release
+	}
+	return c.err
+}
+
+func TestResource_Close(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("idempotent", func(t *testing.T) {
+		c := &testCloser{}
+		r := internal.NewResource("test", c)
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				assert.NoError(t, r.Close(ctx))
+			}()
+		}
+		wg.Wait()
+		require.NoError(t, r.Close(ctx))
+		assert.Equal(t, int32(1), c.calls.Load())
+	})
+
+	t.Run("error", func(t *testing.T) {
+		errClose := errors.New("close failed")
+		c := &testCloser{err: errClose}
+		r := internal.NewResource("test", c)
+		require.ErrorIs(t, r.Close(ctx), errClose)
+		require.ErrorIs(t, r.Close(ctx), errClose)
+		assert.ErrorContains(t, r.Close(ctx), "failed to close test")
+		assert.Equal(t, int32(1), c.calls.Load())
+	})
+
+	t.Run("deadline", func(t *testing.T) {
+		c := &testCloser{release: make(chan struct{})}
+		r := internal.NewResource("test", c)
+		tctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+		require.ErrorIs(t, r.Close(tctx), context.DeadlineExceeded)
+
+		close(c.release)
+		require.NoError(t, r.Close(ctx))
+		assert.Equal(t, int32(1), c.calls.Load())
+	})
+
+	t.Run("stop", func(t *testing.T) {
+		var stops atomic.Int32
+		r := internal.NewStopResource("test", stopFn(func() { stops.Add(1) }))
+		require.NoError(t, r.Close(ctx))
+		require.NoError(t, r.Close(ctx))
+		assert.Equal(t, int32(1), stops.Load())
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		var r *internal.Resource
+		require.NoError(t, r.Close(ctx))
+		assert.Equal(t, "", r.Name())
+	})
+}
+
+type stopFn func()
+
+func (s stopFn) Stop() { s() }
+
+func TestResources_Close(t *testing.T) {
+	errClose := errors.New("close failed")
+	first, second := &testCloser{err: errClose}, &testCloser{}
+	var stops atomic.Int32
+
+	var rs internal.Resources
+	rs.AddCloser("first", first)
+	rs.AddCloser("second", second)
+	rs.AddStopper("third", stopFn(func() { stops.Add(1) }))
+	rs.Add(nil)
+
+	err := rs.Close(context.Background())
+	require.ErrorIs(t, err, errClose)
+	assert.ErrorContains(t, err, "failed to close first")
+	assert.Equal(t, int32(1), first.calls.Load())
+	assert.Equal(t, int32(1), second.calls.Load())
+	assert.Equal(t, int32(1), stops.Load())
+
+	require.ErrorIs(t, rs.Close(context.Background()), errClose)
+	assert.Equal(t, int32(1), first.calls.Load())
+}
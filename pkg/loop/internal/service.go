@@ -2,16 +2,27 @@ package internal
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/emptypb"
 
-	"github.com/smartcontractkit/chainlink-relay/pkg/loop/internal/pb"
+	"github.com/smartcontractkit/chainlink-relay/pkg/buildinfo"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/pb"
 	"github.com/smartcontractkit/chainlink-relay/pkg/types"
 )
 
-var _ types.Service = (*serviceClient)(nil)
+// VersionedService is implemented by service clients, which report the build of the process serving them.
+type VersionedService interface {
+	// Version fails with codes.Unimplemented for older servers.
+	Version(ctx context.Context) (buildinfo.Info, error)
+}
+
+var (
+	_ types.Service    = (*serviceClient)(nil)
+	_ VersionedService = (*serviceClient)(nil)
+)
 
 type serviceClient struct {
 	b    *brokerExt
@@ -28,17 +39,21 @@ func (s *serviceClient) Start(ctx context.Context) error {
 }
 
 func (s *serviceClient) Close() error {
-	ctx, cancel := s.b.stopCtx()
+	ctx, cancel := s.b.StopCh.NewCtx()
 	defer cancel()
 
 	_, err := s.grpc.Close(ctx, &emptypb.Empty{})
+	if cc, ok := s.cc.(*clientConn); ok {
+		// the remote service is gone, so release the connection and anything served for it
+		if cerr := cc.Close(); cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}
 	return err
 }
 
 func (s *serviceClient) Ready() error {
-	ctx, cancel := s.b.stopCtx()
-	defer cancel()
-	ctx, cancel = context.WithTimeout(ctx, time.Second)
+	ctx, cancel := s.b.StopCh.CtxCancel(context.WithTimeout(context.Background(), time.Second))
 	defer cancel()
 
 	_, err := s.grpc.Ready(ctx, &emptypb.Empty{})
@@ -48,9 +63,7 @@ func (s *serviceClient) Ready() error {
 func (s *serviceClient) Name() string { return s.b.Logger.Name() }
 
 func (s *serviceClient) HealthReport() map[string]error {
-	ctx, cancel := s.b.stopCtx()
-	defer cancel()
-	ctx, cancel = context.WithTimeout(ctx, time.Second)
+	ctx, cancel := s.b.StopCh.CtxCancel(context.WithTimeout(context.Background(), time.Second))
 	defer cancel()
 
 	reply, err := s.grpc.HealthReport(ctx, &emptypb.Empty{})
@@ -62,14 +75,27 @@ func (s *serviceClient) HealthReport() map[string]error {
 	return hr
 }
 
+func (s *serviceClient) Version(ctx context.Context) (_ buildinfo.Info, err error) {
+	defer recoverPanic(s.b.Logger, "Service.Version", &err)
+	reply, err := s.grpc.Version(ctx, &emptypb.Empty{})
+	if err != nil {
+		return buildinfo.Info{}, err
+	}
+	return buildinfo.Info{Version: reply.Version, Sha: reply.Sha, Date: reply.Date}, nil
+}
+
 var _ pb.ServiceServer = (*serviceServer)(nil)
 
 type serviceServer struct {
 	pb.UnimplementedServiceServer
 	srv types.Service
+	res *Resource // optional: closed instead of srv, when srv is also a dependency of the server
 }
 
 func (s *serviceServer) Close(ctx context.Context, empty *emptypb.Empty) (*emptypb.Empty, error) {
+	if s.res != nil {
+		return &emptypb.Empty{}, s.res.Close(ctx)
+	}
 	return &emptypb.Empty{}, s.srv.Close()
 }
 
@@ -89,3 +115,9 @@ func (s *serviceServer) HealthReport(ctx context.Context, empty *emptypb.Empty)
 	}
 	return &r, nil
 }
+
+// Version returns the build info of this process, rather than of srv.
+func (s *serviceServer) Version(ctx context.Context, empty *emptypb.Empty) (*pb.VersionReply, error) {
+	info := buildinfo.Get()
+	return &pb.VersionReply{Version: info.Version, Sha: info.Sha, Date: info.Date}, nil
+}
@@ -0,0 +1,97 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	libocr "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+var observationTimestampsSkewed = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "loop_median_observation_timestamps_skewed",
+	Help: "Number of median observation timestamps outside of the allowed skew from the local clock.",
+}, []string{"direction", "action"})
+
+// ErrObservationTimestampSkew is returned when a median observation timestamp is outside of the allowed
+// [types.TimestampSkew].
+type ErrObservationTimestampSkew struct {
+	Observer  uint8
+	Timestamp uint32
+	Min, Max  uint32
+}
+
+func (e ErrObservationTimestampSkew) Error() string {
+	return fmt.Sprintf("invalid observation timestamp %d from oracle %d: outside of allowed skew [%d, %d]", e.Timestamp, e.Observer, e.Min, e.Max)
+}
+
+// NewSkewCheckedReportingPluginFactory returns a factory of median reporting plugins, which check the timestamps of
+// their own observations against skew, and either reject them with [ErrObservationTimestampSkew] or clamp them.
+// Only observations are checked, since reports must be built deterministically by all oracles, regardless of their
+// local clocks.
+func NewSkewCheckedReportingPluginFactory(skew types.TimestampSkew, factory libocr.ReportingPluginFactory) (libocr.ReportingPluginFactory, error) {
+	if err := skew.Validate(); err != nil {
+		return nil, err
+	}
+	return &skewCheckedReportingPluginFactory{factory: factory, skew: skew, now: time.Now}, nil
+}
+
+type skewCheckedReportingPluginFactory struct {
+	factory libocr.ReportingPluginFactory
+	skew    types.TimestampSkew
+	now     func() time.Time
+}
+
+func (s *skewCheckedReportingPluginFactory) NewReportingPlugin(config libocr.ReportingPluginConfig) (libocr.ReportingPlugin, libocr.ReportingPluginInfo, error) {
+	rp, info, err := s.factory.NewReportingPlugin(config)
+	if err != nil {
+		return nil, info, err
+	}
+	return &skewCheckedReportingPlugin{ReportingPlugin: rp, oracleID: uint8(config.OracleID), skew: s.skew, now: s.now}, info, nil
+}
+
+type skewCheckedReportingPlugin struct {
+	libocr.ReportingPlugin
+	oracleID uint8
+	skew     types.TimestampSkew
+	now      func() time.Time
+}
+
+// Observation checks the timestamp of the observation, and re-encodes it if clamped.
+func (s *skewCheckedReportingPlugin) Observation(ctx context.Context, ts libocr.ReportTimestamp, query libocr.Query) (libocr.Observation, error) {
+	obs, err := s.ReportingPlugin.Observation(ctx, ts, query)
+	if err != nil {
+		return nil, err
+	}
+	var o median.NumericalMedianObservationProto
+	if err = proto.Unmarshal(obs, &o); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal median observation: %w", err)
+	}
+
+	min, max := s.skew.Range(s.now())
+	var direction string
+	timestamp := o.Timestamp
+	switch {
+	case timestamp < min:
+		direction = "past"
+		o.Timestamp = min
+	case timestamp > max:
+		direction = "future"
+		o.Timestamp = max
+	default:
+		return obs, nil
+	}
+	if !s.skew.Clamp {
+		observationTimestampsSkewed.WithLabelValues(direction, "rejected").Inc()
+		return nil, ErrObservationTimestampSkew{Observer: s.oracleID, Timestamp: timestamp, Min: min, Max: max}
+	}
+	observationTimestampsSkewed.WithLabelValues(direction, "clamped").Inc()
+	return proto.Marshal(&o)
+}
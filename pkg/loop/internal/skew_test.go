@@ -0,0 +1,112 @@
+package internal_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	libocr "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/internal"
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+// staticObservationFactory creates reporting plugins which always observe timestamp.
+type staticObservationFactory struct {
+	timestamp time.Time
+}
+
+func (s staticObservationFactory) NewReportingPlugin(libocr.ReportingPluginConfig) (libocr.ReportingPlugin, libocr.ReportingPluginInfo, error) {
+	return staticObservationPlugin{s.timestamp}, libocr.ReportingPluginInfo{Name: "static"}, nil
+}
+
+type staticObservationPlugin struct {
+	timestamp time.Time
+}
+
+func (s staticObservationPlugin) Query(context.Context, libocr.ReportTimestamp) (libocr.Query, error) {
+	return nil, nil
+}
+
+func (s staticObservationPlugin) Observation(context.Context, libocr.ReportTimestamp, libocr.Query) (libocr.Observation, error) {
+	return proto.Marshal(&median.NumericalMedianObservationProto{Timestamp: uint32(s.timestamp.Unix()), Value: []byte{1}, JuelsPerFeeCoin: []byte{2}})
+}
+
+func (s staticObservationPlugin) Report(context.Context, libocr.ReportTimestamp, libocr.Query, []libocr.AttributedObservation) (bool, libocr.Report, error) {
+	return false, nil, nil
+}
+
+func (s staticObservationPlugin) ShouldAcceptFinalizedReport(context.Context, libocr.ReportTimestamp, libocr.Report) (bool, error) {
+	return false, nil
+}
+
+func (s staticObservationPlugin) ShouldTransmitAcceptedReport(context.Context, libocr.ReportTimestamp, libocr.Report) (bool, error) {
+	return false, nil
+}
+
+func (s staticObservationPlugin) Close() error { return nil }
+
+func TestNewSkewCheckedReportingPluginFactory(t *testing.T) {
+	_, err := internal.NewSkewCheckedReportingPluginFactory(types.TimestampSkew{MaxPast: -time.Second}, staticObservationFactory{})
+	require.Error(t, err)
+
+	now := time.Now()
+	skew := types.TimestampSkew{MaxPast: time.Hour, MaxFuture: time.Hour}
+	observe := func(t *testing.T, skew types.TimestampSkew, ts time.Time) (*median.NumericalMedianObservationProto, error) {
+		factory, err := internal.NewSkewCheckedReportingPluginFactory(skew, staticObservationFactory{ts})
+		require.NoError(t, err)
+		rp, info, err := factory.NewReportingPlugin(libocr.ReportingPluginConfig{OracleID: 3})
+		require.NoError(t, err)
+		assert.Equal(t, "static", info.Name)
+		obs, err := rp.Observation(context.Background(), libocr.ReportTimestamp{}, nil)
+		if err != nil {
+			return nil, err
+		}
+		var o median.NumericalMedianObservationProto
+		require.NoError(t, proto.Unmarshal(obs, &o))
+		return &o, nil
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		for _, ts := range []time.Time{now.Add(-time.Minute), now, now.Add(time.Minute)} {
+			o, err := observe(t, skew, ts)
+			require.NoError(t, err)
+			assert.Equal(t, uint32(ts.Unix()), o.Timestamp)
+		}
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		for _, ts := range []time.Time{now.Add(-2 * time.Hour), now.Add(2 * time.Hour)} {
+			_, err := observe(t, skew, ts)
+			var skewErr internal.ErrObservationTimestampSkew
+			require.ErrorAs(t, err, &skewErr)
+			assert.Equal(t, uint8(3), skewErr.Observer)
+			assert.Equal(t, uint32(ts.Unix()), skewErr.Timestamp)
+		}
+	})
+
+	t.Run("clamped", func(t *testing.T) {
+		clamp := skew
+		clamp.Clamp = true
+		past, err := observe(t, clamp, now.Add(-2*time.Hour))
+		require.NoError(t, err)
+		assert.InDelta(t, now.Add(-time.Hour).Unix(), past.Timestamp, 5)
+		assert.Equal(t, []byte{1}, past.Value)
+		assert.Equal(t, []byte{2}, past.JuelsPerFeeCoin)
+
+		future, err := observe(t, clamp, now.Add(2*time.Hour))
+		require.NoError(t, err)
+		assert.InDelta(t, now.Add(time.Hour).Unix(), future.Timestamp, 5)
+	})
+
+	t.Run("unlimited", func(t *testing.T) {
+		o, err := observe(t, types.TimestampSkew{MaxFuture: time.Hour}, time.Unix(1, 0))
+		require.NoError(t, err)
+		assert.Equal(t, uint32(1), o.Timestamp)
+	})
+}
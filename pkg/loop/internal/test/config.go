@@ -3,11 +3,14 @@ package test
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 
 	libocr "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
 )
 
 type staticConfigProvider struct{}
@@ -63,6 +66,20 @@ func (s staticContractConfigTracker) LatestBlockHeight(ctx context.Context) (uin
 	return blockHeight, nil
 }
 
+var transmitStatuses = []types.TransmitStatus{{
+	ReportContext: reportContext,
+	State:         types.TransmitFailed,
+	TxHash:        []byte{31: 1},
+	Err:           errors.New("execution reverted"),
+}, {
+	ReportContext: reportContext,
+	State:         types.TransmitConfirmed,
+	TxHash:        []byte{31: 2},
+	BlockNumber:   1234,
+}}
+
+var _ types.TransmitStatusTransmitter = staticContractTransmitter{}
+
 type staticContractTransmitter struct{}
 
 func (s staticContractTransmitter) Transmit(ctx context.Context, rc libocr.ReportContext, r libocr.Report, ss []libocr.AttributedOnchainSignature) error {
@@ -85,3 +102,32 @@ func (s staticContractTransmitter) LatestConfigDigestAndEpoch(ctx context.Contex
 func (s staticContractTransmitter) FromAccount() (libocr.Account, error) {
 	return account, nil
 }
+
+// SubscribeTransmitStatus sends the static statuses and returns, rather than blocking for new statuses.
+func (s staticContractTransmitter) SubscribeTransmitStatus(ctx context.Context, fn func(types.TransmitStatus) error) error {
+	for _, ts := range transmitStatuses {
+		if err := fn(ts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkTransmitStatus(ctx context.Context, ct libocr.ContractTransmitter) error {
+	sst, ok := ct.(types.TransmitStatusTransmitter)
+	if !ok {
+		return fmt.Errorf("expected TransmitStatusTransmitter but got %T", ct)
+	}
+	var got []types.TransmitStatus
+	err := sst.SubscribeTransmitStatus(ctx, func(ts types.TransmitStatus) error {
+		got = append(got, ts)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to SubscribeTransmitStatus: %w", err)
+	}
+	if !assert.ObjectsAreEqual(transmitStatuses, got) {
+		return fmt.Errorf("expected TransmitStatuses %v but got %v", transmitStatuses, got)
+	}
+	return nil
+}
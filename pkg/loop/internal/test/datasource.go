@@ -6,7 +6,9 @@ import (
 	"math/big"
 
 	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
-	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+	libocr "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
 )
 
 var _ median.DataSource = (*staticDataSource)(nil)
@@ -19,7 +21,12 @@ func StaticDataSource() median.DataSource { return &staticDataSource{value} }
 
 func StaticJuelsPerFeeCoinDataSource() median.DataSource { return &staticDataSource{juelsPerFeeCoin} }
 
-func (s *staticDataSource) Observe(ctx context.Context, timestamp types.ReportTimestamp) (*big.Int, error) {
+// FixedJuelsPerFeeCoinConfig returns a fixed strategy config which observes the same value as StaticJuelsPerFeeCoinDataSource.
+func FixedJuelsPerFeeCoinConfig() types.JuelsPerFeeCoinConfig {
+	return types.JuelsPerFeeCoinConfig{Strategy: types.JuelsPerFeeCoinFixed, FixedValue: juelsPerFeeCoin}
+}
+
+func (s *staticDataSource) Observe(ctx context.Context, timestamp libocr.ReportTimestamp) (*big.Int, error) {
 	if timestamp != reportContext.ReportTimestamp {
 		return nil, fmt.Errorf("expected %v but got %v", reportContext.ReportTimestamp, timestamp)
 	}
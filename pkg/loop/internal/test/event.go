@@ -0,0 +1,97 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
+)
+
+var (
+	eventFilter = types.EventFilter{
+		Addresses: []string{"0xabcd", "0x1234"},
+		EventSigs: [][]byte{{31: 7}},
+		FromBlock: 100,
+		Cursor:    "100-3",
+		Limit:     2,
+	}
+	events = []types.Event{{
+		Address:        "0xabcd",
+		Topics:         [][]byte{{31: 7}, {31: 1}},
+		Data:           []byte{63: 9},
+		BlockNumber:    101,
+		BlockHash:      []byte{31: 101},
+		BlockTimestamp: time.Unix(1234567890, 0).UTC(),
+		TxHash:         []byte{31: 42},
+		Index:          4,
+		Cursor:         "101-4",
+	}, {
+		Address:        "0x1234",
+		Topics:         [][]byte{{31: 7}},
+		Data:           []byte{31: 3},
+		BlockNumber:    102,
+		BlockHash:      []byte{31: 102},
+		BlockTimestamp: time.Unix(1234567902, 0).UTC(),
+		TxHash:         []byte{31: 43},
+		Index:          0,
+		Cursor:         "102-0",
+	}}
+	eventPage = types.EventPage{Events: events, NextCursor: "102-0"}
+)
+
+// TestEventQuerier asserts that p exposes an EventQuerier backed by the static fixture.
+func TestEventQuerier(t *testing.T, p any) {
+	ep, ok := p.(types.EventQuerierProvider)
+	require.True(t, ok, "expected EventQuerierProvider but got %T", p)
+	require.NoError(t, checkEventQuerier(utils.Context(t), ep.EventQuerier()))
+}
+
+func checkEventQuerier(ctx context.Context, eq types.EventQuerier) error {
+	gotPage, err := eq.FilteredLogs(ctx, eventFilter)
+	if err != nil {
+		return fmt.Errorf("failed to get FilteredLogs: %w", err)
+	}
+	if !assert.ObjectsAreEqual(eventPage, gotPage) {
+		return fmt.Errorf("expected EventPage %v but got %v", eventPage, gotPage)
+	}
+	var gotEvents []types.Event
+	err = eq.SubscribeLogs(ctx, eventFilter, func(e types.Event) error {
+		gotEvents = append(gotEvents, e)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to SubscribeLogs: %w", err)
+	}
+	if !assert.ObjectsAreEqual(events, gotEvents) {
+		return fmt.Errorf("expected subscribed events %v but got %v", events, gotEvents)
+	}
+	return nil
+}
+
+type staticEventQuerier struct{}
+
+func (s staticEventQuerier) FilteredLogs(ctx context.Context, filter types.EventFilter) (types.EventPage, error) {
+	if !assert.ObjectsAreEqual(eventFilter, filter) {
+		return types.EventPage{}, fmt.Errorf("expected EventFilter %v but got %v", eventFilter, filter)
+	}
+	return eventPage, nil
+}
+
+// SubscribeLogs sends the static events and returns, rather than blocking for new events.
+func (s staticEventQuerier) SubscribeLogs(ctx context.Context, filter types.EventFilter, fn func(types.Event) error) error {
+	if !assert.ObjectsAreEqual(eventFilter, filter) {
+		return fmt.Errorf("expected EventFilter %v but got %v", eventFilter, filter)
+	}
+	for _, e := range events {
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	feetypes "github.com/smartcontractkit/chainlink-relay/pkg/fee/types"
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
+)
+
+// TestGasEstimator asserts that p exposes a GasEstimator backed by the static fixture.
+func TestGasEstimator(t *testing.T, p any) {
+	gp, ok := p.(types.GasEstimatorProvider)
+	require.True(t, ok, "expected GasEstimatorProvider but got %T", p)
+	require.NoError(t, checkGasEstimator(utils.Context(t), gp.GasEstimator()))
+}
+
+func checkGasEstimator(ctx context.Context, ge types.GasEstimator) error {
+	gotFee, err := ge.GetFee(ctx, calldata, fee.Limit, maxPrice, feetypes.OptForceRefetch)
+	if err != nil {
+		return fmt.Errorf("failed to GetFee: %w", err)
+	}
+	if !assert.ObjectsAreEqual(fee, gotFee) {
+		return fmt.Errorf("expected Fee %v but got %v", fee, gotFee)
+	}
+	gotBumped, err := ge.BumpFee(ctx, fee, maxPrice)
+	if err != nil {
+		return fmt.Errorf("failed to BumpFee: %w", err)
+	}
+	if !assert.ObjectsAreEqual(bumpedFee, gotBumped) {
+		return fmt.Errorf("expected bumped Fee %v but got %v", bumpedFee, gotBumped)
+	}
+	return nil
+}
+
+type staticGasEstimator struct{}
+
+func (s staticGasEstimator) GetFee(ctx context.Context, cd []byte, l uint64, mp *big.Int, opts ...feetypes.Opt) (types.Fee, error) {
+	if !assert.ObjectsAreEqual(calldata, cd) {
+		return types.Fee{}, fmt.Errorf("expected calldata %x but got %x", calldata, cd)
+	}
+	if l != fee.Limit {
+		return types.Fee{}, fmt.Errorf("expected limit %d but got %d", fee.Limit, l)
+	}
+	if maxPrice.Cmp(mp) != 0 {
+		return types.Fee{}, fmt.Errorf("expected maxPrice %s but got %s", maxPrice, mp)
+	}
+	if !assert.ObjectsAreEqual([]feetypes.Opt{feetypes.OptForceRefetch}, opts) {
+		return types.Fee{}, fmt.Errorf("expected opts %v but got %v", []feetypes.Opt{feetypes.OptForceRefetch}, opts)
+	}
+	return fee, nil
+}
+
+func (s staticGasEstimator) BumpFee(ctx context.Context, original types.Fee, mp *big.Int) (types.Fee, error) {
+	if !assert.ObjectsAreEqual(fee, original) {
+		return types.Fee{}, fmt.Errorf("expected original %v but got %v", fee, original)
+	}
+	if maxPrice.Cmp(mp) != 0 {
+		return types.Fee{}, fmt.Errorf("expected maxPrice %s but got %s", maxPrice, mp)
+	}
+	return bumpedFee, nil
+}
@@ -0,0 +1,69 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
+)
+
+var heads = []types.Head{{
+	Height:     200,
+	Hash:       []byte{31: 200},
+	ParentHash: []byte{31: 199},
+	Timestamp:  time.Unix(1234567900, 0).UTC(),
+}, {
+	Height:     201,
+	Hash:       []byte{31: 201},
+	ParentHash: []byte{31: 200},
+	Timestamp:  time.Unix(1234567912, 0).UTC(),
+}}
+
+// TestHeadTracker asserts that p exposes a HeadTracker backed by the static fixture.
+func TestHeadTracker(t *testing.T, p any) {
+	hp, ok := p.(types.HeadTrackerProvider)
+	require.True(t, ok, "expected HeadTrackerProvider but got %T", p)
+	require.NoError(t, checkHeadTracker(utils.Context(t), hp.HeadTracker()))
+}
+
+func checkHeadTracker(ctx context.Context, ht types.HeadTracker) error {
+	latest, err := ht.LatestHead(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get LatestHead: %w", err)
+	}
+	if !assert.ObjectsAreEqual(heads[0], latest) {
+		return fmt.Errorf("expected latest Head %v but got %v", heads[0], latest)
+	}
+	var gotHeads []types.Head
+	err = ht.SubscribeNewHead(ctx, func(h types.Head) error {
+		gotHeads = append(gotHeads, h)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to SubscribeNewHead: %w", err)
+	}
+	if !assert.ObjectsAreEqual(heads, gotHeads) {
+		return fmt.Errorf("expected subscribed heads %v but got %v", heads, gotHeads)
+	}
+	return nil
+}
+
+type staticHeadTracker struct{}
+
+func (s staticHeadTracker) LatestHead(ctx context.Context) (types.Head, error) { return heads[0], nil }
+
+// SubscribeNewHead sends the static heads and returns, rather than blocking for new heads.
+func (s staticHeadTracker) SubscribeNewHead(ctx context.Context, fn func(types.Head) error) error {
+	for _, h := range heads {
+		if err := fn(h); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package test
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"reflect"
@@ -15,28 +16,78 @@ import (
 	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
 	libocr "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
 
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/testutils"
 	"github.com/smartcontractkit/chainlink-relay/pkg/types"
 	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
 )
 
 func TestPluginMedian(t *testing.T, p types.PluginMedian) {
-	PluginMedianTest{&StaticMedianProvider{}}.TestPluginMedian(t, p)
+	PluginMedianTest{MedianProvider: &StaticMedianProvider{}}.TestPluginMedian(t, p)
 }
 
 type PluginMedianTest struct {
 	types.MedianProvider
+	// DataSource overrides the default static DataSource, if set.
+	DataSource median.DataSource
+	// JuelsPerFeeCoin overrides the default static juelsPerFeeCoin DataSource, if set.
+	JuelsPerFeeCoin median.DataSource
+	// Leaks configures the leak check, e.g. with the ResourceCounts of the plugin's broker.
+	Leaks []testutils.LeakOption
 }
 
 func (m PluginMedianTest) TestPluginMedian(t *testing.T, p types.PluginMedian) {
 	t.Run("PluginMedian", func(t *testing.T) {
+		testutils.AssertNoLeaks(t, m.Leaks...)
 		ctx := utils.Context(t)
-		factory, err := p.NewMedianFactory(ctx, m.MedianProvider, &staticDataSource{value}, &staticDataSource{juelsPerFeeCoin}, &StaticErrorLog{})
+		ds := m.DataSource
+		if ds == nil {
+			ds = &staticDataSource{value}
+		}
+		juels := m.JuelsPerFeeCoin
+		if juels == nil {
+			juels = &staticDataSource{juelsPerFeeCoin}
+		}
+		factory, err := p.NewMedianFactory(ctx, m.MedianProvider, ds, juels, &StaticErrorLog{})
 		require.NoError(t, err)
+		t.Cleanup(func() { assert.NoError(t, factory.Close()) })
 
 		TestReportingPluginFactory(t, factory)
 	})
 }
 
+// TestPluginMedianMaxMsgSize expects NewReportingPlugin to fail, due to a max message size which is too small for the
+// ReportingPluginLimits.
+func TestPluginMedianMaxMsgSize(t *testing.T, p types.PluginMedian) {
+	ctx := utils.Context(t)
+	factory, err := p.NewMedianFactory(ctx, &StaticMedianProvider{}, &staticDataSource{value}, &staticDataSource{juelsPerFeeCoin}, &StaticErrorLog{})
+	require.NoError(t, err)
+
+	_, _, err = factory.NewReportingPlugin(reportingPluginConfig)
+	require.ErrorContains(t, err, "exceeds max message size")
+}
+
+// TestNumericalMedianFactory exercises a factory backed by [median.NumericalMedianFactory] and the static fixtures.
+func TestNumericalMedianFactory(t *testing.T, factory types.ReportingPluginFactory) {
+	t.Run("NumericalMedianFactory", func(t *testing.T) {
+		cfg := reportingPluginConfig
+		cfg.OnchainConfig = encoded
+		cfg.OffchainConfig = median.OffchainConfig{AlphaReportInfinite: true, AlphaAcceptInfinite: true, DeltaC: time.Hour}.Encode()
+		rp, gotRPI, err := factory.NewReportingPlugin(cfg)
+		require.NoError(t, err)
+		t.Cleanup(func() { assert.NoError(t, rp.Close()) })
+		assert.Equal(t, "NumericalMedian", gotRPI.Name)
+		assert.Equal(t, max, gotRPI.Limits.MaxReportLength)
+
+		ctx := utils.Context(t)
+		gotQuery, err := rp.Query(ctx, reportContext.ReportTimestamp)
+		require.NoError(t, err)
+		assert.Empty(t, gotQuery)
+		gotObs, err := rp.Observation(ctx, reportContext.ReportTimestamp, gotQuery)
+		require.NoError(t, err)
+		assert.NotEmpty(t, gotObs)
+	})
+}
+
 func TestReportingPluginFactory(t *testing.T, factory types.ReportingPluginFactory) {
 	t.Run("ReportingPluginFactory", func(t *testing.T) {
 		rp, gotRPI, err := factory.NewReportingPlugin(reportingPluginConfig)
@@ -77,6 +128,15 @@ func (s StaticPluginMedian) NewMedianFactory(ctx context.Context, provider types
 	if gotDigestPrefix != configDigestPrefix {
 		return nil, fmt.Errorf("expected ConfigDigestPrefix %x but got %x", configDigestPrefix, gotDigestPrefix)
 	}
+	if mp, ok := ocd.(types.MultiPrefixConfigDigester); ok {
+		gotDigestPrefixes, err := mp.ConfigDigestPrefixes()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get ConfigDigestPrefixes: %w", err)
+		}
+		if !reflect.DeepEqual(gotDigestPrefixes, []libocr.ConfigDigestPrefix{configDigestPrefix}) {
+			return nil, fmt.Errorf("expected ConfigDigestPrefixes [%x] but got %x", configDigestPrefix, gotDigestPrefixes)
+		}
+	}
 	gotDigest, err := ocd.ConfigDigest(contractConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get ConfigDigest: %w", err)
@@ -117,6 +177,15 @@ func (s StaticPluginMedian) NewMedianFactory(ctx context.Context, provider types
 	if gotAccount != account {
 		return nil, fmt.Errorf("expectd FromAccount %s but got %s", account, gotAccount)
 	}
+	if rat, ok := ct.(types.ReportAccountTransmitter); ok {
+		gotAccount, err = rat.FromAccountForReport(ctx, reportContext)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get FromAccountForReport: %w", err)
+		}
+		if gotAccount != account {
+			return nil, fmt.Errorf("expected FromAccountForReport %s but got %s", account, gotAccount)
+		}
+	}
 	gotConfigDigest, gotEpoch, err := ct.LatestConfigDigestAndEpoch(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get LatestConfigDigestAndEpoch: %w", err)
@@ -131,6 +200,9 @@ func (s StaticPluginMedian) NewMedianFactory(ctx context.Context, provider types
 	if err != nil {
 		return nil, fmt.Errorf("failed to Transmit")
 	}
+	if err = checkTransmitStatus(ctx, ct); err != nil {
+		return nil, err
+	}
 	rc := provider.ReportCodec()
 	gotReport, err := rc.BuildReport(pobs)
 	if err != nil {
@@ -153,6 +225,11 @@ func (s StaticPluginMedian) NewMedianFactory(ctx context.Context, provider types
 	if gotMax != max {
 		return nil, fmt.Errorf("expected MaxReportLength %d but got %d", max, gotMax)
 	}
+	if rcc, ok := rc.(types.ReportCodecCtx); ok {
+		if err = checkReportCodecCtx(ctx, rcc); err != nil {
+			return nil, err
+		}
+	}
 	mc := provider.MedianContract()
 	gotConfigDigest, gotEpoch, gotRound, err := mc.LatestRoundRequested(ctx, lookbackDuration)
 	if err != nil {
@@ -201,6 +278,32 @@ func (s StaticPluginMedian) NewMedianFactory(ctx context.Context, provider types
 	if !reflect.DeepEqual(gotDecoded, onchainConfig) {
 		return nil, fmt.Errorf("expected OnchainConfig %s but got %s", onchainConfig, gotDecoded)
 	}
+	if occc, ok := occ.(types.OnchainConfigCodecCtx); ok {
+		if err = checkOnchainConfigCodecCtx(ctx, occc); err != nil {
+			return nil, err
+		}
+	}
+	gp, ok := provider.(types.GasEstimatorProvider)
+	if !ok {
+		return nil, fmt.Errorf("expected GasEstimatorProvider but got %T", provider)
+	}
+	if err = checkGasEstimator(ctx, gp.GasEstimator()); err != nil {
+		return nil, err
+	}
+	ep, ok := provider.(types.EventQuerierProvider)
+	if !ok {
+		return nil, fmt.Errorf("expected EventQuerierProvider but got %T", provider)
+	}
+	if err = checkEventQuerier(ctx, ep.EventQuerier()); err != nil {
+		return nil, err
+	}
+	hp, ok := provider.(types.HeadTrackerProvider)
+	if !ok {
+		return nil, fmt.Errorf("expected HeadTrackerProvider but got %T", provider)
+	}
+	if err = checkHeadTracker(ctx, hp.HeadTracker()); err != nil {
+		return nil, err
+	}
 	gotVal, err := dataSource.Observe(ctx, reportContext.ReportTimestamp)
 	if err != nil {
 		return nil, fmt.Errorf("failed to observe dataSource: %w", err)
@@ -221,6 +324,30 @@ func (s StaticPluginMedian) NewMedianFactory(ctx context.Context, provider types
 	return staticPluginFactory{}, nil
 }
 
+// LargeReportsPluginMedian is a [StaticPluginMedian] whose reporting plugins permit reports of MaxReportLength bytes.
+type LargeReportsPluginMedian struct {
+	MaxReportLength int
+}
+
+func (l LargeReportsPluginMedian) NewMedianFactory(ctx context.Context, provider types.MedianProvider, dataSource, juelsPerFeeCoinDataSource median.DataSource, errorLog types.ErrorLog) (types.ReportingPluginFactory, error) {
+	factory, err := StaticPluginMedian{}.NewMedianFactory(ctx, provider, dataSource, juelsPerFeeCoinDataSource, errorLog)
+	if err != nil {
+		return nil, err
+	}
+	return largeReportsPluginFactory{factory, l.MaxReportLength}, nil
+}
+
+type largeReportsPluginFactory struct {
+	types.ReportingPluginFactory
+	maxReportLength int
+}
+
+func (l largeReportsPluginFactory) NewReportingPlugin(config libocr.ReportingPluginConfig) (libocr.ReportingPlugin, libocr.ReportingPluginInfo, error) {
+	rp, info, err := l.ReportingPluginFactory.NewReportingPlugin(config)
+	info.Limits.MaxReportLength = l.maxReportLength
+	return rp, info, err
+}
+
 type staticPluginFactory struct{}
 
 func (s staticPluginFactory) Name() string { panic("implement me") }
@@ -279,7 +406,7 @@ func (s StaticMedianProvider) Start(ctx context.Context) error { return nil }
 
 func (s StaticMedianProvider) Close() error { return nil }
 
-func (s StaticMedianProvider) Ready() error { panic("unimplemented") }
+func (s StaticMedianProvider) Ready() error { return nil }
 
 func (s StaticMedianProvider) Name() string { panic("unimplemented") }
 
@@ -305,6 +432,75 @@ func (s StaticMedianProvider) OnchainConfigCodec() median.OnchainConfigCodec {
 	return staticOnchainConfigCodec{}
 }
 
+func (s StaticMedianProvider) GasEstimator() types.GasEstimator { return staticGasEstimator{} }
+
+func (s StaticMedianProvider) EventQuerier() types.EventQuerier { return staticEventQuerier{} }
+
+func (s StaticMedianProvider) HeadTracker() types.HeadTracker { return staticHeadTracker{} }
+
+func checkReportCodecCtx(ctx context.Context, rc types.ReportCodecCtx) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+	gotReport, err := rc.BuildReportCtx(ctx, pobs)
+	if err != nil {
+		return fmt.Errorf("failed to BuildReportCtx: %w", err)
+	}
+	if !bytes.Equal(gotReport, report) {
+		return fmt.Errorf("expected Report %x but got %x", report, gotReport)
+	}
+	gotMedianValue, err := rc.MedianFromReportCtx(ctx, report)
+	if err != nil {
+		return fmt.Errorf("failed to get MedianFromReportCtx: %w", err)
+	}
+	if medianValue.Cmp(gotMedianValue) != 0 {
+		return fmt.Errorf("expected MedianValue %s but got %s", medianValue, gotMedianValue)
+	}
+	gotMax, err := rc.MaxReportLengthCtx(ctx, n)
+	if err != nil {
+		return fmt.Errorf("failed to get MaxReportLengthCtx: %w", err)
+	}
+	if gotMax != max {
+		return fmt.Errorf("expected MaxReportLength %d but got %d", max, gotMax)
+	}
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err = rc.BuildReportCtx(canceled, pobs); err == nil {
+		return errors.New("expected BuildReportCtx to fail with canceled context")
+	}
+	return nil
+}
+
+func checkOnchainConfigCodecCtx(ctx context.Context, occ types.OnchainConfigCodecCtx) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+	gotEncoded, err := occ.EncodeCtx(ctx, onchainConfig)
+	if err != nil {
+		return fmt.Errorf("failed to EncodeCtx: %w", err)
+	}
+	if !bytes.Equal(gotEncoded, encoded) {
+		return fmt.Errorf("expected Encoded %s but got %s", encoded, gotEncoded)
+	}
+	gotDecoded, err := occ.DecodeCtx(ctx, encoded)
+	if err != nil {
+		return fmt.Errorf("failed to DecodeCtx: %w", err)
+	}
+	if !reflect.DeepEqual(gotDecoded, onchainConfig) {
+		return fmt.Errorf("expected OnchainConfig %s but got %s", onchainConfig, gotDecoded)
+	}
+	return nil
+}
+
+// checkContext returns an error if ctx is already done, like a context-aware implementation would.
+func checkContext(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("context done: %w", err)
+	}
+	return nil
+}
+
+var _ types.ReportCodecCtx = staticReportCodec{}
+
 type staticReportCodec struct{}
 
 func (s staticReportCodec) BuildReport(os []median.ParsedAttributedObservation) (libocr.Report, error) {
@@ -328,6 +524,27 @@ func (s staticReportCodec) MaxReportLength(n2 int) (int, error) {
 	return max, nil
 }
 
+func (s staticReportCodec) BuildReportCtx(ctx context.Context, os []median.ParsedAttributedObservation) (libocr.Report, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+	return s.BuildReport(os)
+}
+
+func (s staticReportCodec) MedianFromReportCtx(ctx context.Context, r libocr.Report) (*big.Int, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+	return s.MedianFromReport(r)
+}
+
+func (s staticReportCodec) MaxReportLengthCtx(ctx context.Context, n2 int) (int, error) {
+	if err := checkContext(ctx); err != nil {
+		return -1, err
+	}
+	return s.MaxReportLength(n2)
+}
+
 type staticMedianContract struct{}
 
 func (s staticMedianContract) LatestTransmissionDetails(ctx context.Context) (libocr.ConfigDigest, uint32, uint8, *big.Int, time.Time, error) {
@@ -341,6 +558,8 @@ func (s staticMedianContract) LatestRoundRequested(ctx context.Context, lookback
 	return configDigest, epoch, round, nil
 }
 
+var _ types.OnchainConfigCodecCtx = staticOnchainConfigCodec{}
+
 type staticOnchainConfigCodec struct{}
 
 func (s staticOnchainConfigCodec) Encode(c median.OnchainConfig) ([]byte, error) {
@@ -359,3 +578,17 @@ func (s staticOnchainConfigCodec) Decode(b []byte) (median.OnchainConfig, error)
 	}
 	return onchainConfig, nil
 }
+
+func (s staticOnchainConfigCodec) EncodeCtx(ctx context.Context, c median.OnchainConfig) ([]byte, error) {
+	if err := checkContext(ctx); err != nil {
+		return nil, err
+	}
+	return s.Encode(c)
+}
+
+func (s staticOnchainConfigCodec) DecodeCtx(ctx context.Context, b []byte) (median.OnchainConfig, error) {
+	if err := checkContext(ctx); err != nil {
+		return median.OnchainConfig{}, err
+	}
+	return s.Decode(b)
+}
@@ -12,7 +12,14 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	libocr "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
 	"github.com/smartcontractkit/chainlink-relay/pkg/loop/internal"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/pb"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/testutils"
 	"github.com/smartcontractkit/chainlink-relay/pkg/types"
 	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
 )
@@ -56,7 +63,10 @@ func (s StaticPluginRelayer) NewRelayer(ctx context.Context, config string, keys
 	return staticRelayer{}, nil
 }
 
-type staticRelayer struct{}
+// staticRelayer implements [types.Transactor] and [types.RPCStatusReporter] as well as [internal.Relayer].
+type staticRelayer struct {
+	staticTransactor
+}
 
 func (s staticRelayer) Start(ctx context.Context) error { return nil }
 
@@ -69,15 +79,15 @@ func (s staticRelayer) Name() string { panic("unimplemented") }
 func (s staticRelayer) HealthReport() map[string]error { panic("unimplemented") }
 
 func (s staticRelayer) NewConfigProvider(ctx context.Context, r types.RelayArgs) (types.ConfigProvider, error) {
-	if !equalRelayArgs(r, RelayArgs) {
-		return nil, fmt.Errorf("expected relay args:\n\t%v\nbut got:\n\t%v", RelayArgs, r)
+	if want := relayArgs(types.ProviderTypeConfig); !equalRelayArgs(r, want) {
+		return nil, fmt.Errorf("expected relay args:\n\t%v\nbut got:\n\t%v", want, r)
 	}
 	return staticConfigProvider{}, nil
 }
 
 func (s staticRelayer) NewMedianProvider(ctx context.Context, r types.RelayArgs, p types.PluginArgs) (types.MedianProvider, error) {
-	if !equalRelayArgs(r, RelayArgs) {
-		return nil, fmt.Errorf("expected relay args:\n\t%v\nbut got:\n\t%v", RelayArgs, r)
+	if want := relayArgs(types.ProviderTypeMedian); !equalRelayArgs(r, want) {
+		return nil, fmt.Errorf("expected relay args:\n\t%v\nbut got:\n\t%v", want, r)
 	}
 	if !reflect.DeepEqual(PluginArgs, p) {
 		return nil, fmt.Errorf("expected plugin args %v but got %v", PluginArgs, p)
@@ -85,6 +95,35 @@ func (s staticRelayer) NewMedianProvider(ctx context.Context, r types.RelayArgs,
 	return StaticMedianProvider{}, nil
 }
 
+func (s staticRelayer) NewPluginProvider(ctx context.Context, r types.RelayArgs, p types.PluginArgs) (types.PluginProvider, error) {
+	if want := relayArgs(types.ProviderTypePlugin); !equalRelayArgs(r, want) {
+		return nil, fmt.Errorf("expected relay args:\n\t%v\nbut got:\n\t%v", want, r)
+	}
+	if !reflect.DeepEqual(PluginArgs, p) {
+		return nil, fmt.Errorf("expected plugin args %v but got %v", PluginArgs, p)
+	}
+	return staticPluginProvider{}, nil
+}
+
+var _ internal.GRPCServiceRegistrar = staticPluginProvider{}
+
+// staticPluginProvider serves an ErrorLog as a product specific service.
+type staticPluginProvider struct {
+	StaticMedianProvider
+}
+
+func (s staticPluginProvider) RegisterGRPCServices(r grpc.ServiceRegistrar) {
+	pb.RegisterErrorLogServer(r, staticErrorLogServer{})
+}
+
+type staticErrorLogServer struct {
+	pb.UnimplementedErrorLogServer
+}
+
+func (s staticErrorLogServer) SaveError(ctx context.Context, request *pb.SaveErrorRequest) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, (&StaticErrorLog{}).SaveError(ctx, request.Message)
+}
+
 func (s staticRelayer) NewMercuryProvider(ctx context.Context, rargs types.RelayArgs, pargs types.PluginArgs) (types.MercuryProvider, error) {
 	panic("unimplemented")
 }
@@ -123,6 +162,13 @@ func (s staticRelayer) NodeStatuses(ctx context.Context, o, l int, cs ...string)
 	return nodes, count, nil
 }
 
+func (s staticRelayer) RPCStatuses(ctx context.Context, cs ...string) ([]types.RPCStatus, error) {
+	if !reflect.DeepEqual(chainIDs, cs) {
+		return nil, fmt.Errorf("expected chain IDs %v but got %v", chainIDs, cs)
+	}
+	return rpcStatuses, nil
+}
+
 func (s staticRelayer) SendTx(ctx context.Context, id, f, t string, a *big.Int, b bool) error {
 	if id != chainID {
 		return fmt.Errorf("expected id %s but got %s", chainID, id)
@@ -142,18 +188,36 @@ func (s staticRelayer) SendTx(ctx context.Context, id, f, t string, a *big.Int,
 	return nil
 }
 
+// relayArgs returns RelayArgs as received by a relayer, with the ProviderType defaulted to t.
+func relayArgs(t types.ProviderType) types.RelayArgs {
+	r := RelayArgs
+	r.ProviderType = t
+	return r
+}
+
 func equalRelayArgs(a, b types.RelayArgs) bool {
 	return a.ExternalJobID == b.ExternalJobID &&
 		a.JobID == b.JobID &&
 		a.ContractID == b.ContractID &&
 		a.New == b.New &&
-		bytes.Equal(a.RelayConfig, b.RelayConfig)
+		bytes.Equal(a.RelayConfig, b.RelayConfig) &&
+		a.ProviderType == b.ProviderType
 }
 
 func TestPluginRelayer(t *testing.T, p internal.PluginRelayer) {
+	PluginRelayerTest{}.TestPluginRelayer(t, p)
+}
+
+type PluginRelayerTest struct {
+	// Leaks configures the leak check, e.g. with the ResourceCounts of the plugin's broker.
+	Leaks []testutils.LeakOption
+}
+
+func (r PluginRelayerTest) TestPluginRelayer(t *testing.T, p internal.PluginRelayer) {
 	ctx := utils.Context(t)
 
 	t.Run("Relayer", func(t *testing.T) {
+		testutils.AssertNoLeaks(t, r.Leaks...)
 		relayer, err := p.NewRelayer(ctx, ConfigTOML, StaticKeystore{})
 		require.NoError(t, err)
 		require.NoError(t, relayer.Start(ctx))
@@ -178,6 +242,11 @@ func TestRelayer(t *testing.T, relayer internal.Relayer) {
 			gotConfigDigestPrefix, err := ocd.ConfigDigestPrefix()
 			require.NoError(t, err)
 			assert.Equal(t, configDigestPrefix, gotConfigDigestPrefix)
+			if mp, ok := ocd.(types.MultiPrefixConfigDigester); assert.True(t, ok) {
+				gotConfigDigestPrefixes, err := mp.ConfigDigestPrefixes()
+				require.NoError(t, err)
+				assert.Equal(t, []libocr.ConfigDigestPrefix{configDigestPrefix}, gotConfigDigestPrefixes)
+			}
 			gotConfigDigest, err := ocd.ConfigDigest(contractConfig)
 			require.NoError(t, err)
 			assert.Equal(t, configDigest, gotConfigDigest)
@@ -214,6 +283,11 @@ func TestRelayer(t *testing.T, relayer internal.Relayer) {
 				gotConfigDigestPrefix, err := ocd.ConfigDigestPrefix()
 				require.NoError(t, err)
 				assert.Equal(t, configDigestPrefix, gotConfigDigestPrefix)
+				if mp, ok := ocd.(types.MultiPrefixConfigDigester); assert.True(t, ok) {
+					gotConfigDigestPrefixes, err := mp.ConfigDigestPrefixes()
+					require.NoError(t, err)
+					assert.Equal(t, []libocr.ConfigDigestPrefix{configDigestPrefix}, gotConfigDigestPrefixes)
+				}
 				gotConfigDigest, err := ocd.ConfigDigest(contractConfig)
 				require.NoError(t, err)
 				assert.Equal(t, configDigest, gotConfigDigest)
@@ -244,6 +318,7 @@ func TestRelayer(t *testing.T, relayer internal.Relayer) {
 				assert.Equal(t, epoch, gotEpoch)
 				err = ct.Transmit(ctx, reportContext, report, sigs)
 				require.NoError(t, err)
+				require.NoError(t, checkTransmitStatus(ctx, ct))
 			})
 			t.Run("ReportCodec", func(t *testing.T) {
 				t.Parallel()
@@ -257,6 +332,9 @@ func TestRelayer(t *testing.T, relayer internal.Relayer) {
 				gotMax, err := rc.MaxReportLength(n)
 				require.NoError(t, err)
 				assert.Equal(t, max, gotMax)
+				rcc, ok := rc.(types.ReportCodecCtx)
+				require.True(t, ok, "expected ReportCodecCtx")
+				require.NoError(t, checkReportCodecCtx(ctx, rcc))
 			})
 			t.Run("MedianContract", func(t *testing.T) {
 				t.Parallel()
@@ -283,10 +361,70 @@ func TestRelayer(t *testing.T, relayer internal.Relayer) {
 				gotDecoded, err := occ.Decode(encoded)
 				require.NoError(t, err)
 				assert.Equal(t, onchainConfig, gotDecoded)
+				occc, ok := occ.(types.OnchainConfigCodecCtx)
+				require.True(t, ok, "expected OnchainConfigCodecCtx")
+				require.NoError(t, checkOnchainConfigCodecCtx(ctx, occc))
+			})
+			t.Run("GasEstimator", func(t *testing.T) {
+				t.Parallel()
+				TestGasEstimator(t, provider)
+			})
+			t.Run("EventQuerier", func(t *testing.T) {
+				t.Parallel()
+				TestEventQuerier(t, provider)
+			})
+			t.Run("HeadTracker", func(t *testing.T) {
+				t.Parallel()
+				TestHeadTracker(t, provider)
 			})
 		})
 	})
 
+	t.Run("ProviderType", func(t *testing.T) {
+		t.Parallel()
+		rargs := RelayArgs
+		rargs.ProviderType = types.ProviderTypeMercury
+		_, err := relayer.NewMedianProvider(ctx, rargs, PluginArgs)
+		assert.ErrorContains(t, err, "unsupported ProviderType mercury for NewMedianProvider")
+		rargs.ProviderType = -1
+		_, err = relayer.NewPluginProvider(ctx, rargs, PluginArgs)
+		assert.ErrorContains(t, err, "unknown ProviderType -1")
+	})
+
+	t.Run("PluginProvider", func(t *testing.T) {
+		t.Parallel()
+		provider, err := relayer.NewPluginProvider(ctx, RelayArgs, PluginArgs)
+		require.NoError(t, err)
+		require.NoError(t, provider.Start(ctx))
+		t.Cleanup(func() { assert.NoError(t, provider.Close()) })
+
+		t.Run("OffchainConfigDigester", func(t *testing.T) {
+			t.Parallel()
+			gotConfigDigest, err := provider.OffchainConfigDigester().ConfigDigest(contractConfig)
+			require.NoError(t, err)
+			assert.Equal(t, configDigest, gotConfigDigest)
+		})
+		t.Run("ContractConfigTracker", func(t *testing.T) {
+			t.Parallel()
+			gotBlockHeight, err := provider.ContractConfigTracker().LatestBlockHeight(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, blockHeight, gotBlockHeight)
+		})
+		t.Run("ContractTransmitter", func(t *testing.T) {
+			t.Parallel()
+			gotAccount, err := provider.ContractTransmitter().FromAccount()
+			require.NoError(t, err)
+			assert.Equal(t, account, gotAccount)
+		})
+		t.Run("ClientConn", func(t *testing.T) {
+			t.Parallel()
+			if gcc, ok := provider.(internal.GRPCClientConn); assert.True(t, ok) {
+				_, err := pb.NewErrorLogClient(gcc.ClientConn()).SaveError(ctx, &pb.SaveErrorRequest{Message: errMsg})
+				require.NoError(t, err)
+			}
+		})
+	})
+
 	t.Run("ChainStatus", func(t *testing.T) {
 		t.Parallel()
 		gotChain, err := relayer.ChainStatus(ctx, chainID)
@@ -310,9 +448,23 @@ func TestRelayer(t *testing.T, relayer internal.Relayer) {
 		assert.Equal(t, count, gotCount)
 	})
 
+	t.Run("RPCStatuses", func(t *testing.T) {
+		t.Parallel()
+		rs, ok := relayer.(types.RPCStatusReporter)
+		require.True(t, ok, "expected RPCStatusReporter but got %T", relayer)
+		gotStatuses, err := rs.RPCStatuses(ctx, chainIDs...)
+		require.NoError(t, err)
+		assert.Equal(t, rpcStatuses, gotStatuses)
+	})
+
 	t.Run("SendTx", func(t *testing.T) {
 		t.Parallel()
 		err := relayer.SendTx(ctx, chainID, from, to, amount, balanceCheck)
 		require.NoError(t, err)
 	})
+
+	t.Run("Transactor", func(t *testing.T) {
+		t.Parallel()
+		TestTransactor(t, relayer)
+	})
 }
@@ -63,3 +63,11 @@ func (s staticReportingPlugin) ShouldTransmitAcceptedReport(ctx context.Context,
 }
 
 func (s staticReportingPlugin) Close() error { return nil }
+
+// StaticReportingPluginFactory is a plain [libocr.ReportingPluginFactory], which does not implement types.Service,
+// for [TestReportingPluginFactory].
+type StaticReportingPluginFactory struct{}
+
+func (s StaticReportingPluginFactory) NewReportingPlugin(config libocr.ReportingPluginConfig) (libocr.ReportingPlugin, libocr.ReportingPluginInfo, error) {
+	return staticPluginFactory{}.NewReportingPlugin(config)
+}
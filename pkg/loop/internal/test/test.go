@@ -1,6 +1,7 @@
 package test
 
 import (
+	"errors"
 	"math/big"
 	"time"
 
@@ -44,7 +45,7 @@ var (
 	chainID            = "chain-id"
 	chainIDs           = []string{"foo", "bar"}
 	chains             = []types.ChainStatus{chain, {ID: "test-id", Enabled: true}}
-	configDigest       = libocr.ConfigDigest([32]byte{2: 10, 12: 16})
+	configDigest       = libocr.ConfigDigest([32]byte{1: 99, 2: 10, 12: 16}) // begins with configDigestPrefix
 	configDigestPrefix = libocr.ConfigDigestPrefix(99)
 	contractConfig     = libocr.ContractConfig{
 		ConfigDigest:          configDigest,
@@ -56,11 +57,15 @@ var (
 		OffchainConfigVersion: 2,
 		OffchainConfig:        []byte{1: 99, 12: 55},
 	}
+	bumpedFee       = types.Fee{Price: big.NewInt(55), Limit: 21000}
+	calldata        = []byte{7: 3}
 	encoded         = []byte{5: 11}
+	fee             = types.Fee{Price: big.NewInt(50), Limit: 21000}
 	juelsPerFeeCoin = big.NewInt(1234)
 	onchainConfig   = median.OnchainConfig{Min: big.NewInt(-12), Max: big.NewInt(1234567890987654321)}
 	latestAnswer    = big.NewInt(-66)
 	latestTimestamp = time.Unix(1234567890, 987654321)
+	maxPrice        = big.NewInt(1000)
 	medianValue     = big.NewInt(-1042)
 	nodes           = []types.NodeStatus{{
 		ChainID: "foo",
@@ -73,6 +78,10 @@ URL = 'http://example.com'
 		Config: `Name = 'baz'
 URL = 'https://test.url'
 `}}
+	rpcStatuses = []types.RPCStatus{
+		{ChainID: "foo", Name: "bar", Priority: 0, Weight: 2, Active: true},
+		{ChainID: "foo", Name: "baz", Priority: 1, Weight: 1, Err: errors.New("connection refused")},
+	}
 	observation = libocr.Observation([]byte{21: 19})
 	obs         = []libocr.AttributedObservation{{Observation: []byte{21: 19}, Observer: commontypes.OracleID(99)}}
 	PluginArgs  = types.PluginArgs{
@@ -80,7 +89,7 @@ URL = 'https://test.url'
 		PluginConfig:  []byte{100: 88},
 	}
 	pobs      = []median.ParsedAttributedObservation{{Timestamp: 123, Value: big.NewInt(31), JuelsPerFeeCoin: big.NewInt(54), Observer: commontypes.OracleID(99)}}
-	query     = []byte{42: 42}
+	query     = []byte{41: 42} // len(query) == rpi.Limits.MaxQueryLength
 	RelayArgs = types.RelayArgs{
 		ExternalJobID: uuid.MustParse("1051429b-aa66-11ed-b0d2-5cff35dfbe67"),
 		JobID:         123,
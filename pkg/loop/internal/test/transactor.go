@@ -0,0 +1,101 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
+)
+
+var (
+	transactRequest = types.TransactRequest{
+		ChainID:        chainID,
+		IdempotencyKey: "idempotency-key",
+		SignedTx:       []byte{0xf8, 0x6b, 0x80, 0x01},
+	}
+	txID       = "tx-id"
+	txStatuses = []types.TxStatus{{
+		ID:    txID,
+		State: types.TxPending,
+	}, {
+		ID:          txID,
+		State:       types.TxFailed,
+		TxHash:      []byte{31: 7},
+		BlockNumber: 1234,
+		Err:         errors.New("execution reverted"),
+	}}
+)
+
+// TestTransactor asserts that r implements Transactor backed by the static fixture.
+func TestTransactor(t *testing.T, r any) {
+	tr, ok := r.(types.Transactor)
+	require.True(t, ok, "expected Transactor but got %T", r)
+	require.NoError(t, checkTransactor(utils.Context(t), tr))
+}
+
+func checkTransactor(ctx context.Context, tr types.Transactor) error {
+	for i := 0; i < 2; i++ { // retries with the same key return the same ID
+		gotID, err := tr.Transact(ctx, transactRequest)
+		if err != nil {
+			return fmt.Errorf("failed to Transact: %w", err)
+		}
+		if gotID != txID {
+			return fmt.Errorf("expected ID %q but got %q", txID, gotID)
+		}
+	}
+	gotStatus, err := tr.TxStatus(ctx, txID)
+	if err != nil {
+		return fmt.Errorf("failed to get TxStatus: %w", err)
+	}
+	if !assert.ObjectsAreEqual(txStatuses[len(txStatuses)-1], gotStatus) {
+		return fmt.Errorf("expected TxStatus %v but got %v", txStatuses[len(txStatuses)-1], gotStatus)
+	}
+	var got []types.TxStatus
+	err = tr.SubscribeTxStatus(ctx, txID, func(s types.TxStatus) error {
+		got = append(got, s)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to SubscribeTxStatus: %w", err)
+	}
+	if !assert.ObjectsAreEqual(txStatuses, got) {
+		return fmt.Errorf("expected TxStatuses %v but got %v", txStatuses, got)
+	}
+	return nil
+}
+
+var _ types.Transactor = staticTransactor{}
+
+type staticTransactor struct{}
+
+func (s staticTransactor) Transact(ctx context.Context, request types.TransactRequest) (string, error) {
+	if !assert.ObjectsAreEqual(transactRequest, request) {
+		return "", fmt.Errorf("expected request %v but got %v", transactRequest, request)
+	}
+	return txID, nil
+}
+
+func (s staticTransactor) TxStatus(ctx context.Context, id string) (types.TxStatus, error) {
+	if id != txID {
+		return types.TxStatus{}, fmt.Errorf("expected ID %q but got %q", txID, id)
+	}
+	return txStatuses[len(txStatuses)-1], nil
+}
+
+func (s staticTransactor) SubscribeTxStatus(ctx context.Context, id string, fn func(types.TxStatus) error) error {
+	if id != txID {
+		return fmt.Errorf("expected ID %q but got %q", txID, id)
+	}
+	for _, ts := range txStatuses {
+		if err := fn(ts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
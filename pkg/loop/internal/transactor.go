@@ -0,0 +1,142 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"google.golang.org/grpc"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/pb"
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+var _ types.Transactor = (*transactorClient)(nil)
+
+// transactorClient implements [types.Transactor]. Calls fail with codes.Unimplemented if the remote relayer does not
+// implement it.
+type transactorClient struct {
+	lggr logger.Logger
+	grpc pb.TransactorClient
+}
+
+func newTransactorClient(lggr logger.Logger, cc grpc.ClientConnInterface) *transactorClient {
+	return &transactorClient{lggr, pb.NewTransactorClient(cc)}
+}
+
+func (t *transactorClient) Transact(ctx context.Context, request types.TransactRequest) (_ string, err error) {
+	defer recoverPanic(t.lggr, "Transactor.Transact", &err)
+	reply, err := t.grpc.Transact(ctx, &pb.TransactRequest{
+		ChainID:        request.ChainID,
+		IdempotencyKey: request.IdempotencyKey,
+		SignedTx:       request.SignedTx,
+	})
+	if err != nil {
+		return "", err
+	}
+	return reply.Id, nil
+}
+
+func (t *transactorClient) TxStatus(ctx context.Context, id string) (_ types.TxStatus, err error) {
+	defer recoverPanic(t.lggr, "Transactor.TxStatus", &err)
+	reply, err := t.grpc.TxStatus(ctx, &pb.TxStatusRequest{Id: id})
+	if err != nil {
+		return types.TxStatus{}, err
+	}
+	return txStatus(reply)
+}
+
+func (t *transactorClient) SubscribeTxStatus(ctx context.Context, id string, fn func(types.TxStatus) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := t.grpc.SubscribeTxStatus(ctx, &pb.TxStatusRequest{Id: id})
+	if err != nil {
+		return err
+	}
+	for {
+		reply, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		s, err := txStatus(reply)
+		if err != nil {
+			return err
+		}
+		if err = fn(s); err != nil {
+			return err
+		}
+	}
+}
+
+var _ pb.TransactorServer = (*transactorServer)(nil)
+
+type transactorServer struct {
+	pb.UnimplementedTransactorServer
+	impl types.Transactor
+}
+
+func (t *transactorServer) Transact(ctx context.Context, request *pb.TransactRequest) (*pb.TransactReply, error) {
+	id, err := t.impl.Transact(ctx, types.TransactRequest{
+		ChainID:        request.ChainID,
+		IdempotencyKey: request.IdempotencyKey,
+		SignedTx:       request.SignedTx,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.TransactReply{Id: id}, nil
+}
+
+func (t *transactorServer) TxStatus(ctx context.Context, request *pb.TxStatusRequest) (*pb.TxStatusReply, error) {
+	s, err := t.impl.TxStatus(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	return pbTxStatus(s), nil
+}
+
+func (t *transactorServer) SubscribeTxStatus(request *pb.TxStatusRequest, stream pb.Transactor_SubscribeTxStatusServer) error {
+	return t.impl.SubscribeTxStatus(stream.Context(), request.Id, func(s types.TxStatus) error {
+		return stream.Send(pbTxStatus(s))
+	})
+}
+
+// registerTransactorServer registers a Transactor server if relayer implements [types.Transactor].
+// Otherwise, clients receive codes.Unimplemented errors.
+func registerTransactorServer(s *grpc.Server, relayer any) {
+	if t, ok := relayer.(types.Transactor); ok {
+		pb.RegisterTransactorServer(s, &transactorServer{impl: t})
+	}
+}
+
+func pbTxStatus(s types.TxStatus) *pb.TxStatusReply {
+	r := &pb.TxStatusReply{
+		Id:          s.ID,
+		State:       int32(s.State),
+		TxHash:      s.TxHash,
+		BlockNumber: s.BlockNumber,
+	}
+	if s.Err != nil {
+		r.Error = s.Err.Error()
+	}
+	return r
+}
+
+func txStatus(r *pb.TxStatusReply) (types.TxStatus, error) {
+	if err := r.Validate(); err != nil {
+		return types.TxStatus{}, err
+	}
+	s := types.TxStatus{
+		ID:          r.Id,
+		State:       types.TxState(r.State),
+		TxHash:      r.TxHash,
+		BlockNumber: r.BlockNumber,
+	}
+	if r.Error != "" {
+		s.Err = errors.New(r.Error)
+	}
+	return s, nil
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"math/big"
 
+	"google.golang.org/grpc"
+
 	"github.com/smartcontractkit/chainlink-relay/pkg/types"
 )
 
@@ -19,6 +21,9 @@ type Relayer interface {
 	NewMedianProvider(context.Context, types.RelayArgs, types.PluginArgs) (types.MedianProvider, error)
 	NewMercuryProvider(context.Context, types.RelayArgs, types.PluginArgs) (types.MercuryProvider, error)
 	NewFunctionsProvider(context.Context, types.RelayArgs, types.PluginArgs) (types.FunctionsProvider, error)
+	// NewPluginProvider returns a provider of the components common to all products. Product specific components can
+	// be served by implementing [GRPCServiceRegistrar].
+	NewPluginProvider(context.Context, types.RelayArgs, types.PluginArgs) (types.PluginProvider, error)
 
 	ChainStatus(ctx context.Context, id string) (types.ChainStatus, error)
 	ChainStatuses(ctx context.Context, offset, limit int) (chains []types.ChainStatus, count int, err error)
@@ -27,3 +32,9 @@ type Relayer interface {
 
 	SendTx(ctx context.Context, chainID, from, to string, amount *big.Int, balanceCheck bool) error
 }
+
+// GRPCServiceRegistrar is optionally implemented by providers returned from [Relayer.NewPluginProvider], to serve
+// product specific gRPC services alongside the common components. Clients reach them via [GRPCClientConn].
+type GRPCServiceRegistrar interface {
+	RegisterGRPCServices(grpc.ServiceRegistrar)
+}
@@ -1,31 +1,65 @@
 package loop
 
 import (
+	"bytes"
+	"encoding/json"
 	"io"
+	"os/exec"
+	"sort"
+	"strings"
 	"sync"
 
 	"github.com/hashicorp/go-hclog"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 
+	"github.com/smartcontractkit/chainlink-relay/pkg/buildinfo"
 	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
 )
 
 // HCLogLogger returns an [hclog.Logger] backed by the given [logger.Logger].
 func HCLogLogger(l logger.Logger) hclog.Logger {
+	return hclogLogger(l, "", nil)
+}
+
+// hclogLogger is like HCLogLogger, but drops messages from the sub-logger named skip, and adds the key/value pairs
+// from the optional fields func to each message.
+func hclogLogger(l logger.Logger, skip string, fields func() []any) hclog.Logger {
 	hcl := hclog.NewInterceptLogger(&hclog.LoggerOptions{
 		Output: io.Discard, // only write through p.Logger Sink
 	})
-	hcl.RegisterSink(&hclSinkAdapter{l: l})
+	hcl.RegisterSink(&hclSinkAdapter{l: l, skip: skip, fields: fields})
 	return hcl
 }
 
+// processFields returns a func for the static log fields identifying a plugin process: the plugin name, the executable
+// path and pid once started, and the version once reported, if available. cmd and version are optional.
+func processFields(pluginName string, cmd *exec.Cmd, version func() (buildinfo.Info, bool)) func() []any {
+	return func() []any {
+		kvs := []any{"plugin", pluginName}
+		if cmd != nil {
+			kvs = append(kvs, "path", cmd.Path)
+			if cmd.Process != nil {
+				kvs = append(kvs, "pid", cmd.Process.Pid)
+			}
+		}
+		if version != nil {
+			if v, ok := version(); ok {
+				kvs = append(kvs, "version", v.Version)
+			}
+		}
+		return kvs
+	}
+}
+
 var _ hclog.SinkAdapter = (*hclSinkAdapter)(nil)
 
 // hclSinkAdapter implements [hclog.SinkAdapter] with a [logger.Logger].
 type hclSinkAdapter struct {
-	l logger.Logger
-	m sync.Map // [string]func() l.Logger
+	l      logger.Logger
+	m      sync.Map // [string]func() l.Logger
+	skip   string
+	fields func() []any // optional
 }
 
 func (h *hclSinkAdapter) named(name string) logger.Logger {
@@ -37,7 +71,13 @@ func (h *hclSinkAdapter) named(name string) logger.Logger {
 }
 
 func (h *hclSinkAdapter) Accept(name string, level hclog.Level, msg string, args ...interface{}) {
+	if h.skip != "" && name == h.skip {
+		return
+	}
 	l := h.named(name)
+	if h.fields != nil {
+		args = append(h.fields(), args...)
+	}
 	switch level {
 	case hclog.NoLevel:
 	case hclog.Debug, hclog.Trace:
@@ -52,14 +92,178 @@ func (h *hclSinkAdapter) Accept(name string, level hclog.Level, msg string, args
 }
 
 // NewLogger returns a new [logger.Logger] configured to encode [hclog] compatible JSON.
-func NewLogger() (logger.Logger, error) {
+// Values of keys matching [logger.DefaultRedactKeys] are redacted.
+func NewLogger() (logger.Logger, error) { return newLogger(zap.DebugLevel) }
+
+func newLogger(lvl zapcore.Level) (logger.Logger, error) {
 	return logger.NewWith(func(cfg *zap.Config) {
-		cfg.Level.SetLevel(zap.DebugLevel)
+		cfg.Level.SetLevel(lvl)
 		cfg.EncoderConfig.LevelKey = "@level"
 		cfg.EncoderConfig.MessageKey = "@message"
 		cfg.EncoderConfig.TimeKey = "@timestamp"
 		cfg.EncoderConfig.EncodeTime = zapcore.TimeEncoderOfLayout("2006-01-02T15:04:05.000000Z07:00")
-	})
+	}, zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		return logger.NewRedactCore(core, logger.DefaultRedactKeys...)
+	}))
+}
+
+var _ io.Writer = (*stderrLogger)(nil)
+
+// stderrLogger is an [io.Writer] which parses the lines of a plugin's stderr and re-emits them with the proper level.
+// Each line is parsed as hclog JSON, then zap JSON, or else logged raw. Entries are tagged with the key/value pairs
+// from fields, like those from [processFields].
+// Write must not be called concurrently.
+type stderrLogger struct {
+	lggr   logger.Logger
+	fields func() []any
+
+	buf       []byte    // partial line
+	panicking bool      // a raw panic was detected, so following raw lines are part of the trace
+	tail      *lineTail // optional, records the last lines
+}
+
+func newStderrLogger(lggr logger.Logger, fields func() []any) *stderrLogger {
+	return &stderrLogger{lggr: lggr, fields: fields}
+}
+
+func (s *stderrLogger) Write(p []byte) (int, error) {
+	s.buf = append(s.buf, p...)
+	for {
+		i := bytes.IndexByte(s.buf, '\n')
+		if i < 0 {
+			break
+		}
+		s.logLine(s.buf[:i])
+		s.buf = s.buf[i+1:]
+	}
+	if len(s.buf) == 0 {
+		s.buf = nil // release
+	}
+	return len(p), nil
+}
+
+func (s *stderrLogger) logLine(line []byte) {
+	line = bytes.TrimRight(line, "\r")
+	if len(bytes.TrimSpace(line)) == 0 {
+		return
+	}
+	if s.tail != nil {
+		s.tail.add(string(line))
+	}
+	tags := s.fields()
+	if line[0] == '{' {
+		var m map[string]any
+		if err := json.Unmarshal(line, &m); err == nil {
+			if lvl, msg, ok := parseJSONEntry(m, "@level", "@message", "@timestamp"); ok { // hclog
+				logLevel(s.lggr, lvl, msg, append(tags, flattenFields(m, "@module", "@caller")...))
+				return
+			}
+			if lvl, msg, ok := parseJSONEntry(m, "level", "msg", "ts"); ok { // zap
+				logLevel(s.lggr, lvl, msg, append(tags, flattenFields(m, "logger", "caller")...))
+				return
+			}
+		}
+	}
+	s.logRaw(tags, string(line))
+}
+
+// logRaw logs a non-JSON line with tags, inferring the level from common prefixes.
+func (s *stderrLogger) logRaw(tags []any, line string) {
+	if strings.HasPrefix(line, "panic: ") || strings.HasPrefix(line, "fatal error: ") {
+		s.panicking = true
+	}
+	if s.panicking {
+		s.lggr.Errorw(line, tags...)
+		return
+	}
+	for _, prefix := range []string{"[TRACE]", "[DEBUG]", "[INFO]", "[WARN]", "[ERROR]"} {
+		if strings.HasPrefix(line, prefix) {
+			logLevel(s.lggr, strings.Trim(prefix, "[]"), strings.TrimSpace(strings.TrimPrefix(line, prefix)), tags)
+			return
+		}
+	}
+	s.lggr.Infow(line, tags...)
+}
+
+// lineTail records the last lines written to it, e.g. from a plugin's stderr, for diagnostics. The zero value retains
+// [stderrTailLines]. It is safe for concurrent use.
+type lineTail struct {
+	mu    sync.Mutex
+	lines []string
+}
+
+const stderrTailLines = 10
+
+func (t *lineTail) add(line string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.lines) == stderrTailLines {
+		copy(t.lines, t.lines[1:])
+		t.lines = t.lines[:len(t.lines)-1]
+	}
+	t.lines = append(t.lines, line)
+}
+
+// get returns a copy of the retained lines, oldest first.
+func (t *lineTail) get() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]string(nil), t.lines...)
+}
+
+// parseJSONEntry removes and returns the level and message from m, along with the timestamp. ok is false if either the
+// level or message are missing.
+func parseJSONEntry(m map[string]any, levelKey, msgKey, tsKey string) (lvl, msg string, ok bool) {
+	if lvl, ok = m[levelKey].(string); !ok {
+		return
+	}
+	if msg, ok = m[msgKey].(string); !ok {
+		return
+	}
+	delete(m, levelKey)
+	delete(m, msgKey)
+	delete(m, tsKey) // re-stamped by lggr
+	return
+}
+
+// flattenFields returns the remaining fields of m as sorted key/value pairs, with any of the rename keys trimmed of
+// their '@' prefix.
+func flattenFields(m map[string]any, rename ...string) []any {
+	for _, k := range rename {
+		if v, ok := m[k]; ok && strings.HasPrefix(k, "@") {
+			delete(m, k)
+			m[strings.TrimPrefix(k, "@")] = v
+		}
+	}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	kvs := make([]any, 0, 2*len(keys))
+	for _, k := range keys {
+		kvs = append(kvs, k, m[k])
+	}
+	return kvs
+}
+
+// logLevel logs to l at the level parsed from lvl, which may be from either hclog or zap. Unknown levels are logged as
+// info, and panic or fatal levels are logged as critical, since the host must not panic or exit.
+func logLevel(l logger.Logger, lvl, msg string, kvs []any) {
+	switch strings.ToLower(lvl) {
+	case "trace", "debug":
+		l.Debugw(msg, kvs...)
+	case "info":
+		l.Infow(msg, kvs...)
+	case "warn", "warning":
+		l.Warnw(msg, kvs...)
+	case "error":
+		l.Errorw(msg, kvs...)
+	case "dpanic", "panic", "fatal", "crit", "critical":
+		logger.Criticalw(l, msg, kvs...)
+	default:
+		l.Infow(msg, kvs...)
+	}
 }
 
 // onceValue returns a function that invokes f only once and returns the value
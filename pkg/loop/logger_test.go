@@ -0,0 +1,75 @@
+package loop
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+)
+
+func TestStderrLogger(t *testing.T) {
+	lggr, observed := logger.TestObserved(t, zapcore.DebugLevel)
+	s := newStderrLogger(lggr, processFields("test-plugin", nil, nil))
+
+	for _, line := range []string{
+		`{"@level":"warn","@message":"hclog","@timestamp":"2023-08-01T00:00:00.000000Z","@module":"foo","a":1}`,
+		`{"level":"error","ts":1690848000,"logger":"bar","msg":"zap","b":"c"}`,
+		`{"level":"fatal","msg":"zap fatal"}`,
+		`[DEBUG] prefixed`,
+		`plain`,
+		`{"not":"a log"}`,
+		``,
+		`panic: oops`,
+		`goroutine 1 [running]:`,
+	} {
+		_, err := s.Write([]byte(line))
+		require.NoError(t, err)
+		_, err = s.Write([]byte("\n"))
+		require.NoError(t, err)
+	}
+	_, err := s.Write([]byte("partial"))
+	require.NoError(t, err)
+
+	all := observed.TakeAll()
+	type entry struct {
+		lvl zapcore.Level
+		msg string
+	}
+	var got []entry
+	for _, e := range all {
+		got = append(got, entry{e.Level, e.Message})
+		assert.Equal(t, "test-plugin", e.ContextMap()["plugin"])
+	}
+	assert.Equal(t, []entry{
+		{zapcore.WarnLevel, "hclog"},
+		{zapcore.ErrorLevel, "zap"},
+		{zapcore.DPanicLevel, "zap fatal"},
+		{zapcore.DebugLevel, "prefixed"},
+		{zapcore.InfoLevel, "plain"},
+		{zapcore.InfoLevel, `{"not":"a log"}`},
+		{zapcore.ErrorLevel, "panic: oops"},
+		{zapcore.ErrorLevel, "goroutine 1 [running]:"},
+	}, got)
+
+	assert.Equal(t, map[string]any{"plugin": "test-plugin", "module": "foo", "a": float64(1)}, all[0].ContextMap())
+	assert.Equal(t, map[string]any{"plugin": "test-plugin", "logger": "bar", "b": "c"}, all[1].ContextMap())
+}
+
+func TestLineTail(t *testing.T) {
+	var tail lineTail
+	assert.Empty(t, tail.get())
+	s := newStderrLogger(logger.Test(t), processFields("test-plugin", nil, nil))
+	s.tail = &tail
+	for i := 0; i < stderrTailLines+2; i++ {
+		_, err := fmt.Fprintf(s, "line %d\n\n", i)
+		require.NoError(t, err)
+	}
+	lines := tail.get()
+	require.Len(t, lines, stderrTailLines)
+	assert.Equal(t, "line 2", lines[0])
+	assert.Equal(t, fmt.Sprintf("line %d", stderrTailLines+1), lines[len(lines)-1])
+}
@@ -4,45 +4,161 @@ import (
 	"context"
 	"fmt"
 	"os/exec"
+	"sync"
+	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
 	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
 
 	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/internal"
 	"github.com/smartcontractkit/chainlink-relay/pkg/types"
 	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
 )
 
 var _ ocrtypes.ReportingPluginFactory = (*MedianService)(nil)
 
+var (
+	medianNewReportingPluginDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "loop_median_service_new_reporting_plugin_duration_seconds",
+		Help:    "Time taken by MedianService.NewReportingPlugin, including waiting for the plugin to launch, by error class.",
+		Buckets: []float64{0.01, 0.1, 0.3, 0.6, 1, 3, 6, 10, 30, 60, 120},
+	}, []string{"class"})
+	medianNewReportingPluginWaitDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "loop_median_service_new_reporting_plugin_wait_duration_seconds",
+		Help:    "Time MedianService.NewReportingPlugin spent blocked waiting for the plugin to launch.",
+		Buckets: []float64{0.001, 0.01, 0.1, 0.3, 0.6, 1, 3, 6, 10, 30, 60, 120},
+	})
+	medianNewReportingPluginFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "loop_median_service_new_reporting_plugin_failures",
+		Help: "Number of MedianService.NewReportingPlugin calls which failed, by error class. Wait is a failure to launch the plugin before stopping.",
+	}, []string{"class"})
+)
+
 // MedianService is a [types.Service] that maintains an internal [types.PluginMedian].
 type MedianService struct {
 	pluginService[*GRPCPluginMedian, types.ReportingPluginFactory]
+
+	provider    internal.GRPCClientConn // non-nil when the provider is proxied
+	providerMu  sync.RWMutex
+	providerErr error
+
+	providerCachePath string // optional
 }
 
 // NewMedianService returns a new [*MedianService].
 // cmd must return a new exec.Cmd each time it is called.
 func NewMedianService(lggr logger.Logger, grpcOpts GRPCOpts, cmd func() *exec.Cmd, provider types.MedianProvider, dataSource, juelsPerFeeCoin median.DataSource, errorLog types.ErrorLog) *MedianService {
+	var ms MedianService
 	newService := func(ctx context.Context, instance any) (types.ReportingPluginFactory, error) {
 		plug, ok := instance.(types.PluginMedian)
 		if !ok {
 			return nil, fmt.Errorf("expected PluginMedian but got %T", instance)
 		}
+		if ms.providerCachePath != "" {
+			ctx = ContextWithProviderCachePath(ctx, ms.providerCachePath)
+		}
 		return plug.NewMedianFactory(ctx, provider, dataSource, juelsPerFeeCoin, errorLog)
 	}
 	stopCh := make(chan struct{})
 	lggr = logger.Named(lggr, "MedianService")
-	var ms MedianService
-	broker := BrokerConfig{StopCh: stopCh, Logger: lggr, GRPCOpts: grpcOpts}
+	broker := BrokerConfig{StopCh: stopCh, Logger: lggr, GRPCOpts: grpcOpts, Resources: &ms.resources}
 	ms.init(PluginMedianName, &GRPCPluginMedian{BrokerConfig: broker}, newService, lggr, cmd, stopCh)
+	if grpcProvider, ok := provider.(internal.GRPCClientConn); ok {
+		ms.provider = grpcProvider
+	}
 	return &ms
 }
 
-func (m *MedianService) NewReportingPlugin(config ocrtypes.ReportingPluginConfig) (ocrtypes.ReportingPlugin, ocrtypes.ReportingPluginInfo, error) {
-	ctx, cancel := utils.ContextFromChan(m.pluginService.stopCh)
+// SetProviderCachePath enables the plugin side cache of the provider's latest config and transmission details, in the
+// file at path. See [ContextWithProviderCachePath]. It must be called before Start.
+func (m *MedianService) SetProviderCachePath(path string) {
+	m.providerCachePath = path
+}
+
+// SetRateLimits limits the rate at which the plugin may call Observe on each of its data sources, and SaveError on its
+// error log, so that a misbehaving plugin can't overload them. A zero limit is unlimited. It must be called before
+// Start.
+func (m *MedianService) SetRateLimits(observe, errorLog utils.RateLimit) error {
+	if err := observe.Validate(); err != nil {
+		return fmt.Errorf("invalid observe rate limit: %w", err)
+	}
+	if err := errorLog.Validate(); err != nil {
+		return fmt.Errorf("invalid error log rate limit: %w", err)
+	}
+	m.grpcPlug.ObserveLimiter = utils.NewRateLimiter(observe, nil)
+	m.grpcPlug.ErrorLogLimiter = utils.NewRateLimiter(errorLog, nil)
+	return nil
+}
+
+func (m *MedianService) Start(ctx context.Context) error {
+	if err := m.pluginService.Start(ctx); err != nil {
+		return err
+	}
+	if m.provider != nil {
+		m.subs.GoNamed("checkProvider", m.checkProvider)
+	}
+	return nil
+}
+
+// checkProvider periodically pings the proxied provider connection. Since the plugin only reaches the provider via
+// the proxy, this is the only liveness signal we have, and it also re-resolves the connection if the relayer restarts.
+func (m *MedianService) checkProvider() {
+	t := m.clock.NewTicker(keepAliveTickDuration)
+	defer t.Stop()
+	for {
+		ctx, cancel := m.stopCh.CtxCancel(utils.WithTimeout(context.Background(), m.clock, keepAliveTickDuration))
+		err := internal.PingClientConn(ctx, m.provider)
+		cancel()
+		if err != nil {
+			m.lggr.Errorw("MedianProvider health check failed", "err", err)
+		}
+		m.providerMu.Lock()
+		m.providerErr = err
+		m.providerMu.Unlock()
+
+		select {
+		case <-m.stopCh:
+			return
+		case <-t.C():
+		}
+	}
+}
+
+// HealthReport includes the status of the proxied provider connection, if any.
+func (m *MedianService) HealthReport() map[string]error {
+	hr := m.pluginService.HealthReport()
+	if m.provider != nil {
+		m.providerMu.RLock()
+		hr[m.Name()+".MedianProvider"] = m.providerErr
+		m.providerMu.RUnlock()
+	}
+	return hr
+}
+
+// NewReportingPlugin blocks until the plugin has launched. The time spent waiting is measured separately from the total,
+// so that slow launches can be told apart from slow or failing plugin configuration.
+func (m *MedianService) NewReportingPlugin(config ocrtypes.ReportingPluginConfig) (_ ocrtypes.ReportingPlugin, _ ocrtypes.ReportingPluginInfo, err error) {
+	start := time.Now()
+	class := "Wait" // until launched
+	defer func() {
+		if class == "" {
+			class = internal.ErrorClass(err)
+		}
+		medianNewReportingPluginDuration.WithLabelValues(class).Observe(time.Since(start).Seconds())
+		if err != nil {
+			medianNewReportingPluginFailures.WithLabelValues(class).Inc()
+		}
+	}()
+	ctx, cancel := m.pluginService.stopCh.NewCtx()
 	defer cancel()
-	if err := m.wait(ctx); err != nil {
+	err = m.wait(ctx)
+	medianNewReportingPluginWaitDuration.Observe(time.Since(start).Seconds())
+	if err != nil {
 		return nil, ocrtypes.ReportingPluginInfo{}, err
 	}
+	class = ""
 	return m.service.NewReportingPlugin(config)
 }
@@ -48,6 +48,17 @@ func TestMedianService(t *testing.T) {
 	})
 }
 
+func TestMedianService_numericalMedian(t *testing.T) {
+	t.Parallel()
+	median := loop.NewMedianService(logger.Test(t), loop.GRPCOpts{}, func() *exec.Cmd {
+		return helperProcess("chainlink-median")
+	}, test.StaticMedianProvider{}, test.StaticDataSource(), test.StaticJuelsPerFeeCoinDataSource(), &test.StaticErrorLog{})
+	require.NoError(t, median.Start(utils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, median.Close()) })
+
+	test.TestNumericalMedianFactory(t, median)
+}
+
 func TestMedianService_recovery(t *testing.T) {
 	t.Parallel()
 	var limit atomic.Int32
@@ -59,3 +70,40 @@ func TestMedianService_recovery(t *testing.T) {
 
 	test.TestReportingPluginFactory(t, median)
 }
+
+func TestMedianService_providerHealth(t *testing.T) {
+	t.Parallel()
+	ctx := utils.Context(t)
+	relayer := loop.NewRelayerService(logger.Test(t), loop.GRPCOpts{}, func() *exec.Cmd {
+		return helperProcess(loop.PluginRelayerName)
+	}, test.ConfigTOML, test.StaticKeystore{})
+	relayerHook := relayer.TestHook()
+	require.NoError(t, relayer.Start(ctx))
+	t.Cleanup(func() { assert.NoError(t, relayer.Close()) })
+	provider, err := relayer.NewMedianProvider(ctx, test.RelayArgs, test.PluginArgs)
+	require.NoError(t, err)
+
+	median := loop.NewMedianService(logger.Test(t), loop.GRPCOpts{}, func() *exec.Cmd {
+		return helperProcess(loop.PluginMedianName)
+	}, provider, test.StaticDataSource(), test.StaticJuelsPerFeeCoinDataSource(), &test.StaticErrorLog{})
+	require.NoError(t, median.Start(ctx))
+	t.Cleanup(func() { assert.NoError(t, median.Close()) })
+
+	providerHealth := func() error {
+		err, ok := median.HealthReport()[median.Name()+".MedianProvider"]
+		require.True(t, ok)
+		return err
+	}
+	require.Eventually(t, func() bool { return providerHealth() == nil }, 2*loop.KeepAliveTickDuration, 100*time.Millisecond)
+	test.TestReportingPluginFactory(t, median)
+
+	t.Run("relayer restart", func(t *testing.T) {
+		relayerHook.Kill()
+
+		// wait for relaunch and re-resolution
+		time.Sleep(2 * loop.KeepAliveTickDuration)
+
+		require.Eventually(t, func() bool { return providerHealth() == nil }, 2*loop.KeepAliveTickDuration, 100*time.Millisecond)
+		test.TestReportingPluginFactory(t, median)
+	})
+}
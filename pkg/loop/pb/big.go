@@ -0,0 +1,82 @@
+package pb
+
+import (
+	"math/big"
+)
+
+func NewBigIntFromInt(b *big.Int) *BigInt {
+	if b == nil {
+		return nil
+	}
+	return &BigInt{
+		Negative: b.Sign() < 0,
+		Value:    b.Bytes(),
+	}
+}
+
+func (b *BigInt) Int() *big.Int {
+	if b == nil {
+		return nil
+	}
+	i := new(big.Int)
+	i.SetBytes(b.Value)
+	if b.Negative {
+		i = i.Neg(i)
+	}
+	return i
+}
+
+// minBigIntBufferSize is the initial number of BigInts allocated by a BigIntBuffer, and bytes for their values.
+const minBigIntBufferSize = 64
+
+// BigIntBuffer is like NewBigIntFromInt, but allocates BigInts and their values in bulk, to amortize the allocations
+// of converting many big.Ints, e.g. the observations of a report. The zero value is ready to use. BigInts from a
+// buffer must not be used after it is Reset.
+type BigIntBuffer struct {
+	ints  []BigInt
+	bytes []byte
+}
+
+// New returns i as a BigInt from the buffer, or nil if i is nil.
+func (b *BigIntBuffer) New(i *big.Int) *BigInt {
+	if i == nil {
+		return nil
+	}
+	if len(b.ints) == cap(b.ints) {
+		// Earlier BigInts keep the old slab, so it is not copied.
+		b.ints = make([]BigInt, 0, growSize(cap(b.ints), 1))
+	}
+	b.ints = b.ints[:len(b.ints)+1]
+	x := &b.ints[len(b.ints)-1]
+	*x = BigInt{Negative: i.Sign() < 0, Value: b.value(i)}
+	return x
+}
+
+// value returns the absolute value of i, in big-endian bytes from the buffer.
+func (b *BigIntBuffer) value(i *big.Int) []byte {
+	n := (i.BitLen() + 7) / 8
+	if cap(b.bytes)-len(b.bytes) < n {
+		b.bytes = make([]byte, 0, growSize(cap(b.bytes), n))
+	}
+	start := len(b.bytes)
+	b.bytes = b.bytes[:start+n]
+	return i.FillBytes(b.bytes[start : start+n : start+n])
+}
+
+// Reset makes the whole buffer available for reuse.
+func (b *BigIntBuffer) Reset() {
+	b.ints = b.ints[:0]
+	b.bytes = b.bytes[:0]
+}
+
+// growSize returns the capacity of a new slab, to replace one of capacity c, with room for at least n.
+func growSize(c, n int) int {
+	c *= 2
+	if c < minBigIntBufferSize {
+		c = minBigIntBufferSize
+	}
+	if c < n {
+		c = n
+	}
+	return c
+}
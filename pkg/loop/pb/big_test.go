@@ -0,0 +1,94 @@
+package pb
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestBigIntBuffer(t *testing.T) {
+	var b BigIntBuffer
+	values := []*big.Int{big.NewInt(0), big.NewInt(-1), big.NewInt(255), new(big.Int).Lsh(big.NewInt(-3), 1000)}
+	for round := 0; round < 3; round++ {
+		var ints []*BigInt
+		for i := 0; i < 100; i++ {
+			ints = append(ints, b.New(values[i%len(values)]))
+		}
+		assert.Nil(t, b.New(nil))
+		for i, x := range ints {
+			expected := NewBigIntFromInt(values[i%len(values)])
+			require.True(t, proto.Equal(expected, x), "expected %v but got %v", expected, x)
+			require.Zero(t, values[i%len(values)].Cmp(x.Int()))
+		}
+		b.Reset()
+	}
+}
+
+// benchmarkObservations returns the values of the observations of a report by n oracles.
+func benchmarkObservations(n int) (values, juels []*big.Int) {
+	for i := 0; i < n; i++ {
+		values = append(values, new(big.Int).Mul(big.NewInt(int64(1_000_000+i)), big.NewInt(1e18)))
+		juels = append(juels, big.NewInt(int64(1e18+i)))
+	}
+	return
+}
+
+// BenchmarkParsedAttributedObservations measures converting the observations of a report by 31 oracles, as sent in a
+// BuildReportRequest.
+func BenchmarkParsedAttributedObservations(b *testing.B) {
+	const n = 31
+	values, juels := benchmarkObservations(n)
+
+	b.Run("NewBigIntFromInt", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var req BuildReportRequest
+			for j := range values {
+				req.Observations = append(req.Observations, &ParsedAttributedObservation{
+					Timestamp:       uint32(j),
+					Value:           NewBigIntFromInt(values[j]),
+					JulesPerFeeCoin: NewBigIntFromInt(juels[j]),
+					Observer:        uint32(j),
+				})
+			}
+		}
+	})
+	b.Run("BigIntBuffer", func(b *testing.B) {
+		b.ReportAllocs()
+		var buf BigIntBuffer
+		obs := make([]ParsedAttributedObservation, n)
+		ptrs := make([]*ParsedAttributedObservation, n)
+		for i := 0; i < b.N; i++ {
+			buf.Reset()
+			for j := range values {
+				obs[j] = ParsedAttributedObservation{
+					Timestamp:       uint32(j),
+					Value:           buf.New(values[j]),
+					JulesPerFeeCoin: buf.New(juels[j]),
+					Observer:        uint32(j),
+				}
+				ptrs[j] = &obs[j]
+			}
+			_ = BuildReportRequest{Observations: ptrs}
+		}
+	})
+	b.Run("Int", func(b *testing.B) {
+		b.ReportAllocs()
+		var req BuildReportRequest
+		for j := range values {
+			req.Observations = append(req.Observations, &ParsedAttributedObservation{
+				Value:           NewBigIntFromInt(values[j]),
+				JulesPerFeeCoin: NewBigIntFromInt(juels[j]),
+			})
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			for _, o := range req.Observations {
+				_, _ = o.Value.Int(), o.JulesPerFeeCoin.Int()
+			}
+		}
+	})
+}
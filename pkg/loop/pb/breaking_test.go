@@ -0,0 +1,25 @@
+package pb
+
+import (
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestBreaking checks the protos for breaking changes via buf, and is skipped if buf is not installed.
+// The reference defaults to the main branch, and may be overridden with BUF_BREAKING_AGAINST, e.g. to check against
+// the last release tag: BUF_BREAKING_AGAINST='../../../.git#tag=v0.1.0,subdir=pkg/loop/pb'
+func TestBreaking(t *testing.T) {
+	buf, err := exec.LookPath("buf")
+	if err != nil {
+		t.Skip("buf not installed")
+	}
+	against := os.Getenv("BUF_BREAKING_AGAINST")
+	if against == "" {
+		against = "../../../.git#branch=main,subdir=pkg/loop/pb"
+	}
+	out, err := exec.Command(buf, "breaking", "--against", against).CombinedOutput()
+	require.NoError(t, err, string(out))
+}
@@ -0,0 +1,15 @@
+// Package pb contains the protocol buffer definitions of the LOOP plugin protocol, along with the generated Go code.
+// External plugin implementations may import the generated code, or the .proto files themselves.
+//
+// # Versioning
+//
+// This package follows the semantic version of the chainlink-relay module:
+//   - Fields, messages, and RPCs may be added in minor versions. New fields must be optional, since hosts and plugins
+//     built against different versions must interoperate.
+//   - Fields and RPCs are never renumbered, retyped, or removed in place. Retired field numbers and names are
+//     reserved instead.
+//   - Breaking changes require a new proto package (e.g. loop.v2), a new plugin handshake magic cookie, and a major
+//     version.
+//
+// TestBreaking enforces these rules with buf (https://buf.build), against the main branch by default.
+package pb
@@ -0,0 +1,174 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.12
+// source: event.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	EventQuerier_FilteredLogs_FullMethodName  = "/loop.EventQuerier/FilteredLogs"
+	EventQuerier_SubscribeLogs_FullMethodName = "/loop.EventQuerier/SubscribeLogs"
+)
+
+// EventQuerierClient is the client API for EventQuerier service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EventQuerierClient interface {
+	FilteredLogs(ctx context.Context, in *FilteredLogsRequest, opts ...grpc.CallOption) (*FilteredLogsReply, error)
+	SubscribeLogs(ctx context.Context, in *SubscribeLogsRequest, opts ...grpc.CallOption) (EventQuerier_SubscribeLogsClient, error)
+}
+
+type eventQuerierClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEventQuerierClient(cc grpc.ClientConnInterface) EventQuerierClient {
+	return &eventQuerierClient{cc}
+}
+
+func (c *eventQuerierClient) FilteredLogs(ctx context.Context, in *FilteredLogsRequest, opts ...grpc.CallOption) (*FilteredLogsReply, error) {
+	out := new(FilteredLogsReply)
+	err := c.cc.Invoke(ctx, EventQuerier_FilteredLogs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventQuerierClient) SubscribeLogs(ctx context.Context, in *SubscribeLogsRequest, opts ...grpc.CallOption) (EventQuerier_SubscribeLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &EventQuerier_ServiceDesc.Streams[0], EventQuerier_SubscribeLogs_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &eventQuerierSubscribeLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type EventQuerier_SubscribeLogsClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type eventQuerierSubscribeLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *eventQuerierSubscribeLogsClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// EventQuerierServer is the server API for EventQuerier service.
+// All implementations must embed UnimplementedEventQuerierServer
+// for forward compatibility
+type EventQuerierServer interface {
+	FilteredLogs(context.Context, *FilteredLogsRequest) (*FilteredLogsReply, error)
+	SubscribeLogs(*SubscribeLogsRequest, EventQuerier_SubscribeLogsServer) error
+	mustEmbedUnimplementedEventQuerierServer()
+}
+
+// UnimplementedEventQuerierServer must be embedded to have forward compatible implementations.
+type UnimplementedEventQuerierServer struct {
+}
+
+func (UnimplementedEventQuerierServer) FilteredLogs(context.Context, *FilteredLogsRequest) (*FilteredLogsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FilteredLogs not implemented")
+}
+func (UnimplementedEventQuerierServer) SubscribeLogs(*SubscribeLogsRequest, EventQuerier_SubscribeLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeLogs not implemented")
+}
+func (UnimplementedEventQuerierServer) mustEmbedUnimplementedEventQuerierServer() {}
+
+// UnsafeEventQuerierServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EventQuerierServer will
+// result in compilation errors.
+type UnsafeEventQuerierServer interface {
+	mustEmbedUnimplementedEventQuerierServer()
+}
+
+func RegisterEventQuerierServer(s grpc.ServiceRegistrar, srv EventQuerierServer) {
+	s.RegisterService(&EventQuerier_ServiceDesc, srv)
+}
+
+func _EventQuerier_FilteredLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilteredLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventQuerierServer).FilteredLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventQuerier_FilteredLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventQuerierServer).FilteredLogs(ctx, req.(*FilteredLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventQuerier_SubscribeLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EventQuerierServer).SubscribeLogs(m, &eventQuerierSubscribeLogsServer{stream})
+}
+
+type EventQuerier_SubscribeLogsServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type eventQuerierSubscribeLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *eventQuerierSubscribeLogsServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// EventQuerier_ServiceDesc is the grpc.ServiceDesc for EventQuerier service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EventQuerier_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "loop.EventQuerier",
+	HandlerType: (*EventQuerierServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FilteredLogs",
+			Handler:    _EventQuerier_FilteredLogs_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeLogs",
+			Handler:       _EventQuerier_SubscribeLogs_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "event.proto",
+}
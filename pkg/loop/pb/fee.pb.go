@@ -0,0 +1,471 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v3.21.12
+// source: fee.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Fee represents [github.com/smartcontractkit/chainlink-relay/pkg/types.Fee].
+type Fee struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Price *BigInt `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
+	Limit uint64  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *Fee) Reset() {
+	*x = Fee{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fee_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Fee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fee) ProtoMessage() {}
+
+func (x *Fee) ProtoReflect() protoreflect.Message {
+	mi := &file_fee_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fee.ProtoReflect.Descriptor instead.
+func (*Fee) Descriptor() ([]byte, []int) {
+	return file_fee_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Fee) GetPrice() *BigInt {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *Fee) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// GetFeeRequest has arguments for [github.com/smartcontractkit/chainlink-relay/pkg/types.GasEstimator.GetFee].
+type GetFeeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Calldata []byte  `protobuf:"bytes,1,opt,name=calldata,proto3" json:"calldata,omitempty"`
+	Limit    uint64  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	MaxPrice *BigInt `protobuf:"bytes,3,opt,name=maxPrice,proto3" json:"maxPrice,omitempty"`
+	Opts     []int32 `protobuf:"varint,4,rep,packed,name=opts,proto3" json:"opts,omitempty"` // [github.com/smartcontractkit/chainlink-relay/pkg/fee/types.Opt]
+}
+
+func (x *GetFeeRequest) Reset() {
+	*x = GetFeeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fee_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFeeRequest) ProtoMessage() {}
+
+func (x *GetFeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fee_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFeeRequest.ProtoReflect.Descriptor instead.
+func (*GetFeeRequest) Descriptor() ([]byte, []int) {
+	return file_fee_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetFeeRequest) GetCalldata() []byte {
+	if x != nil {
+		return x.Calldata
+	}
+	return nil
+}
+
+func (x *GetFeeRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetFeeRequest) GetMaxPrice() *BigInt {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return nil
+}
+
+func (x *GetFeeRequest) GetOpts() []int32 {
+	if x != nil {
+		return x.Opts
+	}
+	return nil
+}
+
+// GetFeeReply has return arguments for [github.com/smartcontractkit/chainlink-relay/pkg/types.GasEstimator.GetFee].
+type GetFeeReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fee *Fee `protobuf:"bytes,1,opt,name=fee,proto3" json:"fee,omitempty"`
+}
+
+func (x *GetFeeReply) Reset() {
+	*x = GetFeeReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fee_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFeeReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFeeReply) ProtoMessage() {}
+
+func (x *GetFeeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_fee_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFeeReply.ProtoReflect.Descriptor instead.
+func (*GetFeeReply) Descriptor() ([]byte, []int) {
+	return file_fee_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetFeeReply) GetFee() *Fee {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+// BumpFeeRequest has arguments for [github.com/smartcontractkit/chainlink-relay/pkg/types.GasEstimator.BumpFee].
+type BumpFeeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Original *Fee    `protobuf:"bytes,1,opt,name=original,proto3" json:"original,omitempty"`
+	MaxPrice *BigInt `protobuf:"bytes,2,opt,name=maxPrice,proto3" json:"maxPrice,omitempty"`
+}
+
+func (x *BumpFeeRequest) Reset() {
+	*x = BumpFeeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fee_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BumpFeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BumpFeeRequest) ProtoMessage() {}
+
+func (x *BumpFeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fee_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BumpFeeRequest.ProtoReflect.Descriptor instead.
+func (*BumpFeeRequest) Descriptor() ([]byte, []int) {
+	return file_fee_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BumpFeeRequest) GetOriginal() *Fee {
+	if x != nil {
+		return x.Original
+	}
+	return nil
+}
+
+func (x *BumpFeeRequest) GetMaxPrice() *BigInt {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return nil
+}
+
+// BumpFeeReply has return arguments for [github.com/smartcontractkit/chainlink-relay/pkg/types.GasEstimator.BumpFee].
+type BumpFeeReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fee *Fee `protobuf:"bytes,1,opt,name=fee,proto3" json:"fee,omitempty"`
+}
+
+func (x *BumpFeeReply) Reset() {
+	*x = BumpFeeReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fee_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BumpFeeReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BumpFeeReply) ProtoMessage() {}
+
+func (x *BumpFeeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_fee_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BumpFeeReply.ProtoReflect.Descriptor instead.
+func (*BumpFeeReply) Descriptor() ([]byte, []int) {
+	return file_fee_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BumpFeeReply) GetFee() *Fee {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+var File_fee_proto protoreflect.FileDescriptor
+
+var file_fee_proto_rawDesc = []byte{
+	0x0a, 0x09, 0x66, 0x65, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x6c, 0x6f, 0x6f,
+	0x70, 0x1a, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x3f, 0x0a, 0x03, 0x46, 0x65, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x2e, 0x42, 0x69,
+	0x67, 0x49, 0x6e, 0x74, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x22, 0x7f, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x28, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x2e, 0x42, 0x69,
+	0x67, 0x49, 0x6e, 0x74, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6f, 0x70, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x04, 0x6f, 0x70,
+	0x74, 0x73, 0x22, 0x2a, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x1b, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09,
+	0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x03, 0x66, 0x65, 0x65, 0x22, 0x61,
+	0x0a, 0x0e, 0x42, 0x75, 0x6d, 0x70, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x25, 0x0a, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x08, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x12, 0x28, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6c, 0x6f, 0x6f, 0x70,
+	0x2e, 0x42, 0x69, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x22, 0x2b, 0x0a, 0x0c, 0x42, 0x75, 0x6d, 0x70, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x1b, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09,
+	0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x03, 0x66, 0x65, 0x65, 0x32, 0x79,
+	0x0a, 0x0c, 0x47, 0x61, 0x73, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x32,
+	0x0a, 0x06, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x12, 0x13, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x2e,
+	0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e,
+	0x6c, 0x6f, 0x6f, 0x70, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x12, 0x35, 0x0a, 0x07, 0x42, 0x75, 0x6d, 0x70, 0x46, 0x65, 0x65, 0x12, 0x14, 0x2e,
+	0x6c, 0x6f, 0x6f, 0x70, 0x2e, 0x42, 0x75, 0x6d, 0x70, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x2e, 0x42, 0x75, 0x6d, 0x70, 0x46,
+	0x65, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6d, 0x61, 0x72, 0x74, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x6b, 0x69, 0x74, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x6c, 0x69,
+	0x6e, 0x6b, 0x2d, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6c, 0x6f, 0x6f,
+	0x70, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_fee_proto_rawDescOnce sync.Once
+	file_fee_proto_rawDescData = file_fee_proto_rawDesc
+)
+
+func file_fee_proto_rawDescGZIP() []byte {
+	file_fee_proto_rawDescOnce.Do(func() {
+		file_fee_proto_rawDescData = protoimpl.X.CompressGZIP(file_fee_proto_rawDescData)
+	})
+	return file_fee_proto_rawDescData
+}
+
+var file_fee_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_fee_proto_goTypes = []interface{}{
+	(*Fee)(nil),            // 0: loop.Fee
+	(*GetFeeRequest)(nil),  // 1: loop.GetFeeRequest
+	(*GetFeeReply)(nil),    // 2: loop.GetFeeReply
+	(*BumpFeeRequest)(nil), // 3: loop.BumpFeeRequest
+	(*BumpFeeReply)(nil),   // 4: loop.BumpFeeReply
+	(*BigInt)(nil),         // 5: loop.BigInt
+}
+var file_fee_proto_depIdxs = []int32{
+	5, // 0: loop.Fee.price:type_name -> loop.BigInt
+	5, // 1: loop.GetFeeRequest.maxPrice:type_name -> loop.BigInt
+	0, // 2: loop.GetFeeReply.fee:type_name -> loop.Fee
+	0, // 3: loop.BumpFeeRequest.original:type_name -> loop.Fee
+	5, // 4: loop.BumpFeeRequest.maxPrice:type_name -> loop.BigInt
+	0, // 5: loop.BumpFeeReply.fee:type_name -> loop.Fee
+	1, // 6: loop.GasEstimator.GetFee:input_type -> loop.GetFeeRequest
+	3, // 7: loop.GasEstimator.BumpFee:input_type -> loop.BumpFeeRequest
+	2, // 8: loop.GasEstimator.GetFee:output_type -> loop.GetFeeReply
+	4, // 9: loop.GasEstimator.BumpFee:output_type -> loop.BumpFeeReply
+	8, // [8:10] is the sub-list for method output_type
+	6, // [6:8] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_fee_proto_init() }
+func file_fee_proto_init() {
+	if File_fee_proto != nil {
+		return
+	}
+	file_relayer_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_fee_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Fee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fee_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFeeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fee_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFeeReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fee_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BumpFeeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fee_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BumpFeeReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_fee_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_fee_proto_goTypes,
+		DependencyIndexes: file_fee_proto_depIdxs,
+		MessageInfos:      file_fee_proto_msgTypes,
+	}.Build()
+	File_fee_proto = out.File
+	file_fee_proto_rawDesc = nil
+	file_fee_proto_goTypes = nil
+	file_fee_proto_depIdxs = nil
+}
@@ -0,0 +1,146 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.12
+// source: fee.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	GasEstimator_GetFee_FullMethodName  = "/loop.GasEstimator/GetFee"
+	GasEstimator_BumpFee_FullMethodName = "/loop.GasEstimator/BumpFee"
+)
+
+// GasEstimatorClient is the client API for GasEstimator service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GasEstimatorClient interface {
+	GetFee(ctx context.Context, in *GetFeeRequest, opts ...grpc.CallOption) (*GetFeeReply, error)
+	BumpFee(ctx context.Context, in *BumpFeeRequest, opts ...grpc.CallOption) (*BumpFeeReply, error)
+}
+
+type gasEstimatorClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGasEstimatorClient(cc grpc.ClientConnInterface) GasEstimatorClient {
+	return &gasEstimatorClient{cc}
+}
+
+func (c *gasEstimatorClient) GetFee(ctx context.Context, in *GetFeeRequest, opts ...grpc.CallOption) (*GetFeeReply, error) {
+	out := new(GetFeeReply)
+	err := c.cc.Invoke(ctx, GasEstimator_GetFee_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gasEstimatorClient) BumpFee(ctx context.Context, in *BumpFeeRequest, opts ...grpc.CallOption) (*BumpFeeReply, error) {
+	out := new(BumpFeeReply)
+	err := c.cc.Invoke(ctx, GasEstimator_BumpFee_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GasEstimatorServer is the server API for GasEstimator service.
+// All implementations must embed UnimplementedGasEstimatorServer
+// for forward compatibility
+type GasEstimatorServer interface {
+	GetFee(context.Context, *GetFeeRequest) (*GetFeeReply, error)
+	BumpFee(context.Context, *BumpFeeRequest) (*BumpFeeReply, error)
+	mustEmbedUnimplementedGasEstimatorServer()
+}
+
+// UnimplementedGasEstimatorServer must be embedded to have forward compatible implementations.
+type UnimplementedGasEstimatorServer struct {
+}
+
+func (UnimplementedGasEstimatorServer) GetFee(context.Context, *GetFeeRequest) (*GetFeeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFee not implemented")
+}
+func (UnimplementedGasEstimatorServer) BumpFee(context.Context, *BumpFeeRequest) (*BumpFeeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BumpFee not implemented")
+}
+func (UnimplementedGasEstimatorServer) mustEmbedUnimplementedGasEstimatorServer() {}
+
+// UnsafeGasEstimatorServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GasEstimatorServer will
+// result in compilation errors.
+type UnsafeGasEstimatorServer interface {
+	mustEmbedUnimplementedGasEstimatorServer()
+}
+
+func RegisterGasEstimatorServer(s grpc.ServiceRegistrar, srv GasEstimatorServer) {
+	s.RegisterService(&GasEstimator_ServiceDesc, srv)
+}
+
+func _GasEstimator_GetFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GasEstimatorServer).GetFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GasEstimator_GetFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GasEstimatorServer).GetFee(ctx, req.(*GetFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GasEstimator_BumpFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BumpFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GasEstimatorServer).BumpFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GasEstimator_BumpFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GasEstimatorServer).BumpFee(ctx, req.(*BumpFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GasEstimator_ServiceDesc is the grpc.ServiceDesc for GasEstimator service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GasEstimator_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "loop.GasEstimator",
+	HandlerType: (*GasEstimatorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetFee",
+			Handler:    _GasEstimator_GetFee_Handler,
+		},
+		{
+			MethodName: "BumpFee",
+			Handler:    _GasEstimator_BumpFee_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "fee.proto",
+}
@@ -0,0 +1,5 @@
+// Code is generated by internal/protogen, which compiles the definitions without protoc, and runs the protoc plugins
+// pinned in go.mod by tools.go. TestGenerated checks that it is up to date.
+
+//go:generate go run ./internal/protogen
+package pb
@@ -0,0 +1,37 @@
+package pb
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGenerated checks that the generated code is up to date, by regenerating it with internal/protogen.
+func TestGenerated(t *testing.T) {
+	gen := t.TempDir()
+	out, err := exec.Command("go", "run", "./internal/protogen", "-out", gen).CombinedOutput()
+	require.NoError(t, err, string(out))
+
+	generated, err := filepath.Glob(filepath.Join(gen, "*.pb.go"))
+	require.NoError(t, err)
+	committed, err := filepath.Glob("*.pb.go")
+	require.NoError(t, err)
+	var names []string
+	for _, g := range generated {
+		names = append(names, filepath.Base(g))
+	}
+	require.ElementsMatch(t, names, committed, "generated files do not match: run go generate ./pkg/loop/pb")
+
+	for _, name := range names {
+		want, err := os.ReadFile(filepath.Join(gen, name))
+		require.NoError(t, err)
+		got, err := os.ReadFile(name)
+		require.NoError(t, err)
+		assert.True(t, bytes.Equal(want, got), "%s is stale: run go generate ./pkg/loop/pb", name)
+	}
+}
@@ -0,0 +1,175 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.12
+// source: head.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	HeadTracker_LatestHead_FullMethodName       = "/loop.HeadTracker/LatestHead"
+	HeadTracker_SubscribeNewHead_FullMethodName = "/loop.HeadTracker/SubscribeNewHead"
+)
+
+// HeadTrackerClient is the client API for HeadTracker service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type HeadTrackerClient interface {
+	LatestHead(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*LatestHeadReply, error)
+	SubscribeNewHead(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (HeadTracker_SubscribeNewHeadClient, error)
+}
+
+type headTrackerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHeadTrackerClient(cc grpc.ClientConnInterface) HeadTrackerClient {
+	return &headTrackerClient{cc}
+}
+
+func (c *headTrackerClient) LatestHead(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*LatestHeadReply, error) {
+	out := new(LatestHeadReply)
+	err := c.cc.Invoke(ctx, HeadTracker_LatestHead_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *headTrackerClient) SubscribeNewHead(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (HeadTracker_SubscribeNewHeadClient, error) {
+	stream, err := c.cc.NewStream(ctx, &HeadTracker_ServiceDesc.Streams[0], HeadTracker_SubscribeNewHead_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &headTrackerSubscribeNewHeadClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type HeadTracker_SubscribeNewHeadClient interface {
+	Recv() (*Head, error)
+	grpc.ClientStream
+}
+
+type headTrackerSubscribeNewHeadClient struct {
+	grpc.ClientStream
+}
+
+func (x *headTrackerSubscribeNewHeadClient) Recv() (*Head, error) {
+	m := new(Head)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// HeadTrackerServer is the server API for HeadTracker service.
+// All implementations must embed UnimplementedHeadTrackerServer
+// for forward compatibility
+type HeadTrackerServer interface {
+	LatestHead(context.Context, *emptypb.Empty) (*LatestHeadReply, error)
+	SubscribeNewHead(*emptypb.Empty, HeadTracker_SubscribeNewHeadServer) error
+	mustEmbedUnimplementedHeadTrackerServer()
+}
+
+// UnimplementedHeadTrackerServer must be embedded to have forward compatible implementations.
+type UnimplementedHeadTrackerServer struct {
+}
+
+func (UnimplementedHeadTrackerServer) LatestHead(context.Context, *emptypb.Empty) (*LatestHeadReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestHead not implemented")
+}
+func (UnimplementedHeadTrackerServer) SubscribeNewHead(*emptypb.Empty, HeadTracker_SubscribeNewHeadServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeNewHead not implemented")
+}
+func (UnimplementedHeadTrackerServer) mustEmbedUnimplementedHeadTrackerServer() {}
+
+// UnsafeHeadTrackerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HeadTrackerServer will
+// result in compilation errors.
+type UnsafeHeadTrackerServer interface {
+	mustEmbedUnimplementedHeadTrackerServer()
+}
+
+func RegisterHeadTrackerServer(s grpc.ServiceRegistrar, srv HeadTrackerServer) {
+	s.RegisterService(&HeadTracker_ServiceDesc, srv)
+}
+
+func _HeadTracker_LatestHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeadTrackerServer).LatestHead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HeadTracker_LatestHead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeadTrackerServer).LatestHead(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HeadTracker_SubscribeNewHead_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(HeadTrackerServer).SubscribeNewHead(m, &headTrackerSubscribeNewHeadServer{stream})
+}
+
+type HeadTracker_SubscribeNewHeadServer interface {
+	Send(*Head) error
+	grpc.ServerStream
+}
+
+type headTrackerSubscribeNewHeadServer struct {
+	grpc.ServerStream
+}
+
+func (x *headTrackerSubscribeNewHeadServer) Send(m *Head) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// HeadTracker_ServiceDesc is the grpc.ServiceDesc for HeadTracker service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var HeadTracker_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "loop.HeadTracker",
+	HandlerType: (*HeadTrackerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LatestHead",
+			Handler:    _HeadTracker_LatestHead_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeNewHead",
+			Handler:       _HeadTracker_SubscribeNewHead_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "head.proto",
+}
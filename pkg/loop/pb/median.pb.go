@@ -28,10 +28,17 @@ type NewMedianFactoryRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MedianProviderID            uint32 `protobuf:"varint,1,opt,name=medianProviderID,proto3" json:"medianProviderID,omitempty"`
-	DataSourceID                uint32 `protobuf:"varint,2,opt,name=dataSourceID,proto3" json:"dataSourceID,omitempty"`
-	JuelsPerFeeCoinDataSourceID uint32 `protobuf:"varint,3,opt,name=juelsPerFeeCoinDataSourceID,proto3" json:"juelsPerFeeCoinDataSourceID,omitempty"`
-	ErrorLogID                  uint32 `protobuf:"varint,4,opt,name=errorLogID,proto3" json:"errorLogID,omitempty"`
+	MedianProviderID                uint32                 `protobuf:"varint,1,opt,name=medianProviderID,proto3" json:"medianProviderID,omitempty"`
+	DataSourceID                    uint32                 `protobuf:"varint,2,opt,name=dataSourceID,proto3" json:"dataSourceID,omitempty"`
+	JuelsPerFeeCoinDataSourceID     uint32                 `protobuf:"varint,3,opt,name=juelsPerFeeCoinDataSourceID,proto3" json:"juelsPerFeeCoinDataSourceID,omitempty"`
+	ErrorLogID                      uint32                 `protobuf:"varint,4,opt,name=errorLogID,proto3" json:"errorLogID,omitempty"`
+	JuelsPerFeeCoinConfig           *JuelsPerFeeCoinConfig `protobuf:"bytes,5,opt,name=juelsPerFeeCoinConfig,proto3" json:"juelsPerFeeCoinConfig,omitempty"`                                                                       // optional
+	DataSourceBounds                *ObservationBounds     `protobuf:"bytes,6,opt,name=dataSourceBounds,proto3" json:"dataSourceBounds,omitempty"`                                                                                 // optional
+	JuelsPerFeeCoinDataSourceBounds *ObservationBounds     `protobuf:"bytes,7,opt,name=juelsPerFeeCoinDataSourceBounds,proto3" json:"juelsPerFeeCoinDataSourceBounds,omitempty"`                                                   // optional
+	FeatureFlags                    map[string]string      `protobuf:"bytes,8,rep,name=featureFlags,proto3" json:"featureFlags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // optional
+	ProviderCachePath               string                 `protobuf:"bytes,9,opt,name=providerCachePath,proto3" json:"providerCachePath,omitempty"`                                                                               // optional
+	KeyValueStoreID                 uint32                 `protobuf:"varint,10,opt,name=keyValueStoreID,proto3" json:"keyValueStoreID,omitempty"`                                                                                 // optional
+	IdempotencyKey                  string                 `protobuf:"bytes,11,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`                                                                                    // optional, identifies retries of the same request
 }
 
 func (x *NewMedianFactoryRequest) Reset() {
@@ -94,6 +101,175 @@ func (x *NewMedianFactoryRequest) GetErrorLogID() uint32 {
 	return 0
 }
 
+func (x *NewMedianFactoryRequest) GetJuelsPerFeeCoinConfig() *JuelsPerFeeCoinConfig {
+	if x != nil {
+		return x.JuelsPerFeeCoinConfig
+	}
+	return nil
+}
+
+func (x *NewMedianFactoryRequest) GetDataSourceBounds() *ObservationBounds {
+	if x != nil {
+		return x.DataSourceBounds
+	}
+	return nil
+}
+
+func (x *NewMedianFactoryRequest) GetJuelsPerFeeCoinDataSourceBounds() *ObservationBounds {
+	if x != nil {
+		return x.JuelsPerFeeCoinDataSourceBounds
+	}
+	return nil
+}
+
+func (x *NewMedianFactoryRequest) GetFeatureFlags() map[string]string {
+	if x != nil {
+		return x.FeatureFlags
+	}
+	return nil
+}
+
+func (x *NewMedianFactoryRequest) GetProviderCachePath() string {
+	if x != nil {
+		return x.ProviderCachePath
+	}
+	return ""
+}
+
+func (x *NewMedianFactoryRequest) GetKeyValueStoreID() uint32 {
+	if x != nil {
+		return x.KeyValueStoreID
+	}
+	return 0
+}
+
+func (x *NewMedianFactoryRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+// JuelsPerFeeCoinConfig represents [github.com/smartcontractkit/chainlink-relay/pkg/types.JuelsPerFeeCoinConfig].
+type JuelsPerFeeCoinConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Strategy     string  `protobuf:"bytes,1,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	FixedValue   *BigInt `protobuf:"bytes,2,opt,name=fixedValue,proto3" json:"fixedValue,omitempty"`
+	MaxStaleness int64   `protobuf:"varint,3,opt,name=maxStaleness,proto3" json:"maxStaleness,omitempty"` // milliseconds
+}
+
+func (x *JuelsPerFeeCoinConfig) Reset() {
+	*x = JuelsPerFeeCoinConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_median_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JuelsPerFeeCoinConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JuelsPerFeeCoinConfig) ProtoMessage() {}
+
+func (x *JuelsPerFeeCoinConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_median_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JuelsPerFeeCoinConfig.ProtoReflect.Descriptor instead.
+func (*JuelsPerFeeCoinConfig) Descriptor() ([]byte, []int) {
+	return file_median_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *JuelsPerFeeCoinConfig) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *JuelsPerFeeCoinConfig) GetFixedValue() *BigInt {
+	if x != nil {
+		return x.FixedValue
+	}
+	return nil
+}
+
+func (x *JuelsPerFeeCoinConfig) GetMaxStaleness() int64 {
+	if x != nil {
+		return x.MaxStaleness
+	}
+	return 0
+}
+
+// ObservationBounds represents [github.com/smartcontractkit/chainlink-relay/pkg/types.ObservationBounds].
+type ObservationBounds struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Min *BigInt `protobuf:"bytes,1,opt,name=min,proto3" json:"min,omitempty"` // optional
+	Max *BigInt `protobuf:"bytes,2,opt,name=max,proto3" json:"max,omitempty"` // optional
+}
+
+func (x *ObservationBounds) Reset() {
+	*x = ObservationBounds{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_median_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ObservationBounds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObservationBounds) ProtoMessage() {}
+
+func (x *ObservationBounds) ProtoReflect() protoreflect.Message {
+	mi := &file_median_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObservationBounds.ProtoReflect.Descriptor instead.
+func (*ObservationBounds) Descriptor() ([]byte, []int) {
+	return file_median_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ObservationBounds) GetMin() *BigInt {
+	if x != nil {
+		return x.Min
+	}
+	return nil
+}
+
+func (x *ObservationBounds) GetMax() *BigInt {
+	if x != nil {
+		return x.Max
+	}
+	return nil
+}
+
 // NewMedianFactoryRequest has return arguments for [github.com/smartcontractkit/chainlink-relay/pkg/loop.Relayer.NewMedianFactory].
 type NewMedianFactoryReply struct {
 	state         protoimpl.MessageState
@@ -106,7 +282,7 @@ type NewMedianFactoryReply struct {
 func (x *NewMedianFactoryReply) Reset() {
 	*x = NewMedianFactoryReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_median_proto_msgTypes[1]
+		mi := &file_median_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -119,7 +295,7 @@ func (x *NewMedianFactoryReply) String() string {
 func (*NewMedianFactoryReply) ProtoMessage() {}
 
 func (x *NewMedianFactoryReply) ProtoReflect() protoreflect.Message {
-	mi := &file_median_proto_msgTypes[1]
+	mi := &file_median_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -132,7 +308,7 @@ func (x *NewMedianFactoryReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NewMedianFactoryReply.ProtoReflect.Descriptor instead.
 func (*NewMedianFactoryReply) Descriptor() ([]byte, []int) {
-	return file_median_proto_rawDescGZIP(), []int{1}
+	return file_median_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *NewMedianFactoryReply) GetReportingPluginFactoryID() uint32 {
@@ -149,12 +325,13 @@ type SaveErrorRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Key     string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"` // optional, see [github.com/smartcontractkit/chainlink-relay/pkg/types.ResolvableErrorLog.SaveKeyedError]
 }
 
 func (x *SaveErrorRequest) Reset() {
 	*x = SaveErrorRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_median_proto_msgTypes[2]
+		mi := &file_median_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -167,7 +344,7 @@ func (x *SaveErrorRequest) String() string {
 func (*SaveErrorRequest) ProtoMessage() {}
 
 func (x *SaveErrorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_median_proto_msgTypes[2]
+	mi := &file_median_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -180,7 +357,7 @@ func (x *SaveErrorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SaveErrorRequest.ProtoReflect.Descriptor instead.
 func (*SaveErrorRequest) Descriptor() ([]byte, []int) {
-	return file_median_proto_rawDescGZIP(), []int{2}
+	return file_median_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SaveErrorRequest) GetMessage() string {
@@ -190,22 +367,79 @@ func (x *SaveErrorRequest) GetMessage() string {
 	return ""
 }
 
+func (x *SaveErrorRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// ResolveErrorRequest has arguments for [github.com/smartcontractkit/chainlink-relay/pkg/types.ResolvableErrorLog.ResolveError].
+type ResolveErrorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *ResolveErrorRequest) Reset() {
+	*x = ResolveErrorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_median_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveErrorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveErrorRequest) ProtoMessage() {}
+
+func (x *ResolveErrorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_median_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveErrorRequest.ProtoReflect.Descriptor instead.
+func (*ResolveErrorRequest) Descriptor() ([]byte, []int) {
+	return file_median_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ResolveErrorRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
 // ParsedAttributedObservation represents [github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median.ParsedAttributedObservation].
 type ParsedAttributedObservation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Timestamp       uint32  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Value           *BigInt `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	JulesPerFeeCoin *BigInt `protobuf:"bytes,3,opt,name=julesPerFeeCoin,proto3" json:"julesPerFeeCoin,omitempty"`
-	Observer        uint32  `protobuf:"varint,4,opt,name=observer,proto3" json:"observer,omitempty"` // uint8
+	Timestamp        uint32            `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Value            *BigInt           `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	JulesPerFeeCoin  *BigInt           `protobuf:"bytes,3,opt,name=julesPerFeeCoin,proto3" json:"julesPerFeeCoin,omitempty"`
+	Observer         uint32            `protobuf:"varint,4,opt,name=observer,proto3" json:"observer,omitempty"`                                                                                        // uint8
+	GasPriceSubunits *BigInt           `protobuf:"bytes,5,opt,name=gasPriceSubunits,proto3" json:"gasPriceSubunits,omitempty"`                                                                         // optional, extended
+	Metadata         map[string][]byte `protobuf:"bytes,6,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // optional, extended
 }
 
 func (x *ParsedAttributedObservation) Reset() {
 	*x = ParsedAttributedObservation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_median_proto_msgTypes[3]
+		mi := &file_median_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -218,7 +452,7 @@ func (x *ParsedAttributedObservation) String() string {
 func (*ParsedAttributedObservation) ProtoMessage() {}
 
 func (x *ParsedAttributedObservation) ProtoReflect() protoreflect.Message {
-	mi := &file_median_proto_msgTypes[3]
+	mi := &file_median_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -231,7 +465,7 @@ func (x *ParsedAttributedObservation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ParsedAttributedObservation.ProtoReflect.Descriptor instead.
 func (*ParsedAttributedObservation) Descriptor() ([]byte, []int) {
-	return file_median_proto_rawDescGZIP(), []int{3}
+	return file_median_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ParsedAttributedObservation) GetTimestamp() uint32 {
@@ -262,6 +496,20 @@ func (x *ParsedAttributedObservation) GetObserver() uint32 {
 	return 0
 }
 
+func (x *ParsedAttributedObservation) GetGasPriceSubunits() *BigInt {
+	if x != nil {
+		return x.GasPriceSubunits
+	}
+	return nil
+}
+
+func (x *ParsedAttributedObservation) GetMetadata() map[string][]byte {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
 // BuildReportRequest has arguments for [github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median.ReportCodec.BuildReport].
 type BuildReportRequest struct {
 	state         protoimpl.MessageState
@@ -269,12 +517,13 @@ type BuildReportRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	Observations []*ParsedAttributedObservation `protobuf:"bytes,1,rep,name=observations,proto3" json:"observations,omitempty"`
+	Extended     bool                           `protobuf:"varint,2,opt,name=extended,proto3" json:"extended,omitempty"` // for [github.com/smartcontractkit/chainlink-relay/pkg/types.ReportCodecExt.BuildReportExt]
 }
 
 func (x *BuildReportRequest) Reset() {
 	*x = BuildReportRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_median_proto_msgTypes[4]
+		mi := &file_median_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -287,7 +536,7 @@ func (x *BuildReportRequest) String() string {
 func (*BuildReportRequest) ProtoMessage() {}
 
 func (x *BuildReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_median_proto_msgTypes[4]
+	mi := &file_median_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -300,7 +549,7 @@ func (x *BuildReportRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuildReportRequest.ProtoReflect.Descriptor instead.
 func (*BuildReportRequest) Descriptor() ([]byte, []int) {
-	return file_median_proto_rawDescGZIP(), []int{4}
+	return file_median_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *BuildReportRequest) GetObservations() []*ParsedAttributedObservation {
@@ -310,6 +559,13 @@ func (x *BuildReportRequest) GetObservations() []*ParsedAttributedObservation {
 	return nil
 }
 
+func (x *BuildReportRequest) GetExtended() bool {
+	if x != nil {
+		return x.Extended
+	}
+	return false
+}
+
 // BuildReportReply has return arguments for [github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median.ReportCodec.BuildReport].
 type BuildReportReply struct {
 	state         protoimpl.MessageState
@@ -322,7 +578,7 @@ type BuildReportReply struct {
 func (x *BuildReportReply) Reset() {
 	*x = BuildReportReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_median_proto_msgTypes[5]
+		mi := &file_median_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -335,7 +591,7 @@ func (x *BuildReportReply) String() string {
 func (*BuildReportReply) ProtoMessage() {}
 
 func (x *BuildReportReply) ProtoReflect() protoreflect.Message {
-	mi := &file_median_proto_msgTypes[5]
+	mi := &file_median_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -348,7 +604,7 @@ func (x *BuildReportReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuildReportReply.ProtoReflect.Descriptor instead.
 func (*BuildReportReply) Descriptor() ([]byte, []int) {
-	return file_median_proto_rawDescGZIP(), []int{5}
+	return file_median_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *BuildReportReply) GetReport() []byte {
@@ -370,7 +626,7 @@ type MedianFromReportRequest struct {
 func (x *MedianFromReportRequest) Reset() {
 	*x = MedianFromReportRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_median_proto_msgTypes[6]
+		mi := &file_median_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -383,7 +639,7 @@ func (x *MedianFromReportRequest) String() string {
 func (*MedianFromReportRequest) ProtoMessage() {}
 
 func (x *MedianFromReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_median_proto_msgTypes[6]
+	mi := &file_median_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -396,7 +652,7 @@ func (x *MedianFromReportRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MedianFromReportRequest.ProtoReflect.Descriptor instead.
 func (*MedianFromReportRequest) Descriptor() ([]byte, []int) {
-	return file_median_proto_rawDescGZIP(), []int{6}
+	return file_median_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *MedianFromReportRequest) GetReport() []byte {
@@ -418,7 +674,7 @@ type MedianFromReportReply struct {
 func (x *MedianFromReportReply) Reset() {
 	*x = MedianFromReportReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_median_proto_msgTypes[7]
+		mi := &file_median_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -431,7 +687,7 @@ func (x *MedianFromReportReply) String() string {
 func (*MedianFromReportReply) ProtoMessage() {}
 
 func (x *MedianFromReportReply) ProtoReflect() protoreflect.Message {
-	mi := &file_median_proto_msgTypes[7]
+	mi := &file_median_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -444,7 +700,7 @@ func (x *MedianFromReportReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MedianFromReportReply.ProtoReflect.Descriptor instead.
 func (*MedianFromReportReply) Descriptor() ([]byte, []int) {
-	return file_median_proto_rawDescGZIP(), []int{7}
+	return file_median_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *MedianFromReportReply) GetMedian() *BigInt {
@@ -466,7 +722,7 @@ type MaxReportLengthRequest struct {
 func (x *MaxReportLengthRequest) Reset() {
 	*x = MaxReportLengthRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_median_proto_msgTypes[8]
+		mi := &file_median_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -479,7 +735,7 @@ func (x *MaxReportLengthRequest) String() string {
 func (*MaxReportLengthRequest) ProtoMessage() {}
 
 func (x *MaxReportLengthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_median_proto_msgTypes[8]
+	mi := &file_median_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -492,7 +748,7 @@ func (x *MaxReportLengthRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MaxReportLengthRequest.ProtoReflect.Descriptor instead.
 func (*MaxReportLengthRequest) Descriptor() ([]byte, []int) {
-	return file_median_proto_rawDescGZIP(), []int{8}
+	return file_median_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *MaxReportLengthRequest) GetN() int64 {
@@ -514,7 +770,7 @@ type MaxReportLengthReply struct {
 func (x *MaxReportLengthReply) Reset() {
 	*x = MaxReportLengthReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_median_proto_msgTypes[9]
+		mi := &file_median_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -527,7 +783,7 @@ func (x *MaxReportLengthReply) String() string {
 func (*MaxReportLengthReply) ProtoMessage() {}
 
 func (x *MaxReportLengthReply) ProtoReflect() protoreflect.Message {
-	mi := &file_median_proto_msgTypes[9]
+	mi := &file_median_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -540,7 +796,7 @@ func (x *MaxReportLengthReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MaxReportLengthReply.ProtoReflect.Descriptor instead.
 func (*MaxReportLengthReply) Descriptor() ([]byte, []int) {
-	return file_median_proto_rawDescGZIP(), []int{9}
+	return file_median_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *MaxReportLengthReply) GetMax() int64 {
@@ -559,7 +815,7 @@ type LatestTransmissionDetailsRequest struct {
 func (x *LatestTransmissionDetailsRequest) Reset() {
 	*x = LatestTransmissionDetailsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_median_proto_msgTypes[10]
+		mi := &file_median_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -572,7 +828,7 @@ func (x *LatestTransmissionDetailsRequest) String() string {
 func (*LatestTransmissionDetailsRequest) ProtoMessage() {}
 
 func (x *LatestTransmissionDetailsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_median_proto_msgTypes[10]
+	mi := &file_median_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -585,7 +841,7 @@ func (x *LatestTransmissionDetailsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LatestTransmissionDetailsRequest.ProtoReflect.Descriptor instead.
 func (*LatestTransmissionDetailsRequest) Descriptor() ([]byte, []int) {
-	return file_median_proto_rawDescGZIP(), []int{10}
+	return file_median_proto_rawDescGZIP(), []int{13}
 }
 
 // LatestTransmissionDetailsReply has return arguments for [github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median.MedianContract.LatestTransmissionDetails].
@@ -604,7 +860,7 @@ type LatestTransmissionDetailsReply struct {
 func (x *LatestTransmissionDetailsReply) Reset() {
 	*x = LatestTransmissionDetailsReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_median_proto_msgTypes[11]
+		mi := &file_median_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -617,7 +873,7 @@ func (x *LatestTransmissionDetailsReply) String() string {
 func (*LatestTransmissionDetailsReply) ProtoMessage() {}
 
 func (x *LatestTransmissionDetailsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_median_proto_msgTypes[11]
+	mi := &file_median_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -630,7 +886,7 @@ func (x *LatestTransmissionDetailsReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LatestTransmissionDetailsReply.ProtoReflect.Descriptor instead.
 func (*LatestTransmissionDetailsReply) Descriptor() ([]byte, []int) {
-	return file_median_proto_rawDescGZIP(), []int{11}
+	return file_median_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *LatestTransmissionDetailsReply) GetConfigDigest() []byte {
@@ -680,7 +936,7 @@ type LatestRoundRequestedRequest struct {
 func (x *LatestRoundRequestedRequest) Reset() {
 	*x = LatestRoundRequestedRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_median_proto_msgTypes[12]
+		mi := &file_median_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -693,7 +949,7 @@ func (x *LatestRoundRequestedRequest) String() string {
 func (*LatestRoundRequestedRequest) ProtoMessage() {}
 
 func (x *LatestRoundRequestedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_median_proto_msgTypes[12]
+	mi := &file_median_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -706,7 +962,7 @@ func (x *LatestRoundRequestedRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LatestRoundRequestedRequest.ProtoReflect.Descriptor instead.
 func (*LatestRoundRequestedRequest) Descriptor() ([]byte, []int) {
-	return file_median_proto_rawDescGZIP(), []int{12}
+	return file_median_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *LatestRoundRequestedRequest) GetLookback() int64 {
@@ -730,7 +986,7 @@ type LatestRoundRequestedReply struct {
 func (x *LatestRoundRequestedReply) Reset() {
 	*x = LatestRoundRequestedReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_median_proto_msgTypes[13]
+		mi := &file_median_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -743,7 +999,7 @@ func (x *LatestRoundRequestedReply) String() string {
 func (*LatestRoundRequestedReply) ProtoMessage() {}
 
 func (x *LatestRoundRequestedReply) ProtoReflect() protoreflect.Message {
-	mi := &file_median_proto_msgTypes[13]
+	mi := &file_median_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -756,7 +1012,7 @@ func (x *LatestRoundRequestedReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LatestRoundRequestedReply.ProtoReflect.Descriptor instead.
 func (*LatestRoundRequestedReply) Descriptor() ([]byte, []int) {
-	return file_median_proto_rawDescGZIP(), []int{13}
+	return file_median_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *LatestRoundRequestedReply) GetConfigDigest() []byte {
@@ -793,7 +1049,7 @@ type OnchainConfig struct {
 func (x *OnchainConfig) Reset() {
 	*x = OnchainConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_median_proto_msgTypes[14]
+		mi := &file_median_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -806,7 +1062,7 @@ func (x *OnchainConfig) String() string {
 func (*OnchainConfig) ProtoMessage() {}
 
 func (x *OnchainConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_median_proto_msgTypes[14]
+	mi := &file_median_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -819,7 +1075,7 @@ func (x *OnchainConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OnchainConfig.ProtoReflect.Descriptor instead.
 func (*OnchainConfig) Descriptor() ([]byte, []int) {
-	return file_median_proto_rawDescGZIP(), []int{14}
+	return file_median_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *OnchainConfig) GetMin() *BigInt {
@@ -848,7 +1104,7 @@ type EncodeRequest struct {
 func (x *EncodeRequest) Reset() {
 	*x = EncodeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_median_proto_msgTypes[15]
+		mi := &file_median_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -861,7 +1117,7 @@ func (x *EncodeRequest) String() string {
 func (*EncodeRequest) ProtoMessage() {}
 
 func (x *EncodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_median_proto_msgTypes[15]
+	mi := &file_median_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -874,7 +1130,7 @@ func (x *EncodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EncodeRequest.ProtoReflect.Descriptor instead.
 func (*EncodeRequest) Descriptor() ([]byte, []int) {
-	return file_median_proto_rawDescGZIP(), []int{15}
+	return file_median_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *EncodeRequest) GetOnchainConfig() *OnchainConfig {
@@ -896,7 +1152,7 @@ type EncodeReply struct {
 func (x *EncodeReply) Reset() {
 	*x = EncodeReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_median_proto_msgTypes[16]
+		mi := &file_median_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -909,7 +1165,7 @@ func (x *EncodeReply) String() string {
 func (*EncodeReply) ProtoMessage() {}
 
 func (x *EncodeReply) ProtoReflect() protoreflect.Message {
-	mi := &file_median_proto_msgTypes[16]
+	mi := &file_median_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -922,7 +1178,7 @@ func (x *EncodeReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EncodeReply.ProtoReflect.Descriptor instead.
 func (*EncodeReply) Descriptor() ([]byte, []int) {
-	return file_median_proto_rawDescGZIP(), []int{16}
+	return file_median_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *EncodeReply) GetEncoded() []byte {
@@ -944,7 +1200,7 @@ type DecodeRequest struct {
 func (x *DecodeRequest) Reset() {
 	*x = DecodeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_median_proto_msgTypes[17]
+		mi := &file_median_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -957,7 +1213,7 @@ func (x *DecodeRequest) String() string {
 func (*DecodeRequest) ProtoMessage() {}
 
 func (x *DecodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_median_proto_msgTypes[17]
+	mi := &file_median_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -970,7 +1226,7 @@ func (x *DecodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DecodeRequest.ProtoReflect.Descriptor instead.
 func (*DecodeRequest) Descriptor() ([]byte, []int) {
-	return file_median_proto_rawDescGZIP(), []int{17}
+	return file_median_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *DecodeRequest) GetEncoded() []byte {
@@ -992,7 +1248,7 @@ type DecodeReply struct {
 func (x *DecodeReply) Reset() {
 	*x = DecodeReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_median_proto_msgTypes[18]
+		mi := &file_median_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1005,7 +1261,7 @@ func (x *DecodeReply) String() string {
 func (*DecodeReply) ProtoMessage() {}
 
 func (x *DecodeReply) ProtoReflect() protoreflect.Message {
-	mi := &file_median_proto_msgTypes[18]
+	mi := &file_median_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1018,7 +1274,7 @@ func (x *DecodeReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DecodeReply.ProtoReflect.Descriptor instead.
 func (*DecodeReply) Descriptor() ([]byte, []int) {
-	return file_median_proto_rawDescGZIP(), []int{18}
+	return file_median_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *DecodeReply) GetOnchainConfig() *OnchainConfig {
@@ -1037,7 +1293,7 @@ var file_median_proto_rawDesc = []byte{
 	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
 	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0xcb, 0x01, 0x0a, 0x17, 0x4e, 0x65, 0x77, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x46,
+	0x6f, 0x22, 0xdc, 0x05, 0x0a, 0x17, 0x4e, 0x65, 0x77, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x46,
 	0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a,
 	0x10, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49,
 	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x50,
@@ -1049,121 +1305,194 @@ var file_median_proto_rawDesc = []byte{
 	0x28, 0x0d, 0x52, 0x1b, 0x6a, 0x75, 0x65, 0x6c, 0x73, 0x50, 0x65, 0x72, 0x46, 0x65, 0x65, 0x43,
 	0x6f, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x12,
 	0x1e, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x49, 0x44, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x49, 0x44, 0x22,
-	0x53, 0x0a, 0x15, 0x4e, 0x65, 0x77, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x46, 0x61, 0x63, 0x74,
-	0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3a, 0x0a, 0x18, 0x72, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x46, 0x61, 0x63, 0x74, 0x6f,
-	0x72, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x18, 0x72, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x46, 0x61, 0x63, 0x74, 0x6f,
-	0x72, 0x79, 0x49, 0x44, 0x22, 0x2c, 0x0a, 0x10, 0x53, 0x61, 0x76, 0x65, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x22, 0xb3, 0x01, 0x0a, 0x1b, 0x50, 0x61, 0x72, 0x73, 0x65, 0x64, 0x41, 0x74, 0x74,
-	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x12, 0x22, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0c, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x2e, 0x42, 0x69, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x12, 0x36, 0x0a, 0x0f, 0x6a, 0x75, 0x6c, 0x65, 0x73, 0x50, 0x65, 0x72,
-	0x46, 0x65, 0x65, 0x43, 0x6f, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
-	0x6c, 0x6f, 0x6f, 0x70, 0x2e, 0x42, 0x69, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x0f, 0x6a, 0x75, 0x6c,
-	0x65, 0x73, 0x50, 0x65, 0x72, 0x46, 0x65, 0x65, 0x43, 0x6f, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
-	0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
-	0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x22, 0x5b, 0x0a, 0x12, 0x42, 0x75, 0x69, 0x6c,
-	0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45,
-	0x0a, 0x0c, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x2e, 0x50, 0x61, 0x72, 0x73,
-	0x65, 0x64, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x4f, 0x62, 0x73, 0x65,
-	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x2a, 0x0a, 0x10, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65,
-	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x22, 0x31, 0x0a, 0x17, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x52,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x65,
-	0x70, 0x6f, 0x72, 0x74, 0x22, 0x3d, 0x0a, 0x15, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x46, 0x72,
-	0x6f, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x24, 0x0a,
-	0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
-	0x6c, 0x6f, 0x6f, 0x70, 0x2e, 0x42, 0x69, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x06, 0x6d, 0x65, 0x64,
-	0x69, 0x61, 0x6e, 0x22, 0x26, 0x0a, 0x16, 0x4d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
-	0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0c, 0x0a,
-	0x01, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x01, 0x6e, 0x22, 0x28, 0x0a, 0x14, 0x4d,
-	0x61, 0x78, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x03, 0x6d, 0x61, 0x78, 0x22, 0x22, 0x0a, 0x20, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xe8, 0x01, 0x0a, 0x1e, 0x4c, 0x61,
-	0x74, 0x65, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x22, 0x0a, 0x0c,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x30, 0x0a, 0x0c,
-	0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x2e, 0x42, 0x69, 0x67, 0x49, 0x6e, 0x74,
-	0x52, 0x0c, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x44,
-	0x0a, 0x0f, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x0f, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x22, 0x39, 0x0a, 0x1b, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x52, 0x6f,
-	0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x6f, 0x6b, 0x62, 0x61, 0x63, 0x6b, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x6f, 0x6f, 0x6b, 0x62, 0x61, 0x63, 0x6b, 0x22,
-	0x6b, 0x0a, 0x19, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x22, 0x0a, 0x0c,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x4f, 0x0a, 0x0d,
-	0x4f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1e, 0x0a,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x49, 0x44, 0x12,
+	0x51, 0x0a, 0x15, 0x6a, 0x75, 0x65, 0x6c, 0x73, 0x50, 0x65, 0x72, 0x46, 0x65, 0x65, 0x43, 0x6f,
+	0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x2e, 0x4a, 0x75, 0x65, 0x6c, 0x73, 0x50, 0x65, 0x72, 0x46, 0x65,
+	0x65, 0x43, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x15, 0x6a, 0x75, 0x65,
+	0x6c, 0x73, 0x50, 0x65, 0x72, 0x46, 0x65, 0x65, 0x43, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x43, 0x0a, 0x10, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c,
+	0x6f, 0x6f, 0x70, 0x2e, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x6f, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x10, 0x64, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x61, 0x0a, 0x1f, 0x6a, 0x75, 0x65, 0x6c, 0x73,
+	0x50, 0x65, 0x72, 0x46, 0x65, 0x65, 0x43, 0x6f, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x2e, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x1f, 0x6a, 0x75, 0x65, 0x6c, 0x73,
+	0x50, 0x65, 0x72, 0x46, 0x65, 0x65, 0x43, 0x6f, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x53, 0x0a, 0x0c, 0x66, 0x65,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2f, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x2e, 0x4e, 0x65, 0x77, 0x4d, 0x65, 0x64, 0x69, 0x61,
+	0x6e, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0c, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12,
+	0x2c, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65,
+	0x50, 0x61, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x43, 0x61, 0x63, 0x68, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x28, 0x0a,
+	0x0f, 0x6b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x44,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70,
+	0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x1a,
+	0x3f, 0x0a, 0x11, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x85, 0x01, 0x0a, 0x15, 0x4a, 0x75, 0x65, 0x6c, 0x73, 0x50, 0x65, 0x72, 0x46, 0x65, 0x65,
+	0x43, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x2c, 0x0a, 0x0a, 0x66, 0x69, 0x78, 0x65, 0x64, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6c, 0x6f, 0x6f,
+	0x70, 0x2e, 0x42, 0x69, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x0a, 0x66, 0x69, 0x78, 0x65, 0x64, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x6c, 0x65,
+	0x6e, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x53,
+	0x74, 0x61, 0x6c, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x22, 0x53, 0x0a, 0x11, 0x4f, 0x62, 0x73, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x1e, 0x0a,
 	0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6c, 0x6f, 0x6f,
 	0x70, 0x2e, 0x42, 0x69, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x1e, 0x0a,
 	0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6c, 0x6f, 0x6f,
-	0x70, 0x2e, 0x42, 0x69, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x22, 0x4a, 0x0a,
-	0x0d, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39,
-	0x0a, 0x0d, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x2e, 0x4f, 0x6e, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0d, 0x6f, 0x6e, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x27, 0x0a, 0x0b, 0x45, 0x6e, 0x63,
-	0x6f, 0x64, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x63, 0x6f,
-	0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64,
-	0x65, 0x64, 0x22, 0x29, 0x0a, 0x0d, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x22, 0x48, 0x0a,
-	0x0b, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x39, 0x0a, 0x0d,
-	0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x2e, 0x4f, 0x6e, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0d, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x32, 0x60, 0x0a, 0x0c, 0x50, 0x6c, 0x75, 0x67, 0x69,
-	0x6e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x12, 0x50, 0x0a, 0x10, 0x4e, 0x65, 0x77, 0x4d, 0x65,
-	0x64, 0x69, 0x61, 0x6e, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1d, 0x2e, 0x6c, 0x6f,
-	0x6f, 0x70, 0x2e, 0x4e, 0x65, 0x77, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x46, 0x61, 0x63, 0x74,
-	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6c, 0x6f, 0x6f,
-	0x70, 0x2e, 0x4e, 0x65, 0x77, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x46, 0x61, 0x63, 0x74, 0x6f,
-	0x72, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x32, 0x49, 0x0a, 0x08, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x12, 0x3d, 0x0a, 0x09, 0x53, 0x61, 0x76, 0x65, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x12, 0x16, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x00, 0x32, 0xf1, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43,
-	0x6f, 0x64, 0x65, 0x63, 0x12, 0x41, 0x0a, 0x0b, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x12, 0x18, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64,
-	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x6c, 0x6f, 0x6f, 0x70, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x10, 0x4d, 0x65, 0x64, 0x69, 0x61,
-	0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1d, 0x2e, 0x6c, 0x6f,
-	0x6f, 0x70, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6c, 0x6f, 0x6f,
+	0x70, 0x2e, 0x42, 0x69, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x22, 0x53, 0x0a,
+	0x15, 0x4e, 0x65, 0x77, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3a, 0x0a, 0x18, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x69, 0x6e, 0x67, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x18, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x69, 0x6e, 0x67, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x49, 0x44, 0x22, 0x3e, 0x0a, 0x10, 0x53, 0x61, 0x76, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x22, 0x27, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0xf7, 0x02, 0x0a, 0x1b,
+	0x50, 0x61, 0x72, 0x73, 0x65, 0x64, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64,
+	0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x22, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x2e,
+	0x42, 0x69, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x36, 0x0a,
+	0x0f, 0x6a, 0x75, 0x6c, 0x65, 0x73, 0x50, 0x65, 0x72, 0x46, 0x65, 0x65, 0x43, 0x6f, 0x69, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x2e, 0x42, 0x69,
+	0x67, 0x49, 0x6e, 0x74, 0x52, 0x0f, 0x6a, 0x75, 0x6c, 0x65, 0x73, 0x50, 0x65, 0x72, 0x46, 0x65,
+	0x65, 0x43, 0x6f, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x12, 0x38, 0x0a, 0x10, 0x67, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x75, 0x62,
+	0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6c, 0x6f,
+	0x6f, 0x70, 0x2e, 0x42, 0x69, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x10, 0x67, 0x61, 0x73, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x53, 0x75, 0x62, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x4b, 0x0a, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e,
+	0x6c, 0x6f, 0x6f, 0x70, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x64, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x64, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x77, 0x0a, 0x12, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x0c, 0x6f,
+	0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x64, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x22, 0x2a,
+	0x0a, 0x10, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x31, 0x0a, 0x17, 0x4d, 0x65,
+	0x64, 0x69, 0x61, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x3d, 0x0a,
+	0x15, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x24, 0x0a, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x2e, 0x42, 0x69,
+	0x67, 0x49, 0x6e, 0x74, 0x52, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x22, 0x26, 0x0a, 0x16,
+	0x4d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x01, 0x6e, 0x22, 0x28, 0x0a, 0x14, 0x4d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6d, 0x61, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x22, 0x22,
+	0x0a, 0x20, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0xe8, 0x01, 0x0a, 0x1e, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44,
+	0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12,
+	0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x30, 0x0a, 0x0c, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x41,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6c, 0x6f,
+	0x6f, 0x70, 0x2e, 0x42, 0x69, 0x67, 0x49, 0x6e, 0x74, 0x52, 0x0c, 0x6c, 0x61, 0x74, 0x65, 0x73,
+	0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x0f, 0x6c, 0x61, 0x74, 0x65, 0x73,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x39, 0x0a,
+	0x1b, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x6c, 0x6f, 0x6f, 0x6b, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x6c, 0x6f, 0x6f, 0x6b, 0x62, 0x61, 0x63, 0x6b, 0x22, 0x6b, 0x0a, 0x19, 0x4c, 0x61, 0x74, 0x65,
+	0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44,
+	0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12,
+	0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x4f, 0x0a, 0x0d, 0x4f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1e, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x2e, 0x42, 0x69, 0x67, 0x49, 0x6e,
+	0x74, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x1e, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x2e, 0x42, 0x69, 0x67, 0x49, 0x6e,
+	0x74, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x22, 0x4a, 0x0a, 0x0d, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x0d, 0x6f, 0x6e, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x2e, 0x4f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x0d, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x22, 0x27, 0x0a, 0x0b, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x07, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x22, 0x29, 0x0a, 0x0d, 0x44,
+	0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x65,
+	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x22, 0x48, 0x0a, 0x0b, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x39, 0x0a, 0x0d, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c,
+	0x6f, 0x6f, 0x70, 0x2e, 0x4f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x0d, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x32, 0x60, 0x0a, 0x0c, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x6e,
+	0x12, 0x50, 0x0a, 0x10, 0x4e, 0x65, 0x77, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x46, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x12, 0x1d, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x2e, 0x4e, 0x65, 0x77, 0x4d,
+	0x65, 0x64, 0x69, 0x61, 0x6e, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x2e, 0x4e, 0x65, 0x77, 0x4d, 0x65,
+	0x64, 0x69, 0x61, 0x6e, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x32, 0x8e, 0x01, 0x0a, 0x08, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x12,
+	0x3d, 0x0a, 0x09, 0x53, 0x61, 0x76, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x2e, 0x6c,
+	0x6f, 0x6f, 0x70, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x43,
+	0x0a, 0x0c, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x19,
+	0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x32, 0xb2, 0x02, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f,
+	0x64, 0x65, 0x63, 0x12, 0x41, 0x0a, 0x0b, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x18, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6c,
+	0x6f, 0x6f, 0x70, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x10, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x6e,
+	0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1d, 0x2e, 0x6c, 0x6f, 0x6f,
 	0x70, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0f, 0x4d, 0x61, 0x78,
-	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x1c, 0x2e, 0x6c,
-	0x6f, 0x6f, 0x70, 0x2e, 0x4d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x65, 0x6e,
-	0x67, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6c, 0x6f, 0x6f,
-	0x70, 0x2e, 0x4d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74,
-	0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x32, 0xdb, 0x01, 0x0a, 0x0e, 0x4d, 0x65, 0x64,
+	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6c, 0x6f, 0x6f, 0x70,
+	0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0f, 0x4d, 0x61, 0x78, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x1c, 0x2e, 0x6c, 0x6f,
+	0x6f, 0x70, 0x2e, 0x4d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6c, 0x6f, 0x6f, 0x70,
+	0x2e, 0x4d, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x12, 0x42, 0x75, 0x69, 0x6c, 0x64,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x65, 0x64, 0x12, 0x18, 0x2e,
+	0x6c, 0x6f, 0x6f, 0x70, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x2e, 0x43,
+	0x68, 0x75, 0x6e, 0x6b, 0x22, 0x00, 0x30, 0x01, 0x32, 0xdb, 0x01, 0x0a, 0x0e, 0x4d, 0x65, 0x64,
 	0x69, 0x61, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x6b, 0x0a, 0x19, 0x4c,
 	0x61, 0x74, 0x65, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
 	0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x26, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x2e,
@@ -1185,11 +1514,11 @@ var file_median_proto_rawDesc = []byte{
 	0x12, 0x32, 0x0a, 0x06, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x13, 0x2e, 0x6c, 0x6f, 0x6f,
 	0x70, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
 	0x11, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x00, 0x42, 0x42, 0x5a, 0x40, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6c, 0x79, 0x22, 0x00, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
 	0x6f, 0x6d, 0x2f, 0x73, 0x6d, 0x61, 0x72, 0x74, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
 	0x6b, 0x69, 0x74, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x2d, 0x72, 0x65,
-	0x6c, 0x61, 0x79, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6c, 0x6f, 0x6f, 0x70, 0x2f, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6c, 0x61, 0x79, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6c, 0x6f, 0x6f, 0x70, 0x2f, 0x70, 0x62, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1204,65 +1533,84 @@ func file_median_proto_rawDescGZIP() []byte {
 	return file_median_proto_rawDescData
 }
 
-var file_median_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_median_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_median_proto_goTypes = []interface{}{
 	(*NewMedianFactoryRequest)(nil),          // 0: loop.NewMedianFactoryRequest
-	(*NewMedianFactoryReply)(nil),            // 1: loop.NewMedianFactoryReply
-	(*SaveErrorRequest)(nil),                 // 2: loop.SaveErrorRequest
-	(*ParsedAttributedObservation)(nil),      // 3: loop.ParsedAttributedObservation
-	(*BuildReportRequest)(nil),               // 4: loop.BuildReportRequest
-	(*BuildReportReply)(nil),                 // 5: loop.BuildReportReply
-	(*MedianFromReportRequest)(nil),          // 6: loop.MedianFromReportRequest
-	(*MedianFromReportReply)(nil),            // 7: loop.MedianFromReportReply
-	(*MaxReportLengthRequest)(nil),           // 8: loop.MaxReportLengthRequest
-	(*MaxReportLengthReply)(nil),             // 9: loop.MaxReportLengthReply
-	(*LatestTransmissionDetailsRequest)(nil), // 10: loop.LatestTransmissionDetailsRequest
-	(*LatestTransmissionDetailsReply)(nil),   // 11: loop.LatestTransmissionDetailsReply
-	(*LatestRoundRequestedRequest)(nil),      // 12: loop.LatestRoundRequestedRequest
-	(*LatestRoundRequestedReply)(nil),        // 13: loop.LatestRoundRequestedReply
-	(*OnchainConfig)(nil),                    // 14: loop.OnchainConfig
-	(*EncodeRequest)(nil),                    // 15: loop.EncodeRequest
-	(*EncodeReply)(nil),                      // 16: loop.EncodeReply
-	(*DecodeRequest)(nil),                    // 17: loop.DecodeRequest
-	(*DecodeReply)(nil),                      // 18: loop.DecodeReply
-	(*BigInt)(nil),                           // 19: loop.BigInt
-	(*timestamppb.Timestamp)(nil),            // 20: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                    // 21: google.protobuf.Empty
+	(*JuelsPerFeeCoinConfig)(nil),            // 1: loop.JuelsPerFeeCoinConfig
+	(*ObservationBounds)(nil),                // 2: loop.ObservationBounds
+	(*NewMedianFactoryReply)(nil),            // 3: loop.NewMedianFactoryReply
+	(*SaveErrorRequest)(nil),                 // 4: loop.SaveErrorRequest
+	(*ResolveErrorRequest)(nil),              // 5: loop.ResolveErrorRequest
+	(*ParsedAttributedObservation)(nil),      // 6: loop.ParsedAttributedObservation
+	(*BuildReportRequest)(nil),               // 7: loop.BuildReportRequest
+	(*BuildReportReply)(nil),                 // 8: loop.BuildReportReply
+	(*MedianFromReportRequest)(nil),          // 9: loop.MedianFromReportRequest
+	(*MedianFromReportReply)(nil),            // 10: loop.MedianFromReportReply
+	(*MaxReportLengthRequest)(nil),           // 11: loop.MaxReportLengthRequest
+	(*MaxReportLengthReply)(nil),             // 12: loop.MaxReportLengthReply
+	(*LatestTransmissionDetailsRequest)(nil), // 13: loop.LatestTransmissionDetailsRequest
+	(*LatestTransmissionDetailsReply)(nil),   // 14: loop.LatestTransmissionDetailsReply
+	(*LatestRoundRequestedRequest)(nil),      // 15: loop.LatestRoundRequestedRequest
+	(*LatestRoundRequestedReply)(nil),        // 16: loop.LatestRoundRequestedReply
+	(*OnchainConfig)(nil),                    // 17: loop.OnchainConfig
+	(*EncodeRequest)(nil),                    // 18: loop.EncodeRequest
+	(*EncodeReply)(nil),                      // 19: loop.EncodeReply
+	(*DecodeRequest)(nil),                    // 20: loop.DecodeRequest
+	(*DecodeReply)(nil),                      // 21: loop.DecodeReply
+	nil,                                      // 22: loop.NewMedianFactoryRequest.FeatureFlagsEntry
+	nil,                                      // 23: loop.ParsedAttributedObservation.MetadataEntry
+	(*BigInt)(nil),                           // 24: loop.BigInt
+	(*timestamppb.Timestamp)(nil),            // 25: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                    // 26: google.protobuf.Empty
+	(*Chunk)(nil),                            // 27: loop.Chunk
 }
 var file_median_proto_depIdxs = []int32{
-	19, // 0: loop.ParsedAttributedObservation.value:type_name -> loop.BigInt
-	19, // 1: loop.ParsedAttributedObservation.julesPerFeeCoin:type_name -> loop.BigInt
-	3,  // 2: loop.BuildReportRequest.observations:type_name -> loop.ParsedAttributedObservation
-	19, // 3: loop.MedianFromReportReply.median:type_name -> loop.BigInt
-	19, // 4: loop.LatestTransmissionDetailsReply.latestAnswer:type_name -> loop.BigInt
-	20, // 5: loop.LatestTransmissionDetailsReply.latestTimestamp:type_name -> google.protobuf.Timestamp
-	19, // 6: loop.OnchainConfig.min:type_name -> loop.BigInt
-	19, // 7: loop.OnchainConfig.max:type_name -> loop.BigInt
-	14, // 8: loop.EncodeRequest.onchainConfig:type_name -> loop.OnchainConfig
-	14, // 9: loop.DecodeReply.onchainConfig:type_name -> loop.OnchainConfig
-	0,  // 10: loop.PluginMedian.NewMedianFactory:input_type -> loop.NewMedianFactoryRequest
-	2,  // 11: loop.ErrorLog.SaveError:input_type -> loop.SaveErrorRequest
-	4,  // 12: loop.ReportCodec.BuildReport:input_type -> loop.BuildReportRequest
-	6,  // 13: loop.ReportCodec.MedianFromReport:input_type -> loop.MedianFromReportRequest
-	8,  // 14: loop.ReportCodec.MaxReportLength:input_type -> loop.MaxReportLengthRequest
-	10, // 15: loop.MedianContract.LatestTransmissionDetails:input_type -> loop.LatestTransmissionDetailsRequest
-	12, // 16: loop.MedianContract.LatestRoundRequested:input_type -> loop.LatestRoundRequestedRequest
-	15, // 17: loop.OnchainConfigCodec.Encode:input_type -> loop.EncodeRequest
-	17, // 18: loop.OnchainConfigCodec.Decode:input_type -> loop.DecodeRequest
-	1,  // 19: loop.PluginMedian.NewMedianFactory:output_type -> loop.NewMedianFactoryReply
-	21, // 20: loop.ErrorLog.SaveError:output_type -> google.protobuf.Empty
-	5,  // 21: loop.ReportCodec.BuildReport:output_type -> loop.BuildReportReply
-	7,  // 22: loop.ReportCodec.MedianFromReport:output_type -> loop.MedianFromReportReply
-	9,  // 23: loop.ReportCodec.MaxReportLength:output_type -> loop.MaxReportLengthReply
-	11, // 24: loop.MedianContract.LatestTransmissionDetails:output_type -> loop.LatestTransmissionDetailsReply
-	13, // 25: loop.MedianContract.LatestRoundRequested:output_type -> loop.LatestRoundRequestedReply
-	16, // 26: loop.OnchainConfigCodec.Encode:output_type -> loop.EncodeReply
-	18, // 27: loop.OnchainConfigCodec.Decode:output_type -> loop.DecodeReply
-	19, // [19:28] is the sub-list for method output_type
-	10, // [10:19] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	1,  // 0: loop.NewMedianFactoryRequest.juelsPerFeeCoinConfig:type_name -> loop.JuelsPerFeeCoinConfig
+	2,  // 1: loop.NewMedianFactoryRequest.dataSourceBounds:type_name -> loop.ObservationBounds
+	2,  // 2: loop.NewMedianFactoryRequest.juelsPerFeeCoinDataSourceBounds:type_name -> loop.ObservationBounds
+	22, // 3: loop.NewMedianFactoryRequest.featureFlags:type_name -> loop.NewMedianFactoryRequest.FeatureFlagsEntry
+	24, // 4: loop.JuelsPerFeeCoinConfig.fixedValue:type_name -> loop.BigInt
+	24, // 5: loop.ObservationBounds.min:type_name -> loop.BigInt
+	24, // 6: loop.ObservationBounds.max:type_name -> loop.BigInt
+	24, // 7: loop.ParsedAttributedObservation.value:type_name -> loop.BigInt
+	24, // 8: loop.ParsedAttributedObservation.julesPerFeeCoin:type_name -> loop.BigInt
+	24, // 9: loop.ParsedAttributedObservation.gasPriceSubunits:type_name -> loop.BigInt
+	23, // 10: loop.ParsedAttributedObservation.metadata:type_name -> loop.ParsedAttributedObservation.MetadataEntry
+	6,  // 11: loop.BuildReportRequest.observations:type_name -> loop.ParsedAttributedObservation
+	24, // 12: loop.MedianFromReportReply.median:type_name -> loop.BigInt
+	24, // 13: loop.LatestTransmissionDetailsReply.latestAnswer:type_name -> loop.BigInt
+	25, // 14: loop.LatestTransmissionDetailsReply.latestTimestamp:type_name -> google.protobuf.Timestamp
+	24, // 15: loop.OnchainConfig.min:type_name -> loop.BigInt
+	24, // 16: loop.OnchainConfig.max:type_name -> loop.BigInt
+	17, // 17: loop.EncodeRequest.onchainConfig:type_name -> loop.OnchainConfig
+	17, // 18: loop.DecodeReply.onchainConfig:type_name -> loop.OnchainConfig
+	0,  // 19: loop.PluginMedian.NewMedianFactory:input_type -> loop.NewMedianFactoryRequest
+	4,  // 20: loop.ErrorLog.SaveError:input_type -> loop.SaveErrorRequest
+	5,  // 21: loop.ErrorLog.ResolveError:input_type -> loop.ResolveErrorRequest
+	7,  // 22: loop.ReportCodec.BuildReport:input_type -> loop.BuildReportRequest
+	9,  // 23: loop.ReportCodec.MedianFromReport:input_type -> loop.MedianFromReportRequest
+	11, // 24: loop.ReportCodec.MaxReportLength:input_type -> loop.MaxReportLengthRequest
+	7,  // 25: loop.ReportCodec.BuildReportChunked:input_type -> loop.BuildReportRequest
+	13, // 26: loop.MedianContract.LatestTransmissionDetails:input_type -> loop.LatestTransmissionDetailsRequest
+	15, // 27: loop.MedianContract.LatestRoundRequested:input_type -> loop.LatestRoundRequestedRequest
+	18, // 28: loop.OnchainConfigCodec.Encode:input_type -> loop.EncodeRequest
+	20, // 29: loop.OnchainConfigCodec.Decode:input_type -> loop.DecodeRequest
+	3,  // 30: loop.PluginMedian.NewMedianFactory:output_type -> loop.NewMedianFactoryReply
+	26, // 31: loop.ErrorLog.SaveError:output_type -> google.protobuf.Empty
+	26, // 32: loop.ErrorLog.ResolveError:output_type -> google.protobuf.Empty
+	8,  // 33: loop.ReportCodec.BuildReport:output_type -> loop.BuildReportReply
+	10, // 34: loop.ReportCodec.MedianFromReport:output_type -> loop.MedianFromReportReply
+	12, // 35: loop.ReportCodec.MaxReportLength:output_type -> loop.MaxReportLengthReply
+	27, // 36: loop.ReportCodec.BuildReportChunked:output_type -> loop.Chunk
+	14, // 37: loop.MedianContract.LatestTransmissionDetails:output_type -> loop.LatestTransmissionDetailsReply
+	16, // 38: loop.MedianContract.LatestRoundRequested:output_type -> loop.LatestRoundRequestedReply
+	19, // 39: loop.OnchainConfigCodec.Encode:output_type -> loop.EncodeReply
+	21, // 40: loop.OnchainConfigCodec.Decode:output_type -> loop.DecodeReply
+	30, // [30:41] is the sub-list for method output_type
+	19, // [19:30] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_median_proto_init() }
@@ -1285,7 +1633,7 @@ func file_median_proto_init() {
 			}
 		}
 		file_median_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewMedianFactoryReply); i {
+			switch v := v.(*JuelsPerFeeCoinConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1297,7 +1645,7 @@ func file_median_proto_init() {
 			}
 		}
 		file_median_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SaveErrorRequest); i {
+			switch v := v.(*ObservationBounds); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1309,7 +1657,7 @@ func file_median_proto_init() {
 			}
 		}
 		file_median_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ParsedAttributedObservation); i {
+			switch v := v.(*NewMedianFactoryReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1321,7 +1669,7 @@ func file_median_proto_init() {
 			}
 		}
 		file_median_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BuildReportRequest); i {
+			switch v := v.(*SaveErrorRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1333,7 +1681,7 @@ func file_median_proto_init() {
 			}
 		}
 		file_median_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BuildReportReply); i {
+			switch v := v.(*ResolveErrorRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1345,7 +1693,7 @@ func file_median_proto_init() {
 			}
 		}
 		file_median_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MedianFromReportRequest); i {
+			switch v := v.(*ParsedAttributedObservation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1357,7 +1705,7 @@ func file_median_proto_init() {
 			}
 		}
 		file_median_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MedianFromReportReply); i {
+			switch v := v.(*BuildReportRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1369,7 +1717,7 @@ func file_median_proto_init() {
 			}
 		}
 		file_median_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MaxReportLengthRequest); i {
+			switch v := v.(*BuildReportReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1381,7 +1729,7 @@ func file_median_proto_init() {
 			}
 		}
 		file_median_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MaxReportLengthReply); i {
+			switch v := v.(*MedianFromReportRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1393,7 +1741,7 @@ func file_median_proto_init() {
 			}
 		}
 		file_median_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LatestTransmissionDetailsRequest); i {
+			switch v := v.(*MedianFromReportReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1405,7 +1753,7 @@ func file_median_proto_init() {
 			}
 		}
 		file_median_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LatestTransmissionDetailsReply); i {
+			switch v := v.(*MaxReportLengthRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1417,7 +1765,7 @@ func file_median_proto_init() {
 			}
 		}
 		file_median_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LatestRoundRequestedRequest); i {
+			switch v := v.(*MaxReportLengthReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1429,7 +1777,7 @@ func file_median_proto_init() {
 			}
 		}
 		file_median_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LatestRoundRequestedReply); i {
+			switch v := v.(*LatestTransmissionDetailsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1441,7 +1789,7 @@ func file_median_proto_init() {
 			}
 		}
 		file_median_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OnchainConfig); i {
+			switch v := v.(*LatestTransmissionDetailsReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1453,7 +1801,7 @@ func file_median_proto_init() {
 			}
 		}
 		file_median_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EncodeRequest); i {
+			switch v := v.(*LatestRoundRequestedRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1465,7 +1813,7 @@ func file_median_proto_init() {
 			}
 		}
 		file_median_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EncodeReply); i {
+			switch v := v.(*LatestRoundRequestedReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1477,7 +1825,7 @@ func file_median_proto_init() {
 			}
 		}
 		file_median_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DecodeRequest); i {
+			switch v := v.(*OnchainConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1489,6 +1837,42 @@ func file_median_proto_init() {
 			}
 		}
 		file_median_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_median_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncodeReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_median_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_median_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DecodeReply); i {
 			case 0:
 				return &v.state
@@ -1507,7 +1891,7 @@ func file_median_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_median_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   19,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   5,
 		},
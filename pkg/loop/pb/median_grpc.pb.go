@@ -110,7 +110,8 @@ var PluginMedian_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	ErrorLog_SaveError_FullMethodName = "/loop.ErrorLog/SaveError"
+	ErrorLog_SaveError_FullMethodName    = "/loop.ErrorLog/SaveError"
+	ErrorLog_ResolveError_FullMethodName = "/loop.ErrorLog/ResolveError"
 )
 
 // ErrorLogClient is the client API for ErrorLog service.
@@ -118,6 +119,7 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ErrorLogClient interface {
 	SaveError(ctx context.Context, in *SaveErrorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ResolveError(ctx context.Context, in *ResolveErrorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type errorLogClient struct {
@@ -137,11 +139,21 @@ func (c *errorLogClient) SaveError(ctx context.Context, in *SaveErrorRequest, op
 	return out, nil
 }
 
+func (c *errorLogClient) ResolveError(ctx context.Context, in *ResolveErrorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ErrorLog_ResolveError_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ErrorLogServer is the server API for ErrorLog service.
 // All implementations must embed UnimplementedErrorLogServer
 // for forward compatibility
 type ErrorLogServer interface {
 	SaveError(context.Context, *SaveErrorRequest) (*emptypb.Empty, error)
+	ResolveError(context.Context, *ResolveErrorRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedErrorLogServer()
 }
 
@@ -152,6 +164,9 @@ type UnimplementedErrorLogServer struct {
 func (UnimplementedErrorLogServer) SaveError(context.Context, *SaveErrorRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SaveError not implemented")
 }
+func (UnimplementedErrorLogServer) ResolveError(context.Context, *ResolveErrorRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveError not implemented")
+}
 func (UnimplementedErrorLogServer) mustEmbedUnimplementedErrorLogServer() {}
 
 // UnsafeErrorLogServer may be embedded to opt out of forward compatibility for this service.
@@ -183,6 +198,24 @@ func _ErrorLog_SaveError_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ErrorLog_ResolveError_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveErrorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ErrorLogServer).ResolveError(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ErrorLog_ResolveError_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ErrorLogServer).ResolveError(ctx, req.(*ResolveErrorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ErrorLog_ServiceDesc is the grpc.ServiceDesc for ErrorLog service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -194,15 +227,20 @@ var ErrorLog_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SaveError",
 			Handler:    _ErrorLog_SaveError_Handler,
 		},
+		{
+			MethodName: "ResolveError",
+			Handler:    _ErrorLog_ResolveError_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "median.proto",
 }
 
 const (
-	ReportCodec_BuildReport_FullMethodName      = "/loop.ReportCodec/BuildReport"
-	ReportCodec_MedianFromReport_FullMethodName = "/loop.ReportCodec/MedianFromReport"
-	ReportCodec_MaxReportLength_FullMethodName  = "/loop.ReportCodec/MaxReportLength"
+	ReportCodec_BuildReport_FullMethodName        = "/loop.ReportCodec/BuildReport"
+	ReportCodec_MedianFromReport_FullMethodName   = "/loop.ReportCodec/MedianFromReport"
+	ReportCodec_MaxReportLength_FullMethodName    = "/loop.ReportCodec/MaxReportLength"
+	ReportCodec_BuildReportChunked_FullMethodName = "/loop.ReportCodec/BuildReportChunked"
 )
 
 // ReportCodecClient is the client API for ReportCodec service.
@@ -212,6 +250,7 @@ type ReportCodecClient interface {
 	BuildReport(ctx context.Context, in *BuildReportRequest, opts ...grpc.CallOption) (*BuildReportReply, error)
 	MedianFromReport(ctx context.Context, in *MedianFromReportRequest, opts ...grpc.CallOption) (*MedianFromReportReply, error)
 	MaxReportLength(ctx context.Context, in *MaxReportLengthRequest, opts ...grpc.CallOption) (*MaxReportLengthReply, error)
+	BuildReportChunked(ctx context.Context, in *BuildReportRequest, opts ...grpc.CallOption) (ReportCodec_BuildReportChunkedClient, error)
 }
 
 type reportCodecClient struct {
@@ -249,6 +288,38 @@ func (c *reportCodecClient) MaxReportLength(ctx context.Context, in *MaxReportLe
 	return out, nil
 }
 
+func (c *reportCodecClient) BuildReportChunked(ctx context.Context, in *BuildReportRequest, opts ...grpc.CallOption) (ReportCodec_BuildReportChunkedClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ReportCodec_ServiceDesc.Streams[0], ReportCodec_BuildReportChunked_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &reportCodecBuildReportChunkedClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ReportCodec_BuildReportChunkedClient interface {
+	Recv() (*Chunk, error)
+	grpc.ClientStream
+}
+
+type reportCodecBuildReportChunkedClient struct {
+	grpc.ClientStream
+}
+
+func (x *reportCodecBuildReportChunkedClient) Recv() (*Chunk, error) {
+	m := new(Chunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ReportCodecServer is the server API for ReportCodec service.
 // All implementations must embed UnimplementedReportCodecServer
 // for forward compatibility
@@ -256,6 +327,7 @@ type ReportCodecServer interface {
 	BuildReport(context.Context, *BuildReportRequest) (*BuildReportReply, error)
 	MedianFromReport(context.Context, *MedianFromReportRequest) (*MedianFromReportReply, error)
 	MaxReportLength(context.Context, *MaxReportLengthRequest) (*MaxReportLengthReply, error)
+	BuildReportChunked(*BuildReportRequest, ReportCodec_BuildReportChunkedServer) error
 	mustEmbedUnimplementedReportCodecServer()
 }
 
@@ -272,6 +344,9 @@ func (UnimplementedReportCodecServer) MedianFromReport(context.Context, *MedianF
 func (UnimplementedReportCodecServer) MaxReportLength(context.Context, *MaxReportLengthRequest) (*MaxReportLengthReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MaxReportLength not implemented")
 }
+func (UnimplementedReportCodecServer) BuildReportChunked(*BuildReportRequest, ReportCodec_BuildReportChunkedServer) error {
+	return status.Errorf(codes.Unimplemented, "method BuildReportChunked not implemented")
+}
 func (UnimplementedReportCodecServer) mustEmbedUnimplementedReportCodecServer() {}
 
 // UnsafeReportCodecServer may be embedded to opt out of forward compatibility for this service.
@@ -339,6 +414,27 @@ func _ReportCodec_MaxReportLength_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReportCodec_BuildReportChunked_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BuildReportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ReportCodecServer).BuildReportChunked(m, &reportCodecBuildReportChunkedServer{stream})
+}
+
+type ReportCodec_BuildReportChunkedServer interface {
+	Send(*Chunk) error
+	grpc.ServerStream
+}
+
+type reportCodecBuildReportChunkedServer struct {
+	grpc.ServerStream
+}
+
+func (x *reportCodecBuildReportChunkedServer) Send(m *Chunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // ReportCodec_ServiceDesc is the grpc.ServiceDesc for ReportCodec service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -359,7 +455,13 @@ var ReportCodec_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _ReportCodec_MaxReportLength_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "BuildReportChunked",
+			Handler:       _ReportCodec_BuildReportChunked_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "median.proto",
 }
 
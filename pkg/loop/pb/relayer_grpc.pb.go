@@ -240,9 +240,11 @@ const (
 	Relayer_NewConfigProvider_FullMethodName  = "/loop.Relayer/NewConfigProvider"
 	Relayer_NewMedianProvider_FullMethodName  = "/loop.Relayer/NewMedianProvider"
 	Relayer_NewMercuryProvider_FullMethodName = "/loop.Relayer/NewMercuryProvider"
+	Relayer_NewPluginProvider_FullMethodName  = "/loop.Relayer/NewPluginProvider"
 	Relayer_ChainStatus_FullMethodName        = "/loop.Relayer/ChainStatus"
 	Relayer_ChainStatuses_FullMethodName      = "/loop.Relayer/ChainStatuses"
 	Relayer_NodeStatuses_FullMethodName       = "/loop.Relayer/NodeStatuses"
+	Relayer_RPCStatuses_FullMethodName        = "/loop.Relayer/RPCStatuses"
 	Relayer_SendTx_FullMethodName             = "/loop.Relayer/SendTx"
 )
 
@@ -253,9 +255,11 @@ type RelayerClient interface {
 	NewConfigProvider(ctx context.Context, in *NewConfigProviderRequest, opts ...grpc.CallOption) (*NewConfigProviderReply, error)
 	NewMedianProvider(ctx context.Context, in *NewMedianProviderRequest, opts ...grpc.CallOption) (*NewMedianProviderReply, error)
 	NewMercuryProvider(ctx context.Context, in *NewMercuryProviderRequest, opts ...grpc.CallOption) (*NewMercuryProviderReply, error)
+	NewPluginProvider(ctx context.Context, in *NewPluginProviderRequest, opts ...grpc.CallOption) (*NewPluginProviderReply, error)
 	ChainStatus(ctx context.Context, in *ChainStatusRequest, opts ...grpc.CallOption) (*ChainStatusReply, error)
 	ChainStatuses(ctx context.Context, in *ChainStatusesRequest, opts ...grpc.CallOption) (*ChainStatusesReply, error)
 	NodeStatuses(ctx context.Context, in *NodeStatusesRequest, opts ...grpc.CallOption) (*NodeStatusesReply, error)
+	RPCStatuses(ctx context.Context, in *RPCStatusesRequest, opts ...grpc.CallOption) (*RPCStatusesReply, error)
 	SendTx(ctx context.Context, in *SendTxRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
@@ -294,6 +298,15 @@ func (c *relayerClient) NewMercuryProvider(ctx context.Context, in *NewMercuryPr
 	return out, nil
 }
 
+func (c *relayerClient) NewPluginProvider(ctx context.Context, in *NewPluginProviderRequest, opts ...grpc.CallOption) (*NewPluginProviderReply, error) {
+	out := new(NewPluginProviderReply)
+	err := c.cc.Invoke(ctx, Relayer_NewPluginProvider_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *relayerClient) ChainStatus(ctx context.Context, in *ChainStatusRequest, opts ...grpc.CallOption) (*ChainStatusReply, error) {
 	out := new(ChainStatusReply)
 	err := c.cc.Invoke(ctx, Relayer_ChainStatus_FullMethodName, in, out, opts...)
@@ -321,6 +334,15 @@ func (c *relayerClient) NodeStatuses(ctx context.Context, in *NodeStatusesReques
 	return out, nil
 }
 
+func (c *relayerClient) RPCStatuses(ctx context.Context, in *RPCStatusesRequest, opts ...grpc.CallOption) (*RPCStatusesReply, error) {
+	out := new(RPCStatusesReply)
+	err := c.cc.Invoke(ctx, Relayer_RPCStatuses_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *relayerClient) SendTx(ctx context.Context, in *SendTxRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Relayer_SendTx_FullMethodName, in, out, opts...)
@@ -337,9 +359,11 @@ type RelayerServer interface {
 	NewConfigProvider(context.Context, *NewConfigProviderRequest) (*NewConfigProviderReply, error)
 	NewMedianProvider(context.Context, *NewMedianProviderRequest) (*NewMedianProviderReply, error)
 	NewMercuryProvider(context.Context, *NewMercuryProviderRequest) (*NewMercuryProviderReply, error)
+	NewPluginProvider(context.Context, *NewPluginProviderRequest) (*NewPluginProviderReply, error)
 	ChainStatus(context.Context, *ChainStatusRequest) (*ChainStatusReply, error)
 	ChainStatuses(context.Context, *ChainStatusesRequest) (*ChainStatusesReply, error)
 	NodeStatuses(context.Context, *NodeStatusesRequest) (*NodeStatusesReply, error)
+	RPCStatuses(context.Context, *RPCStatusesRequest) (*RPCStatusesReply, error)
 	SendTx(context.Context, *SendTxRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedRelayerServer()
 }
@@ -357,6 +381,9 @@ func (UnimplementedRelayerServer) NewMedianProvider(context.Context, *NewMedianP
 func (UnimplementedRelayerServer) NewMercuryProvider(context.Context, *NewMercuryProviderRequest) (*NewMercuryProviderReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NewMercuryProvider not implemented")
 }
+func (UnimplementedRelayerServer) NewPluginProvider(context.Context, *NewPluginProviderRequest) (*NewPluginProviderReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewPluginProvider not implemented")
+}
 func (UnimplementedRelayerServer) ChainStatus(context.Context, *ChainStatusRequest) (*ChainStatusReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChainStatus not implemented")
 }
@@ -366,6 +393,9 @@ func (UnimplementedRelayerServer) ChainStatuses(context.Context, *ChainStatusesR
 func (UnimplementedRelayerServer) NodeStatuses(context.Context, *NodeStatusesRequest) (*NodeStatusesReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NodeStatuses not implemented")
 }
+func (UnimplementedRelayerServer) RPCStatuses(context.Context, *RPCStatusesRequest) (*RPCStatusesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RPCStatuses not implemented")
+}
 func (UnimplementedRelayerServer) SendTx(context.Context, *SendTxRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendTx not implemented")
 }
@@ -436,6 +466,24 @@ func _Relayer_NewMercuryProvider_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Relayer_NewPluginProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewPluginProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelayerServer).NewPluginProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Relayer_NewPluginProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelayerServer).NewPluginProvider(ctx, req.(*NewPluginProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Relayer_ChainStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ChainStatusRequest)
 	if err := dec(in); err != nil {
@@ -490,6 +538,24 @@ func _Relayer_NodeStatuses_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Relayer_RPCStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RPCStatusesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelayerServer).RPCStatuses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Relayer_RPCStatuses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelayerServer).RPCStatuses(ctx, req.(*RPCStatusesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Relayer_SendTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SendTxRequest)
 	if err := dec(in); err != nil {
@@ -527,6 +593,10 @@ var Relayer_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "NewMercuryProvider",
 			Handler:    _Relayer_NewMercuryProvider_Handler,
 		},
+		{
+			MethodName: "NewPluginProvider",
+			Handler:    _Relayer_NewPluginProvider_Handler,
+		},
 		{
 			MethodName: "ChainStatus",
 			Handler:    _Relayer_ChainStatus_Handler,
@@ -539,6 +609,10 @@ var Relayer_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "NodeStatuses",
 			Handler:    _Relayer_NodeStatuses_Handler,
 		},
+		{
+			MethodName: "RPCStatuses",
+			Handler:    _Relayer_RPCStatuses_Handler,
+		},
 		{
 			MethodName: "SendTx",
 			Handler:    _Relayer_SendTx_Handler,
@@ -770,6 +844,7 @@ const (
 	ContractConfigTracker_LatestConfigDetails_FullMethodName = "/loop.ContractConfigTracker/LatestConfigDetails"
 	ContractConfigTracker_LatestConfig_FullMethodName        = "/loop.ContractConfigTracker/LatestConfig"
 	ContractConfigTracker_LatestBlockHeight_FullMethodName   = "/loop.ContractConfigTracker/LatestBlockHeight"
+	ContractConfigTracker_NotifyNewConfig_FullMethodName     = "/loop.ContractConfigTracker/NotifyNewConfig"
 )
 
 // ContractConfigTrackerClient is the client API for ContractConfigTracker service.
@@ -779,6 +854,7 @@ type ContractConfigTrackerClient interface {
 	LatestConfigDetails(ctx context.Context, in *LatestConfigDetailsRequest, opts ...grpc.CallOption) (*LatestConfigDetailsReply, error)
 	LatestConfig(ctx context.Context, in *LatestConfigRequest, opts ...grpc.CallOption) (*LatestConfigReply, error)
 	LatestBlockHeight(ctx context.Context, in *LatestBlockHeightRequest, opts ...grpc.CallOption) (*LatestBlockHeightReply, error)
+	NotifyNewConfig(ctx context.Context, in *NotifyNewConfigRequest, opts ...grpc.CallOption) (ContractConfigTracker_NotifyNewConfigClient, error)
 }
 
 type contractConfigTrackerClient struct {
@@ -816,6 +892,38 @@ func (c *contractConfigTrackerClient) LatestBlockHeight(ctx context.Context, in
 	return out, nil
 }
 
+func (c *contractConfigTrackerClient) NotifyNewConfig(ctx context.Context, in *NotifyNewConfigRequest, opts ...grpc.CallOption) (ContractConfigTracker_NotifyNewConfigClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ContractConfigTracker_ServiceDesc.Streams[0], ContractConfigTracker_NotifyNewConfig_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &contractConfigTrackerNotifyNewConfigClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ContractConfigTracker_NotifyNewConfigClient interface {
+	Recv() (*NotifyNewConfigReply, error)
+	grpc.ClientStream
+}
+
+type contractConfigTrackerNotifyNewConfigClient struct {
+	grpc.ClientStream
+}
+
+func (x *contractConfigTrackerNotifyNewConfigClient) Recv() (*NotifyNewConfigReply, error) {
+	m := new(NotifyNewConfigReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ContractConfigTrackerServer is the server API for ContractConfigTracker service.
 // All implementations must embed UnimplementedContractConfigTrackerServer
 // for forward compatibility
@@ -823,6 +931,7 @@ type ContractConfigTrackerServer interface {
 	LatestConfigDetails(context.Context, *LatestConfigDetailsRequest) (*LatestConfigDetailsReply, error)
 	LatestConfig(context.Context, *LatestConfigRequest) (*LatestConfigReply, error)
 	LatestBlockHeight(context.Context, *LatestBlockHeightRequest) (*LatestBlockHeightReply, error)
+	NotifyNewConfig(*NotifyNewConfigRequest, ContractConfigTracker_NotifyNewConfigServer) error
 	mustEmbedUnimplementedContractConfigTrackerServer()
 }
 
@@ -839,6 +948,9 @@ func (UnimplementedContractConfigTrackerServer) LatestConfig(context.Context, *L
 func (UnimplementedContractConfigTrackerServer) LatestBlockHeight(context.Context, *LatestBlockHeightRequest) (*LatestBlockHeightReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LatestBlockHeight not implemented")
 }
+func (UnimplementedContractConfigTrackerServer) NotifyNewConfig(*NotifyNewConfigRequest, ContractConfigTracker_NotifyNewConfigServer) error {
+	return status.Errorf(codes.Unimplemented, "method NotifyNewConfig not implemented")
+}
 func (UnimplementedContractConfigTrackerServer) mustEmbedUnimplementedContractConfigTrackerServer() {}
 
 // UnsafeContractConfigTrackerServer may be embedded to opt out of forward compatibility for this service.
@@ -906,6 +1018,27 @@ func _ContractConfigTracker_LatestBlockHeight_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ContractConfigTracker_NotifyNewConfig_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(NotifyNewConfigRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ContractConfigTrackerServer).NotifyNewConfig(m, &contractConfigTrackerNotifyNewConfigServer{stream})
+}
+
+type ContractConfigTracker_NotifyNewConfigServer interface {
+	Send(*NotifyNewConfigReply) error
+	grpc.ServerStream
+}
+
+type contractConfigTrackerNotifyNewConfigServer struct {
+	grpc.ServerStream
+}
+
+func (x *contractConfigTrackerNotifyNewConfigServer) Send(m *NotifyNewConfigReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // ContractConfigTracker_ServiceDesc is the grpc.ServiceDesc for ContractConfigTracker service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -926,7 +1059,13 @@ var ContractConfigTracker_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _ContractConfigTracker_LatestBlockHeight_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "NotifyNewConfig",
+			Handler:       _ContractConfigTracker_NotifyNewConfig_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "relayer.proto",
 }
 
@@ -934,6 +1073,8 @@ const (
 	ContractTransmitter_Transmit_FullMethodName                   = "/loop.ContractTransmitter/Transmit"
 	ContractTransmitter_LatestConfigDigestAndEpoch_FullMethodName = "/loop.ContractTransmitter/LatestConfigDigestAndEpoch"
 	ContractTransmitter_FromAccount_FullMethodName                = "/loop.ContractTransmitter/FromAccount"
+	ContractTransmitter_SubscribeTransmitStatus_FullMethodName    = "/loop.ContractTransmitter/SubscribeTransmitStatus"
+	ContractTransmitter_TransmitChunked_FullMethodName            = "/loop.ContractTransmitter/TransmitChunked"
 )
 
 // ContractTransmitterClient is the client API for ContractTransmitter service.
@@ -943,6 +1084,8 @@ type ContractTransmitterClient interface {
 	Transmit(ctx context.Context, in *TransmitRequest, opts ...grpc.CallOption) (*TransmitReply, error)
 	LatestConfigDigestAndEpoch(ctx context.Context, in *LatestConfigDigestAndEpochRequest, opts ...grpc.CallOption) (*LatestConfigDigestAndEpochReply, error)
 	FromAccount(ctx context.Context, in *FromAccountRequest, opts ...grpc.CallOption) (*FromAccountReply, error)
+	SubscribeTransmitStatus(ctx context.Context, in *SubscribeTransmitStatusRequest, opts ...grpc.CallOption) (ContractTransmitter_SubscribeTransmitStatusClient, error)
+	TransmitChunked(ctx context.Context, opts ...grpc.CallOption) (ContractTransmitter_TransmitChunkedClient, error)
 }
 
 type contractTransmitterClient struct {
@@ -980,6 +1123,72 @@ func (c *contractTransmitterClient) FromAccount(ctx context.Context, in *FromAcc
 	return out, nil
 }
 
+func (c *contractTransmitterClient) SubscribeTransmitStatus(ctx context.Context, in *SubscribeTransmitStatusRequest, opts ...grpc.CallOption) (ContractTransmitter_SubscribeTransmitStatusClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ContractTransmitter_ServiceDesc.Streams[0], ContractTransmitter_SubscribeTransmitStatus_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &contractTransmitterSubscribeTransmitStatusClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ContractTransmitter_SubscribeTransmitStatusClient interface {
+	Recv() (*TransmitStatus, error)
+	grpc.ClientStream
+}
+
+type contractTransmitterSubscribeTransmitStatusClient struct {
+	grpc.ClientStream
+}
+
+func (x *contractTransmitterSubscribeTransmitStatusClient) Recv() (*TransmitStatus, error) {
+	m := new(TransmitStatus)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *contractTransmitterClient) TransmitChunked(ctx context.Context, opts ...grpc.CallOption) (ContractTransmitter_TransmitChunkedClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ContractTransmitter_ServiceDesc.Streams[1], ContractTransmitter_TransmitChunked_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &contractTransmitterTransmitChunkedClient{stream}
+	return x, nil
+}
+
+type ContractTransmitter_TransmitChunkedClient interface {
+	Send(*Chunk) error
+	CloseAndRecv() (*TransmitReply, error)
+	grpc.ClientStream
+}
+
+type contractTransmitterTransmitChunkedClient struct {
+	grpc.ClientStream
+}
+
+func (x *contractTransmitterTransmitChunkedClient) Send(m *Chunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *contractTransmitterTransmitChunkedClient) CloseAndRecv() (*TransmitReply, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(TransmitReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ContractTransmitterServer is the server API for ContractTransmitter service.
 // All implementations must embed UnimplementedContractTransmitterServer
 // for forward compatibility
@@ -987,6 +1196,8 @@ type ContractTransmitterServer interface {
 	Transmit(context.Context, *TransmitRequest) (*TransmitReply, error)
 	LatestConfigDigestAndEpoch(context.Context, *LatestConfigDigestAndEpochRequest) (*LatestConfigDigestAndEpochReply, error)
 	FromAccount(context.Context, *FromAccountRequest) (*FromAccountReply, error)
+	SubscribeTransmitStatus(*SubscribeTransmitStatusRequest, ContractTransmitter_SubscribeTransmitStatusServer) error
+	TransmitChunked(ContractTransmitter_TransmitChunkedServer) error
 	mustEmbedUnimplementedContractTransmitterServer()
 }
 
@@ -1003,6 +1214,12 @@ func (UnimplementedContractTransmitterServer) LatestConfigDigestAndEpoch(context
 func (UnimplementedContractTransmitterServer) FromAccount(context.Context, *FromAccountRequest) (*FromAccountReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FromAccount not implemented")
 }
+func (UnimplementedContractTransmitterServer) SubscribeTransmitStatus(*SubscribeTransmitStatusRequest, ContractTransmitter_SubscribeTransmitStatusServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeTransmitStatus not implemented")
+}
+func (UnimplementedContractTransmitterServer) TransmitChunked(ContractTransmitter_TransmitChunkedServer) error {
+	return status.Errorf(codes.Unimplemented, "method TransmitChunked not implemented")
+}
 func (UnimplementedContractTransmitterServer) mustEmbedUnimplementedContractTransmitterServer() {}
 
 // UnsafeContractTransmitterServer may be embedded to opt out of forward compatibility for this service.
@@ -1070,6 +1287,53 @@ func _ContractTransmitter_FromAccount_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ContractTransmitter_SubscribeTransmitStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeTransmitStatusRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ContractTransmitterServer).SubscribeTransmitStatus(m, &contractTransmitterSubscribeTransmitStatusServer{stream})
+}
+
+type ContractTransmitter_SubscribeTransmitStatusServer interface {
+	Send(*TransmitStatus) error
+	grpc.ServerStream
+}
+
+type contractTransmitterSubscribeTransmitStatusServer struct {
+	grpc.ServerStream
+}
+
+func (x *contractTransmitterSubscribeTransmitStatusServer) Send(m *TransmitStatus) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ContractTransmitter_TransmitChunked_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ContractTransmitterServer).TransmitChunked(&contractTransmitterTransmitChunkedServer{stream})
+}
+
+type ContractTransmitter_TransmitChunkedServer interface {
+	SendAndClose(*TransmitReply) error
+	Recv() (*Chunk, error)
+	grpc.ServerStream
+}
+
+type contractTransmitterTransmitChunkedServer struct {
+	grpc.ServerStream
+}
+
+func (x *contractTransmitterTransmitChunkedServer) SendAndClose(m *TransmitReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *contractTransmitterTransmitChunkedServer) Recv() (*Chunk, error) {
+	m := new(Chunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ContractTransmitter_ServiceDesc is the grpc.ServiceDesc for ContractTransmitter service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1090,7 +1354,18 @@ var ContractTransmitter_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _ContractTransmitter_FromAccount_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeTransmitStatus",
+			Handler:       _ContractTransmitter_SubscribeTransmitStatus_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "TransmitChunked",
+			Handler:       _ContractTransmitter_TransmitChunked_Handler,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "relayer.proto",
 }
 
@@ -1099,6 +1374,7 @@ const (
 	Service_Close_FullMethodName        = "/loop.Service/Close"
 	Service_Ready_FullMethodName        = "/loop.Service/Ready"
 	Service_HealthReport_FullMethodName = "/loop.Service/HealthReport"
+	Service_Version_FullMethodName      = "/loop.Service/Version"
 )
 
 // ServiceClient is the client API for Service service.
@@ -1109,6 +1385,7 @@ type ServiceClient interface {
 	Close(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Ready(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	HealthReport(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HealthReportReply, error)
+	Version(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*VersionReply, error)
 }
 
 type serviceClient struct {
@@ -1155,6 +1432,15 @@ func (c *serviceClient) HealthReport(ctx context.Context, in *emptypb.Empty, opt
 	return out, nil
 }
 
+func (c *serviceClient) Version(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*VersionReply, error) {
+	out := new(VersionReply)
+	err := c.cc.Invoke(ctx, Service_Version_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 // All implementations must embed UnimplementedServiceServer
 // for forward compatibility
@@ -1163,6 +1449,7 @@ type ServiceServer interface {
 	Close(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	Ready(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	HealthReport(context.Context, *emptypb.Empty) (*HealthReportReply, error)
+	Version(context.Context, *emptypb.Empty) (*VersionReply, error)
 	mustEmbedUnimplementedServiceServer()
 }
 
@@ -1182,6 +1469,9 @@ func (UnimplementedServiceServer) Ready(context.Context, *emptypb.Empty) (*empty
 func (UnimplementedServiceServer) HealthReport(context.Context, *emptypb.Empty) (*HealthReportReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HealthReport not implemented")
 }
+func (UnimplementedServiceServer) Version(context.Context, *emptypb.Empty) (*VersionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Version not implemented")
+}
 func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
 
 // UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1267,6 +1557,24 @@ func _Service_HealthReport_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).Version(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_Version_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).Version(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Service_ServiceDesc is the grpc.ServiceDesc for Service service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1290,6 +1598,10 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "HealthReport",
 			Handler:    _Service_HealthReport_Handler,
 		},
+		{
+			MethodName: "Version",
+			Handler:    _Service_Version_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "relayer.proto",
@@ -1121,7 +1121,7 @@ var file_reporting_proto_rawDesc = []byte{
 	0x65, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x75, 0x67, 0x69,
 	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x2e,
 	0x4e, 0x65, 0x77, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x75, 0x67,
-	0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x32, 0xdd, 0x03, 0x0a, 0x0f, 0x52, 0x65,
+	0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x32, 0xd3, 0x04, 0x0a, 0x0f, 0x52, 0x65,
 	0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x2f, 0x0a,
 	0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x12, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x2e, 0x51, 0x75,
 	0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x6c, 0x6f, 0x6f,
@@ -1151,12 +1151,19 @@ var file_reporting_proto_rawDesc = []byte{
 	0x0a, 0x05, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
 	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x42, 0x42, 0x5a, 0x40, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6d, 0x61, 0x72, 0x74, 0x63, 0x6f, 0x6e,
-	0x74, 0x72, 0x61, 0x63, 0x74, 0x6b, 0x69, 0x74, 0x2f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x6c, 0x69,
-	0x6e, 0x6b, 0x2d, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6c, 0x6f, 0x6f,
-	0x70, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x12, 0x4f, 0x62, 0x73,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x65, 0x64, 0x12,
+	0x18, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x2e, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x6c, 0x6f, 0x6f, 0x70,
+	0x2e, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x00, 0x30, 0x01, 0x12, 0x33, 0x0a, 0x0d, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x65, 0x64, 0x12, 0x0b, 0x2e, 0x6c, 0x6f,
+	0x6f, 0x70, 0x2e, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x1a, 0x11, 0x2e, 0x6c, 0x6f, 0x6f, 0x70, 0x2e,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x28, 0x01, 0x42,
+	0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6d,
+	0x61, 0x72, 0x74, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6b, 0x69, 0x74, 0x2f, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x2d, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x6c, 0x6f, 0x6f, 0x70, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -1191,6 +1198,7 @@ var file_reporting_proto_goTypes = []interface{}{
 	(*ShouldTransmitAcceptedReportReply)(nil),   // 15: loop.ShouldTransmitAcceptedReportReply
 	(*ReportTimestamp)(nil),                     // 16: loop.ReportTimestamp
 	(*emptypb.Empty)(nil),                       // 17: google.protobuf.Empty
+	(*Chunk)(nil),                               // 18: loop.Chunk
 }
 var file_reporting_proto_depIdxs = []int32{
 	2,  // 0: loop.NewReportingPluginRequest.reportingPluginConfig:type_name -> loop.ReportingPluginConfig
@@ -1209,15 +1217,19 @@ var file_reporting_proto_depIdxs = []int32{
 	12, // 13: loop.ReportingPlugin.ShouldAcceptFinalizedReport:input_type -> loop.ShouldAcceptFinalizedReportRequest
 	14, // 14: loop.ReportingPlugin.ShouldTransmitAcceptedReport:input_type -> loop.ShouldTransmitAcceptedReportRequest
 	17, // 15: loop.ReportingPlugin.Close:input_type -> google.protobuf.Empty
-	1,  // 16: loop.ReportingPluginFactory.NewReportingPlugin:output_type -> loop.NewReportingPluginReply
-	6,  // 17: loop.ReportingPlugin.Query:output_type -> loop.QueryReply
-	8,  // 18: loop.ReportingPlugin.Observation:output_type -> loop.ObservationReply
-	11, // 19: loop.ReportingPlugin.Report:output_type -> loop.ReportReply
-	13, // 20: loop.ReportingPlugin.ShouldAcceptFinalizedReport:output_type -> loop.ShouldAcceptFinalizedReportReply
-	15, // 21: loop.ReportingPlugin.ShouldTransmitAcceptedReport:output_type -> loop.ShouldTransmitAcceptedReportReply
-	17, // 22: loop.ReportingPlugin.Close:output_type -> google.protobuf.Empty
-	16, // [16:23] is the sub-list for method output_type
-	9,  // [9:16] is the sub-list for method input_type
+	7,  // 16: loop.ReportingPlugin.ObservationChunked:input_type -> loop.ObservationRequest
+	18, // 17: loop.ReportingPlugin.ReportChunked:input_type -> loop.Chunk
+	1,  // 18: loop.ReportingPluginFactory.NewReportingPlugin:output_type -> loop.NewReportingPluginReply
+	6,  // 19: loop.ReportingPlugin.Query:output_type -> loop.QueryReply
+	8,  // 20: loop.ReportingPlugin.Observation:output_type -> loop.ObservationReply
+	11, // 21: loop.ReportingPlugin.Report:output_type -> loop.ReportReply
+	13, // 22: loop.ReportingPlugin.ShouldAcceptFinalizedReport:output_type -> loop.ShouldAcceptFinalizedReportReply
+	15, // 23: loop.ReportingPlugin.ShouldTransmitAcceptedReport:output_type -> loop.ShouldTransmitAcceptedReportReply
+	17, // 24: loop.ReportingPlugin.Close:output_type -> google.protobuf.Empty
+	18, // 25: loop.ReportingPlugin.ObservationChunked:output_type -> loop.Chunk
+	11, // 26: loop.ReportingPlugin.ReportChunked:output_type -> loop.ReportReply
+	18, // [18:27] is the sub-list for method output_type
+	9,  // [9:18] is the sub-list for method input_type
 	9,  // [9:9] is the sub-list for extension type_name
 	9,  // [9:9] is the sub-list for extension extendee
 	0,  // [0:9] is the sub-list for field type_name
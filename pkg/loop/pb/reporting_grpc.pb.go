@@ -117,6 +117,8 @@ const (
 	ReportingPlugin_ShouldAcceptFinalizedReport_FullMethodName  = "/loop.ReportingPlugin/ShouldAcceptFinalizedReport"
 	ReportingPlugin_ShouldTransmitAcceptedReport_FullMethodName = "/loop.ReportingPlugin/ShouldTransmitAcceptedReport"
 	ReportingPlugin_Close_FullMethodName                        = "/loop.ReportingPlugin/Close"
+	ReportingPlugin_ObservationChunked_FullMethodName           = "/loop.ReportingPlugin/ObservationChunked"
+	ReportingPlugin_ReportChunked_FullMethodName                = "/loop.ReportingPlugin/ReportChunked"
 )
 
 // ReportingPluginClient is the client API for ReportingPlugin service.
@@ -129,6 +131,8 @@ type ReportingPluginClient interface {
 	ShouldAcceptFinalizedReport(ctx context.Context, in *ShouldAcceptFinalizedReportRequest, opts ...grpc.CallOption) (*ShouldAcceptFinalizedReportReply, error)
 	ShouldTransmitAcceptedReport(ctx context.Context, in *ShouldTransmitAcceptedReportRequest, opts ...grpc.CallOption) (*ShouldTransmitAcceptedReportReply, error)
 	Close(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ObservationChunked(ctx context.Context, in *ObservationRequest, opts ...grpc.CallOption) (ReportingPlugin_ObservationChunkedClient, error)
+	ReportChunked(ctx context.Context, opts ...grpc.CallOption) (ReportingPlugin_ReportChunkedClient, error)
 }
 
 type reportingPluginClient struct {
@@ -193,6 +197,72 @@ func (c *reportingPluginClient) Close(ctx context.Context, in *emptypb.Empty, op
 	return out, nil
 }
 
+func (c *reportingPluginClient) ObservationChunked(ctx context.Context, in *ObservationRequest, opts ...grpc.CallOption) (ReportingPlugin_ObservationChunkedClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ReportingPlugin_ServiceDesc.Streams[0], ReportingPlugin_ObservationChunked_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &reportingPluginObservationChunkedClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ReportingPlugin_ObservationChunkedClient interface {
+	Recv() (*Chunk, error)
+	grpc.ClientStream
+}
+
+type reportingPluginObservationChunkedClient struct {
+	grpc.ClientStream
+}
+
+func (x *reportingPluginObservationChunkedClient) Recv() (*Chunk, error) {
+	m := new(Chunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *reportingPluginClient) ReportChunked(ctx context.Context, opts ...grpc.CallOption) (ReportingPlugin_ReportChunkedClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ReportingPlugin_ServiceDesc.Streams[1], ReportingPlugin_ReportChunked_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &reportingPluginReportChunkedClient{stream}
+	return x, nil
+}
+
+type ReportingPlugin_ReportChunkedClient interface {
+	Send(*Chunk) error
+	CloseAndRecv() (*ReportReply, error)
+	grpc.ClientStream
+}
+
+type reportingPluginReportChunkedClient struct {
+	grpc.ClientStream
+}
+
+func (x *reportingPluginReportChunkedClient) Send(m *Chunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *reportingPluginReportChunkedClient) CloseAndRecv() (*ReportReply, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ReportReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ReportingPluginServer is the server API for ReportingPlugin service.
 // All implementations must embed UnimplementedReportingPluginServer
 // for forward compatibility
@@ -203,6 +273,8 @@ type ReportingPluginServer interface {
 	ShouldAcceptFinalizedReport(context.Context, *ShouldAcceptFinalizedReportRequest) (*ShouldAcceptFinalizedReportReply, error)
 	ShouldTransmitAcceptedReport(context.Context, *ShouldTransmitAcceptedReportRequest) (*ShouldTransmitAcceptedReportReply, error)
 	Close(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	ObservationChunked(*ObservationRequest, ReportingPlugin_ObservationChunkedServer) error
+	ReportChunked(ReportingPlugin_ReportChunkedServer) error
 	mustEmbedUnimplementedReportingPluginServer()
 }
 
@@ -228,6 +300,12 @@ func (UnimplementedReportingPluginServer) ShouldTransmitAcceptedReport(context.C
 func (UnimplementedReportingPluginServer) Close(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Close not implemented")
 }
+func (UnimplementedReportingPluginServer) ObservationChunked(*ObservationRequest, ReportingPlugin_ObservationChunkedServer) error {
+	return status.Errorf(codes.Unimplemented, "method ObservationChunked not implemented")
+}
+func (UnimplementedReportingPluginServer) ReportChunked(ReportingPlugin_ReportChunkedServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReportChunked not implemented")
+}
 func (UnimplementedReportingPluginServer) mustEmbedUnimplementedReportingPluginServer() {}
 
 // UnsafeReportingPluginServer may be embedded to opt out of forward compatibility for this service.
@@ -349,6 +427,53 @@ func _ReportingPlugin_Close_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReportingPlugin_ObservationChunked_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ObservationRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ReportingPluginServer).ObservationChunked(m, &reportingPluginObservationChunkedServer{stream})
+}
+
+type ReportingPlugin_ObservationChunkedServer interface {
+	Send(*Chunk) error
+	grpc.ServerStream
+}
+
+type reportingPluginObservationChunkedServer struct {
+	grpc.ServerStream
+}
+
+func (x *reportingPluginObservationChunkedServer) Send(m *Chunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ReportingPlugin_ReportChunked_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ReportingPluginServer).ReportChunked(&reportingPluginReportChunkedServer{stream})
+}
+
+type ReportingPlugin_ReportChunkedServer interface {
+	SendAndClose(*ReportReply) error
+	Recv() (*Chunk, error)
+	grpc.ServerStream
+}
+
+type reportingPluginReportChunkedServer struct {
+	grpc.ServerStream
+}
+
+func (x *reportingPluginReportChunkedServer) SendAndClose(m *ReportReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *reportingPluginReportChunkedServer) Recv() (*Chunk, error) {
+	m := new(Chunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ReportingPlugin_ServiceDesc is the grpc.ServiceDesc for ReportingPlugin service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -381,6 +506,17 @@ var ReportingPlugin_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _ReportingPlugin_Close_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ObservationChunked",
+			Handler:       _ReportingPlugin_ObservationChunked_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ReportChunked",
+			Handler:       _ReportingPlugin_ReportChunked_Handler,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "reporting.proto",
 }
@@ -0,0 +1,9 @@
+//go:build tools
+
+package pb
+
+// Pin the versions of the protoc plugins used by go:generate, via go.mod.
+import (
+	_ "google.golang.org/grpc/cmd/protoc-gen-go-grpc"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go"
+)
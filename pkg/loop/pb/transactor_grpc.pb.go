@@ -0,0 +1,211 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.12
+// source: transactor.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Transactor_Transact_FullMethodName          = "/loop.Transactor/Transact"
+	Transactor_TxStatus_FullMethodName          = "/loop.Transactor/TxStatus"
+	Transactor_SubscribeTxStatus_FullMethodName = "/loop.Transactor/SubscribeTxStatus"
+)
+
+// TransactorClient is the client API for Transactor service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TransactorClient interface {
+	Transact(ctx context.Context, in *TransactRequest, opts ...grpc.CallOption) (*TransactReply, error)
+	TxStatus(ctx context.Context, in *TxStatusRequest, opts ...grpc.CallOption) (*TxStatusReply, error)
+	SubscribeTxStatus(ctx context.Context, in *TxStatusRequest, opts ...grpc.CallOption) (Transactor_SubscribeTxStatusClient, error)
+}
+
+type transactorClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTransactorClient(cc grpc.ClientConnInterface) TransactorClient {
+	return &transactorClient{cc}
+}
+
+func (c *transactorClient) Transact(ctx context.Context, in *TransactRequest, opts ...grpc.CallOption) (*TransactReply, error) {
+	out := new(TransactReply)
+	err := c.cc.Invoke(ctx, Transactor_Transact_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactorClient) TxStatus(ctx context.Context, in *TxStatusRequest, opts ...grpc.CallOption) (*TxStatusReply, error) {
+	out := new(TxStatusReply)
+	err := c.cc.Invoke(ctx, Transactor_TxStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactorClient) SubscribeTxStatus(ctx context.Context, in *TxStatusRequest, opts ...grpc.CallOption) (Transactor_SubscribeTxStatusClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Transactor_ServiceDesc.Streams[0], Transactor_SubscribeTxStatus_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &transactorSubscribeTxStatusClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Transactor_SubscribeTxStatusClient interface {
+	Recv() (*TxStatusReply, error)
+	grpc.ClientStream
+}
+
+type transactorSubscribeTxStatusClient struct {
+	grpc.ClientStream
+}
+
+func (x *transactorSubscribeTxStatusClient) Recv() (*TxStatusReply, error) {
+	m := new(TxStatusReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// TransactorServer is the server API for Transactor service.
+// All implementations must embed UnimplementedTransactorServer
+// for forward compatibility
+type TransactorServer interface {
+	Transact(context.Context, *TransactRequest) (*TransactReply, error)
+	TxStatus(context.Context, *TxStatusRequest) (*TxStatusReply, error)
+	SubscribeTxStatus(*TxStatusRequest, Transactor_SubscribeTxStatusServer) error
+	mustEmbedUnimplementedTransactorServer()
+}
+
+// UnimplementedTransactorServer must be embedded to have forward compatible implementations.
+type UnimplementedTransactorServer struct {
+}
+
+func (UnimplementedTransactorServer) Transact(context.Context, *TransactRequest) (*TransactReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Transact not implemented")
+}
+func (UnimplementedTransactorServer) TxStatus(context.Context, *TxStatusRequest) (*TxStatusReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxStatus not implemented")
+}
+func (UnimplementedTransactorServer) SubscribeTxStatus(*TxStatusRequest, Transactor_SubscribeTxStatusServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeTxStatus not implemented")
+}
+func (UnimplementedTransactorServer) mustEmbedUnimplementedTransactorServer() {}
+
+// UnsafeTransactorServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TransactorServer will
+// result in compilation errors.
+type UnsafeTransactorServer interface {
+	mustEmbedUnimplementedTransactorServer()
+}
+
+func RegisterTransactorServer(s grpc.ServiceRegistrar, srv TransactorServer) {
+	s.RegisterService(&Transactor_ServiceDesc, srv)
+}
+
+func _Transactor_Transact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactorServer).Transact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactor_Transact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactorServer).Transact(ctx, req.(*TransactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactor_TxStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactorServer).TxStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transactor_TxStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactorServer).TxStatus(ctx, req.(*TxStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transactor_SubscribeTxStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TxStatusRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TransactorServer).SubscribeTxStatus(m, &transactorSubscribeTxStatusServer{stream})
+}
+
+type Transactor_SubscribeTxStatusServer interface {
+	Send(*TxStatusReply) error
+	grpc.ServerStream
+}
+
+type transactorSubscribeTxStatusServer struct {
+	grpc.ServerStream
+}
+
+func (x *transactorSubscribeTxStatusServer) Send(m *TxStatusReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Transactor_ServiceDesc is the grpc.ServiceDesc for Transactor service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Transactor_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "loop.Transactor",
+	HandlerType: (*TransactorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Transact",
+			Handler:    _Transactor_Transact_Handler,
+		},
+		{
+			MethodName: "TxStatus",
+			Handler:    _Transactor_TxStatus_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeTxStatus",
+			Handler:       _Transactor_SubscribeTxStatus_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "transactor.proto",
+}
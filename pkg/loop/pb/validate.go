@@ -0,0 +1,337 @@
+package pb
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Validate methods check the invariants of messages which the generated code cannot express, like required fields,
+// fixed lengths, and the bounds of narrower Go types, so that they may be rejected on receipt instead of being
+// truncated, padded, or dereferenced while nil. A nil message is missing.
+
+// ErrMissing is the [ErrInvalid.Err] of a required field which is not set.
+var ErrMissing = errors.New("missing")
+
+// ErrInvalid is returned by Validate for an invalid field of a message.
+type ErrInvalid struct {
+	Message string // e.g. TransmitStatus
+	Field   string // e.g. ReportContext.ReportTimestamp.Round, or empty for the whole message
+	Err     error
+}
+
+func (e ErrInvalid) Error() string {
+	if e.Field == "" {
+		return fmt.Sprintf("invalid %s: %s", e.Message, e.Err)
+	}
+	return fmt.Sprintf("invalid %s %s: %s", e.Message, e.Field, e.Err)
+}
+
+func (e ErrInvalid) Unwrap() error {
+	return e.Err
+}
+
+type ErrConfigDigestLen int
+
+func (e ErrConfigDigestLen) Error() string {
+	return fmt.Sprintf("invalid ConfigDigest len %d: must be 32", e)
+}
+
+type ErrUint8Bounds struct {
+	U    uint32
+	Name string
+}
+
+func (e ErrUint8Bounds) Error() string {
+	return fmt.Sprintf("expected uint8 %s (max %d) but got %d", e.Name, math.MaxUint8, e.U)
+}
+
+// ErrLen is returned for a fixed length bytes field of the wrong length.
+type ErrLen struct {
+	Len  int
+	Want int
+}
+
+func (e ErrLen) Error() string {
+	return fmt.Sprintf("invalid len %d: must be %d", e.Len, e.Want)
+}
+
+// ErrEnum is returned for an enum field outside of the range of known values.
+type ErrEnum struct {
+	Name  string // e.g. TxState
+	Value int32
+}
+
+func (e ErrEnum) Error() string {
+	return fmt.Sprintf("unknown %s %d", e.Name, e.Value)
+}
+
+func invalid(message, field string, err error) error {
+	return ErrInvalid{Message: message, Field: field, Err: err}
+}
+
+// nested returns err, from validating field of message, as an [ErrInvalid] of message itself.
+func nested(message, field string, err error) error {
+	if err == nil {
+		return nil
+	}
+	var e ErrInvalid
+	if !errors.As(err, &e) {
+		return invalid(message, field, err)
+	}
+	if e.Field != "" {
+		field += "." + e.Field
+	}
+	return invalid(message, field, e.Err)
+}
+
+func validateConfigDigest(message, field string, digest []byte) error {
+	if l := len(digest); l != 32 {
+		return invalid(message, field, ErrConfigDigestLen(l))
+	}
+	return nil
+}
+
+func validateUint8(message, field string, u uint32) error {
+	if u > math.MaxUint8 {
+		return invalid(message, field, ErrUint8Bounds{Name: field, U: u})
+	}
+	return nil
+}
+
+func (x *ReportTimestamp) Validate() error {
+	if x == nil {
+		return invalid("ReportTimestamp", "", ErrMissing)
+	}
+	if err := validateConfigDigest("ReportTimestamp", "ConfigDigest", x.ConfigDigest); err != nil {
+		return err
+	}
+	return validateUint8("ReportTimestamp", "Round", x.Round)
+}
+
+func (x *ReportContext) Validate() error {
+	if x == nil {
+		return invalid("ReportContext", "", ErrMissing)
+	}
+	if err := nested("ReportContext", "ReportTimestamp", x.ReportTimestamp.Validate()); err != nil {
+		return err
+	}
+	if l := len(x.ExtraHash); l != 32 {
+		return invalid("ReportContext", "ExtraHash", ErrLen{Len: l, Want: 32})
+	}
+	return nil
+}
+
+func (x *ContractConfig) Validate() error {
+	if x == nil {
+		return invalid("ContractConfig", "", ErrMissing)
+	}
+	if err := validateConfigDigest("ContractConfig", "ConfigDigest", x.ConfigDigest); err != nil {
+		return err
+	}
+	return validateUint8("ContractConfig", "F", x.F)
+}
+
+func (x *ConfigDigestRequest) Validate() error {
+	if x == nil {
+		return invalid("ConfigDigestRequest", "", ErrMissing)
+	}
+	return nested("ConfigDigestRequest", "ContractConfig", x.ContractConfig.Validate())
+}
+
+func (x *ConfigDigestReply) Validate() error {
+	if x == nil {
+		return invalid("ConfigDigestReply", "", ErrMissing)
+	}
+	return validateConfigDigest("ConfigDigestReply", "ConfigDigest", x.ConfigDigest)
+}
+
+func (x *LatestConfigDetailsReply) Validate() error {
+	if x == nil {
+		return invalid("LatestConfigDetailsReply", "", ErrMissing)
+	}
+	return validateConfigDigest("LatestConfigDetailsReply", "ConfigDigest", x.ConfigDigest)
+}
+
+func (x *LatestConfigReply) Validate() error {
+	if x == nil {
+		return invalid("LatestConfigReply", "", ErrMissing)
+	}
+	return nested("LatestConfigReply", "ContractConfig", x.ContractConfig.Validate())
+}
+
+func (x *LatestConfigDigestAndEpochReply) Validate() error {
+	if x == nil {
+		return invalid("LatestConfigDigestAndEpochReply", "", ErrMissing)
+	}
+	return validateConfigDigest("LatestConfigDigestAndEpochReply", "ConfigDigest", x.ConfigDigest)
+}
+
+func (x *LatestTransmissionDetailsReply) Validate() error {
+	if x == nil {
+		return invalid("LatestTransmissionDetailsReply", "", ErrMissing)
+	}
+	if err := validateConfigDigest("LatestTransmissionDetailsReply", "ConfigDigest", x.ConfigDigest); err != nil {
+		return err
+	}
+	return validateUint8("LatestTransmissionDetailsReply", "Round", x.Round)
+}
+
+func (x *LatestRoundRequestedReply) Validate() error {
+	if x == nil {
+		return invalid("LatestRoundRequestedReply", "", ErrMissing)
+	}
+	if err := validateConfigDigest("LatestRoundRequestedReply", "ConfigDigest", x.ConfigDigest); err != nil {
+		return err
+	}
+	return validateUint8("LatestRoundRequestedReply", "Round", x.Round)
+}
+
+func (x *ReportingPluginConfig) Validate() error {
+	if x == nil {
+		return invalid("ReportingPluginConfig", "", ErrMissing)
+	}
+	if err := validateConfigDigest("ReportingPluginConfig", "ConfigDigest", x.ConfigDigest); err != nil {
+		return err
+	}
+	return validateUint8("ReportingPluginConfig", "OracleID", x.OracleID)
+}
+
+func (x *NewReportingPluginRequest) Validate() error {
+	if x == nil {
+		return invalid("NewReportingPluginRequest", "", ErrMissing)
+	}
+	return nested("NewReportingPluginRequest", "ReportingPluginConfig", x.ReportingPluginConfig.Validate())
+}
+
+func (x *ReportingPluginInfo) Validate() error {
+	if x == nil {
+		return invalid("ReportingPluginInfo", "", ErrMissing)
+	}
+	if x.ReportingPluginLimits == nil {
+		return invalid("ReportingPluginInfo", "ReportingPluginLimits", ErrMissing)
+	}
+	return nil
+}
+
+func (x *NewReportingPluginReply) Validate() error {
+	if x == nil {
+		return invalid("NewReportingPluginReply", "", ErrMissing)
+	}
+	return nested("NewReportingPluginReply", "ReportingPluginInfo", x.ReportingPluginInfo.Validate())
+}
+
+func (x *AttributedObservation) Validate() error {
+	if x == nil {
+		return invalid("AttributedObservation", "", ErrMissing)
+	}
+	return validateUint8("AttributedObservation", "Observer", x.Observer)
+}
+
+func (x *ParsedAttributedObservation) Validate() error {
+	if x == nil {
+		return invalid("ParsedAttributedObservation", "", ErrMissing)
+	}
+	if x.Value == nil {
+		return invalid("ParsedAttributedObservation", "Value", ErrMissing)
+	}
+	if x.JulesPerFeeCoin == nil {
+		return invalid("ParsedAttributedObservation", "JulesPerFeeCoin", ErrMissing)
+	}
+	return validateUint8("ParsedAttributedObservation", "Observer", x.Observer)
+}
+
+func (x *AttributedOnchainSignature) Validate() error {
+	if x == nil {
+		return invalid("AttributedOnchainSignature", "", ErrMissing)
+	}
+	return validateUint8("AttributedOnchainSignature", "Signer", x.Signer)
+}
+
+func (x *OnchainConfig) Validate() error {
+	if x == nil {
+		return invalid("OnchainConfig", "", ErrMissing)
+	}
+	if x.Min == nil {
+		return invalid("OnchainConfig", "Min", ErrMissing)
+	}
+	if x.Max == nil {
+		return invalid("OnchainConfig", "Max", ErrMissing)
+	}
+	return nil
+}
+
+func (x *EncodeRequest) Validate() error {
+	if x == nil {
+		return invalid("EncodeRequest", "", ErrMissing)
+	}
+	return nested("EncodeRequest", "OnchainConfig", x.OnchainConfig.Validate())
+}
+
+func (x *DecodeReply) Validate() error {
+	if x == nil {
+		return invalid("DecodeReply", "", ErrMissing)
+	}
+	return nested("DecodeReply", "OnchainConfig", x.OnchainConfig.Validate())
+}
+
+func (x *ChainStatusReply) Validate() error {
+	if x == nil {
+		return invalid("ChainStatusReply", "", ErrMissing)
+	}
+	if x.Chain == nil {
+		return invalid("ChainStatusReply", "Chain", ErrMissing)
+	}
+	return nil
+}
+
+// The known values of [github.com/smartcontractkit/chainlink-relay/pkg/types.TransmitState].
+const (
+	minTransmitState = 1 // TransmitConfirmed
+	maxTransmitState = 2 // TransmitFailed
+)
+
+func (x *TransmitStatus) Validate() error {
+	if x == nil {
+		return invalid("TransmitStatus", "", ErrMissing)
+	}
+	if err := nested("TransmitStatus", "ReportContext", x.ReportContext.Validate()); err != nil {
+		return err
+	}
+	if x.State < minTransmitState || x.State > maxTransmitState {
+		return invalid("TransmitStatus", "State", ErrEnum{Name: "TransmitState", Value: x.State})
+	}
+	return nil
+}
+
+// The known values of [github.com/smartcontractkit/chainlink-relay/pkg/types.TxState].
+const (
+	minTxState = 1 // TxPending
+	maxTxState = 3 // TxFailed
+)
+
+func (x *TxStatusReply) Validate() error {
+	if x == nil {
+		return invalid("TxStatusReply", "", ErrMissing)
+	}
+	if x.State < minTxState || x.State > maxTxState {
+		return invalid("TxStatusReply", "State", ErrEnum{Name: "TxState", Value: x.State})
+	}
+	return nil
+}
+
+// The largest known value of [github.com/smartcontractkit/chainlink-relay/pkg/types.ProviderType]. Zero is unspecified.
+const maxProviderType = 5 // ProviderTypePlugin
+
+func (x *RelayArgs) Validate() error {
+	if x == nil {
+		return invalid("RelayArgs", "", ErrMissing)
+	}
+	if l := len(x.ExternalJobID); l != 16 {
+		return invalid("RelayArgs", "ExternalJobID", ErrLen{Len: l, Want: 16})
+	}
+	if x.ProviderType < 0 || x.ProviderType > maxProviderType {
+		return invalid("RelayArgs", "ProviderType", ErrEnum{Name: "ProviderType", Value: x.ProviderType})
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package pb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+func TestValidate(t *testing.T) {
+	digest := make([]byte, 32)
+	validTimestamp := func() *ReportTimestamp { return &ReportTimestamp{ConfigDigest: digest, Epoch: 1, Round: 2} }
+	validContext := func() *ReportContext {
+		return &ReportContext{ReportTimestamp: validTimestamp(), ExtraHash: make([]byte, 32)}
+	}
+	for _, tt := range []struct {
+		name    string
+		msg     interface{ Validate() error }
+		field   string // empty if valid
+		wantErr error
+	}{
+		{"ReportTimestamp", validTimestamp(), "", nil},
+		{"ReportTimestamp nil", (*ReportTimestamp)(nil), "", ErrMissing},
+		{"ReportTimestamp digest", &ReportTimestamp{ConfigDigest: digest[:31]}, "ConfigDigest", ErrConfigDigestLen(31)},
+		{"ReportTimestamp round", &ReportTimestamp{ConfigDigest: digest, Round: 256}, "Round", ErrUint8Bounds{Name: "Round", U: 256}},
+
+		{"ReportContext", validContext(), "", nil},
+		{"ReportContext timestamp", &ReportContext{ExtraHash: make([]byte, 32)}, "ReportTimestamp", ErrMissing},
+		{"ReportContext nested", &ReportContext{ReportTimestamp: &ReportTimestamp{ConfigDigest: digest, Round: 300}, ExtraHash: make([]byte, 32)},
+			"ReportTimestamp.Round", ErrUint8Bounds{Name: "Round", U: 300}},
+		{"ReportContext extra hash", &ReportContext{ReportTimestamp: validTimestamp(), ExtraHash: make([]byte, 33)}, "ExtraHash", ErrLen{Len: 33, Want: 32}},
+
+		{"LatestConfigReply", &LatestConfigReply{ContractConfig: &ContractConfig{ConfigDigest: digest, F: 1}}, "", nil},
+		{"LatestConfigReply missing", &LatestConfigReply{}, "ContractConfig", ErrMissing},
+		{"LatestConfigReply F", &LatestConfigReply{ContractConfig: &ContractConfig{ConfigDigest: digest, F: 256}}, "ContractConfig.F", ErrUint8Bounds{Name: "F", U: 256}},
+		{"LatestTransmissionDetailsReply digest", &LatestTransmissionDetailsReply{}, "ConfigDigest", ErrConfigDigestLen(0)},
+		{"NewReportingPluginReply limits", &NewReportingPluginReply{ReportingPluginInfo: &ReportingPluginInfo{}}, "ReportingPluginInfo.ReportingPluginLimits", ErrMissing},
+		{"NewReportingPluginRequest oracle", &NewReportingPluginRequest{ReportingPluginConfig: &ReportingPluginConfig{ConfigDigest: digest, OracleID: 256}},
+			"ReportingPluginConfig.OracleID", ErrUint8Bounds{Name: "OracleID", U: 256}},
+		{"ParsedAttributedObservation value", &ParsedAttributedObservation{JulesPerFeeCoin: &BigInt{}}, "Value", ErrMissing},
+		{"DecodeReply max", &DecodeReply{OnchainConfig: &OnchainConfig{Min: &BigInt{}}}, "OnchainConfig.Max", ErrMissing},
+
+		{"TransmitStatus", &TransmitStatus{ReportContext: validContext(), State: int32(types.TransmitFailed)}, "", nil},
+		{"TransmitStatus state", &TransmitStatus{ReportContext: validContext(), State: 0}, "State", ErrEnum{Name: "TransmitState", Value: 0}},
+		{"TxStatusReply", &TxStatusReply{State: int32(types.TxPending)}, "", nil},
+		{"TxStatusReply state", &TxStatusReply{State: int32(types.TxFailed) + 1}, "State", ErrEnum{Name: "TxState", Value: int32(types.TxFailed) + 1}},
+
+		{"RelayArgs", &RelayArgs{ExternalJobID: make([]byte, 16), ProviderType: int32(types.ProviderTypePlugin)}, "", nil},
+		{"RelayArgs external job ID", &RelayArgs{ExternalJobID: make([]byte, 32)}, "ExternalJobID", ErrLen{Len: 32, Want: 16}},
+		{"RelayArgs provider type", &RelayArgs{ExternalJobID: make([]byte, 16), ProviderType: -1}, "ProviderType", ErrEnum{Name: "ProviderType", Value: -1}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.Validate()
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			var invalid ErrInvalid
+			require.ErrorAs(t, err, &invalid)
+			assert.Equal(t, tt.field, invalid.Field)
+			assert.Equal(t, tt.wantErr, invalid.Err)
+			assert.True(t, errors.Is(err, tt.wantErr))
+		})
+	}
+}
+
+func TestValidate_enums(t *testing.T) {
+	// pb does not depend on types, so the known values are duplicated
+	assert.EqualValues(t, types.TransmitConfirmed, minTransmitState)
+	assert.EqualValues(t, types.TransmitFailed, maxTransmitState)
+	assert.EqualValues(t, types.TxPending, minTxState)
+	assert.EqualValues(t, types.TxFailed, maxTxState)
+	assert.EqualValues(t, types.ProviderTypePlugin, maxProviderType)
+	assert.Error(t, types.ProviderType(maxProviderType+1).Validate())
+}
@@ -0,0 +1,118 @@
+package loop
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+)
+
+// Host environment variables which are propagated to plugins by default.
+const (
+	EnvLogLevel        = "CL_LOG_LEVEL"
+	EnvTracingEndpoint = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	EnvFeatureFlags    = "CL_FEATURE_FLAGS"
+)
+
+// DefaultPropagateEnv is the default value of [LaunchConfig.PropagateEnv], which only applies with
+// [LaunchConfig.IsolateEnv].
+var DefaultPropagateEnv = []string{EnvLogLevel, EnvTracingEndpoint, EnvFeatureFlags}
+
+// LaunchConfig configures the environment of each plugin subprocess, independently of the cmd func.
+//
+// By default, plugins are started by go-plugin, and get the Env of their cmd, then Env, then the entire host environment,
+// which go-plugin appends, and which takes precedence over duplicate keys. With IsolateEnv, plugins do not inherit the
+// host environment: each one gets exactly the Env of its cmd, then the PropagateEnv copied from the host, then Env,
+// with later values taking precedence over duplicate keys.
+type LaunchConfig struct {
+	// Env holds additional environment variables to set.
+	Env map[string]string
+	// IsolateEnv optionally starts plugins without the host environment, except for PropagateEnv. The plugin process is
+	// then started by the host rather than by go-plugin, so AutoMTLS is not supported.
+	IsolateEnv bool
+	// PropagateEnv lists host environment variables to copy with IsolateEnv, if set. No others are copied.
+	PropagateEnv []string
+	// AllowedSecrets lists keys from Env and PropagateEnv which are permitted to match [logger.DefaultRedactKeys].
+	// Keys which look like secrets are otherwise rejected, to guard against passing them accidentally.
+	AllowedSecrets []string
+
+	// Dir optionally sets the working directory.
+	Dir string
+
+	// Stderr optionally receives a raw copy of the plugin's stderr, which is otherwise only logged.
+	Stderr io.Writer
+	// SyncStdout and SyncStderr optionally receive the plugin's os.Stdout and os.Stderr, as synced by go-plugin.
+	SyncStdout, SyncStderr io.Writer
+}
+
+// DefaultLaunchConfig inherits the host environment, or only propagates the [DefaultPropagateEnv] if IsolateEnv is set.
+var DefaultLaunchConfig = LaunchConfig{PropagateEnv: DefaultPropagateEnv}
+
+func (c LaunchConfig) validate() error {
+	var err error
+	allowed := make(map[string]struct{}, len(c.AllowedSecrets))
+	for _, k := range c.AllowedSecrets {
+		allowed[k] = struct{}{}
+	}
+	checkKey := func(k string) {
+		if k == "" || strings.ContainsAny(k, "=\x00") {
+			err = errors.Join(err, fmt.Errorf("invalid environment variable name: %q", k))
+			return
+		}
+		if _, ok := allowed[k]; !ok && logger.MatchKey(k, logger.DefaultRedactKeys...) {
+			err = errors.Join(err, fmt.Errorf("environment variable %q looks like a secret: must be included in AllowedSecrets", k))
+		}
+	}
+	for _, k := range sortedKeys(c.Env) {
+		checkKey(k)
+	}
+	for _, k := range c.PropagateEnv {
+		checkKey(k)
+	}
+	if c.Dir != "" {
+		if fi, serr := os.Stat(c.Dir); serr != nil {
+			err = errors.Join(err, fmt.Errorf("invalid Dir: %w", serr))
+		} else if !fi.IsDir() {
+			err = errors.Join(err, fmt.Errorf("invalid Dir: %s is not a directory", c.Dir))
+		}
+	}
+	return err
+}
+
+// apply updates cmd with the environment and working directory. With IsolateEnv, cmd.Env is always set, even if empty,
+// so that the host environment is not inherited.
+func (c LaunchConfig) apply(cmd *exec.Cmd) {
+	if c.Dir != "" {
+		cmd.Dir = c.Dir
+	}
+	if !c.IsolateEnv && len(c.Env) == 0 {
+		return
+	}
+	env := make([]string, 0, len(cmd.Env)+len(c.PropagateEnv)+len(c.Env))
+	env = append(env, cmd.Env...)
+	if c.IsolateEnv {
+		for _, k := range c.PropagateEnv {
+			if v, ok := os.LookupEnv(k); ok {
+				env = append(env, k+"="+v)
+			}
+		}
+	}
+	for _, k := range sortedKeys(c.Env) {
+		env = append(env, k+"="+c.Env[k])
+	}
+	cmd.Env = env
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
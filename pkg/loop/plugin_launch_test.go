@@ -0,0 +1,149 @@
+package loop
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+)
+
+func TestLaunchConfig_validate(t *testing.T) {
+	dir := t.TempDir()
+	for _, tt := range []struct {
+		name   string
+		cfg    LaunchConfig
+		errMsg string
+	}{
+		{name: "default", cfg: DefaultLaunchConfig},
+		{name: "full", cfg: LaunchConfig{
+			Env:            map[string]string{"FOO": "bar", "DB_PASSWORD": "hunter2"},
+			PropagateEnv:   []string{EnvLogLevel},
+			AllowedSecrets: []string{"DB_PASSWORD"},
+			Dir:            dir,
+		}},
+		{name: "secret", cfg: LaunchConfig{Env: map[string]string{"CL_API_KEY": "abc"}}, errMsg: `"CL_API_KEY" looks like a secret`},
+		{name: "propagate-secret", cfg: LaunchConfig{PropagateEnv: []string{"MNEMONIC"}}, errMsg: `"MNEMONIC" looks like a secret`},
+		{name: "invalid-key", cfg: LaunchConfig{Env: map[string]string{"A=B": "c"}}, errMsg: `invalid environment variable name: "A=B"`},
+		{name: "missing-dir", cfg: LaunchConfig{Dir: dir + "/missing"}, errMsg: "invalid Dir"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.validate()
+			if tt.errMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.errMsg)
+			}
+		})
+	}
+}
+
+func TestLaunchConfig_apply(t *testing.T) {
+	t.Setenv(EnvLogLevel, "debug")
+	cfg := LaunchConfig{
+		Env:          map[string]string{"B": "2", "A": "1"},
+		PropagateEnv: []string{EnvLogLevel, EnvFeatureFlags},
+		Dir:          "/tmp",
+	}
+	cmd := exec.Command("test")
+	cmd.Env = []string{"C=3"}
+	cfg.apply(cmd)
+	assert.Equal(t, "/tmp", cmd.Dir)
+	assert.Equal(t, []string{"C=3", "A=1", "B=2"}, cmd.Env, "PropagateEnv only applies with IsolateEnv")
+
+	cfg.IsolateEnv = true
+	cmd = exec.Command("test")
+	cmd.Env = []string{"C=3"}
+	cfg.apply(cmd)
+	assert.Equal(t, []string{"C=3", EnvLogLevel + "=debug", "A=1", "B=2"}, cmd.Env)
+
+	cmd = exec.Command("test")
+	LaunchConfig{}.apply(cmd)
+	assert.Nil(t, cmd.Env, "go-plugin appends the host environment")
+
+	cmd = exec.Command("test")
+	LaunchConfig{IsolateEnv: true}.apply(cmd)
+	assert.NotNil(t, cmd.Env, "must not inherit the host environment")
+}
+
+func TestLaunchConfig_inheritEnv(t *testing.T) {
+	t.Setenv("LOOP_TEST_UNLISTED", "host")
+	cmd := exec.Command(os.Args[0], "-test.run=TestHelperProcess", "--", "env")
+	cmd.Env = []string{"GO_WANT_HELPER_PROCESS=1"}
+	LaunchConfig{Env: map[string]string{"LOOP_TEST_CONFIG": "config"}}.apply(cmd)
+	var stderr bytes.Buffer
+
+	client := plugin.NewClient(&plugin.ClientConfig{
+		HandshakeConfig:  PluginMedianHandshakeConfig(),
+		AllowedProtocols: []plugin.Protocol{plugin.ProtocolGRPC},
+		Cmd:              cmd,
+		Stderr:           &stderr,
+		Logger:           hclogLogger(logger.Test(t), filepath.Base(cmd.Path), func() []any { return nil }),
+	})
+	_, err := client.Client()
+	require.Error(t, err, "the helper exits without serving")
+	client.Kill() // wait for stderr
+
+	env := strings.Split(stderr.String(), "\n")
+	assert.Contains(t, env, "GO_WANT_HELPER_PROCESS=1")
+	assert.Contains(t, env, "LOOP_TEST_CONFIG=config")
+	assert.Contains(t, env, "LOOP_TEST_UNLISTED=host")
+	assert.Contains(t, env, "PATH="+os.Getenv("PATH"))
+}
+
+func TestLaunchConfig_hostEnv(t *testing.T) {
+	t.Setenv(EnvLogLevel, "debug")
+	t.Setenv("LOOP_TEST_UNLISTED", "secret")
+	t.Setenv("LOOP_TEST_OVERRIDDEN", "host")
+	cfg := LaunchConfig{
+		Env:          map[string]string{"LOOP_TEST_OVERRIDDEN": "config"},
+		PropagateEnv: []string{EnvLogLevel, "LOOP_TEST_OVERRIDDEN"},
+		IsolateEnv:   true,
+	}
+	cmd := exec.Command(os.Args[0], "-test.run=TestHelperProcess", "--", "env")
+	cmd.Env = []string{"GO_WANT_HELPER_PROCESS=1"}
+	cfg.apply(cmd)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	cc := &plugin.ClientConfig{HandshakeConfig: PluginMedianHandshakeConfig(), AllowedProtocols: []plugin.Protocol{plugin.ProtocolGRPC}}
+	require.Error(t, startPlugin(logger.Test(t), cc, cmd, nil), "the helper exits without serving")
+
+	env := strings.Split(stderr.String(), "\n")
+	assert.Contains(t, env, "GO_WANT_HELPER_PROCESS=1")
+	assert.Contains(t, env, EnvLogLevel+"=debug")
+	assert.Contains(t, env, "LOOP_TEST_OVERRIDDEN=config")
+	assert.NotContains(t, env, "LOOP_TEST_OVERRIDDEN=host")
+	for _, kv := range env {
+		assert.False(t, strings.HasPrefix(kv, "LOOP_TEST_UNLISTED="), "host variable not listed in PropagateEnv")
+	}
+}
+
+func TestParseHandshake(t *testing.T) {
+	versions := map[int]plugin.PluginSet{1: {}}
+	cc := &plugin.ClientConfig{AllowedProtocols: []plugin.Protocol{plugin.ProtocolGRPC}}
+	rc, err := parseHandshake(cc, versions, "1|1|tcp|127.0.0.1:1234|grpc|\n", 42)
+	require.NoError(t, err)
+	assert.Equal(t, plugin.ProtocolGRPC, rc.Protocol)
+	assert.Equal(t, 1, rc.ProtocolVersion)
+	assert.Equal(t, "127.0.0.1:1234", rc.Addr.String())
+	assert.Equal(t, 42, rc.Pid)
+
+	for line, errMsg := range map[string]string{
+		"hello":                         "unrecognized plugin handshake",
+		"2|1|tcp|127.0.0.1:1234|grpc":   "incompatible core protocol version 2",
+		"1|2|tcp|127.0.0.1:1234|grpc":   "incompatible protocol version 2",
+		"1|1|udp|127.0.0.1:1234|grpc":   "unknown address type",
+		"1|1|tcp|127.0.0.1:1234|netrpc": `unsupported plugin protocol "netrpc"`,
+	} {
+		_, err := parseHandshake(cc, versions, line, 42)
+		assert.ErrorContains(t, err, errMsg, line)
+	}
+}
@@ -6,6 +6,9 @@ import (
 	"github.com/hashicorp/go-plugin"
 	"google.golang.org/grpc"
 
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	libocr "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
 	"github.com/smartcontractkit/chainlink-relay/pkg/loop/internal"
 	"github.com/smartcontractkit/chainlink-relay/pkg/types"
 )
@@ -29,18 +32,81 @@ func PluginMedianHandshakeConfig() plugin.HandshakeConfig {
 // Deprecated
 type ReportingPluginFactory = types.ReportingPluginFactory
 
+// JuelsPerFeeCoinSource is a juelsPerFeeCoin DataSource which applies a [types.JuelsPerFeeCoinStrategy].
+type JuelsPerFeeCoinSource = internal.JuelsPerFeeCoinSource
+
+// NewJuelsPerFeeCoinSource returns a [JuelsPerFeeCoinSource] for cfg, backed by pipeline when required.
+// Pass the result as juelsPerFeeCoin to [NewMedianService] to apply the strategy on the plugin side.
+func NewJuelsPerFeeCoinSource(cfg types.JuelsPerFeeCoinConfig, pipeline median.DataSource) (JuelsPerFeeCoinSource, error) {
+	return internal.NewJuelsPerFeeCoinSource(cfg, pipeline)
+}
+
+// ConcurrencyLimit limits the reporting plugin requests served concurrently by a median plugin. See [ServeMedian].
+type ConcurrencyLimit = internal.ConcurrencyLimit
+
+// BoundedDataSource is a DataSource which rejects observations outside of its [types.ObservationBounds].
+type BoundedDataSource = internal.BoundedDataSource
+
+// ErrObservationBounds is returned by a [BoundedDataSource] for observations outside of its bounds.
+type ErrObservationBounds = internal.ErrObservationBounds
+
+// ErrMaxQueryLength is returned by reporting plugins for queries longer than their MaxQueryLength limit.
+type ErrMaxQueryLength = internal.ErrMaxQueryLength
+
+// NewBoundedDataSource returns a [BoundedDataSource] which applies bounds to dataSource.
+// Pass the result as dataSource or juelsPerFeeCoin to [NewMedianService] to apply the bounds on both the host and the
+// plugin side.
+// To combine with a [JuelsPerFeeCoinSource], bound its pipeline.
+func NewBoundedDataSource(bounds types.ObservationBounds, dataSource median.DataSource) (BoundedDataSource, error) {
+	return internal.NewBoundedDataSource(bounds, dataSource)
+}
+
+// ErrObservationTimestampSkew is returned by reporting plugins from [NewSkewCheckedReportingPluginFactory] for
+// observation timestamps outside of the allowed skew.
+type ErrObservationTimestampSkew = internal.ErrObservationTimestampSkew
+
+// NewSkewCheckedReportingPluginFactory returns a median ReportingPluginFactory, whose reporting plugins check the
+// timestamps of their own observations against skew. Out of range timestamps are counted by the
+// loop_median_observation_timestamps_skewed metric, and either rejected with [ErrObservationTimestampSkew], or clamped
+// if [types.TimestampSkew.Clamp] is set. Reports are never checked, since they must be built deterministically.
+func NewSkewCheckedReportingPluginFactory(skew types.TimestampSkew, factory libocr.ReportingPluginFactory) (libocr.ReportingPluginFactory, error) {
+	return internal.NewSkewCheckedReportingPluginFactory(skew, factory)
+}
+
+// ContextWithProviderCachePath returns a copy of ctx carrying path, for [types.PluginMedian.NewMedianFactory]. The
+// plugin then caches the latest config details and transmission details of the provider in the file at path, so that
+// after a restart it can serve libocr's initial queries immediately, while the connection to the relayer warms up.
+// Cached transmission details are dropped when the config digest changes. Each job requires its own path, e.g. from
+// its plugin config.
+func ContextWithProviderCachePath(ctx context.Context, path string) context.Context {
+	return internal.ContextWithProviderCachePath(ctx, path)
+}
+
 type GRPCPluginMedian struct {
 	plugin.NetRPCUnsupportedPlugin
 
 	BrokerConfig
 
 	PluginServer types.PluginMedian
+	// ConcurrencyLimit optionally limits the reporting plugin requests served concurrently, across all factories.
+	ConcurrencyLimit ConcurrencyLimit
+	// CheckConfigDigest optionally fails new factories if the latest config digest of their provider does not match
+	// the digest computed by it, which indicates misconfigured relay args. See
+	// [github.com/smartcontractkit/chainlink-relay/pkg/loop/digestcheck.CheckLatest].
+	CheckConfigDigest bool
+	// ObservationTimestampSkew optionally checks the timestamps of observations made by the plugin, and rejects or
+	// clamps those outside of the allowed skew from the plugin clock. See [NewSkewCheckedReportingPluginFactory].
+	ObservationTimestampSkew types.TimestampSkew
 
 	pluginClient *internal.PluginMedianClient
 }
 
 func (p *GRPCPluginMedian) GRPCServer(broker *plugin.GRPCBroker, server *grpc.Server) error {
-	return internal.RegisterPluginMedianServer(server, broker, p.BrokerConfig, p.PluginServer)
+	return internal.RegisterPluginMedianServer(server, broker, p.BrokerConfig, p.PluginServer, internal.PluginMedianServerOptions{
+		ConcurrencyLimit:         p.ConcurrencyLimit,
+		CheckConfigDigest:        p.CheckConfigDigest,
+		ObservationTimestampSkew: p.ObservationTimestampSkew,
+	})
 }
 
 // GRPCClient implements [plugin.GRPCPlugin] and returns the pluginClient [types.PluginMedian], updated with the new broker and conn.
@@ -2,24 +2,46 @@ package loop_test
 
 import (
 	"context"
+	"encoding/binary"
+	"io"
+	"math/big"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"testing/quick"
 	"time"
 
 	"github.com/hashicorp/go-plugin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/status"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	libocr "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
 
 	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
 	"github.com/smartcontractkit/chainlink-relay/pkg/loop"
 	"github.com/smartcontractkit/chainlink-relay/pkg/loop/internal/test"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/pb"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/testutils"
 	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
 )
 
+// Tests running the PluginMedian suite are not parallel, since it checks for leaks.
 func TestPluginMedian(t *testing.T) {
-	t.Parallel()
-
 	stopCh := newStopCh(t)
-	testPlugin(t, loop.PluginMedianName, &loop.GRPCPluginMedian{PluginServer: test.StaticPluginMedian{}, BrokerConfig: loop.BrokerConfig{Logger: logger.Test(t), StopCh: stopCh}}, test.TestPluginMedian)
+	var resources loop.ResourceCounts
+	pm := test.PluginMedianTest{MedianProvider: &test.StaticMedianProvider{}, Leaks: []testutils.LeakOption{testutils.WithResources(&resources)}}
+	testPlugin(t, loop.PluginMedianName, &loop.GRPCPluginMedian{PluginServer: test.StaticPluginMedian{}, BrokerConfig: loop.BrokerConfig{Logger: logger.Test(t), StopCh: stopCh, Resources: &resources}}, pm.TestPluginMedian)
 
 	t.Run("proxy", func(t *testing.T) {
 		testPlugin(t, loop.PluginRelayerName, &loop.GRPCPluginRelayer{PluginServer: test.StaticPluginRelayer{}, BrokerConfig: loop.BrokerConfig{Logger: logger.Test(t), StopCh: stopCh}}, func(t *testing.T, pr loop.PluginRelayer) {
@@ -30,8 +52,1078 @@ func TestPluginMedian(t *testing.T) {
 	})
 }
 
-func TestPluginMedianExec(t *testing.T) {
+func TestPluginMedian_juelsPerFeeCoin(t *testing.T) {
+	stopCh := newStopCh(t)
+	for _, tt := range []struct {
+		name     string
+		cfg      types.JuelsPerFeeCoinConfig
+		pipeline median.DataSource
+	}{
+		{"fixed", test.FixedJuelsPerFeeCoinConfig(), nil},
+		{"pipeline", types.JuelsPerFeeCoinConfig{Strategy: types.JuelsPerFeeCoinPipeline}, test.StaticJuelsPerFeeCoinDataSource()},
+		{"cached", types.JuelsPerFeeCoinConfig{Strategy: types.JuelsPerFeeCoinCached, MaxStaleness: *utils.MustNewDuration(time.Minute)}, test.StaticJuelsPerFeeCoinDataSource()},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			juels, err := loop.NewJuelsPerFeeCoinSource(tt.cfg, tt.pipeline)
+			require.NoError(t, err)
+			pm := test.PluginMedianTest{MedianProvider: test.StaticMedianProvider{}, JuelsPerFeeCoin: juels}
+			testPlugin(t, loop.PluginMedianName, &loop.GRPCPluginMedian{PluginServer: test.StaticPluginMedian{}, BrokerConfig: loop.BrokerConfig{Logger: logger.Test(t), StopCh: stopCh}}, pm.TestPluginMedian)
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := loop.NewJuelsPerFeeCoinSource(types.JuelsPerFeeCoinConfig{Strategy: types.JuelsPerFeeCoinFixed}, nil)
+		require.Error(t, err)
+		_, err = loop.NewJuelsPerFeeCoinSource(types.JuelsPerFeeCoinConfig{Strategy: types.JuelsPerFeeCoinCached}, test.StaticJuelsPerFeeCoinDataSource())
+		require.Error(t, err)
+		_, err = loop.NewJuelsPerFeeCoinSource(types.JuelsPerFeeCoinConfig{Strategy: "unknown"}, test.StaticJuelsPerFeeCoinDataSource())
+		require.Error(t, err)
+	})
+}
+
+func TestPluginMedian_observationBounds(t *testing.T) {
+	stopCh := newStopCh(t)
+	t.Run("within", func(t *testing.T) {
+		ds, err := loop.NewBoundedDataSource(types.ObservationBounds{Min: big.NewInt(1)}, test.StaticDataSource())
+		require.NoError(t, err)
+		juels, err := loop.NewBoundedDataSource(types.ObservationBounds{Max: new(big.Int).Lsh(big.NewInt(1), 128)}, test.StaticJuelsPerFeeCoinDataSource())
+		require.NoError(t, err)
+		pm := test.PluginMedianTest{MedianProvider: test.StaticMedianProvider{}, DataSource: ds, JuelsPerFeeCoin: juels}
+		testPlugin(t, loop.PluginMedianName, &loop.GRPCPluginMedian{PluginServer: test.StaticPluginMedian{}, BrokerConfig: loop.BrokerConfig{Logger: logger.Test(t), StopCh: stopCh}}, pm.TestPluginMedian)
+	})
+	t.Run("invalid", func(t *testing.T) {
+		_, err := loop.NewBoundedDataSource(types.ObservationBounds{Min: big.NewInt(2), Max: big.NewInt(1)}, test.StaticDataSource())
+		require.Error(t, err)
+	})
+	t.Run("outside", func(t *testing.T) {
+		bounds := types.ObservationBounds{Min: big.NewInt(0), Max: big.NewInt(100)}
+		ds, err := loop.NewBoundedDataSource(bounds, constantDataSource{big.NewInt(-1)})
+		require.NoError(t, err)
+		juelsPipeline, err := loop.NewBoundedDataSource(bounds, constantDataSource{big.NewInt(101)})
+		require.NoError(t, err)
+		juels, err := loop.NewJuelsPerFeeCoinSource(types.JuelsPerFeeCoinConfig{Strategy: types.JuelsPerFeeCoinPipeline}, juelsPipeline)
+		require.NoError(t, err)
+
+		// host side
+		_, err = ds.Observe(utils.Context(t), libocr.ReportTimestamp{})
+		var boundsErr loop.ErrObservationBounds
+		require.ErrorAs(t, err, &boundsErr)
+		assert.Equal(t, big.NewInt(-1), boundsErr.Value)
+
+		// served by the host
+		plugin := &observingPluginMedian{errs: make(chan error, 2)}
+		testPlugin(t, loop.PluginMedianName, &loop.GRPCPluginMedian{PluginServer: plugin, BrokerConfig: loop.BrokerConfig{Logger: logger.Test(t), StopCh: stopCh}}, func(t *testing.T, p loop.PluginMedian) {
+			factory, err := p.NewMedianFactory(utils.Context(t), test.StaticMedianProvider{}, ds, juels, &test.StaticErrorLog{})
+			require.NoError(t, err)
+			test.TestReportingPluginFactory(t, factory)
+		})
+		for _, exp := range []int64{-1, 101} {
+			err := <-plugin.errs
+			require.Equal(t, codes.OutOfRange, status.Code(err), "unexpected error: %v", err)
+			assert.ErrorContains(t, err, loop.ErrObservationBounds{Value: big.NewInt(exp), Bounds: bounds}.Error())
+		}
+	})
+}
+
+type constantDataSource struct{ value *big.Int }
+
+func (c constantDataSource) Observe(context.Context, libocr.ReportTimestamp) (*big.Int, error) {
+	return c.value, nil
+}
+
+// observingPluginMedian reports the errors from observing each DataSource, before delegating to
+// [test.StaticPluginMedian] with static DataSources.
+type observingPluginMedian struct {
+	errs chan error
+}
+
+func (o *observingPluginMedian) NewMedianFactory(ctx context.Context, provider types.MedianProvider, dataSource, juelsPerFeeCoin median.DataSource, errorLog types.ErrorLog) (types.ReportingPluginFactory, error) {
+	for _, ds := range []median.DataSource{dataSource, juelsPerFeeCoin} {
+		_, err := ds.Observe(ctx, libocr.ReportTimestamp{})
+		o.errs <- err
+	}
+	return test.StaticPluginMedian{}.NewMedianFactory(ctx, provider, test.StaticDataSource(), test.StaticJuelsPerFeeCoinDataSource(), errorLog)
+}
+
+func TestPluginMedian_concurrencyLimit(t *testing.T) {
+	t.Parallel()
+
+	stopCh := newStopCh(t)
+	plugin := &blockingPluginMedian{started: make(chan struct{}, 1), unblock: make(chan struct{})}
+	limit := loop.ConcurrencyLimit{Max: 1, QueueTimeout: 100 * time.Millisecond}
+	testPlugin(t, loop.PluginMedianName, &loop.GRPCPluginMedian{PluginServer: plugin, ConcurrencyLimit: limit, BrokerConfig: loop.BrokerConfig{Logger: logger.Test(t), StopCh: stopCh}}, func(t *testing.T, p loop.PluginMedian) {
+		ctx := utils.Context(t)
+		newPlugin := func() libocr.ReportingPlugin {
+			factory, err := p.NewMedianFactory(ctx, test.StaticMedianProvider{}, test.StaticDataSource(), test.StaticJuelsPerFeeCoinDataSource(), &test.StaticErrorLog{})
+			require.NoError(t, err)
+			rp, _, err := factory.NewReportingPlugin(libocr.ReportingPluginConfig{})
+			require.NoError(t, err)
+			t.Cleanup(func() { assert.NoError(t, rp.Close()) })
+			return rp
+		}
+		rp, other := newPlugin(), newPlugin()
+
+		blocked := make(chan error, 1)
+		go func() {
+			_, err := rp.Observation(ctx, libocr.ReportTimestamp{}, nil)
+			blocked <- err
+		}()
+		<-plugin.started
+
+		_, err := rp.Observation(ctx, libocr.ReportTimestamp{}, nil)
+		require.Equal(t, codes.ResourceExhausted, status.Code(err), "unexpected error: %v", err)
+		// the limit is shared by all factories, so it does not grow with the number of jobs
+		_, err = other.Observation(ctx, libocr.ReportTimestamp{}, nil)
+		require.Equal(t, codes.ResourceExhausted, status.Code(err), "unexpected error: %v", err)
+
+		close(plugin.unblock)
+		require.NoError(t, <-blocked)
+		_, err = rp.Observation(ctx, libocr.ReportTimestamp{}, nil)
+		require.NoError(t, err)
+	})
+}
+
+// blockingPluginMedian serves reporting plugins which block in Observation until unblock is closed.
+type blockingPluginMedian struct {
+	started chan struct{}
+	unblock chan struct{}
+}
+
+func (b *blockingPluginMedian) NewMedianFactory(ctx context.Context, provider types.MedianProvider, dataSource, juelsPerFeeCoin median.DataSource, errorLog types.ErrorLog) (types.ReportingPluginFactory, error) {
+	factory, err := test.StaticPluginMedian{}.NewMedianFactory(ctx, provider, dataSource, juelsPerFeeCoin, errorLog)
+	if err != nil {
+		return nil, err
+	}
+	return &blockingFactory{ReportingPluginFactory: factory, plugin: b}, nil
+}
+
+type blockingFactory struct {
+	types.ReportingPluginFactory
+	plugin *blockingPluginMedian
+}
+
+func (b *blockingFactory) NewReportingPlugin(libocr.ReportingPluginConfig) (libocr.ReportingPlugin, libocr.ReportingPluginInfo, error) {
+	return &blockingReportingPlugin{plugin: b.plugin}, libocr.ReportingPluginInfo{Name: "blocking"}, nil
+}
+
+type blockingReportingPlugin struct {
+	libocr.ReportingPlugin // only Observation and Close are implemented
+	plugin                 *blockingPluginMedian
+}
+
+func (b *blockingReportingPlugin) Observation(ctx context.Context, _ libocr.ReportTimestamp, _ libocr.Query) (libocr.Observation, error) {
+	b.plugin.started <- struct{}{}
+	select {
+	case <-b.plugin.unblock:
+		return libocr.Observation{}, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (b *blockingReportingPlugin) Close() error { return nil }
+
+func TestPluginMedian_retry(t *testing.T) {
+	t.Parallel()
+
+	stopCh := newStopCh(t)
+	plugin := &slowPluginMedian{release: make(chan struct{})}
+	testPlugin(t, loop.PluginMedianName, &loop.GRPCPluginMedian{PluginServer: plugin, BrokerConfig: loop.BrokerConfig{Logger: logger.Test(t), StopCh: stopCh}}, func(t *testing.T, p loop.PluginMedian) {
+		factory, err := p.NewMedianFactory(utils.Context(t), test.StaticMedianProvider{}, test.StaticDataSource(), test.StaticJuelsPerFeeCoinDataSource(), &test.StaticErrorLog{})
+		require.NoError(t, err)
+		t.Cleanup(func() { assert.NoError(t, factory.Close()) })
+
+		require.ErrorIs(t, factory.Ready(), context.DeadlineExceeded, "first attempt times out")
+		close(plugin.release) // the plugin creates the factory anyway
+
+		test.TestReportingPluginFactory(t, factory)
+		assert.Equal(t, int32(1), plugin.calls.Load(), "retry must return the existing factory")
+	})
+}
+
+// slowPluginMedian blocks creating factories until release is closed, and fails if the context expires first.
+type slowPluginMedian struct {
+	release chan struct{}
+	calls   atomic.Int32
+}
+
+func (s *slowPluginMedian) NewMedianFactory(ctx context.Context, provider types.MedianProvider, dataSource, juelsPerFeeCoin median.DataSource, errorLog types.ErrorLog) (types.ReportingPluginFactory, error) {
+	s.calls.Add(1)
+	select {
+	case <-s.release:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	return test.StaticPluginMedian{}.NewMedianFactory(ctx, provider, dataSource, juelsPerFeeCoin, errorLog)
+}
+
+func TestPluginMedian_query(t *testing.T) {
+	t.Parallel()
+
+	const maxQueryLength = 8
+	stopCh := newStopCh(t)
+	plugin := &queryPluginMedian{maxQueryLength: maxQueryLength}
+	testPlugin(t, loop.PluginMedianName, &loop.GRPCPluginMedian{PluginServer: plugin, BrokerConfig: loop.BrokerConfig{Logger: logger.Test(t), StopCh: stopCh}}, func(t *testing.T, p loop.PluginMedian) {
+		ctx := utils.Context(t)
+		factory, err := p.NewMedianFactory(ctx, test.StaticMedianProvider{}, test.StaticDataSource(), test.StaticJuelsPerFeeCoinDataSource(), &test.StaticErrorLog{})
+		require.NoError(t, err)
+		rp, rpi, err := factory.NewReportingPlugin(libocr.ReportingPluginConfig{N: 4})
+		require.NoError(t, err)
+		t.Cleanup(func() { assert.NoError(t, rp.Close()) })
+		require.Equal(t, maxQueryLength, rpi.Limits.MaxQueryLength)
+
+		plugin.query.Store([]byte("in-range"))
+		q, err := rp.Query(ctx, libocr.ReportTimestamp{})
+		require.NoError(t, err)
+		require.Equal(t, "in-range", string(q))
+		o, err := rp.Observation(ctx, libocr.ReportTimestamp{}, q)
+		require.NoError(t, err)
+		require.Equal(t, "in-range", string(o), "query should be passed through to Observation")
+		_, r, err := rp.Report(ctx, libocr.ReportTimestamp{}, q, nil)
+		require.NoError(t, err)
+		require.Equal(t, "in-range", string(r), "query should be passed through to Report")
+
+		plugin.query.Store([]byte("too-long!"))
+		_, err = rp.Query(ctx, libocr.ReportTimestamp{})
+		require.ErrorContains(t, err, "exceeds MaxQueryLength", "plugin side")
+
+		long := libocr.Query("too-long!")
+		var lenErr loop.ErrMaxQueryLength
+		_, err = rp.Observation(ctx, libocr.ReportTimestamp{}, long)
+		require.ErrorAs(t, err, &lenErr, "host side")
+		_, _, err = rp.Report(ctx, libocr.ReportTimestamp{}, long, nil)
+		require.ErrorAs(t, err, &lenErr, "host side")
+	})
+}
+
+// queryPluginMedian serves reporting plugins which use the Query phase. Observations and reports echo the query.
+type queryPluginMedian struct {
+	maxQueryLength int
+	query          atomic.Value // []byte
+}
+
+func (q *queryPluginMedian) NewMedianFactory(context.Context, types.MedianProvider, median.DataSource, median.DataSource, types.ErrorLog) (types.ReportingPluginFactory, error) {
+	return queryFactory{q}, nil
+}
+
+type queryFactory struct {
+	plugin *queryPluginMedian
+}
+
+func (f queryFactory) Name() string { return "queryFactory" }
+
+func (f queryFactory) Start(context.Context) error { return nil }
+
+func (f queryFactory) Close() error { return nil }
+
+func (f queryFactory) Ready() error { return nil }
+
+func (f queryFactory) HealthReport() map[string]error { return map[string]error{f.Name(): nil} }
+
+func (f queryFactory) NewReportingPlugin(libocr.ReportingPluginConfig) (libocr.ReportingPlugin, libocr.ReportingPluginInfo, error) {
+	return queryReportingPlugin{f.plugin}, libocr.ReportingPluginInfo{
+		Name:   "query",
+		Limits: libocr.ReportingPluginLimits{MaxQueryLength: f.plugin.maxQueryLength, MaxObservationLength: 16, MaxReportLength: 16},
+	}, nil
+}
+
+type queryReportingPlugin struct {
+	plugin *queryPluginMedian
+}
+
+func (q queryReportingPlugin) Query(context.Context, libocr.ReportTimestamp) (libocr.Query, error) {
+	return q.plugin.query.Load().([]byte), nil
+}
+
+func (q queryReportingPlugin) Observation(_ context.Context, _ libocr.ReportTimestamp, query libocr.Query) (libocr.Observation, error) {
+	return libocr.Observation(query), nil
+}
+
+func (q queryReportingPlugin) Report(_ context.Context, _ libocr.ReportTimestamp, query libocr.Query, _ []libocr.AttributedObservation) (bool, libocr.Report, error) {
+	return true, libocr.Report(query), nil
+}
+
+func (q queryReportingPlugin) ShouldAcceptFinalizedReport(context.Context, libocr.ReportTimestamp, libocr.Report) (bool, error) {
+	return true, nil
+}
+
+func (q queryReportingPlugin) ShouldTransmitAcceptedReport(context.Context, libocr.ReportTimestamp, libocr.Report) (bool, error) {
+	return true, nil
+}
+
+func (q queryReportingPlugin) Close() error { return nil }
+
+func TestPluginMedian_contractTransmitter(t *testing.T) {
+	t.Parallel()
+
+	stopCh := newStopCh(t)
+	first, second := &countingTransmitter{account: "first"}, &countingTransmitter{account: "second"}
+	roundRobin, err := loop.NewRoundRobinTransmitter(first, second)
+	require.NoError(t, err)
+	_, err = loop.NewRoundRobinTransmitter()
+	require.Error(t, err)
+
+	plugin := checkingPluginMedian(func(ctx context.Context, provider types.MedianProvider) {
+		ct := provider.ContractTransmitter()
+		for i := 0; i < 3; i++ {
+			account, err := ct.FromAccount()
+			assert.NoError(t, err)
+			assert.Equal(t, libocr.Account("first"), account)
+		}
+		assert.Equal(t, int64(1), first.fromAccountCalls.Load(), "FromAccount should be cached")
+		if cache, ok := ct.(loop.FromAccountCache); assert.True(t, ok) {
+			cache.InvalidateFromAccount()
+			_, err := ct.FromAccount()
+			assert.NoError(t, err)
+			assert.Equal(t, int64(2), first.fromAccountCalls.Load(), "FromAccount should be resolved again")
+		}
+
+		rat, ok := ct.(types.ReportAccountTransmitter)
+		if !assert.True(t, ok) {
+			return
+		}
+		for round, expected := range []libocr.Account{"first", "second", "first"} {
+			rc := libocr.ReportContext{ReportTimestamp: libocr.ReportTimestamp{Epoch: 1, Round: uint8(round)}}
+			account, err := rat.FromAccountForReport(ctx, rc)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, account)
+			assert.NoError(t, ct.Transmit(ctx, rc, nil, nil))
+		}
+		assert.Equal(t, int64(2), first.transmits.Load())
+		assert.Equal(t, int64(1), second.transmits.Load())
+
+		if sst, ok := ct.(types.TransmitStatusTransmitter); assert.True(t, ok) {
+			err := sst.SubscribeTransmitStatus(ctx, func(types.TransmitStatus) error { return nil })
+			assert.Equal(t, codes.Unimplemented, status.Code(err), "neither transmitter reports statuses")
+		}
+	})
+	provider := transmitterMedianProvider{ct: roundRobin}
+	testPlugin(t, loop.PluginMedianName, &loop.GRPCPluginMedian{PluginServer: plugin, BrokerConfig: loop.BrokerConfig{Logger: logger.Test(t), StopCh: stopCh}}, func(t *testing.T, p loop.PluginMedian) {
+		_, err := p.NewMedianFactory(utils.Context(t), provider, test.StaticDataSource(), test.StaticJuelsPerFeeCoinDataSource(), &test.StaticErrorLog{})
+		require.NoError(t, err)
+	})
+}
+
+func TestPluginMedian_notifyNewConfig(t *testing.T) {
+	t.Parallel()
+
+	stopCh := newStopCh(t)
+	tracker := &notifyingTracker{ch: make(chan struct{})}
+	plugin := checkingPluginMedian(func(ctx context.Context, provider types.MedianProvider) {
+		ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		notify := provider.ContractConfigTracker().Notify()
+		for i := 0; i < 3; i++ {
+			select {
+			case tracker.ch <- struct{}{}:
+			case <-ctx.Done():
+				t.Errorf("notification %d was not sent: %v", i, ctx.Err())
+				return
+			}
+			select {
+			case <-notify:
+			case <-ctx.Done():
+				t.Errorf("notification %d was not received: %v", i, ctx.Err())
+				return
+			}
+		}
+	})
+	provider := trackerMedianProvider{cct: tracker}
+	testPlugin(t, loop.PluginMedianName, &loop.GRPCPluginMedian{PluginServer: plugin, BrokerConfig: loop.BrokerConfig{Logger: logger.Test(t), StopCh: stopCh}}, func(t *testing.T, p loop.PluginMedian) {
+		factory, err := p.NewMedianFactory(utils.Context(t), provider, test.StaticDataSource(), test.StaticJuelsPerFeeCoinDataSource(), &test.StaticErrorLog{})
+		require.NoError(t, err)
+		require.NoError(t, factory.Close()) // connects first
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		t.Parallel()
+
+		stopCh := newStopCh(t)
+		lggr, observed := logger.TestObserved(t, zapcore.DebugLevel)
+		plugin := checkingPluginMedian(func(ctx context.Context, provider types.MedianProvider) {
+			notify := provider.ContractConfigTracker().Notify()
+			assert.Eventually(t, func() bool {
+				return observed.FilterMessageSnippet("does not notify").Len() > 0
+			}, 5*time.Second, 10*time.Millisecond)
+			select {
+			case <-notify:
+				t.Error("unexpected notification")
+			default:
+			}
+		})
+		testPlugin(t, loop.PluginMedianName, &loop.GRPCPluginMedian{PluginServer: plugin, BrokerConfig: loop.BrokerConfig{Logger: lggr, StopCh: stopCh}}, func(t *testing.T, p loop.PluginMedian) {
+			factory, err := p.NewMedianFactory(utils.Context(t), test.StaticMedianProvider{}, test.StaticDataSource(), test.StaticJuelsPerFeeCoinDataSource(), &test.StaticErrorLog{})
+			require.NoError(t, err)
+			require.NoError(t, factory.Close()) // connects first
+		})
+	})
+}
+
+type trackerMedianProvider struct {
+	test.StaticMedianProvider
+	cct libocr.ContractConfigTracker
+}
+
+func (t trackerMedianProvider) ContractConfigTracker() libocr.ContractConfigTracker { return t.cct }
+
+// notifyingTracker notifies for each send on ch.
+type notifyingTracker struct {
+	libocr.ContractConfigTracker // only Notify is implemented
+	ch                           chan struct{}
+}
+
+func (n *notifyingTracker) Notify() <-chan struct{} { return n.ch }
+
+func TestPluginMedian_reportContext(t *testing.T) {
+	t.Parallel()
+
+	stopCh := newStopCh(t)
+	transmitter := &recordingTransmitter{}
+	valid := func() *pb.ReportContext {
+		return &pb.ReportContext{
+			ReportTimestamp: &pb.ReportTimestamp{ConfigDigest: make([]byte, 32), Epoch: 1, Round: 2},
+			ExtraHash:       make([]byte, 32),
+		}
+	}
+	plugin := checkingPluginMedian(func(ctx context.Context, provider types.MedianProvider) {
+		ct := provider.ContractTransmitter()
+		roundTrip := func(digest libocr.ConfigDigest, epoch uint32, round uint8, extraHash [32]byte) bool {
+			rc := libocr.ReportContext{
+				ReportTimestamp: libocr.ReportTimestamp{ConfigDigest: digest, Epoch: epoch, Round: round},
+				ExtraHash:       extraHash,
+			}
+			return assert.NoError(t, ct.Transmit(ctx, rc, nil, nil)) && assert.Equal(t, rc, transmitter.last)
+		}
+		assert.NoError(t, quick.Check(roundTrip, nil))
+
+		grpcProvider, ok := provider.(loop.GRPCClientConn)
+		if !assert.True(t, ok) {
+			return
+		}
+		client := pb.NewContractTransmitterClient(grpcProvider.ClientConn())
+		for _, tt := range []struct {
+			name   string
+			modify func(*pb.ReportContext) *pb.ReportContext
+			err    string
+		}{
+			{"valid", func(rc *pb.ReportContext) *pb.ReportContext { return rc }, ""},
+			{"missing", func(*pb.ReportContext) *pb.ReportContext { return nil }, "invalid ReportContext: missing"},
+			{"missing timestamp", func(rc *pb.ReportContext) *pb.ReportContext {
+				rc.ReportTimestamp = nil
+				return rc
+			}, "invalid ReportContext ReportTimestamp: missing"},
+			{"short digest", func(rc *pb.ReportContext) *pb.ReportContext {
+				rc.ReportTimestamp.ConfigDigest = rc.ReportTimestamp.ConfigDigest[:31]
+				return rc
+			}, "invalid ReportContext ReportTimestamp.ConfigDigest"},
+			{"long digest", func(rc *pb.ReportContext) *pb.ReportContext {
+				rc.ReportTimestamp.ConfigDigest = append(rc.ReportTimestamp.ConfigDigest, 0)
+				return rc
+			}, "invalid ReportContext ReportTimestamp.ConfigDigest"},
+			{"round", func(rc *pb.ReportContext) *pb.ReportContext {
+				rc.ReportTimestamp.Round = 256
+				return rc
+			}, "invalid ReportContext ReportTimestamp.Round"},
+			{"short extra hash", func(rc *pb.ReportContext) *pb.ReportContext {
+				rc.ExtraHash = nil
+				return rc
+			}, "invalid ReportContext ExtraHash"},
+			{"long extra hash", func(rc *pb.ReportContext) *pb.ReportContext {
+				rc.ExtraHash = append(rc.ExtraHash, 0)
+				return rc
+			}, "invalid ReportContext ExtraHash"},
+		} {
+			_, err := client.Transmit(ctx, &pb.TransmitRequest{ReportContext: tt.modify(valid())})
+			if tt.err == "" {
+				assert.NoError(t, err, tt.name)
+			} else {
+				assert.ErrorContains(t, err, tt.err, tt.name)
+			}
+		}
+	})
+	provider := transmitterMedianProvider{ct: transmitter}
+	testPlugin(t, loop.PluginMedianName, &loop.GRPCPluginMedian{PluginServer: plugin, BrokerConfig: loop.BrokerConfig{Logger: logger.Test(t), StopCh: stopCh}}, func(t *testing.T, p loop.PluginMedian) {
+		factory, err := p.NewMedianFactory(utils.Context(t), provider, test.StaticDataSource(), test.StaticJuelsPerFeeCoinDataSource(), &test.StaticErrorLog{})
+		require.NoError(t, err)
+		require.NoError(t, factory.Close()) // connects first
+	})
+}
+
+func TestPluginMedian_configDigestPrefix(t *testing.T) {
+	t.Parallel()
+
+	stopCh := newStopCh(t)
+	digest := func(prefix libocr.ConfigDigestPrefix) (d libocr.ConfigDigest) {
+		binary.BigEndian.PutUint16(d[:2], uint16(prefix))
+		d[31] = 1
+		return
+	}
+	for _, tt := range []struct {
+		name     string
+		digester prefixDigester
+		err      string
+	}{
+		{"valid", prefixDigester{99, []libocr.ConfigDigestPrefix{99}, digest(99)}, ""},
+		{"multiple", prefixDigester{99, []libocr.ConfigDigestPrefix{99, 100}, digest(100)}, ""},
+		{"mismatched", prefixDigester{99, []libocr.ConfigDigestPrefix{99, 100}, digest(101)}, "does not match ConfigDigestPrefix"},
+		{"reserved", prefixDigester{99, []libocr.ConfigDigestPrefix{99, 0}, digest(99)}, "reserved"},
+		{"missing", prefixDigester{99, []libocr.ConfigDigestPrefix{100}, digest(100)}, "missing ConfigDigestPrefix"},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			// host side
+			err := loop.CheckConfigDigestPrefix(tt.digester, tt.digester.digest)
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.err)
+			}
+
+			// plugin side
+			plugin := checkingPluginMedian(func(ctx context.Context, provider types.MedianProvider) {
+				ocd := provider.OffchainConfigDigester()
+				got, err := ocd.ConfigDigest(libocr.ContractConfig{})
+				if tt.err != "" {
+					assert.ErrorContains(t, err, tt.err)
+					return
+				}
+				assert.NoError(t, err)
+				assert.Equal(t, tt.digester.digest, got)
+				if mp, ok := ocd.(types.MultiPrefixConfigDigester); assert.True(t, ok) {
+					prefixes, err := mp.ConfigDigestPrefixes()
+					assert.NoError(t, err)
+					assert.Equal(t, tt.digester.prefixes, prefixes)
+				}
+			})
+			provider := digesterMedianProvider{ocd: tt.digester}
+			testPlugin(t, loop.PluginMedianName, &loop.GRPCPluginMedian{PluginServer: plugin, BrokerConfig: loop.BrokerConfig{Logger: logger.Test(t), StopCh: stopCh}}, func(t *testing.T, p loop.PluginMedian) {
+				_, err := p.NewMedianFactory(utils.Context(t), provider, test.StaticDataSource(), test.StaticJuelsPerFeeCoinDataSource(), &test.StaticErrorLog{})
+				require.NoError(t, err)
+			})
+		})
+	}
+}
+
+func TestPluginMedian_featureFlags(t *testing.T) {
 	t.Parallel()
+
+	stopCh := newStopCh(t)
+	flags := types.FeatureFlags{"batchObserve": "true", "cacheTTL": "1m", "maxBatch": "bad"}
+	plugin := checkingPluginMedian(func(ctx context.Context, provider types.MedianProvider) {
+		got := types.FeatureFlagsFromContext(ctx)
+		assert.Equal(t, flags, got)
+
+		batch, err := got.Bool("batchObserve", false)
+		assert.NoError(t, err)
+		assert.True(t, batch)
+		ttl, err := got.Duration("cacheTTL", time.Second)
+		assert.NoError(t, err)
+		assert.Equal(t, time.Minute, ttl)
+		maxBatch, err := got.Int("maxBatch", 10)
+		assert.Error(t, err)
+		assert.Equal(t, int64(10), maxBatch)
+		assert.Equal(t, "default", got.String("unknown", "default"))
+	})
+	testPlugin(t, loop.PluginMedianName, &loop.GRPCPluginMedian{PluginServer: plugin, BrokerConfig: loop.BrokerConfig{Logger: logger.Test(t), StopCh: stopCh}}, func(t *testing.T, p loop.PluginMedian) {
+		ctx := types.ContextWithFeatureFlags(utils.Context(t), flags)
+		_, err := p.NewMedianFactory(ctx, test.StaticMedianProvider{}, test.StaticDataSource(), test.StaticJuelsPerFeeCoinDataSource(), &test.StaticErrorLog{})
+		require.NoError(t, err)
+	})
+}
+
+func TestPluginMedian_extendedObservations(t *testing.T) {
+	t.Parallel()
+
+	observations := []types.ParsedAttributedObservationExt{{
+		ParsedAttributedObservation: median.ParsedAttributedObservation{Timestamp: 123, Value: big.NewInt(31), JuelsPerFeeCoin: big.NewInt(54), Observer: 9},
+		GasPriceSubunits:            big.NewInt(1000),
+		Metadata:                    map[string][]byte{"l1Fee": {7}},
+	}}
+	for _, tt := range []struct {
+		name  string
+		flags types.FeatureFlags
+		err   error
+	}{
+		{"enabled", types.FeatureFlags{types.FeatureFlagExtendedObservations: "true"}, nil},
+		{"disabled", nil, types.ErrExtendedObservationsUnsupported},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			stopCh := newStopCh(t)
+			codec := &extReportCodec{ReportCodec: test.StaticMedianProvider{}.ReportCodec()}
+			plugin := checkingPluginMedian(func(ctx context.Context, provider types.MedianProvider) {
+				ext, ok := provider.ReportCodec().(types.ReportCodecExt)
+				if !assert.True(t, ok) {
+					return
+				}
+				report, err := ext.BuildReportExt(ctx, observations)
+				if tt.err != nil {
+					assert.ErrorIs(t, err, tt.err)
+					return
+				}
+				assert.NoError(t, err)
+				assert.Equal(t, libocr.Report("extended"), report)
+			})
+			provider := extMedianProvider{codec: codec}
+			testPlugin(t, loop.PluginMedianName, &loop.GRPCPluginMedian{PluginServer: plugin, BrokerConfig: loop.BrokerConfig{Logger: logger.Test(t), StopCh: stopCh}}, func(t *testing.T, p loop.PluginMedian) {
+				ctx := types.ContextWithFeatureFlags(utils.Context(t), tt.flags)
+				factory, err := p.NewMedianFactory(ctx, provider, test.StaticDataSource(), test.StaticJuelsPerFeeCoinDataSource(), &test.StaticErrorLog{})
+				require.NoError(t, err)
+				require.NoError(t, factory.Close()) // connects first
+			})
+			if tt.err == nil {
+				assert.Equal(t, observations, codec.observations)
+			}
+		})
+	}
+}
+
+// extMedianProvider is a [test.StaticMedianProvider] with a fee-aware ReportCodec.
+type extMedianProvider struct {
+	test.StaticMedianProvider
+	codec *extReportCodec
+}
+
+func (p extMedianProvider) ReportCodec() median.ReportCodec { return p.codec }
+
+// extReportCodec implements [types.ReportCodecExt] by recording the observations.
+type extReportCodec struct {
+	median.ReportCodec
+	observations []types.ParsedAttributedObservationExt
+}
+
+func (c *extReportCodec) BuildReportExt(ctx context.Context, observations []types.ParsedAttributedObservationExt) (libocr.Report, error) {
+	c.observations = observations
+	return libocr.Report("extended"), nil
+}
+
+func TestPluginMedian_providerCache(t *testing.T) {
+	t.Parallel()
+
+	stopCh := newStopCh(t)
+	path := filepath.Join(t.TempDir(), "provider.json")
+	plugin := checkingPluginMedian(func(ctx context.Context, provider types.MedianProvider) {
+		_, _, err := provider.ContractConfigTracker().LatestConfigDetails(ctx)
+		if assert.NoError(t, err) {
+			assert.FileExists(t, path, "cached by the plugin")
+		}
+	})
+	testPlugin(t, loop.PluginMedianName, &loop.GRPCPluginMedian{PluginServer: plugin, BrokerConfig: loop.BrokerConfig{Logger: logger.Test(t), StopCh: stopCh}}, func(t *testing.T, p loop.PluginMedian) {
+		ctx := loop.ContextWithProviderCachePath(utils.Context(t), path)
+		factory, err := p.NewMedianFactory(ctx, test.StaticMedianProvider{}, test.StaticDataSource(), test.StaticJuelsPerFeeCoinDataSource(), &test.StaticErrorLog{})
+		require.NoError(t, err)
+		require.NoError(t, factory.Close()) // connects first
+		assert.FileExists(t, path)
+	})
+}
+
+func TestPluginMedian_errorLog(t *testing.T) {
+	t.Parallel()
+
+	stopCh := newStopCh(t)
+	plugin := errorLogPluginMedian(func(ctx context.Context, errorLog types.ErrorLog) {
+		r, ok := errorLog.(types.ResolvableErrorLog)
+		if !assert.True(t, ok, "ResolvableErrorLog") {
+			return
+		}
+		assert.NoError(t, r.SaveKeyedError(ctx, "feed", "stale 1"))
+		assert.NoError(t, r.SaveKeyedError(ctx, "rpc", "unreachable"))
+		assert.NoError(t, r.SaveKeyedError(ctx, "feed", "stale 2"))
+		assert.NoError(t, r.ResolveError(ctx, "rpc"))
+		assert.NoError(t, r.ResolveError(ctx, "missing"))
+		assert.NoError(t, r.SaveError(ctx, "plain"))
+	})
+	testPlugin(t, loop.PluginMedianName, &loop.GRPCPluginMedian{PluginServer: plugin, BrokerConfig: loop.BrokerConfig{Logger: logger.Test(t), StopCh: stopCh}}, func(t *testing.T, p loop.PluginMedian) {
+		t.Run("resolvable", func(t *testing.T) {
+			errorLog := types.NewMemErrorLog(0)
+			factory, err := p.NewMedianFactory(utils.Context(t), test.StaticMedianProvider{}, test.StaticDataSource(), test.StaticJuelsPerFeeCoinDataSource(), errorLog)
+			require.NoError(t, err)
+			require.NoError(t, factory.Close()) // connects first
+			entries := errorLog.Errors()
+			for i := range entries {
+				assert.False(t, entries[i].LastSeen.IsZero())
+				entries[i].LastSeen = time.Time{}
+			}
+			assert.Equal(t, []types.ErrorLogEntry{
+				{Message: "plain", Count: 1},
+				{Key: "feed", Message: "stale 2", Count: 2},
+			}, entries)
+		})
+		t.Run("fallback", func(t *testing.T) {
+			errorLog := &recordingErrorLog{}
+			factory, err := p.NewMedianFactory(utils.Context(t), test.StaticMedianProvider{}, test.StaticDataSource(), test.StaticJuelsPerFeeCoinDataSource(), errorLog)
+			require.NoError(t, err)
+			require.NoError(t, factory.Close()) // connects first
+			assert.Equal(t, []string{"stale 1", "unreachable", "stale 2", "plain"}, errorLog.msgs)
+		})
+	})
+}
+
+// errorLogPluginMedian runs checks against each error log, before delegating to [test.StaticPluginMedian] with a
+// static error log.
+type errorLogPluginMedian func(context.Context, types.ErrorLog)
+
+func (e errorLogPluginMedian) NewMedianFactory(ctx context.Context, provider types.MedianProvider, dataSource, juelsPerFeeCoin median.DataSource, errorLog types.ErrorLog) (types.ReportingPluginFactory, error) {
+	e(ctx, errorLog)
+	return test.StaticPluginMedian{}.NewMedianFactory(ctx, test.StaticMedianProvider{}, dataSource, juelsPerFeeCoin, &test.StaticErrorLog{})
+}
+
+// recordingErrorLog is a [types.ErrorLog] without keys, which records each message.
+type recordingErrorLog struct {
+	mu   sync.Mutex
+	msgs []string
+}
+
+func (r *recordingErrorLog) SaveError(_ context.Context, msg string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.msgs = append(r.msgs, msg)
+	return nil
+}
+
+func TestPluginMedian_keyValueStore(t *testing.T) {
+	t.Parallel()
+
+	stopCh := newStopCh(t)
+	store := &memKeyValueStore{}
+	plugin := checkingPluginMedian(func(ctx context.Context, provider types.MedianProvider) {
+		checkKeyValueStore(ctx, t)
+	})
+	testPlugin(t, loop.PluginMedianName, &loop.GRPCPluginMedian{PluginServer: plugin, BrokerConfig: loop.BrokerConfig{Logger: logger.Test(t), StopCh: stopCh}}, func(t *testing.T, p loop.PluginMedian) {
+		ctx := types.ContextWithKeyValueStore(utils.Context(t), store)
+		factory, err := p.NewMedianFactory(ctx, test.StaticMedianProvider{}, test.StaticDataSource(), test.StaticJuelsPerFeeCoinDataSource(), &test.StaticErrorLog{})
+		require.NoError(t, err)
+		require.NoError(t, factory.Close()) // connects first
+		assert.Equal(t, map[string][]byte{"job-1/round": {7}}, store.values)
+	})
+}
+
+// checkKeyValueStore checks the store served to the plugin, and leaves "round" set to 7 in namespace "job-1".
+func checkKeyValueStore(ctx context.Context, t *testing.T) {
+	store := types.KeyValueStoreFromContext(ctx)
+	if !assert.NotNil(t, store) {
+		return
+	}
+	_, found, err := store.Get(ctx, "job-1", "round")
+	assert.NoError(t, err)
+	assert.False(t, found)
+	assert.NoError(t, store.Set(ctx, "job-1", "round", []byte{6}))
+	assert.NoError(t, store.Set(ctx, "job-2", "round", []byte{1}))
+	assert.NoError(t, store.Set(ctx, "job-1", "round", []byte{7}))
+	value, found, err := store.Get(ctx, "job-1", "round")
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, []byte{7}, value)
+	assert.NoError(t, store.Delete(ctx, "job-2", "round"))
+	assert.NoError(t, store.Delete(ctx, "job-2", "missing"))
+}
+
+// memKeyValueStore is an in-memory [types.KeyValueStore].
+type memKeyValueStore struct {
+	mu     sync.Mutex
+	values map[string][]byte
+}
+
+func (m *memKeyValueStore) Get(_ context.Context, namespace, key string) ([]byte, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	v, ok := m.values[namespace+"/"+key]
+	return v, ok, nil
+}
+
+func (m *memKeyValueStore) Set(_ context.Context, namespace, key string, value []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.values == nil {
+		m.values = map[string][]byte{}
+	}
+	m.values[namespace+"/"+key] = value
+	return nil
+}
+
+func (m *memKeyValueStore) Delete(_ context.Context, namespace, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.values, namespace+"/"+key)
+	return nil
+}
+
+// prefixDigester returns digest, and declares prefix and prefixes.
+type prefixDigester struct {
+	prefix   libocr.ConfigDigestPrefix
+	prefixes []libocr.ConfigDigestPrefix
+	digest   libocr.ConfigDigest
+}
+
+func (p prefixDigester) ConfigDigest(libocr.ContractConfig) (libocr.ConfigDigest, error) {
+	return p.digest, nil
+}
+
+func (p prefixDigester) ConfigDigestPrefix() (libocr.ConfigDigestPrefix, error) { return p.prefix, nil }
+
+func (p prefixDigester) ConfigDigestPrefixes() ([]libocr.ConfigDigestPrefix, error) {
+	return p.prefixes, nil
+}
+
+type digesterMedianProvider struct {
+	test.StaticMedianProvider
+	ocd libocr.OffchainConfigDigester
+}
+
+func (d digesterMedianProvider) OffchainConfigDigester() libocr.OffchainConfigDigester { return d.ocd }
+
+type transmitterMedianProvider struct {
+	test.StaticMedianProvider
+	ct libocr.ContractTransmitter
+}
+
+func (t transmitterMedianProvider) ContractTransmitter() libocr.ContractTransmitter { return t.ct }
+
+type countingTransmitter struct {
+	libocr.ContractTransmitter // only Transmit and FromAccount are implemented
+	account                    libocr.Account
+	fromAccountCalls           atomic.Int64
+	transmits                  atomic.Int64
+}
+
+func (c *countingTransmitter) Transmit(context.Context, libocr.ReportContext, libocr.Report, []libocr.AttributedOnchainSignature) error {
+	c.transmits.Add(1)
+	return nil
+}
+
+func (c *countingTransmitter) FromAccount() (libocr.Account, error) {
+	c.fromAccountCalls.Add(1)
+	return c.account, nil
+}
+
+// recordingTransmitter records the ReportContext of the last Transmit.
+type recordingTransmitter struct {
+	libocr.ContractTransmitter // only Transmit is implemented
+	last                       libocr.ReportContext
+}
+
+func (r *recordingTransmitter) Transmit(_ context.Context, rc libocr.ReportContext, _ libocr.Report, _ []libocr.AttributedOnchainSignature) error {
+	r.last = rc
+	return nil
+}
+
+// checkingPluginMedian runs checks against each provider, before delegating to [test.StaticPluginMedian] with a static
+// provider.
+type checkingPluginMedian func(context.Context, types.MedianProvider)
+
+func (c checkingPluginMedian) NewMedianFactory(ctx context.Context, provider types.MedianProvider, dataSource, juelsPerFeeCoin median.DataSource, errorLog types.ErrorLog) (types.ReportingPluginFactory, error) {
+	c(ctx, provider)
+	return test.StaticPluginMedian{}.NewMedianFactory(ctx, test.StaticMedianProvider{}, dataSource, juelsPerFeeCoin, errorLog)
+}
+
+// TestPluginMedian_maxMsgSize checks that limits are rejected if queries or reports exceed the max message size, since
+// unlike observations, they are not sent in chunks.
+func TestPluginMedian_maxMsgSize(t *testing.T) {
+	t.Parallel()
+
+	stopCh := newStopCh(t)
+	grpcOpts := loop.GRPCOpts{MaxMsgSize: 512}
+	testPlugin(t, loop.PluginMedianName, &loop.GRPCPluginMedian{PluginServer: test.LargeReportsPluginMedian{MaxReportLength: 1024}, BrokerConfig: loop.BrokerConfig{Logger: logger.Test(t), StopCh: stopCh, GRPCOpts: grpcOpts}}, test.TestPluginMedianMaxMsgSize)
+}
+
+// TestPluginMedian_chunkSize runs the full suite with a tiny chunk size, so that observations, reports, and
+// transmissions are all split into many chunks.
+func TestPluginMedian_chunkSize(t *testing.T) {
+	stopCh := newStopCh(t)
+	grpcOpts := loop.GRPCOpts{ChunkSize: 4}
+	testPlugin(t, loop.PluginMedianName, &loop.GRPCPluginMedian{PluginServer: test.StaticPluginMedian{}, BrokerConfig: loop.BrokerConfig{Logger: logger.Test(t), StopCh: stopCh, GRPCOpts: grpcOpts}}, test.TestPluginMedian)
+}
+
+func TestPluginMedian_compression(t *testing.T) {
+	for _, compressor := range []string{loop.CompressorGzip, loop.CompressorSnappy} {
+		compressor := compressor
+		t.Run(compressor, func(t *testing.T) {
+			stopCh := newStopCh(t)
+			grpcOpts := loop.GRPCOpts{Compression: compressor}
+			testPlugin(t, loop.PluginMedianName, &loop.GRPCPluginMedian{PluginServer: test.StaticPluginMedian{}, BrokerConfig: loop.BrokerConfig{Logger: logger.Test(t), StopCh: stopCh, GRPCOpts: grpcOpts}}, test.TestPluginMedian)
+		})
+	}
+	t.Run("by name", func(t *testing.T) {
+		stopCh := newStopCh(t)
+		before := countingCompressions.Load()
+		grpcOpts := loop.GRPCOpts{CompressionByName: map[string]string{"DataSource": countingCompressorName}}
+		testPlugin(t, loop.PluginMedianName, &loop.GRPCPluginMedian{PluginServer: test.StaticPluginMedian{}, BrokerConfig: loop.BrokerConfig{Logger: logger.Test(t), StopCh: stopCh, GRPCOpts: grpcOpts}}, test.TestPluginMedian)
+		assert.Greater(t, countingCompressions.Load(), before)
+	})
+	t.Run("unknown", func(t *testing.T) {
+		stopCh := make(chan struct{})
+		lggr, observed := logger.TestObserved(t, zapcore.DebugLevel)
+		grpcOpts := loop.GRPCOpts{Compression: "unknown"}
+		testPlugin(t, loop.PluginMedianName, &loop.GRPCPluginMedian{PluginServer: test.StaticPluginMedian{}, BrokerConfig: loop.BrokerConfig{Logger: lggr, StopCh: stopCh, GRPCOpts: grpcOpts}}, func(t *testing.T, p loop.PluginMedian) {
+			factory, err := p.NewMedianFactory(utils.Context(t), test.StaticMedianProvider{}, test.StaticDataSource(), test.StaticJuelsPerFeeCoinDataSource(), &test.StaticErrorLog{})
+			require.NoError(t, err) // connects lazily
+			errCh := make(chan error, 1)
+			go func() {
+				_, _, err := factory.NewReportingPlugin(libocr.ReportingPluginConfig{})
+				errCh <- err
+			}()
+			// the connection is retried until stopped
+			require.Eventually(t, func() bool {
+				return observed.FilterMessageSnippet("refresh attempt failed").Filter(func(e observer.LoggedEntry) bool {
+					err, _ := e.ContextMap()["err"].(string)
+					return strings.Contains(err, `unknown compressor "unknown"`)
+				}).Len() > 0
+			}, 5*time.Second, 10*time.Millisecond)
+			close(stopCh)
+			assert.Error(t, <-errCh)
+		})
+	})
+}
+
+const countingCompressorName = "counting-gzip"
+
+// countingCompressions counts the messages compressed by the countingCompressor.
+var countingCompressions atomic.Int64
+
+func init() {
+	encoding.RegisterCompressor(countingCompressor{encoding.GetCompressor(loop.CompressorGzip)})
+}
+
+// countingCompressor is a gzip compressor, which counts the messages it compresses.
+type countingCompressor struct {
+	encoding.Compressor
+}
+
+func (c countingCompressor) Name() string { return countingCompressorName }
+
+func (c countingCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	countingCompressions.Add(1)
+	return c.Compressor.Compress(w)
+}
+
+func TestPluginMedian_serviceConfig(t *testing.T) {
+	t.Parallel()
+
+	const serviceConfig = `{"methodConfig": [{
+		"name": [{"service": "loop.DataSource", "method": "Observe"}],
+		"timeout": "0.5s",
+		"retryPolicy": {
+			"maxAttempts": 3,
+			"initialBackoff": "0.01s",
+			"maxBackoff": "0.01s",
+			"backoffMultiplier": 1,
+			"retryableStatusCodes": ["UNAVAILABLE"]
+		}
+	}]}`
+	for _, tt := range []struct {
+		name          string
+		serviceConfig string
+		dataSource    *flakyDataSource
+		expCode       codes.Code
+		expCalls      int64
+	}{
+		{"default", "", &flakyDataSource{failures: 2}, codes.Unavailable, 1},
+		{"retry", serviceConfig, &flakyDataSource{failures: 2}, codes.OK, 3},
+		{"retry exhausted", serviceConfig, &flakyDataSource{failures: 3}, codes.Unavailable, 3},
+		{"timeout", serviceConfig, &flakyDataSource{block: true}, codes.DeadlineExceeded, 1},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			stopCh := newStopCh(t)
+			plugin := &observingPluginMedian{errs: make(chan error, 2)}
+			grpcOpts := loop.GRPCOpts{ServiceConfig: tt.serviceConfig}
+			testPlugin(t, loop.PluginMedianName, &loop.GRPCPluginMedian{PluginServer: plugin, BrokerConfig: loop.BrokerConfig{Logger: logger.Test(t), StopCh: stopCh, GRPCOpts: grpcOpts}}, func(t *testing.T, p loop.PluginMedian) {
+				factory, err := p.NewMedianFactory(utils.Context(t), test.StaticMedianProvider{}, tt.dataSource, test.StaticJuelsPerFeeCoinDataSource(), &test.StaticErrorLog{})
+				require.NoError(t, err)
+				require.NoError(t, factory.Close()) // connects first
+			})
+			err := <-plugin.errs
+			assert.Equal(t, tt.expCode, status.Code(err), err)
+			assert.Equal(t, tt.expCalls, tt.dataSource.calls.Load())
+		})
+	}
+}
+
+// flakyDataSource fails with codes.Unavailable for the first failures calls, or blocks until the context is done if
+// block is set.
+type flakyDataSource struct {
+	failures int64
+	block    bool
+	calls    atomic.Int64
+}
+
+func (f *flakyDataSource) Observe(ctx context.Context, _ libocr.ReportTimestamp) (*big.Int, error) {
+	if f.calls.Add(1) <= f.failures {
+		return nil, status.Error(codes.Unavailable, "temporarily unavailable")
+	}
+	if f.block {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	return big.NewInt(42), nil
+}
+
+func TestPluginMedian_deterministicIDs(t *testing.T) {
+	run := func(t *testing.T) []uint32 {
+		stopCh := newStopCh(t)
+		ids := &recordingIDs{IDAllocator: &loop.DeterministicIDs{}}
+		testPlugin(t, loop.PluginMedianName, &loop.GRPCPluginMedian{PluginServer: test.StaticPluginMedian{}, BrokerConfig: loop.BrokerConfig{Logger: logger.Test(t), StopCh: stopCh, IDs: ids}}, test.TestPluginMedian)
+		return ids.sorted()
+	}
+	first := run(t)
+	require.NotEmpty(t, first)
+	require.Equal(t, first, run(t))
+}
+
+// recordingIDs records the IDs allocated by an [loop.IDAllocator].
+type recordingIDs struct {
+	loop.IDAllocator
+	mu  sync.Mutex
+	ids []uint32
+}
+
+func (r *recordingIDs) NextID(name string) uint32 {
+	id := r.IDAllocator.NextID(name)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.ids = append(r.ids, id)
+	return id
+}
+
+func (r *recordingIDs) sorted() []uint32 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ids := append([]uint32(nil), r.ids...)
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func TestPluginMedian_relayerRestart(t *testing.T) {
+	ctx := utils.Context(t)
+	relayer := loop.NewRelayerService(logger.Test(t), loop.GRPCOpts{}, func() *exec.Cmd {
+		return helperProcess(loop.PluginRelayerName)
+	}, test.ConfigTOML, test.StaticKeystore{})
+	hook := relayer.TestHook()
+	require.NoError(t, relayer.Start(ctx))
+	t.Cleanup(func() { assert.NoError(t, relayer.Close()) })
+	p, err := relayer.NewMedianProvider(ctx, test.RelayArgs, test.PluginArgs)
+	require.NoError(t, err)
+	// connect eagerly, so that the connections are not attributed to the tests using p
+	require.NoError(t, p.Ready())
+	pm := test.PluginMedianTest{MedianProvider: p}
+
+	stopCh := newStopCh(t)
+	testPlugin(t, loop.PluginMedianName, &loop.GRPCPluginMedian{PluginServer: test.StaticPluginMedian{}, BrokerConfig: loop.BrokerConfig{Logger: logger.Test(t), StopCh: stopCh}}, pm.TestPluginMedian)
+
+	hook.Kill()
+
+	// wait for relaunch
+	time.Sleep(2 * loop.KeepAliveTickDuration)
+
+	// the same provider is proxied again, and must be transparently reconnected
+	require.Eventually(t, func() bool { return p.Ready() == nil }, time.Minute, 100*time.Millisecond)
+	testPlugin(t, loop.PluginMedianName, &loop.GRPCPluginMedian{PluginServer: test.StaticPluginMedian{}, BrokerConfig: loop.BrokerConfig{Logger: logger.Test(t), StopCh: stopCh}}, pm.TestPluginMedian)
+}
+
+func TestPluginMedianExec(t *testing.T) {
 	stopCh := newStopCh(t)
 	median := loop.GRPCPluginMedian{BrokerConfig: loop.BrokerConfig{Logger: logger.Test(t), StopCh: stopCh}}
 	cc := median.ClientConfig()
@@ -73,5 +1165,7 @@ func newMedianProvider(t *testing.T, pr loop.PluginRelayer) types.MedianProvider
 	require.NoError(t, err)
 	require.NoError(t, p.Start(ctx))
 	t.Cleanup(func() { assert.NoError(t, p.Close()) })
+	// connect eagerly, so that the connections are not attributed to the tests using p
+	require.NoError(t, p.Ready())
 	return p
 }
@@ -0,0 +1,9 @@
+package loop
+
+import "syscall"
+
+// setParentDeathSignal kills the process when the host thread which started it exits, e.g. because the host crashed.
+// Descendants are still only killed along with the process group, which requires the host.
+func setParentDeathSignal(attr *syscall.SysProcAttr) {
+	attr.Pdeathsig = syscall.SIGKILL
+}
@@ -0,0 +1,16 @@
+package loop
+
+import (
+	"os/exec"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetProcessGroup(t *testing.T) {
+	cmd := exec.Command("true")
+	setProcessGroup(cmd)
+	assert.True(t, cmd.SysProcAttr.Setpgid)
+	assert.Equal(t, syscall.SIGKILL, cmd.SysProcAttr.Pdeathsig)
+}
@@ -0,0 +1,8 @@
+//go:build !linux && !windows
+
+package loop
+
+import "syscall"
+
+// setParentDeathSignal is a no-op, since parent death signals are specific to linux.
+func setParentDeathSignal(*syscall.SysProcAttr) {}
@@ -0,0 +1,40 @@
+//go:build !windows
+
+package loop
+
+import (
+	"errors"
+	"os/exec"
+	"syscall"
+)
+
+// setProcessGroup configures cmd to start in a new process group, so that any descendants can be killed with it, and
+// where supported, to be killed if the host dies without doing so.
+func setProcessGroup(cmd *exec.Cmd) {
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.Setpgid = true
+	setParentDeathSignal(cmd.SysProcAttr)
+}
+
+// processGroup is the process group led by a plugin process.
+type processGroup struct {
+	pgid int
+}
+
+// newProcessGroup returns the process group of cmd, which must have been started after [setProcessGroup].
+func newProcessGroup(cmd *exec.Cmd) (*processGroup, error) {
+	if cmd.Process == nil {
+		return nil, errors.New("process not started")
+	}
+	return &processGroup{pgid: cmd.Process.Pid}, nil
+}
+
+// kill kills every remaining process in the group.
+func (g *processGroup) kill() error {
+	if err := syscall.Kill(-g.pgid, syscall.SIGKILL); err != nil && !errors.Is(err, syscall.ESRCH) {
+		return err
+	}
+	return nil
+}
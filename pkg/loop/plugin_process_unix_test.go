@@ -0,0 +1,97 @@
+//go:build !windows
+
+package loop_test
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/internal/test"
+	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
+)
+
+func TestPluginService_subprocesses(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	var launches int
+	relayer := loop.NewRelayerService(logger.Test(t), loop.GRPCOpts{}, func() *exec.Cmd {
+		launches++
+		cmd := helperProcess(loop.PluginRelayerName)
+		cmd.Env = append(cmd.Env, envHelperSubprocessPIDFile+"="+filepath.Join(dir, strconv.Itoa(launches)))
+		return cmd
+	}, test.ConfigTOML, test.StaticKeystore{})
+	hook := relayer.TestHook()
+	require.NoError(t, relayer.Start(utils.Context(t)))
+	closed := false
+	t.Cleanup(func() {
+		if !closed {
+			assert.NoError(t, relayer.Close())
+		}
+	})
+
+	t.Run("control", func(t *testing.T) {
+		test.TestRelayer(t, relayer)
+	})
+	first := readPID(t, filepath.Join(dir, "1"))
+	require.True(t, processAlive(first))
+
+	t.Run("Kill", func(t *testing.T) {
+		hook.Kill()
+
+		// wait for relaunch
+		time.Sleep(2 * loop.KeepAliveTickDuration)
+
+		require.Eventually(t, func() bool { return !processAlive(first) }, time.Second, 10*time.Millisecond)
+		test.TestRelayer(t, relayer)
+	})
+
+	t.Run("Close", func(t *testing.T) {
+		second := readPID(t, filepath.Join(dir, "2"))
+		require.True(t, processAlive(second))
+
+		closed = true
+		require.NoError(t, relayer.Close())
+
+		require.Eventually(t, func() bool { return !processAlive(second) }, time.Second, 10*time.Millisecond)
+	})
+}
+
+func readPID(t *testing.T, path string) int {
+	var b []byte
+	require.Eventually(t, func() bool {
+		var err error
+		b, err = os.ReadFile(path)
+		return err == nil && len(b) > 0
+	}, 10*time.Second, 10*time.Millisecond)
+	pid, err := strconv.Atoi(string(b))
+	require.NoError(t, err)
+	return pid
+}
+
+// processAlive returns true if pid is running. Orphans may not be reaped promptly, so zombies are considered dead.
+func processAlive(pid int) bool {
+	if err := syscall.Kill(pid, 0); errors.Is(err, syscall.ESRCH) {
+		return false
+	}
+	stat, err := os.ReadFile(filepath.Join("/proc", strconv.Itoa(pid), "stat"))
+	if err != nil {
+		return true // no procfs
+	}
+	// state follows the parenthesized command name
+	if i := bytes.LastIndexByte(stat, ')'); i >= 0 && i+2 < len(stat) {
+		return stat[i+2] != 'Z'
+	}
+	return true
+}
@@ -0,0 +1,67 @@
+//go:build windows
+
+package loop
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+// setProcessGroup configures cmd to start in a new process group, so that console signals are not shared with the host.
+func setProcessGroup(cmd *exec.Cmd) {
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.CreationFlags |= syscall.CREATE_NEW_PROCESS_GROUP
+}
+
+// processGroup is a job object containing a plugin process and its descendants. Windows has no process groups which
+// can be killed together, and descendants are not tracked once their parent exits, so a job is used instead. The job
+// is configured to kill its processes when the last handle is closed, which also covers the host exiting abruptly.
+type processGroup struct {
+	job windows.Handle
+}
+
+// newProcessGroup assigns cmd, which must have been started, to a new job object. With [LaunchConfig.IsolateEnv], it is
+// called by startPlugin right after the process starts, before the handshake, so only descendants spawned in between
+// could escape the job. Otherwise, go-plugin starts the process, and it is called once the handshake completes.
+func newProcessGroup(cmd *exec.Cmd) (*processGroup, error) {
+	if cmd.Process == nil {
+		return nil, errors.New("process not started")
+	}
+	job, err := windows.CreateJobObject(nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create job object: %w", err)
+	}
+	info := windows.JOBOBJECT_EXTENDED_LIMIT_INFORMATION{
+		BasicLimitInformation: windows.JOBOBJECT_BASIC_LIMIT_INFORMATION{
+			LimitFlags: windows.JOB_OBJECT_LIMIT_KILL_ON_JOB_CLOSE,
+		},
+	}
+	if _, err = windows.SetInformationJobObject(job, windows.JobObjectExtendedLimitInformation,
+		uintptr(unsafe.Pointer(&info)), uint32(unsafe.Sizeof(info))); err != nil {
+		_ = windows.CloseHandle(job)
+		return nil, fmt.Errorf("failed to configure job object: %w", err)
+	}
+	p, err := windows.OpenProcess(windows.PROCESS_SET_QUOTA|windows.PROCESS_TERMINATE, false, uint32(cmd.Process.Pid))
+	if err != nil {
+		_ = windows.CloseHandle(job)
+		return nil, fmt.Errorf("failed to open process: %w", err)
+	}
+	defer windows.CloseHandle(p)
+	if err = windows.AssignProcessToJobObject(job, p); err != nil {
+		_ = windows.CloseHandle(job)
+		return nil, fmt.Errorf("failed to assign process to job object: %w", err)
+	}
+	return &processGroup{job: job}, nil
+}
+
+// kill kills every remaining process in the job.
+func (g *processGroup) kill() error {
+	return windows.CloseHandle(g.job)
+}
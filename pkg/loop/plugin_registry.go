@@ -0,0 +1,167 @@
+package loop
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-plugin"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/buildinfo"
+)
+
+// PluginState is the state of a plugin service and its subprocess.
+type PluginState string
+
+const (
+	// PluginStateUnstarted means the service has not been started.
+	PluginStateUnstarted PluginState = "Unstarted"
+	// PluginStateLaunching means the subprocess is being launched, or relaunched.
+	PluginStateLaunching PluginState = "Launching"
+	// PluginStateRunning means the subprocess is running, and its service is available.
+	PluginStateRunning PluginState = "Running"
+	// PluginStateExited means the subprocess has exited, and will be relaunched.
+	PluginStateExited PluginState = "Exited"
+	// PluginStateExhausted means the subprocess will not be relaunched. See [ErrPluginRestartsExhausted].
+	PluginStateExhausted PluginState = "Exhausted"
+	// PluginStateStopped means the service has been closed.
+	PluginStateStopped PluginState = "Stopped"
+)
+
+// PluginInfo describes a plugin service and its subprocess, at the time it is returned.
+type PluginInfo struct {
+	// Name of the service, e.g. "EVM.1.RelayerService".
+	Name string `json:"name"`
+	// Type of the plugin, e.g. [PluginRelayerName] or [PluginMedianName].
+	Type  string      `json:"type"`
+	State PluginState `json:"state"`
+	// PID of the subprocess, or zero if not launched.
+	PID int `json:"pid,omitempty"`
+	// Uptime of the subprocess since it was launched, or zero if not launched.
+	Uptime time.Duration `json:"uptime,omitempty"`
+	// Restarts counts the launches after the first.
+	Restarts int64 `json:"restarts"`
+	// Resources counts the servers accepting brokered connections from the plugin, like providers and data sources,
+	// by name.
+	Resources map[string]int `json:"resources,omitempty"`
+	// Version is the build info reported by the plugin, if available.
+	Version *buildinfo.Info `json:"version,omitempty"`
+}
+
+// pluginProcess is a launched plugin subprocess.
+type pluginProcess struct {
+	client   *plugin.Client
+	pid      int
+	launched time.Time
+}
+
+// PluginInfo returns the current state of the service and its subprocess.
+func (s *pluginService[P, S]) PluginInfo() PluginInfo {
+	info := PluginInfo{
+		Name: s.Name(),
+		Type: s.pluginName,
+	}
+	if resources := s.resources.Get(); len(resources) > 0 {
+		info.Resources = resources
+	}
+	if launches := s.launches.Load(); launches > 1 {
+		info.Restarts = launches - 1
+	}
+	if v, ok := s.PluginVersion(); ok {
+		info.Version = &v
+	}
+	p := s.process.Load()
+	if p != nil {
+		info.PID = p.pid
+		info.Uptime = s.clock.Now().Sub(p.launched)
+	}
+	switch state := s.State(); {
+	case state == "Unstarted":
+		info.State = PluginStateUnstarted
+	case state == "Stopping" || state == "Stopped":
+		info.State = PluginStateStopped
+	case s.exhausted.Load():
+		info.State = PluginStateExhausted
+	case p == nil:
+		info.State = PluginStateLaunching
+	case p.client.Exited():
+		info.State = PluginStateExited
+	default:
+		info.State = PluginStateRunning
+	}
+	return info
+}
+
+// PluginInfoer is implemented by plugin services, like [*RelayerService] and [*MedianService], to describe themselves
+// to a [Registry].
+type PluginInfoer interface {
+	PluginInfo() PluginInfo
+}
+
+var (
+	_ PluginInfoer = (*RelayerService)(nil)
+	_ PluginInfoer = (*MedianService)(nil)
+)
+
+// Registry tracks the plugin services of a host, so that operators can see which plugin subprocesses exist, and their
+// health, at a glance. Services are registered by name, and should be unregistered once closed.
+// The zero value is ready to use.
+type Registry struct {
+	mu      sync.RWMutex
+	plugins map[string]PluginInfoer
+}
+
+// Register adds the plugin service p under name, which must be unique.
+func (r *Registry) Register(name string, p PluginInfoer) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.plugins[name]; ok {
+		return fmt.Errorf("plugin %q already registered", name)
+	}
+	if r.plugins == nil {
+		r.plugins = make(map[string]PluginInfoer)
+	}
+	r.plugins[name] = p
+	return nil
+}
+
+// Unregister removes the plugin service registered under name, if any.
+func (r *Registry) Unregister(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.plugins, name)
+}
+
+// List returns the current info of each registered plugin service, sorted by the names they were registered under.
+func (r *Registry) List() []PluginInfo {
+	r.mu.RLock()
+	names := make([]string, 0, len(r.plugins))
+	for name := range r.plugins {
+		names = append(names, name)
+	}
+	plugins := make([]PluginInfoer, len(names))
+	sort.Strings(names)
+	for i, name := range names {
+		plugins[i] = r.plugins[name]
+	}
+	r.mu.RUnlock()
+
+	infos := make([]PluginInfo, len(plugins))
+	for i, p := range plugins {
+		infos[i] = p.PluginInfo()
+	}
+	return infos
+}
+
+// HTTPHandler returns a debug handler which serves List as JSON.
+func (r *Registry) HTTPHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(r.List()); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
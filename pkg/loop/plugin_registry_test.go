@@ -0,0 +1,70 @@
+package loop_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/internal/test"
+	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
+)
+
+func TestRegistry(t *testing.T) {
+	t.Parallel()
+	relayer := loop.NewRelayerService(logger.Test(t), loop.GRPCOpts{}, func() *exec.Cmd {
+		return helperProcess(loop.PluginRelayerName)
+	}, test.ConfigTOML, test.StaticKeystore{})
+	hook := relayer.TestHook()
+
+	var registry loop.Registry
+	require.NoError(t, registry.Register("relayer", relayer))
+	require.Error(t, registry.Register("relayer", relayer))
+
+	list := registry.List()
+	require.Len(t, list, 1)
+	assert.Equal(t, loop.PluginInfo{Name: "RelayerService", Type: loop.PluginRelayerName, State: loop.PluginStateUnstarted}, list[0])
+
+	require.NoError(t, relayer.Start(utils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, relayer.Close()) })
+	running := func() bool { return registry.List()[0].State == loop.PluginStateRunning }
+	require.Eventually(t, running, 3*loop.KeepAliveTickDuration, 100*time.Millisecond)
+	_, _, _ = relayer.ChainStatuses(utils.Context(t), 0, 0) // any call creates the relayer, which serves the keystore
+
+	info := registry.List()[0]
+	assert.Equal(t, loop.PluginStateRunning, info.State)
+	assert.NotZero(t, info.PID)
+	assert.Positive(t, info.Uptime)
+	assert.Zero(t, info.Restarts)
+	assert.Equal(t, map[string]int{"Keystore": 1}, info.Resources)
+
+	t.Run("restart", func(t *testing.T) {
+		hook.Kill()
+		assert.Equal(t, loop.PluginStateLaunching, registry.List()[0].State)
+
+		require.Eventually(t, running, 3*loop.KeepAliveTickDuration, 100*time.Millisecond)
+		restarted := registry.List()[0]
+		assert.Equal(t, int64(1), restarted.Restarts)
+		assert.NotEqual(t, info.PID, restarted.PID)
+	})
+
+	t.Run("HTTPHandler", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		registry.HTTPHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/plugins", nil))
+		require.Equal(t, http.StatusOK, rec.Code)
+		var infos []loop.PluginInfo
+		require.NoError(t, json.NewDecoder(rec.Body).Decode(&infos))
+		require.Len(t, infos, 1)
+		assert.Equal(t, loop.PluginRelayerName, infos[0].Type)
+	})
+
+	registry.Unregister("relayer")
+	assert.Empty(t, registry.List())
+}
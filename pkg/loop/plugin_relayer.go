@@ -2,10 +2,13 @@ package loop
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/go-plugin"
 	"google.golang.org/grpc"
 
+	libocr "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
 	"github.com/smartcontractkit/chainlink-relay/pkg/loop/internal"
 	"github.com/smartcontractkit/chainlink-relay/pkg/types"
 )
@@ -27,6 +30,46 @@ type Keystore = types.Keystore
 
 type Relayer = internal.Relayer
 
+// GRPCServiceRegistrar is optionally implemented by the providers returned from [Relayer.NewPluginProvider], to serve
+// product specific gRPC services alongside the common components.
+type GRPCServiceRegistrar = internal.GRPCServiceRegistrar
+
+// GRPCClientConn is implemented by providers proxied over gRPC. The providers returned from
+// [Relayer.NewPluginProvider] expose the connection for product specific clients.
+type GRPCClientConn = internal.GRPCClientConn
+
+// FromAccountCache is implemented by the ContractTransmitters of providers proxied over gRPC, which cache
+// FromAccount once resolved. Use it to invalidate the cached account, e.g. after rotating keys.
+type FromAccountCache = internal.FromAccountCache
+
+// VersionedService is implemented by the services of plugins, to report the [buildinfo.Info] of the plugin process.
+type VersionedService = internal.VersionedService
+
+// ErrConfigDigestPrefix is returned for a ConfigDigest which does not match the prefixes of its OffchainConfigDigester.
+// Digests are checked by the server, before being returned over gRPC.
+type ErrConfigDigestPrefix = internal.ErrConfigDigestPrefix
+
+// ErrReportContext is returned for a malformed ReportContext or ReportTimestamp received over gRPC, e.g. from a
+// [types.TransmitStatusTransmitter]. Servers return it for malformed requests, rather than truncating them.
+type ErrReportContext = internal.ErrReportContext
+
+// ConfigDigestPrefixes returns the prefixes declared by d, via [types.MultiPrefixConfigDigester] if implemented.
+func ConfigDigestPrefixes(d libocr.OffchainConfigDigester) ([]libocr.ConfigDigestPrefix, error) {
+	return internal.ConfigDigestPrefixes(d)
+}
+
+// CheckConfigDigestPrefix returns [ErrConfigDigestPrefix] if digest does not match one of the prefixes declared by d,
+// e.g. to cross-check the digests from a ContractConfigTracker.
+func CheckConfigDigestPrefix(d libocr.OffchainConfigDigester, digest libocr.ConfigDigest) error {
+	return internal.CheckConfigDigestPrefix(d, digest)
+}
+
+// NewRoundRobinTransmitter returns a [types.ReportAccountTransmitter] which transmits consecutive rounds with each of
+// transmitters in turn. FromAccount and LatestConfigDigestAndEpoch are delegated to the first.
+func NewRoundRobinTransmitter(transmitters ...libocr.ContractTransmitter) (types.ReportAccountTransmitter, error) {
+	return internal.NewRoundRobinTransmitter(transmitters...)
+}
+
 var _ plugin.GRPCPlugin = (*GRPCPluginRelayer)(nil)
 
 // GRPCPluginRelayer implements [plugin.GRPCPlugin] for [types.PluginRelayer].
@@ -63,3 +106,10 @@ func (p *GRPCPluginRelayer) ClientConfig() *plugin.ClientConfig {
 		Logger:           HCLogLogger(p.Logger),
 	}
 }
+
+// ContextWithProviderIdleTimeout returns a copy of ctx carrying timeout, for [PluginRelayer.NewRelayer]. Providers
+// created by the relayer are then not started until first used, shut down after being unused for timeout, e.g. while
+// their job is paused, and transparently restarted on next use. Ready, HealthReport, and Close do not count as use.
+func ContextWithProviderIdleTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return internal.ContextWithProviderIdleTimeout(ctx, timeout)
+}
@@ -1,25 +1,127 @@
 package loop_test
 
 import (
+	"context"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/go-plugin"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
 	"github.com/smartcontractkit/chainlink-relay/pkg/loop"
 	"github.com/smartcontractkit/chainlink-relay/pkg/loop/internal/test"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/testutils"
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
 )
 
+// Tests running the PluginRelayer suite are not parallel, since it checks for leaks.
 func TestPluginRelayer(t *testing.T) {
+	stopCh := newStopCh(t)
+	var resources loop.ResourceCounts
+	pr := test.PluginRelayerTest{Leaks: []testutils.LeakOption{testutils.WithResources(&resources)}}
+	testPlugin(t, loop.PluginRelayerName, &loop.GRPCPluginRelayer{PluginServer: test.StaticPluginRelayer{}, BrokerConfig: loop.BrokerConfig{Logger: logger.Test(t), StopCh: stopCh, Resources: &resources}}, pr.TestPluginRelayer)
+}
+
+func TestPluginRelayer_keyValueStore(t *testing.T) {
 	t.Parallel()
 
 	stopCh := newStopCh(t)
-	testPlugin(t, loop.PluginRelayerName, &loop.GRPCPluginRelayer{PluginServer: test.StaticPluginRelayer{}, BrokerConfig: loop.BrokerConfig{Logger: logger.Test(t), StopCh: stopCh}}, test.TestPluginRelayer)
+	store := &memKeyValueStore{}
+	plugin := checkingPluginRelayer(func(ctx context.Context) { checkKeyValueStore(ctx, t) })
+	testPlugin(t, loop.PluginRelayerName, &loop.GRPCPluginRelayer{PluginServer: plugin, BrokerConfig: loop.BrokerConfig{Logger: logger.Test(t), StopCh: stopCh}}, func(t *testing.T, p loop.PluginRelayer) {
+		ctx := types.ContextWithKeyValueStore(utils.Context(t), store)
+		r, err := p.NewRelayer(ctx, test.ConfigTOML, test.StaticKeystore{})
+		require.NoError(t, err)
+		require.NoError(t, r.Close()) // connects first
+		assert.Equal(t, map[string][]byte{"job-1/round": {7}}, store.values)
+	})
 }
 
-func TestPluginRelayerExec(t *testing.T) {
+// checkingPluginRelayer runs checks against the ctx of each new relayer, before delegating to
+// [test.StaticPluginRelayer].
+type checkingPluginRelayer func(context.Context)
+
+func (c checkingPluginRelayer) NewRelayer(ctx context.Context, config string, keystore types.Keystore) (loop.Relayer, error) {
+	c(ctx)
+	return test.StaticPluginRelayer{}.NewRelayer(ctx, config, keystore)
+}
+
+func TestPluginRelayer_providerIdleTimeout(t *testing.T) {
 	t.Parallel()
+
+	stopCh := newStopCh(t)
+	var plugin countingPluginRelayer
+	testPlugin(t, loop.PluginRelayerName, &loop.GRPCPluginRelayer{PluginServer: &plugin, BrokerConfig: loop.BrokerConfig{Logger: logger.Test(t), StopCh: stopCh}}, func(t *testing.T, p loop.PluginRelayer) {
+		ctx := loop.ContextWithProviderIdleTimeout(utils.Context(t), 100*time.Millisecond)
+		r, err := p.NewRelayer(ctx, test.ConfigTOML, test.StaticKeystore{})
+		require.NoError(t, err)
+		t.Cleanup(func() { assert.NoError(t, r.Close()) })
+
+		provider, err := r.NewMedianProvider(ctx, test.RelayArgs, test.PluginArgs)
+		require.NoError(t, err)
+		require.NoError(t, provider.Ready())
+		assert.Equal(t, int64(0), plugin.created.Load(), "not started until first use")
+
+		_, _, err = provider.ContractConfigTracker().LatestConfigDetails(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), plugin.created.Load())
+		require.Eventually(t, func() bool { return plugin.closed.Load() == 1 }, 5*time.Second, 10*time.Millisecond, "shut down when idle")
+
+		require.NoError(t, provider.Ready())
+		assert.Equal(t, int64(1), plugin.created.Load(), "health checks do not restart")
+
+		_, _, err = provider.ContractConfigTracker().LatestConfigDetails(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), plugin.created.Load(), "restarted on next use")
+
+		require.NoError(t, provider.Close())
+		assert.Equal(t, int64(2), plugin.closed.Load())
+	})
+}
+
+// countingPluginRelayer counts the median providers created and closed by its relayers, which otherwise delegate to
+// [test.StaticPluginRelayer].
+type countingPluginRelayer struct {
+	created, closed atomic.Int64
+}
+
+func (c *countingPluginRelayer) NewRelayer(ctx context.Context, config string, keystore types.Keystore) (loop.Relayer, error) {
+	r, err := test.StaticPluginRelayer{}.NewRelayer(ctx, config, keystore)
+	if err != nil {
+		return nil, err
+	}
+	return &countingRelayer{Relayer: r, c: c}, nil
+}
+
+type countingRelayer struct {
+	loop.Relayer
+	c *countingPluginRelayer
+}
+
+func (r *countingRelayer) NewMedianProvider(ctx context.Context, rargs types.RelayArgs, pargs types.PluginArgs) (types.MedianProvider, error) {
+	p, err := r.Relayer.NewMedianProvider(ctx, rargs, pargs)
+	if err != nil {
+		return nil, err
+	}
+	r.c.created.Add(1)
+	return &countingMedianProvider{MedianProvider: p, c: r.c}, nil
+}
+
+type countingMedianProvider struct {
+	types.MedianProvider
+	c *countingPluginRelayer
+}
+
+func (p *countingMedianProvider) Close() error {
+	p.c.closed.Add(1)
+	return p.MedianProvider.Close()
+}
+
+func TestPluginRelayerExec(t *testing.T) {
 	stopCh := newStopCh(t)
 
 	pr := newPluginRelayerExec(t, stopCh)
@@ -0,0 +1,220 @@
+package loop
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/hashicorp/go-plugin"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+// Plugin environment variables which configure the [ConcurrencyLimit] applied by [ServeMedian].
+// Set them on the host via [LaunchConfig.Env].
+const (
+	EnvReportingMaxConcurrency = "CL_REPORTING_MAX_CONCURRENCY"
+	EnvReportingQueueTimeout   = "CL_REPORTING_QUEUE_TIMEOUT"
+)
+
+// EnvCheckConfigDigest is a plugin environment variable which enables [GRPCPluginMedian.CheckConfigDigest] for
+// [ServeMedian], when set to true.
+const EnvCheckConfigDigest = "CL_CHECK_CONFIG_DIGEST"
+
+// EnvAccountFamily is a plugin environment variable which sets the [BrokerConfig.AccountFamily] for [ServeMedian] and
+// [ServeRelayer], in order to validate the accounts of ContractTransmitters from the host.
+const EnvAccountFamily = "CL_ACCOUNT_FAMILY"
+
+// Plugin environment variables which configure the [GRPCPluginMedian.ObservationTimestampSkew] applied by
+// [ServeMedian]. Set them on the host via [LaunchConfig.Env].
+const (
+	EnvObservationTimestampMaxPast   = "CL_OBSERVATION_TIMESTAMP_MAX_PAST"
+	EnvObservationTimestampMaxFuture = "CL_OBSERVATION_TIMESTAMP_MAX_FUTURE"
+	EnvObservationTimestampClamp     = "CL_OBSERVATION_TIMESTAMP_CLAMP"
+)
+
+// ServeRelayer is a plugin main() helper which serves the [PluginRelayer] returned by newImpl, and does not return
+// until the host terminates the plugin. See [ServeMedian].
+func ServeRelayer(newImpl func(logger.Logger) PluginRelayer) {
+	serve(PluginRelayerName, PluginRelayerHandshakeConfig(), func(lggr logger.Logger, cfg BrokerConfig) plugin.Plugin {
+		return &GRPCPluginRelayer{PluginServer: newImpl(lggr), BrokerConfig: cfg}
+	})
+}
+
+// ServeMedian is a plugin main() helper which serves the [types.PluginMedian] returned by newImpl, and does not
+// return until the host terminates the plugin.
+//
+// The logger passed to newImpl encodes hclog compatible JSON to stderr, at the level from [EnvLogLevel] (default
+// debug, since the host filters). Telemetry is set up via [SetupTelemetry], and SIGTERM closes the
+// [BrokerConfig.StopCh] and stops the server gracefully, for up to five seconds, before exiting. Reporting plugin
+// requests are limited by [EnvReportingMaxConcurrency] and [EnvReportingQueueTimeout] (default unlimited), config
+// digests are checked if [EnvCheckConfigDigest] is set, observation timestamps are checked if
+// [EnvObservationTimestampMaxPast] or [EnvObservationTimestampMaxFuture] is set, and the accounts of the host's
+// ContractTransmitters are validated if [EnvAccountFamily] is set.
+func ServeMedian(newImpl func(logger.Logger) types.PluginMedian) {
+	limit, err := envConcurrencyLimit()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid concurrency limit: %v\n", err)
+		os.Exit(1)
+	}
+	checkDigest, err := envCheckConfigDigest()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid %s: %v\n", EnvCheckConfigDigest, err)
+		os.Exit(1)
+	}
+	skew, err := envObservationTimestampSkew()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid observation timestamp skew: %v\n", err)
+		os.Exit(1)
+	}
+	serve(PluginMedianName, PluginMedianHandshakeConfig(), func(lggr logger.Logger, cfg BrokerConfig) plugin.Plugin {
+		return &GRPCPluginMedian{PluginServer: newImpl(lggr), BrokerConfig: cfg, ConcurrencyLimit: limit, CheckConfigDigest: checkDigest, ObservationTimestampSkew: skew}
+	})
+}
+
+// shutdownTimeout bounds how long serve waits for its gRPC server to stop gracefully after SIGTERM, before exiting
+// anyway. Long-lived streams only end once the host disconnects.
+const shutdownTimeout = 5 * time.Second
+
+// logFields are static key/value pairs added to every log line by serve. See [SetLogFields].
+var logFields []any
+
+// SetLogFields sets static key/value pairs to include in every log line from this plugin, including those from
+// go-plugin and the logger passed to newImpl. They are passed through to the host along with its own fields, like the
+// plugin name and pid. It must be called from main() before [ServeMedian] or [ServeRelayer].
+func SetLogFields(keysAndValues ...any) {
+	logFields = keysAndValues
+}
+
+func serve(name string, handshake plugin.HandshakeConfig, newPlugin func(logger.Logger, BrokerConfig) plugin.Plugin) {
+	lvl, err := envLogLevel()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid %s: %v\n", EnvLogLevel, err)
+		os.Exit(1)
+	}
+	lggr, err := newLogger(lvl)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to create logger: %v\n", err)
+		os.Exit(1)
+	}
+	if len(logFields) > 0 {
+		lggr = logger.With(lggr, logFields...)
+	}
+	lggr = logger.Named(lggr, name)
+	family, err := envAccountFamily()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid %s: %v\n", EnvAccountFamily, err)
+		os.Exit(1)
+	}
+
+	var server atomic.Pointer[grpc.Server] // set once serving
+	stopCh := make(chan struct{})
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGTERM)
+	go func() {
+		sig := <-sigCh
+		lggr.Infow("Received signal, shutting down", "signal", sig)
+		close(stopCh)
+		if s := server.Load(); s != nil {
+			gracefulStop(lggr, s, shutdownTimeout)
+		}
+		_ = lggr.Sync()
+		os.Exit(0)
+	}()
+
+	grpcOpts := SetupTelemetry(nil)
+	p := newPlugin(lggr, BrokerConfig{StopCh: stopCh, Logger: lggr, GRPCOpts: grpcOpts, AccountFamily: family})
+	plugin.Serve(&plugin.ServeConfig{
+		HandshakeConfig: handshake,
+		Plugins:         map[string]plugin.Plugin{name: p},
+		GRPCServer: func(opts []grpc.ServerOption) *grpc.Server {
+			s := grpcOpts.NewServer(opts)
+			server.Store(s)
+			return s
+		},
+		Logger: HCLogLogger(lggr),
+	})
+}
+
+// gracefulStop stops server after in-flight RPCs complete, or forcibly after timeout.
+func gracefulStop(lggr logger.Logger, server *grpc.Server, timeout time.Duration) {
+	t := time.AfterFunc(timeout, func() {
+		lggr.Warnw("Forcing stop of server after shutdown timeout", "timeout", timeout)
+		server.Stop()
+	})
+	defer t.Stop()
+	server.GracefulStop()
+}
+
+// envLogLevel returns the level from [EnvLogLevel], or debug if unset.
+func envLogLevel() (lvl zapcore.Level, err error) {
+	lvl = zapcore.DebugLevel
+	if s := os.Getenv(EnvLogLevel); s != "" {
+		err = lvl.UnmarshalText([]byte(s))
+	}
+	return
+}
+
+// envAccountFamily returns the family from [EnvAccountFamily], which must have a registered validator, or empty if
+// unset.
+func envAccountFamily() (string, error) {
+	family := os.Getenv(EnvAccountFamily)
+	if family != "" && !types.HasAccountValidator(family) {
+		return "", fmt.Errorf("no account validator registered for %q", family)
+	}
+	return family, nil
+}
+
+// envConcurrencyLimit returns the limit from [EnvReportingMaxConcurrency] and [EnvReportingQueueTimeout], or
+// unlimited if unset.
+func envConcurrencyLimit() (limit ConcurrencyLimit, err error) {
+	if s := os.Getenv(EnvReportingMaxConcurrency); s != "" {
+		if limit.Max, err = strconv.Atoi(s); err != nil {
+			return limit, fmt.Errorf("%s: %w", EnvReportingMaxConcurrency, err)
+		}
+	}
+	if s := os.Getenv(EnvReportingQueueTimeout); s != "" {
+		if limit.QueueTimeout, err = time.ParseDuration(s); err != nil {
+			return limit, fmt.Errorf("%s: %w", EnvReportingQueueTimeout, err)
+		}
+	}
+	err = limit.Validate()
+	return
+}
+
+// envCheckConfigDigest returns the value of [EnvCheckConfigDigest], or false if unset.
+func envCheckConfigDigest() (bool, error) {
+	if s := os.Getenv(EnvCheckConfigDigest); s != "" {
+		return strconv.ParseBool(s)
+	}
+	return false, nil
+}
+
+// envObservationTimestampSkew returns the skew from [EnvObservationTimestampMaxPast],
+// [EnvObservationTimestampMaxFuture], and [EnvObservationTimestampClamp], or unlimited if unset.
+func envObservationTimestampSkew() (skew types.TimestampSkew, err error) {
+	if s := os.Getenv(EnvObservationTimestampMaxPast); s != "" {
+		if skew.MaxPast, err = time.ParseDuration(s); err != nil {
+			return skew, fmt.Errorf("%s: %w", EnvObservationTimestampMaxPast, err)
+		}
+	}
+	if s := os.Getenv(EnvObservationTimestampMaxFuture); s != "" {
+		if skew.MaxFuture, err = time.ParseDuration(s); err != nil {
+			return skew, fmt.Errorf("%s: %w", EnvObservationTimestampMaxFuture, err)
+		}
+	}
+	if s := os.Getenv(EnvObservationTimestampClamp); s != "" {
+		if skew.Clamp, err = strconv.ParseBool(s); err != nil {
+			return skew, fmt.Errorf("%s: %w", EnvObservationTimestampClamp, err)
+		}
+	}
+	err = skew.Validate()
+	return
+}
@@ -0,0 +1,63 @@
+package loop_test
+
+import (
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/buildinfo"
+	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/internal/test"
+	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
+)
+
+func TestServeRelayer(t *testing.T) {
+	t.Parallel()
+	relayer := loop.NewRelayerService(logger.Test(t), loop.GRPCOpts{}, func() *exec.Cmd {
+		cmd := helperProcess("serve-" + loop.PluginRelayerName)
+		cmd.Env = append(cmd.Env, loop.EnvLogLevel+"=info")
+		return cmd
+	}, test.ConfigTOML, test.StaticKeystore{})
+	require.NoError(t, relayer.Start(utils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, relayer.Close()) })
+
+	test.TestRelayer(t, relayer)
+
+	// The plugin is served by this test binary, and reports its version once healthy.
+	require.Eventually(t, func() bool {
+		_, ok := relayer.PluginVersion()
+		return ok
+	}, 15*time.Second, 100*time.Millisecond)
+	version, _ := relayer.PluginVersion()
+	assert.Equal(t, buildinfo.Get(), version)
+}
+
+func TestServeMedian(t *testing.T) {
+	t.Parallel()
+	lggr, observed := logger.TestObserved(t, zapcore.DebugLevel)
+	var path string
+	median := loop.NewMedianService(lggr, loop.GRPCOpts{}, func() *exec.Cmd {
+		cmd := helperProcess("serve-" + loop.PluginMedianName)
+		cmd.Env = append(cmd.Env, loop.EnvCheckConfigDigest+"=true")
+		path = cmd.Path
+		return cmd
+	}, test.StaticMedianProvider{}, test.StaticDataSource(), test.StaticJuelsPerFeeCoinDataSource(), &test.StaticErrorLog{})
+	require.NoError(t, median.Start(utils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, median.Close()) })
+
+	test.TestReportingPluginFactory(t, median)
+
+	// Logs from the plugin are tagged by the host, and with the fields set by the plugin.
+	logs := observed.FilterField(zap.String("helper", "serve-"+loop.PluginMedianName)).All()
+	require.NotEmpty(t, logs)
+	fields := logs[0].ContextMap()
+	assert.Equal(t, loop.PluginMedianName, fields["plugin"])
+	assert.Equal(t, path, fields["path"])
+	assert.NotZero(t, fields["pid"])
+}
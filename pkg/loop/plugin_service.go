@@ -4,25 +4,117 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os/exec"
-	"sync"
+	"path/filepath"
+	"sync/atomic"
 	"time"
 
 	"github.com/hashicorp/go-plugin"
+	"github.com/jpillora/backoff"
 	"golang.org/x/exp/maps"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/smartcontractkit/chainlink-relay/pkg/buildinfo"
 	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
 	"github.com/smartcontractkit/chainlink-relay/pkg/loop/internal"
+	"github.com/smartcontractkit/chainlink-relay/pkg/services"
 	"github.com/smartcontractkit/chainlink-relay/pkg/types"
 	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
 )
 
 const keepAliveTickDuration = 5 * time.Second //TODO from config
 
+// waitLogInterval is the interval at which callers blocked waiting for a plugin to launch log their progress.
+const waitLogInterval = 10 * time.Second
+
+// ErrPluginRestartsExhausted is reported when a plugin has exceeded [RestartPolicy.MaxAttempts].
+var ErrPluginRestartsExhausted = errors.New("plugin restart attempts exhausted")
+
+// ErrPluginNotReady is returned by calls which waited longer than the max wait for the plugin to launch. See
+// SetMaxWait.
+type ErrPluginNotReady struct {
+	Name   string
+	Waited time.Duration
+	State  string   // of the plugin subprocess, e.g. launching (pid 123, for 1m0s)
+	Stderr []string // the last lines, if any
+}
+
+func (e ErrPluginNotReady) Error() string {
+	msg := fmt.Sprintf("plugin %s not ready after %s: %s", e.Name, e.Waited, e.State)
+	if len(e.Stderr) > 0 {
+		msg += fmt.Sprintf(": last stderr: %q", e.Stderr[len(e.Stderr)-1])
+	}
+	return msg
+}
+
+// RestartPolicy configures the relaunching of plugins which crash, fail to launch, or become unhealthy.
+// Consecutive attempts are delayed exponentially, with jitter, and reset once the plugin is healthy.
+type RestartPolicy struct {
+	// InitialDelay is the delay before the first relaunch, including the first after a stable period.
+	InitialDelay time.Duration
+	// Multiplier increases the delay for each consecutive relaunch.
+	Multiplier float64
+	// MaxDelay caps the delay between relaunches.
+	MaxDelay time.Duration
+	// MaxAttempts is the number of consecutive launches permitted before giving up and reporting
+	// [ErrPluginRestartsExhausted]. Zero is unlimited.
+	MaxAttempts int
+	// StableAfter is how long a launched plugin must stay healthy before the consecutive launches and delay are
+	// reset. Zero resets them on the first healthy check.
+	StableAfter time.Duration
+}
+
+// DefaultRestartPolicy relaunches indefinitely, with delays from one second up to one minute, which are reset after
+// one minute of health.
+var DefaultRestartPolicy = RestartPolicy{
+	InitialDelay: time.Second,
+	Multiplier:   2,
+	MaxDelay:     time.Minute,
+	StableAfter:  time.Minute,
+}
+
+func (p RestartPolicy) validate() error {
+	var err error
+	if p.InitialDelay <= 0 {
+		err = errors.Join(err, fmt.Errorf("InitialDelay must be positive: %s", p.InitialDelay))
+	}
+	if p.Multiplier < 1 {
+		err = errors.Join(err, fmt.Errorf("Multiplier must be at least 1: %v", p.Multiplier))
+	}
+	if p.MaxDelay < p.InitialDelay {
+		err = errors.Join(err, fmt.Errorf("MaxDelay must not be less than InitialDelay: %s < %s", p.MaxDelay, p.InitialDelay))
+	}
+	if p.MaxAttempts < 0 {
+		err = errors.Join(err, fmt.Errorf("MaxAttempts must not be negative: %d", p.MaxAttempts))
+	}
+	if p.StableAfter < 0 {
+		err = errors.Join(err, fmt.Errorf("StableAfter must not be negative: %s", p.StableAfter))
+	}
+	return err
+}
+
+func (p RestartPolicy) backoff() backoff.Backoff {
+	return backoff.Backoff{
+		Min:    p.InitialDelay,
+		Max:    p.MaxDelay,
+		Factor: p.Multiplier,
+		Jitter: true,
+	}
+}
+
 type BrokerConfig = internal.BrokerConfig
 
+// ResourceCounts counts the servers of a broker by name, and its dialed connections. See [BrokerConfig.Resources].
+type ResourceCounts = internal.ResourceCounts
+
+// IDAllocator allocates the IDs of brokered connections. See [BrokerConfig.IDs].
+type IDAllocator = internal.IDAllocator
+
+// DeterministicIDs is an [IDAllocator] which allocates stable IDs, for tests.
+type DeterministicIDs = internal.DeterministicIDs
+
 type grpcPlugin interface {
 	plugin.Plugin
 	plugin.GRPCPlugin
@@ -32,61 +124,128 @@ type grpcPlugin interface {
 // pluginService is a [types.Service] wrapper that maintains an internal [types.Service] created from a [grpcPlugin]
 // client instance by launching and re-launching as necessary.
 type pluginService[P grpcPlugin, S types.Service] struct {
-	utils.StartStopOnce
+	services.StateMachine
 
 	pluginName string
 
 	lggr logger.Logger
 	cmd  func() *exec.Cmd
 
-	wg     sync.WaitGroup
-	stopCh chan struct{}
+	restartPolicy RestartPolicy
+	exhausted     atomic.Bool // true once restartPolicy.MaxAttempts is exceeded
+
+	launchConfig  LaunchConfig
+	featureFlags  types.FeatureFlags  // optional
+	keyValueStore types.KeyValueStore // optional
+	maxWait       time.Duration       // optional
+
+	subs   utils.Subprocesses
+	stopCh utils.StopChan
+	clock  utils.Clock // measures health check intervals and timeouts
 
 	grpcPlug P
 
 	client         *plugin.Client
 	clientProtocol plugin.ClientProtocol
+	clientGroup    *processGroup // optional
 
 	newService func(context.Context, any) (S, error)
 
 	serviceCh chan struct{} // closed when service is available
 	service   S
 
+	version atomic.Pointer[buildinfo.Info] // reported by the running plugin, if available
+
+	process   atomic.Pointer[pluginProcess] // the launched plugin process, if any
+	launching atomic.Pointer[launchStage]   // the current stage of launch, if launching
+	stderr    lineTail                      // the last lines from the plugin, across launches
+	launches  atomic.Int64                  // successful launches
+	resources ResourceCounts                // served to the plugin
+
 	testInterrupt chan func(*pluginService[P, S]) // tests only (via TestHook) to enable access to internals without racing
 }
 
-func (s *pluginService[P, S]) init(pluginName string, p P, newService func(context.Context, any) (S, error), lggr logger.Logger, cmd func() *exec.Cmd, stopCh chan struct{}) {
+func (s *pluginService[P, S]) init(pluginName string, p P, newService func(context.Context, any) (S, error), lggr logger.Logger, cmd func() *exec.Cmd, stopCh utils.StopChan) {
 	s.pluginName = pluginName
 	s.lggr = lggr
+	s.subs.Logger = lggr
 	s.cmd = cmd
+	s.restartPolicy = DefaultRestartPolicy
+	s.launchConfig = DefaultLaunchConfig
 	s.stopCh = stopCh
+	s.clock = utils.RealClock
 	s.grpcPlug = p
 	s.newService = newService
 	s.serviceCh = make(chan struct{})
 }
 
 func (s *pluginService[P, S]) keepAlive() {
-	defer s.wg.Done()
-
-	s.lggr.Debugw("Staring keepAlive", "tick", keepAliveTickDuration)
-
-	t := time.NewTicker(keepAliveTickDuration)
+	s.lggr.Debugw("Staring keepAlive", "tick", keepAliveTickDuration, "restartPolicy", s.restartPolicy)
+
+	b := s.restartPolicy.backoff()
+	var attempts int           // consecutive, since last stable
+	var launched bool          // ever, so that relaunches are delayed
+	var healthySince time.Time // since last unhealthy
+	var versionChecked bool    // since last launch
+	t := s.clock.NewTicker(keepAliveTickDuration)
 	defer t.Stop()
 	for {
 		select {
 		case <-s.stopCh:
 			return
-		case <-t.C:
+		case <-t.C():
+			if s.exhausted.Load() {
+				continue
+			}
 			c := s.client
 			cp := s.clientProtocol
 			if c != nil && !c.Exited() && cp != nil {
 				// launched
 				err := cp.Ping()
 				if err == nil {
+					now := s.clock.Now()
+					if healthySince.IsZero() {
+						healthySince = now
+					}
+					if attempts > 0 && now.Sub(healthySince) >= s.restartPolicy.StableAfter {
+						// Only once stable, so that a plugin failing shortly after each launch still backs off.
+						attempts = 0
+						b.Reset()
+					}
+					if !versionChecked {
+						versionChecked = true
+						// Not while launching, since calls block until the service connection is refreshed.
+						s.subs.GoNamed("updateVersion", s.updateVersion)
+					}
 					continue // healthy
 				}
 				s.lggr.Errorw("Relaunching unhealthy plugin", "err", err)
 			}
+			healthySince = time.Time{}
+			if max := s.restartPolicy.MaxAttempts; max > 0 && attempts >= max {
+				s.exhausted.Store(true)
+				logger.Criticalw(s.lggr, "Giving up on relaunching plugin", "attempts", attempts, "err", ErrPluginRestartsExhausted)
+				if cerr := s.closeClient(); cerr != nil {
+					s.lggr.Errorw("Error closing client", "err", cerr)
+				}
+				s.client, s.clientProtocol, s.clientGroup = nil, nil, nil
+				continue
+			}
+			if launched {
+				wait := b.Duration()
+				s.lggr.Infow("Waiting to relaunch plugin", "wait", wait, "attempts", attempts)
+				timer := s.clock.NewTimer(wait)
+				select {
+				case <-s.stopCh:
+					timer.Stop()
+					return
+				case <-timer.C():
+				}
+			}
+			attempts++
+			launched = true
+			versionChecked = false
+			s.version.Store(nil)
 			if err := s.tryLaunch(cp); err != nil {
 				s.lggr.Errorw("Failed to launch plugin", "err", err)
 			}
@@ -104,59 +263,195 @@ func (s *pluginService[P, S]) tryLaunch(old plugin.ClientProtocol) (err error) {
 	if cerr := s.closeClient(); cerr != nil {
 		s.lggr.Errorw("Error closing old client", "err", cerr)
 	}
-	s.client, s.clientProtocol, err = s.launch()
+	s.client, s.clientProtocol, s.clientGroup, err = s.launch()
 	return
 }
 
-func (s *pluginService[P, S]) launch() (*plugin.Client, plugin.ClientProtocol, error) {
-	ctx, cancelFn := utils.ContextFromChan(s.stopCh)
+func (s *pluginService[P, S]) launch() (*plugin.Client, plugin.ClientProtocol, *processGroup, error) {
+	ctx, cancelFn := s.stopCh.NewCtx()
 	defer cancelFn()
 
 	s.lggr.Debug("Launching")
+	started := s.clock.Now()
+	s.launching.Store(&launchStage{name: "starting", started: started})
+	defer s.launching.Store(nil)
 
 	cc := s.grpcPlug.ClientConfig()
-	cc.Cmd = s.cmd()
+	cmd := s.cmd()
+	s.launchConfig.apply(cmd)
+	// Plugins may spawn their own subprocesses, which must not outlive them.
+	setProcessGroup(cmd)
+	// Parse stderr ourselves, and skip the lines go-plugin would otherwise log from the sub-logger named after the cmd.
+	// Tag all logs about the process, so that they can be filtered reliably when running multiple plugins.
+	fields := processFields(s.pluginName, cmd, s.PluginVersion)
+	stderrLogger := newStderrLogger(s.lggr, fields)
+	stderrLogger.tail = &s.stderr
+	var stderr io.Writer = stderrLogger
+	if s.launchConfig.Stderr != nil {
+		stderr = io.MultiWriter(stderr, s.launchConfig.Stderr)
+	}
+	cc.SyncStdout = s.launchConfig.SyncStdout
+	cc.SyncStderr = s.launchConfig.SyncStderr
+	cc.Logger = hclogLogger(s.lggr, filepath.Base(cmd.Path), fields)
+	killGroup := func(group *processGroup) {
+		if group == nil {
+			return
+		}
+		if kerr := group.kill(); kerr != nil {
+			s.lggr.Errorw("Error killing plugin subprocesses", "err", kerr)
+		}
+	}
+	var group *processGroup
+	if s.launchConfig.IsolateEnv {
+		cmd.Stderr = stderr
+		err := startPlugin(logger.With(s.lggr, fields()...), cc, cmd, func(cmd *exec.Cmd) {
+			var gerr error
+			if group, gerr = newProcessGroup(cmd); gerr != nil {
+				s.lggr.Errorw("Failed to track plugin subprocesses", "err", gerr)
+			}
+		})
+		if err != nil {
+			killGroup(group)
+			return nil, nil, nil, fmt.Errorf("failed to start plugin: %w", err)
+		}
+	} else {
+		cc.Cmd = cmd
+		cc.Stderr = stderr
+	}
 	client := plugin.NewClient(cc)
 	cp, err := client.Client()
+	if group == nil && cmd.Process != nil {
+		// started by go-plugin
+		var gerr error
+		if group, gerr = newProcessGroup(cmd); gerr != nil {
+			s.lggr.Errorw("Failed to track plugin subprocesses", "err", gerr)
+		}
+	}
 	if err != nil {
 		client.Kill()
-		return nil, nil, fmt.Errorf("failed to create ClientProtocol: %w", err)
+		killGroup(group)
+		return nil, nil, nil, fmt.Errorf("failed to create ClientProtocol: %w", err)
 	}
+	launched := &pluginProcess{client: client, launched: s.clock.Now(), pid: cmd.Process.Pid}
+	s.launching.Store(&launchStage{name: "dispensing", started: started, process: launched})
 	abort := func() {
 		if cerr := cp.Close(); cerr != nil {
 			s.lggr.Errorw("Error closing ClientProtocol", "err", cerr)
 		}
 		client.Kill()
+		killGroup(group)
 	}
 	i, err := cp.Dispense(s.pluginName)
 	if err != nil {
 		abort()
-		return nil, nil, fmt.Errorf("failed to Dispense %q plugin: %w", s.pluginName, err)
+		return nil, nil, nil, fmt.Errorf("failed to Dispense %q plugin: %w", s.pluginName, err)
 	}
 
 	select {
 	case <-s.serviceCh:
 		// s.service already set
 	default:
+		if s.featureFlags != nil {
+			ctx = types.ContextWithFeatureFlags(ctx, s.featureFlags)
+		}
+		if s.keyValueStore != nil {
+			ctx = types.ContextWithKeyValueStore(ctx, s.keyValueStore)
+		}
+		s.launching.Store(&launchStage{name: "creating service", started: started, process: launched})
 		s.service, err = s.newService(ctx, i)
 		if err != nil {
 			abort()
-			return nil, nil, fmt.Errorf("failed to create service: %w", err)
+			return nil, nil, nil, fmt.Errorf("failed to create service: %w", err)
 		}
 		defer close(s.serviceCh)
 	}
-	return client, cp, nil
+	s.process.Store(launched)
+	s.launches.Add(1)
+	return client, cp, group, nil
+}
+
+// updateVersion logs and records the build info reported by the running plugin.
+func (s *pluginService[P, S]) updateVersion() {
+	vs, ok := any(s.service).(VersionedService)
+	if !ok {
+		return
+	}
+	ctx, cancel := s.stopCh.CtxCancel(utils.WithTimeout(context.Background(), s.clock, keepAliveTickDuration))
+	defer cancel()
+	info, err := vs.Version(ctx)
+	if err != nil {
+		s.lggr.Debugw("Plugin version unavailable", "err", err)
+		return
+	}
+	s.version.Store(&info)
+	s.lggr.Infow("Plugin version", "version", info.Version, "sha", info.Sha, "date", info.Date)
+}
+
+// PluginVersion returns the build info reported by the running plugin, if available. Older plugins do not report it.
+func (s *pluginService[P, S]) PluginVersion() (buildinfo.Info, bool) {
+	if v := s.version.Load(); v != nil {
+		return *v, true
+	}
+	return buildinfo.Info{}, false
+}
+
+// SetRestartPolicy overrides the [DefaultRestartPolicy]. It must be called before Start.
+func (s *pluginService[P, S]) SetRestartPolicy(p RestartPolicy) error {
+	if err := p.validate(); err != nil {
+		return fmt.Errorf("invalid RestartPolicy: %w", err)
+	}
+	s.restartPolicy = p
+	return nil
+}
+
+// SetLaunchConfig overrides the [DefaultLaunchConfig]. It must be called before Start.
+func (s *pluginService[P, S]) SetLaunchConfig(c LaunchConfig) error {
+	if err := c.validate(); err != nil {
+		return fmt.Errorf("invalid LaunchConfig: %w", err)
+	}
+	s.launchConfig = c
+	return nil
+}
+
+// SetMaxWait limits the time that calls will wait for the plugin to launch, before failing with [ErrPluginNotReady].
+// Zero is unlimited. It must be called before Start.
+func (s *pluginService[P, S]) SetMaxWait(d time.Duration) error {
+	if d < 0 {
+		return fmt.Errorf("max wait must not be negative: %s", d)
+	}
+	s.maxWait = d
+	return nil
+}
+
+// SetFeatureFlags sets flags to deliver to the plugin, in order to toggle experimental behaviors. It must be called
+// before Start.
+func (s *pluginService[P, S]) SetFeatureFlags(flags types.FeatureFlags) {
+	s.featureFlags = flags
+}
+
+// SetClock overrides the [utils.RealClock], which measures health check intervals and timeouts, e.g. with a
+// [utils.FakeClock] for tests. It must be called before Start.
+func (s *pluginService[P, S]) SetClock(clock utils.Clock) {
+	s.clock = clock
+}
+
+// SetKeyValueStore sets a store to serve to the plugin, so that it can persist state on the host, e.g. in the node's
+// database. See [types.KeyValueStoreFromContext]. It must be called before Start.
+func (s *pluginService[P, S]) SetKeyValueStore(store types.KeyValueStore) {
+	s.keyValueStore = store
 }
 
 func (s *pluginService[P, S]) Start(context.Context) error {
 	return s.StartOnce("PluginService", func() error {
-		s.wg.Add(1)
-		go s.keepAlive()
+		s.subs.GoNamed("keepAlive", s.keepAlive)
 		return nil
 	})
 }
 
 func (s *pluginService[P, S]) Ready() error {
+	if s.exhausted.Load() {
+		return ErrPluginRestartsExhausted
+	}
 	select {
 	case <-s.serviceCh:
 		return s.service.Ready()
@@ -168,10 +463,25 @@ func (s *pluginService[P, S]) Ready() error {
 func (s *pluginService[P, S]) Name() string { return s.lggr.Name() }
 
 func (s *pluginService[P, S]) HealthReport() map[string]error {
+	if s.exhausted.Load() {
+		return map[string]error{s.Name(): ErrPluginRestartsExhausted}
+	}
+	if err := s.subs.Err(); err != nil {
+		// keepAlive panicked, and no longer relaunches the plugin.
+		return map[string]error{s.Name(): err}
+	}
 	select {
 	case <-s.serviceCh:
 		hr := map[string]error{s.Name(): s.Healthy()}
 		maps.Copy(hr, s.service.HealthReport())
+		if v := s.version.Load(); v != nil {
+			// Identify the build, for operators.
+			for name, err := range hr {
+				if err != nil {
+					hr[name] = fmt.Errorf("%w (plugin %s)", err, v)
+				}
+			}
+		}
 		return hr
 	default:
 		return map[string]error{s.Name(): ErrPluginUnavailable}
@@ -181,7 +491,8 @@ func (s *pluginService[P, S]) HealthReport() map[string]error {
 func (s *pluginService[P, S]) Close() error {
 	return s.StopOnce("PluginService", func() (err error) {
 		close(s.stopCh)
-		s.wg.Wait()
+		s.subs.Wait()
+		err = s.subs.Err()
 
 		select {
 		case <-s.serviceCh:
@@ -196,6 +507,7 @@ func (s *pluginService[P, S]) Close() error {
 }
 
 func (s *pluginService[P, S]) closeClient() (err error) {
+	s.process.Store(nil)
 	if s.clientProtocol != nil {
 		if cerr := s.clientProtocol.Close(); !errors.Is(cerr, context.Canceled) {
 			err = cerr
@@ -204,14 +516,71 @@ func (s *pluginService[P, S]) closeClient() (err error) {
 	if s.client != nil {
 		s.client.Kill()
 	}
+	if s.clientGroup != nil {
+		// Kill any orphaned descendants, which would otherwise outlive the plugin.
+		if kerr := s.clientGroup.kill(); kerr != nil {
+			err = errors.Join(err, fmt.Errorf("failed to kill plugin subprocesses: %w", kerr))
+		}
+	}
 	return
 }
 
+// wait blocks until the service is available, ctx is done, or the max wait has elapsed. Progress is logged
+// periodically, along with the state of the plugin subprocess, so that stuck launches can be diagnosed.
 func (s *pluginService[P, S]) wait(ctx context.Context) error {
 	select {
-	case <-ctx.Done():
-		return context.Cause(ctx)
 	case <-s.serviceCh:
 		return nil
+	default:
+	}
+	start := s.clock.Now()
+	t := s.clock.NewTicker(waitLogInterval)
+	defer t.Stop()
+	var timeout <-chan time.Time
+	if s.maxWait > 0 {
+		timer := s.clock.NewTimer(s.maxWait)
+		defer timer.Stop()
+		timeout = timer.C()
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return context.Cause(ctx)
+		case <-s.serviceCh:
+			return nil
+		case <-timeout:
+			return ErrPluginNotReady{Name: s.Name(), Waited: s.clock.Now().Sub(start), State: s.launchState(), Stderr: s.stderr.get()}
+		case <-t.C():
+			s.lggr.Infow("Waiting for plugin to launch", "elapsed", s.clock.Now().Sub(start), "state", s.launchState(), "stderr", s.stderr.get())
+		}
+	}
+}
+
+// launchStage is a stage of launch, for diagnostics.
+type launchStage struct {
+	name    string
+	started time.Time      // the launch
+	process *pluginProcess // once started
+}
+
+// launchState describes the state of the plugin subprocess, for diagnostics.
+func (s *pluginService[P, S]) launchState() string {
+	if s.exhausted.Load() {
+		return ErrPluginRestartsExhausted.Error()
+	}
+	l := s.launching.Load()
+	if l == nil {
+		if p := s.process.Load(); p != nil && !p.client.Exited() {
+			return fmt.Sprintf("running (pid %d)", p.pid)
+		}
+		return fmt.Sprintf("waiting to launch (%d previous launches)", s.launches.Load())
+	}
+	elapsed := s.clock.Now().Sub(l.started)
+	if l.process == nil {
+		return fmt.Sprintf("%s (for %s)", l.name, elapsed)
+	}
+	if l.process.client.Exited() {
+		return fmt.Sprintf("exited while %s (pid %d)", l.name, l.process.pid)
 	}
+	return fmt.Sprintf("%s (pid %d, for %s)", l.name, l.process.pid, elapsed)
 }
@@ -0,0 +1,185 @@
+package loop
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-plugin"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+)
+
+// Defaults of go-plugin, which must be applied before it would, in order to hand them to the plugin ourselves.
+const (
+	defaultPluginMinPort      = 10000
+	defaultPluginMaxPort      = 25000
+	defaultPluginStartTimeout = time.Minute
+	// pluginWaitDelay bounds waiting for the plugin's output to be copied once it has exited, in case descendants
+	// still hold its stdout or stderr open.
+	pluginWaitDelay = 5 * time.Second
+)
+
+// startPlugin starts cmd with exactly cmd.Env and the handshake variables of cc, waits for the plugin to report its
+// address, and updates cc to reattach to it, for [LaunchConfig.IsolateEnv]. go-plugin would otherwise start cmd itself,
+// and append the entire host environment to it, which takes precedence over cmd.Env. onStart is called right after
+// the process starts, and before the handshake, e.g. to track any descendants from the start.
+//
+// cmd.Stdout must be unset, since it carries the handshake. The process is killed if the handshake fails.
+func startPlugin(lggr logger.Logger, cc *plugin.ClientConfig, cmd *exec.Cmd, onStart func(*exec.Cmd)) error {
+	if cc.AutoMTLS {
+		return errors.New("AutoMTLS is not supported with an isolated environment")
+	}
+	if cc.SecureConfig != nil {
+		if ok, err := cc.SecureConfig.Check(cmd.Path); err != nil {
+			return fmt.Errorf("error verifying checksum: %w", err)
+		} else if !ok {
+			return plugin.ErrChecksumsDoNotMatch
+		}
+	}
+	if cc.MinPort == 0 && cc.MaxPort == 0 {
+		cc.MinPort, cc.MaxPort = defaultPluginMinPort, defaultPluginMaxPort
+	}
+	timeout := cc.StartTimeout
+	if timeout == 0 {
+		timeout = defaultPluginStartTimeout
+	}
+	versions := map[int]plugin.PluginSet{int(cc.ProtocolVersion): cc.Plugins}
+	for v, ps := range cc.VersionedPlugins {
+		versions[v] = ps
+	}
+	versionStrings := make([]string, 0, len(versions))
+	for v := range versions {
+		versionStrings = append(versionStrings, strconv.Itoa(v))
+	}
+	sort.Strings(versionStrings)
+	cmd.Env = append(cmd.Env[:len(cmd.Env):len(cmd.Env)],
+		fmt.Sprintf("%s=%s", cc.MagicCookieKey, cc.MagicCookieValue),
+		fmt.Sprintf("PLUGIN_MIN_PORT=%d", cc.MinPort),
+		fmt.Sprintf("PLUGIN_MAX_PORT=%d", cc.MaxPort),
+		fmt.Sprintf("PLUGIN_PROTOCOL_VERSIONS=%s", strings.Join(versionStrings, ",")),
+	)
+	cmd.Stdin = os.Stdin
+	cmd.WaitDelay = pluginWaitDelay
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	if err = cmd.Start(); err != nil {
+		return err
+	}
+	if onStart != nil {
+		onStart(cmd)
+	}
+	pid := cmd.Process.Pid
+	exited := make(chan struct{})
+	lines := make(chan string)
+	go func() {
+		defer close(lines)
+		scanner := bufio.NewScanner(stdout)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+		// drain, so that the plugin never blocks writing to stdout
+		_, _ = io.Copy(io.Discard, stdout)
+	}()
+	// Log the remaining lines once the handshake is done, successfully or not, so that the scanner never blocks.
+	defer func() {
+		go func() {
+			for line := range lines {
+				lggr.Debugw("Plugin stdout", "pid", pid, "line", line)
+			}
+		}()
+	}()
+	go func() {
+		defer close(exited)
+		// Reap the process, so that go-plugin sees it exit.
+		if werr := cmd.Wait(); werr != nil {
+			lggr.Errorw("Plugin process exited", "pid", pid, "err", werr)
+		} else {
+			lggr.Infow("Plugin process exited", "pid", pid)
+		}
+	}()
+
+	select {
+	case <-time.After(timeout):
+		err = errors.New("timeout while waiting for plugin to start")
+	case <-exited:
+		err = errors.New("plugin exited before we could connect")
+	case line, ok := <-lines:
+		if !ok {
+			err = errors.New("plugin closed stdout before we could connect")
+			break
+		}
+		cc.Reattach, err = parseHandshake(cc, versions, line, pid)
+	}
+	if err != nil {
+		_ = cmd.Process.Kill()
+		<-exited
+		return err
+	}
+	cc.Cmd = nil
+	return nil
+}
+
+// parseHandshake parses the line written by go-plugin to announce the address of a plugin, like go-plugin does when it
+// launches a plugin itself.
+func parseHandshake(cc *plugin.ClientConfig, versions map[int]plugin.PluginSet, line string, pid int) (*plugin.ReattachConfig, error) {
+	parts := strings.SplitN(strings.TrimSpace(line), "|", 6)
+	if len(parts) < 4 {
+		return nil, fmt.Errorf("unrecognized plugin handshake: %q", line)
+	}
+	if core, err := strconv.Atoi(parts[0]); err != nil {
+		return nil, fmt.Errorf("failed to parse core protocol version: %w", err)
+	} else if core != plugin.CoreProtocolVersion {
+		return nil, fmt.Errorf("incompatible core protocol version %d: expected %d", core, plugin.CoreProtocolVersion)
+	}
+	version, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse protocol version: %w", err)
+	}
+	ps, ok := versions[version]
+	if !ok {
+		return nil, fmt.Errorf("incompatible protocol version %d", version)
+	}
+	cc.Plugins = ps
+
+	var addr net.Addr
+	switch parts[2] {
+	case "tcp":
+		addr, err = net.ResolveTCPAddr("tcp", parts[3])
+	case "unix":
+		addr, err = net.ResolveUnixAddr("unix", parts[3])
+	default:
+		err = fmt.Errorf("unknown address type: %s", parts[2])
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	protocol := plugin.ProtocolNetRPC
+	if len(parts) >= 5 {
+		protocol = plugin.Protocol(parts[4])
+	}
+	allowed := cc.AllowedProtocols
+	if len(allowed) == 0 {
+		allowed = []plugin.Protocol{plugin.ProtocolNetRPC}
+	}
+	var found bool
+	for _, p := range allowed {
+		found = found || p == protocol
+	}
+	if !found {
+		return nil, fmt.Errorf("unsupported plugin protocol %q: expected one of %v", protocol, allowed)
+	}
+	return &plugin.ReattachConfig{Protocol: protocol, ProtocolVersion: version, Addr: addr, Pid: pid}, nil
+}
@@ -16,6 +16,8 @@ import (
 	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
 	"github.com/smartcontractkit/chainlink-relay/pkg/loop"
 	"github.com/smartcontractkit/chainlink-relay/pkg/loop/internal/test"
+	"github.com/smartcontractkit/chainlink-relay/pkg/reportingplugins/median"
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
 	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
 )
 
@@ -67,6 +69,10 @@ func testPlugin[I any](t *testing.T, name string, p plugin.Plugin, testFn func(*
 	require.Error(t, clientProtocol.Ping())
 }
 
+// envHelperSubprocessPIDFile directs the helper process to launch a long-running subprocess of its own, and write its
+// pid to the named file.
+const envHelperSubprocessPIDFile = "HELPER_SUBPROCESS_PIDFILE"
+
 func helperProcess(s ...string) *exec.Cmd {
 	cs := []string{"-test.run=TestHelperProcess", "--"}
 	cs = append(cs, s...)
@@ -105,6 +111,20 @@ func TestHelperProcess(t *testing.T) {
 
 	cmd, args := args[0], args[1:]
 
+	if pidFile := os.Getenv(envHelperSubprocessPIDFile); pidFile != "" {
+		// Simulate a plugin which launches its own helper process.
+		sub := helperProcess("sleep")
+		sub.Env = append(sub.Env, envHelperSubprocessPIDFile+"=")
+		if err := sub.Start(); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to start subprocess: %s\n", err)
+			os.Exit(2)
+		}
+		if err := os.WriteFile(pidFile, []byte(strconv.Itoa(sub.Process.Pid)), 0600); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to write pid file: %s\n", err)
+			os.Exit(2)
+		}
+	}
+
 	limit := -1
 	if len(args) > 0 {
 		var err error
@@ -147,6 +167,30 @@ func TestHelperProcess(t *testing.T) {
 		})
 		os.Exit(0)
 
+	case "sleep":
+		time.Sleep(time.Hour)
+		os.Exit(0)
+
+	case "env":
+		// Report the environment, instead of serving.
+		for _, kv := range os.Environ() {
+			fmt.Fprintln(os.Stderr, kv)
+		}
+		os.Exit(0)
+
+	case "serve-" + loop.PluginRelayerName:
+		loop.ServeRelayer(func(logger.Logger) loop.PluginRelayer { return test.StaticPluginRelayer{} })
+		os.Exit(0)
+
+	case "serve-" + loop.PluginMedianName:
+		loop.SetLogFields("helper", cmd)
+		loop.ServeMedian(func(logger.Logger) types.PluginMedian { return test.StaticPluginMedian{} })
+		os.Exit(0)
+
+	case "chainlink-median":
+		loop.ServeMedian(func(lggr logger.Logger) types.PluginMedian { return median.NewPlugin(lggr) })
+		os.Exit(0)
+
 	default:
 		fmt.Fprintf(os.Stderr, "Unknown command: %q\n", cmd)
 		os.Exit(2)
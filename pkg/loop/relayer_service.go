@@ -6,6 +6,10 @@ import (
 	"fmt"
 	"math/big"
 	"os/exec"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
 	"github.com/smartcontractkit/chainlink-relay/pkg/types"
@@ -13,21 +17,31 @@ import (
 
 var ErrPluginUnavailable = errors.New("plugin unavailable")
 
-var _ Relayer = (*RelayerService)(nil)
+var (
+	_ Relayer                 = (*RelayerService)(nil)
+	_ types.Transactor        = (*RelayerService)(nil)
+	_ types.RPCStatusReporter = (*RelayerService)(nil)
+)
 
 // RelayerService is a [types.Service] that maintains an internal [Relayer].
 type RelayerService struct {
 	pluginService[*GRPCPluginRelayer, Relayer]
+
+	providerIdleTimeout time.Duration // optional
 }
 
 // NewRelayerService returns a new [*RelayerService].
 // cmd must return a new exec.Cmd each time it is called.
 func NewRelayerService(lggr logger.Logger, grpcOpts GRPCOpts, cmd func() *exec.Cmd, config string, keystore types.Keystore) *RelayerService {
+	var rs RelayerService
 	newService := func(ctx context.Context, instance any) (Relayer, error) {
 		plug, ok := instance.(PluginRelayer)
 		if !ok {
 			return nil, fmt.Errorf("expected PluginRelayer but got %T", instance)
 		}
+		if rs.providerIdleTimeout > 0 {
+			ctx = ContextWithProviderIdleTimeout(ctx, rs.providerIdleTimeout)
+		}
 		r, err := plug.NewRelayer(ctx, config, keystore)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create Relayer: %w", err)
@@ -36,12 +50,27 @@ func NewRelayerService(lggr logger.Logger, grpcOpts GRPCOpts, cmd func() *exec.C
 	}
 	stopCh := make(chan struct{})
 	lggr = logger.Named(lggr, "RelayerService")
-	var rs RelayerService
-	broker := BrokerConfig{StopCh: stopCh, Logger: lggr, GRPCOpts: grpcOpts}
+	broker := BrokerConfig{StopCh: stopCh, Logger: lggr, GRPCOpts: grpcOpts, Resources: &rs.resources}
 	rs.init(PluginRelayerName, &GRPCPluginRelayer{BrokerConfig: broker}, newService, lggr, cmd, stopCh)
 	return &rs
 }
 
+// SetProviderIdleTimeout enables shutting down providers after being unused for timeout, and restarting them on next
+// use. See [ContextWithProviderIdleTimeout]. It must be called before Start.
+func (r *RelayerService) SetProviderIdleTimeout(timeout time.Duration) {
+	r.providerIdleTimeout = timeout
+}
+
+// SetAccountFamily enables validating the accounts of the ContractTransmitters of providers from the plugin, with the
+// validator registered for family by [types.RegisterAccountValidator]. It must be called before Start.
+func (r *RelayerService) SetAccountFamily(family string) error {
+	if !types.HasAccountValidator(family) {
+		return fmt.Errorf("no account validator registered for %q", family)
+	}
+	r.grpcPlug.BrokerConfig.AccountFamily = family
+	return nil
+}
+
 func (r *RelayerService) NewConfigProvider(ctx context.Context, args types.RelayArgs) (types.ConfigProvider, error) {
 	if err := r.wait(ctx); err != nil {
 		return nil, err
@@ -56,6 +85,13 @@ func (r *RelayerService) NewMedianProvider(ctx context.Context, rargs types.Rela
 	return r.service.NewMedianProvider(ctx, rargs, pargs)
 }
 
+func (r *RelayerService) NewPluginProvider(ctx context.Context, rargs types.RelayArgs, pargs types.PluginArgs) (types.PluginProvider, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	return r.service.NewPluginProvider(ctx, rargs, pargs)
+}
+
 func (r *RelayerService) NewMercuryProvider(ctx context.Context, rargs types.RelayArgs, pargs types.PluginArgs) (types.MercuryProvider, error) {
 	if err := r.wait(ctx); err != nil {
 		return nil, err
@@ -97,3 +133,53 @@ func (r *RelayerService) SendTx(ctx context.Context, chainID, from, to string, a
 	}
 	return r.service.SendTx(ctx, chainID, from, to, amount, balanceCheck)
 }
+
+// Transact fails with codes.Unimplemented if the plugin relayer does not implement [types.Transactor].
+func (r *RelayerService) Transact(ctx context.Context, request types.TransactRequest) (string, error) {
+	t, err := r.transactor(ctx)
+	if err != nil {
+		return "", err
+	}
+	return t.Transact(ctx, request)
+}
+
+// TxStatus fails with codes.Unimplemented if the plugin relayer does not implement [types.Transactor].
+func (r *RelayerService) TxStatus(ctx context.Context, id string) (types.TxStatus, error) {
+	t, err := r.transactor(ctx)
+	if err != nil {
+		return types.TxStatus{}, err
+	}
+	return t.TxStatus(ctx, id)
+}
+
+// SubscribeTxStatus fails with codes.Unimplemented if the plugin relayer does not implement [types.Transactor].
+func (r *RelayerService) SubscribeTxStatus(ctx context.Context, id string, fn func(types.TxStatus) error) error {
+	t, err := r.transactor(ctx)
+	if err != nil {
+		return err
+	}
+	return t.SubscribeTxStatus(ctx, id, fn)
+}
+
+// RPCStatuses fails with codes.Unimplemented if the plugin relayer does not implement [types.RPCStatusReporter].
+func (r *RelayerService) RPCStatuses(ctx context.Context, chainIDs ...string) ([]types.RPCStatus, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	rs, ok := r.service.(types.RPCStatusReporter)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "%T does not implement RPCStatusReporter", r.service)
+	}
+	return rs.RPCStatuses(ctx, chainIDs...)
+}
+
+func (r *RelayerService) transactor(ctx context.Context) (types.Transactor, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	t, ok := r.service.(types.Transactor)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "%T does not implement Transactor", r.service)
+	}
+	return t, nil
+}
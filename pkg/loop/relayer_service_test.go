@@ -1,6 +1,7 @@
 package loop_test
 
 import (
+	"errors"
 	"os/exec"
 	"strconv"
 	"sync/atomic"
@@ -9,10 +10,12 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
 
 	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
 	"github.com/smartcontractkit/chainlink-relay/pkg/loop"
 	"github.com/smartcontractkit/chainlink-relay/pkg/loop/internal/test"
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
 	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
 )
 
@@ -59,3 +62,142 @@ func TestRelayerService_recovery(t *testing.T) {
 
 	test.TestRelayer(t, relayer)
 }
+
+func TestRelayerService_clock(t *testing.T) {
+	t.Parallel()
+	clock := utils.NewFakeClock(time.Now())
+	relayer := loop.NewRelayerService(logger.Test(t), loop.GRPCOpts{}, func() *exec.Cmd {
+		return helperProcess(loop.PluginRelayerName)
+	}, test.ConfigTOML, test.StaticKeystore{})
+	relayer.SetClock(clock)
+	require.NoError(t, relayer.Start(utils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, relayer.Close()) })
+
+	clock.BlockUntil(1)
+	require.ErrorIs(t, relayer.Ready(), loop.ErrPluginUnavailable, "not launched until the first tick")
+	clock.Advance(loop.KeepAliveTickDuration)
+
+	test.TestRelayer(t, relayer)
+}
+
+func TestRelayerService_maxWait(t *testing.T) {
+	t.Parallel()
+	clock := utils.NewFakeClock(time.Now())
+	relayer := loop.NewRelayerService(logger.Test(t), loop.GRPCOpts{}, func() *exec.Cmd {
+		return helperProcess(loop.PluginRelayerName)
+	}, test.ConfigTOML, test.StaticKeystore{})
+	relayer.SetClock(clock)
+	require.Error(t, relayer.SetMaxWait(-time.Second))
+	require.NoError(t, relayer.SetMaxWait(time.Second))
+	require.NoError(t, relayer.Start(utils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, relayer.Close()) })
+
+	clock.BlockUntil(1) // keepAlive
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := relayer.ChainStatus(utils.Context(t), "id")
+		errCh <- err
+	}()
+	clock.BlockUntil(3) // progress ticker and max wait
+	clock.Advance(time.Second)
+
+	var notReady loop.ErrPluginNotReady
+	require.ErrorAs(t, <-errCh, &notReady)
+	assert.Equal(t, relayer.Name(), notReady.Name)
+	assert.Equal(t, time.Second, notReady.Waited)
+	assert.Equal(t, "waiting to launch (0 previous launches)", notReady.State)
+	assert.Empty(t, notReady.Stderr)
+}
+
+func TestRelayerService_restartPolicy(t *testing.T) {
+	t.Parallel()
+	relayer := loop.NewRelayerService(logger.Test(t), loop.GRPCOpts{}, func() *exec.Cmd {
+		return helperProcess("unknown-plugin")
+	}, test.ConfigTOML, test.StaticKeystore{})
+	require.Error(t, relayer.SetRestartPolicy(loop.RestartPolicy{}))
+	require.NoError(t, relayer.SetRestartPolicy(loop.RestartPolicy{
+		InitialDelay: 10 * time.Millisecond,
+		Multiplier:   2,
+		MaxDelay:     time.Second,
+		MaxAttempts:  2,
+	}))
+	require.NoError(t, relayer.Start(utils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, relayer.Close()) })
+
+	require.Eventually(t, func() bool {
+		return errors.Is(relayer.Ready(), loop.ErrPluginRestartsExhausted)
+	}, 5*loop.KeepAliveTickDuration, 100*time.Millisecond)
+	assert.ErrorIs(t, relayer.HealthReport()[relayer.Name()], loop.ErrPluginRestartsExhausted)
+}
+
+func TestRelayerService_accountFamily(t *testing.T) {
+	t.Parallel()
+	relayer := loop.NewRelayerService(logger.Test(t), loop.GRPCOpts{}, func() *exec.Cmd {
+		return helperProcess(loop.PluginRelayerName)
+	}, test.ConfigTOML, test.StaticKeystore{})
+	require.Error(t, relayer.SetAccountFamily("relayer-service-test-unregistered"))
+	require.NoError(t, types.RegisterAccountValidator("relayer-service-test", func(string) error {
+		return errors.New("rejected")
+	}))
+	require.NoError(t, relayer.SetAccountFamily("relayer-service-test"))
+	require.NoError(t, relayer.Start(utils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, relayer.Close()) })
+
+	provider, err := relayer.NewMedianProvider(utils.Context(t), test.RelayArgs, test.PluginArgs)
+	require.NoError(t, err)
+	_, err = provider.ContractTransmitter().FromAccount()
+	require.ErrorContains(t, err, "rejected")
+}
+
+func TestRelayerService_restartPolicyStableAfter(t *testing.T) {
+	t.Parallel()
+	clock := utils.NewFakeClock(time.Now())
+	lggr, logs := logger.TestObserved(t, zapcore.InfoLevel)
+	relayer := loop.NewRelayerService(lggr, loop.GRPCOpts{}, func() *exec.Cmd {
+		return helperProcess(loop.PluginRelayerName)
+	}, test.ConfigTOML, test.StaticKeystore{})
+	hook := relayer.TestHook()
+	relayer.SetClock(clock)
+	require.NoError(t, relayer.SetRestartPolicy(loop.RestartPolicy{
+		InitialDelay: time.Minute,
+		Multiplier:   1,
+		MaxDelay:     time.Minute,
+		MaxAttempts:  2,
+		StableAfter:  time.Hour,
+	}))
+	require.NoError(t, relayer.Start(utils.Context(t)))
+	t.Cleanup(func() { _ = relayer.Close() }) // the service of the abandoned plugin may be unreachable
+
+	clock.BlockUntil(1)
+	launched := func() {
+		_, _, err := relayer.ChainStatuses(utils.Context(t), 11, 42) // waits for launch
+		require.NoError(t, err)
+	}
+	clock.Advance(loop.KeepAliveTickDuration) // launch
+	launched()
+
+	killHealthy := func() {
+		clock.Advance(loop.KeepAliveTickDuration)
+		require.Eventually(t, func() bool {
+			_, ok := relayer.PluginVersion() // reported after a healthy check
+			return ok
+		}, time.Second, 10*time.Millisecond)
+		hook.Kill()
+		clock.Advance(loop.KeepAliveTickDuration)
+	}
+
+	killHealthy()
+	require.Eventually(t, func() bool {
+		return logs.FilterMessage("Waiting to relaunch plugin").Len() == 1
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, time.Minute, logs.FilterMessage("Waiting to relaunch plugin").All()[0].ContextMap()["wait"],
+		"relaunch is delayed after a healthy launch")
+	clock.BlockUntil(2) // keepAlive and relaunch delay
+	clock.Advance(time.Minute)
+	launched()
+
+	killHealthy()
+	require.Eventually(t, func() bool {
+		return errors.Is(relayer.Ready(), loop.ErrPluginRestartsExhausted)
+	}, time.Second, 10*time.Millisecond, "attempts are not reset before StableAfter")
+}
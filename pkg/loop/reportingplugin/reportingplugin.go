@@ -0,0 +1,54 @@
+// Package reportingplugin serves arbitrary OCR2 reporting plugins over gRPC, with the same adapters used by the median
+// LOOP. The factory is served on a connection of its own, and each reporting plugin is served on a new brokered
+// connection.
+package reportingplugin
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
+
+	libocr "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/internal"
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+// Broker is a subset of the methods exported by [*plugin.GRPCBroker].
+type Broker = internal.Broker
+
+// RegisterServer registers factory with server. Each reporting plugin is served on a new connection from broker, and
+// requests are subject to limit. If factory implements [types.Service], then it is served as well.
+func RegisterServer(server *grpc.Server, broker Broker, cfg loop.BrokerConfig, factory libocr.ReportingPluginFactory, limit loop.ConcurrencyLimit) error {
+	return internal.RegisterReportingPluginFactoryServer(server, broker, cfg, factory, limit)
+}
+
+// NewClient returns a factory backed by a server registered on cc with [RegisterServer]. Each reporting plugin is
+// dialed on a new connection from broker.
+func NewClient(broker Broker, cfg loop.BrokerConfig, cc grpc.ClientConnInterface) types.ReportingPluginFactory {
+	return internal.NewReportingPluginFactoryClient(broker, cfg, cc)
+}
+
+var _ plugin.GRPCPlugin = (*GRPCPlugin)(nil)
+
+// GRPCPlugin is a [plugin.GRPCPlugin] which serves PluginServer, and dispenses a [types.ReportingPluginFactory].
+type GRPCPlugin struct {
+	plugin.NetRPCUnsupportedPlugin
+
+	loop.BrokerConfig
+
+	PluginServer libocr.ReportingPluginFactory
+	// ConcurrencyLimit optionally limits the reporting plugin requests served concurrently.
+	ConcurrencyLimit loop.ConcurrencyLimit
+}
+
+func (p *GRPCPlugin) GRPCServer(broker *plugin.GRPCBroker, server *grpc.Server) error {
+	return RegisterServer(server, broker, p.BrokerConfig, p.PluginServer, p.ConcurrencyLimit)
+}
+
+// GRPCClient implements [plugin.GRPCPlugin] and returns a [types.ReportingPluginFactory].
+func (p *GRPCPlugin) GRPCClient(_ context.Context, broker *plugin.GRPCBroker, conn *grpc.ClientConn) (interface{}, error) {
+	return NewClient(broker, p.BrokerConfig, conn), nil
+}
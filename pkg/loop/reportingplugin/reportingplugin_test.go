@@ -0,0 +1,78 @@
+package reportingplugin_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/internal/test"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/reportingplugin"
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
+)
+
+const name = "reporting"
+
+func TestGRPCPlugin(t *testing.T) {
+	stopCh := make(chan struct{})
+	t.Cleanup(func() { close(stopCh) })
+	p := &reportingplugin.GRPCPlugin{
+		PluginServer: test.StaticReportingPluginFactory{},
+		BrokerConfig: loop.BrokerConfig{Logger: logger.Test(t), StopCh: stopCh},
+	}
+	factory := dispense(t, p)
+	test.TestReportingPluginFactory(t, factory)
+
+	require.NoError(t, factory.Ready())
+	assert.NoError(t, factory.Close())
+}
+
+func TestGRPCPlugin_invalidLimit(t *testing.T) {
+	p := &reportingplugin.GRPCPlugin{
+		PluginServer:     test.StaticReportingPluginFactory{},
+		BrokerConfig:     loop.BrokerConfig{Logger: logger.Test(t)},
+		ConcurrencyLimit: loop.ConcurrencyLimit{Max: -1},
+	}
+	require.ErrorContains(t, p.GRPCServer(nil, nil), "invalid ConcurrencyLimit")
+}
+
+// dispense serves p in-process, and returns the dispensed client.
+func dispense(t *testing.T, p plugin.Plugin) types.ReportingPluginFactory {
+	ctx, cancel := context.WithCancel(utils.Context(t))
+	t.Cleanup(cancel)
+
+	ch := make(chan *plugin.ReattachConfig, 1)
+	go plugin.Serve(&plugin.ServeConfig{
+		Test: &plugin.ServeTestConfig{
+			Context:          ctx,
+			ReattachConfigCh: ch,
+		},
+		GRPCServer: plugin.DefaultGRPCServer,
+		Plugins:    map[string]plugin.Plugin{name: p},
+	})
+
+	var config *plugin.ReattachConfig
+	select {
+	case config = <-ch:
+	case <-time.After(5 * time.Second):
+		t.Fatal("should've received reattach")
+	}
+
+	c := plugin.NewClient(&plugin.ClientConfig{
+		Reattach: config,
+		Plugins:  map[string]plugin.Plugin{name: p},
+	})
+	t.Cleanup(c.Kill)
+	clientProtocol, err := c.Client()
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, clientProtocol.Close()) })
+	i, err := clientProtocol.Dispense(name)
+	require.NoError(t, err)
+	return i.(types.ReportingPluginFactory)
+}
@@ -17,6 +17,12 @@ import (
 
 type GRPCOpts = internal.GRPCOpts
 
+// Compressors for [GRPCOpts.Compression].
+const (
+	CompressorGzip   = internal.CompressorGzip
+	CompressorSnappy = internal.CompressorSnappy
+)
+
 // SetupTelemetry initializes open telemetry and returns GRPCOpts with telemetry interceptors.
 func SetupTelemetry(registerer prometheus.Registerer) GRPCOpts {
 	otel.SetTracerProvider(sdktrace.NewTracerProvider(
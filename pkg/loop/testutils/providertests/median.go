@@ -0,0 +1,234 @@
+// Package providertests is a conformance test suite for the providers of relayer plugins. Relayer teams run it
+// against the providers of their LOOP plugins, backed by a mock chain endpoint, to certify compatibility with the
+// median plugin before integrating with a node.
+package providertests
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/libocr/commontypes"
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	libocr "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/digestcheck"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/testutils"
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
+)
+
+const (
+	// DefaultTimeout bounds waiting for the chain to reflect changes, if [MedianProviderTest.Timeout] is not set.
+	DefaultTimeout = time.Minute
+	// DefaultPollInterval is the interval for polling the provider, if [MedianProviderTest.PollInterval] is not set.
+	DefaultPollInterval = 100 * time.Millisecond
+)
+
+// Chain is the mock chain endpoint backing a provider under test, e.g. a simulated backend, or a dev node with the
+// contracts deployed. It must accept unsigned, fake reports.
+type Chain interface {
+	// SetConfig sets config on the contract, which computes the ConfigDigest and ConfigCount.
+	SetConfig(ctx context.Context, config libocr.ContractConfig) error
+	// Transmissions returns the reports received by the contract, oldest first.
+	Transmissions(ctx context.Context) ([]libocr.Report, error)
+}
+
+// MedianProviderTest configures [MedianProviderTest.TestMedianProvider].
+type MedianProviderTest struct {
+	Chain Chain
+	// Config is set on Chain. Its ConfigDigest and ConfigCount are ignored. Signers must not be empty, and determine
+	// the number of observations in fake reports.
+	Config libocr.ContractConfig
+
+	Timeout      time.Duration // optional, see DefaultTimeout
+	PollInterval time.Duration // optional, see DefaultPollInterval
+	// Leaks configures the leak check, e.g. with the ResourceCounts of the provider's broker.
+	Leaks []testutils.LeakOption
+}
+
+// TestMedianProvider checks that provider behaves as the median plugin expects, end to end with m.Chain: the config
+// tracker picks up a new config, the digester agrees with its digest, and fake reports built by the report codec are
+// transmitted and reflected by the median contract. The subtests run in order, and depend on each other. Goroutines
+// and brokered connections started by the suite must be released once it is complete, see [testutils.AssertNoLeaks].
+func (m MedianProviderTest) TestMedianProvider(t *testing.T, provider types.MedianProvider) {
+	testutils.AssertNoLeaks(t, m.Leaks...)
+	require.NotEmpty(t, m.Config.Signers, "Config.Signers must not be empty")
+	if m.Timeout == 0 {
+		m.Timeout = DefaultTimeout
+	}
+	if m.PollInterval == 0 {
+		m.PollInterval = DefaultPollInterval
+	}
+
+	t.Run("Ready", func(t *testing.T) {
+		require.Eventually(t, func() bool { return provider.Ready() == nil }, m.Timeout, m.PollInterval)
+	})
+
+	var latest libocr.ContractConfig
+	t.Run("ContractConfigTracker", func(t *testing.T) {
+		latest = m.testContractConfigTracker(t, provider.ContractConfigTracker())
+	})
+	if latest.ConfigDigest == (libocr.ConfigDigest{}) {
+		t.Fatal("no config to continue with")
+	}
+
+	t.Run("OffchainConfigDigester", func(t *testing.T) {
+		testOffchainConfigDigester(t, provider, latest)
+	})
+
+	t.Run("OnchainConfigCodec", func(t *testing.T) {
+		testOnchainConfigCodec(t, provider.OnchainConfigCodec())
+	})
+
+	t.Run("Transmit", func(t *testing.T) {
+		m.testTransmit(t, provider, latest)
+	})
+}
+
+// testContractConfigTracker sets m.Config on chain, polls the tracker until it is reported, and returns it.
+func (m MedianProviderTest) testContractConfigTracker(t *testing.T, tracker libocr.ContractConfigTracker) (latest libocr.ContractConfig) {
+	ctx := utils.Context(t)
+	_, err := tracker.LatestBlockHeight(ctx)
+	require.NoError(t, err, "LatestBlockHeight")
+	_, before, err := tracker.LatestConfigDetails(ctx)
+	require.NoError(t, err, "LatestConfigDetails")
+
+	require.NoError(t, m.Chain.SetConfig(ctx, m.Config), "Chain.SetConfig")
+
+	var changedInBlock uint64
+	require.Eventually(t, func() bool {
+		var digest libocr.ConfigDigest
+		changedInBlock, digest, err = tracker.LatestConfigDetails(ctx)
+		return err == nil && digest != before
+	}, m.Timeout, m.PollInterval, "LatestConfigDetails did not report the new config: %v", err)
+
+	latest, err = tracker.LatestConfig(ctx, changedInBlock)
+	require.NoError(t, err, "LatestConfig")
+	assert.NotEqual(t, libocr.ConfigDigest{}, latest.ConfigDigest)
+	assert.Equal(t, m.Config.Signers, latest.Signers)
+	assert.Equal(t, m.Config.Transmitters, latest.Transmitters)
+	assert.Equal(t, m.Config.F, latest.F)
+	assert.Equal(t, m.Config.OnchainConfig, latest.OnchainConfig)
+	assert.Equal(t, m.Config.OffchainConfigVersion, latest.OffchainConfigVersion)
+	assert.Equal(t, m.Config.OffchainConfig, latest.OffchainConfig)
+
+	height, err := tracker.LatestBlockHeight(ctx)
+	require.NoError(t, err, "LatestBlockHeight")
+	assert.GreaterOrEqual(t, height, changedInBlock, "LatestBlockHeight is behind the config")
+	return
+}
+
+func testOffchainConfigDigester(t *testing.T, provider types.MedianProvider, latest libocr.ContractConfig) {
+	ctx := utils.Context(t)
+	digester := provider.OffchainConfigDigester()
+	require.NoError(t, digestcheck.Check(digester, latest))
+	require.NoError(t, digestcheck.CheckLatest(ctx, provider.ContractConfigTracker(), digester))
+
+	var prefixes []libocr.ConfigDigestPrefix
+	if mp, ok := digester.(types.MultiPrefixConfigDigester); ok {
+		var err error
+		prefixes, err = mp.ConfigDigestPrefixes()
+		require.NoError(t, err, "ConfigDigestPrefixes")
+	} else {
+		prefix, err := digester.ConfigDigestPrefix()
+		require.NoError(t, err, "ConfigDigestPrefix")
+		prefixes = []libocr.ConfigDigestPrefix{prefix}
+	}
+	var matched bool
+	for _, p := range prefixes {
+		matched = matched || p.IsPrefixOf(latest.ConfigDigest)
+	}
+	assert.True(t, matched, "ConfigDigest %s does not match prefixes %v", latest.ConfigDigest, prefixes)
+
+	changed := latest
+	changed.ConfigCount++
+	digest, err := digester.ConfigDigest(changed)
+	require.NoError(t, err, "ConfigDigest")
+	assert.NotEqual(t, latest.ConfigDigest, digest, "ConfigDigest must depend on ConfigCount")
+}
+
+func testOnchainConfigCodec(t *testing.T, codec median.OnchainConfigCodec) {
+	config := median.OnchainConfig{Min: big.NewInt(-100), Max: big.NewInt(1_000_000)}
+	encoded, err := codec.Encode(config)
+	require.NoError(t, err, "Encode")
+	decoded, err := codec.Decode(encoded)
+	require.NoError(t, err, "Decode")
+	assert.Zero(t, config.Min.Cmp(decoded.Min), "Min: expected %s but got %s", config.Min, decoded.Min)
+	assert.Zero(t, config.Max.Cmp(decoded.Max), "Max: expected %s but got %s", config.Max, decoded.Max)
+}
+
+// testTransmit builds a fake report from an observation by each signer, transmits it, and checks that it reaches the
+// chain and the median contract.
+func (m MedianProviderTest) testTransmit(t *testing.T, provider types.MedianProvider, latest libocr.ContractConfig) {
+	ctx := utils.Context(t)
+	observations := FakeObservations(len(latest.Signers), time.Now())
+	expectedMedian := observations[len(observations)/2].Value
+
+	codec := provider.ReportCodec()
+	report, err := codec.BuildReport(observations)
+	require.NoError(t, err, "BuildReport")
+	maxLen, err := codec.MaxReportLength(len(observations))
+	require.NoError(t, err, "MaxReportLength")
+	assert.LessOrEqual(t, len(report), maxLen, "report exceeds MaxReportLength")
+	gotMedian, err := codec.MedianFromReport(report)
+	require.NoError(t, err, "MedianFromReport")
+	assert.Zero(t, expectedMedian.Cmp(gotMedian), "MedianFromReport: expected %s but got %s", expectedMedian, gotMedian)
+
+	ct := provider.ContractTransmitter()
+	account, err := ct.FromAccount()
+	require.NoError(t, err, "FromAccount")
+	assert.NotEmpty(t, account)
+	digest, epoch, err := ct.LatestConfigDigestAndEpoch(ctx)
+	require.NoError(t, err, "LatestConfigDigestAndEpoch")
+	require.Equal(t, latest.ConfigDigest, digest, "LatestConfigDigestAndEpoch disagrees with the ContractConfigTracker")
+
+	before, err := m.Chain.Transmissions(ctx)
+	require.NoError(t, err, "Chain.Transmissions")
+	rc := libocr.ReportContext{ReportTimestamp: libocr.ReportTimestamp{ConfigDigest: digest, Epoch: epoch + 1, Round: 1}}
+	require.NoError(t, ct.Transmit(ctx, rc, report, nil), "Transmit")
+
+	require.Eventually(t, func() bool {
+		transmissions, err := m.Chain.Transmissions(ctx)
+		return err == nil && len(transmissions) > len(before) && assert.ObjectsAreEqual(report, transmissions[len(transmissions)-1])
+	}, m.Timeout, m.PollInterval, "report was not transmitted to the chain")
+
+	require.Eventually(t, func() bool {
+		_, gotEpoch, _, _, _, err := provider.MedianContract().LatestTransmissionDetails(ctx)
+		return err == nil && gotEpoch == rc.Epoch
+	}, m.Timeout, m.PollInterval, "LatestTransmissionDetails did not report the transmission")
+	gotDigest, gotEpoch, gotRound, latestAnswer, _, err := provider.MedianContract().LatestTransmissionDetails(ctx)
+	require.NoError(t, err, "LatestTransmissionDetails")
+	assert.Equal(t, rc.ConfigDigest, gotDigest)
+	assert.Equal(t, rc.Epoch, gotEpoch)
+	assert.Equal(t, rc.Round, gotRound)
+	assert.Zero(t, expectedMedian.Cmp(latestAnswer), "latestAnswer: expected %s but got %s", expectedMedian, latestAnswer)
+
+	digest, gotEpoch, err = ct.LatestConfigDigestAndEpoch(ctx)
+	require.NoError(t, err, "LatestConfigDigestAndEpoch")
+	assert.Equal(t, latest.ConfigDigest, digest)
+	assert.Equal(t, rc.Epoch, gotEpoch, "LatestConfigDigestAndEpoch did not advance")
+}
+
+// FakeObservations returns an observation from each of n oracles, sorted by value, as the median plugin passes them
+// to [median.ReportCodec.BuildReport].
+func FakeObservations(n int, now time.Time) []median.ParsedAttributedObservation {
+	observations := make([]median.ParsedAttributedObservation, n)
+	for i := range observations {
+		observations[i] = median.ParsedAttributedObservation{
+			Timestamp:       uint32(now.Unix()),
+			Value:           big.NewInt(int64(1000 * (n - i))),
+			JuelsPerFeeCoin: big.NewInt(1_000_000_000_000_000_000),
+			Observer:        commontypes.OracleID(i),
+		}
+	}
+	sort.Slice(observations, func(i, j int) bool {
+		return observations[i].Value.Cmp(observations[j].Value) < 0
+	})
+	return observations
+}
@@ -0,0 +1,218 @@
+package providertests_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	libocr "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop/testutils/providertests"
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+func TestMedianProviderTest(t *testing.T) {
+	chain := &fakeChain{height: 10}
+	providertests.MedianProviderTest{
+		Chain: chain,
+		Config: libocr.ContractConfig{
+			Signers:               []libocr.OnchainPublicKey{{1}, {2}, {3}, {4}},
+			Transmitters:          []libocr.Account{"a", "b", "c", "d"},
+			F:                     1,
+			OnchainConfig:         []byte{1, 2, 3},
+			OffchainConfigVersion: 2,
+			OffchainConfig:        []byte{4, 5, 6},
+		},
+		Timeout:      10 * time.Second,
+		PollInterval: time.Millisecond,
+	}.TestMedianProvider(t, fakeMedianProvider{chain})
+}
+
+var fakePrefix = libocr.ConfigDigestPrefix(0xfa4e)
+
+// fakeChain is an in-memory chain with a median contract, which accepts unsigned reports of the median value, in
+// decimal.
+type fakeChain struct {
+	mu            sync.Mutex
+	height        uint64
+	config        libocr.ContractConfig
+	configBlock   uint64
+	transmissions []libocr.Report
+	latest        libocr.ReportTimestamp
+}
+
+func (c *fakeChain) SetConfig(ctx context.Context, config libocr.ContractConfig) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.height++
+	config.ConfigCount = c.config.ConfigCount + 1
+	config.ConfigDigest = fakeDigest(config)
+	c.config = config
+	c.configBlock = c.height
+	c.latest = libocr.ReportTimestamp{ConfigDigest: config.ConfigDigest}
+	return nil
+}
+
+func (c *fakeChain) Transmissions(ctx context.Context) ([]libocr.Report, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]libocr.Report(nil), c.transmissions...), nil
+}
+
+func (c *fakeChain) transmit(rc libocr.ReportContext, report libocr.Report) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if rc.ConfigDigest != c.config.ConfigDigest {
+		return fmt.Errorf("wrong config digest %s", rc.ConfigDigest)
+	}
+	if rc.Epoch < c.latest.Epoch || rc.Epoch == c.latest.Epoch && rc.Round <= c.latest.Round {
+		return errors.New("stale report")
+	}
+	c.height++
+	c.transmissions = append(c.transmissions, report)
+	c.latest = rc.ReportTimestamp
+	return nil
+}
+
+func fakeDigest(config libocr.ContractConfig) (digest libocr.ConfigDigest) {
+	h := sha256.New()
+	_ = binary.Write(h, binary.BigEndian, config.ConfigCount)
+	for _, s := range config.Signers {
+		h.Write(s)
+	}
+	for _, a := range config.Transmitters {
+		h.Write([]byte(a))
+	}
+	h.Write([]byte{config.F})
+	h.Write(config.OnchainConfig)
+	_ = binary.Write(h, binary.BigEndian, config.OffchainConfigVersion)
+	h.Write(config.OffchainConfig)
+	copy(digest[:], h.Sum(nil))
+	binary.BigEndian.PutUint16(digest[:2], uint16(fakePrefix))
+	return
+}
+
+type fakeMedianProvider struct {
+	*fakeChain
+}
+
+func (p fakeMedianProvider) Name() string                   { return "fakeMedianProvider" }
+func (p fakeMedianProvider) Start(context.Context) error    { return nil }
+func (p fakeMedianProvider) Close() error                   { return nil }
+func (p fakeMedianProvider) Ready() error                   { return nil }
+func (p fakeMedianProvider) HealthReport() map[string]error { return map[string]error{p.Name(): nil} }
+func (p fakeMedianProvider) OffchainConfigDigester() libocr.OffchainConfigDigester {
+	return fakeDigester{}
+}
+func (p fakeMedianProvider) ContractConfigTracker() libocr.ContractConfigTracker {
+	return fakeTracker(p)
+}
+func (p fakeMedianProvider) ContractTransmitter() libocr.ContractTransmitter {
+	return fakeTransmitter(p)
+}
+func (p fakeMedianProvider) ReportCodec() median.ReportCodec       { return fakeReportCodec{} }
+func (p fakeMedianProvider) MedianContract() median.MedianContract { return fakeMedianContract(p) }
+func (p fakeMedianProvider) OnchainConfigCodec() median.OnchainConfigCodec {
+	return median.StandardOnchainConfigCodec{}
+}
+
+var _ types.MedianProvider = fakeMedianProvider{}
+
+type fakeDigester struct{}
+
+func (fakeDigester) ConfigDigest(config libocr.ContractConfig) (libocr.ConfigDigest, error) {
+	return fakeDigest(config), nil
+}
+
+func (fakeDigester) ConfigDigestPrefix() (libocr.ConfigDigestPrefix, error) { return fakePrefix, nil }
+
+type fakeTracker struct {
+	*fakeChain
+}
+
+func (t fakeTracker) Notify() <-chan struct{} { return nil }
+
+func (t fakeTracker) LatestConfigDetails(ctx context.Context) (uint64, libocr.ConfigDigest, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.configBlock, t.config.ConfigDigest, nil
+}
+
+func (t fakeTracker) LatestConfig(ctx context.Context, changedInBlock uint64) (libocr.ContractConfig, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if changedInBlock != t.configBlock {
+		return libocr.ContractConfig{}, fmt.Errorf("no config changed in block %d", changedInBlock)
+	}
+	return t.config, nil
+}
+
+func (t fakeTracker) LatestBlockHeight(ctx context.Context) (uint64, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.height, nil
+}
+
+type fakeTransmitter struct {
+	*fakeChain
+}
+
+func (t fakeTransmitter) Transmit(ctx context.Context, rc libocr.ReportContext, report libocr.Report, _ []libocr.AttributedOnchainSignature) error {
+	return t.transmit(rc, report)
+}
+
+func (t fakeTransmitter) LatestConfigDigestAndEpoch(ctx context.Context) (libocr.ConfigDigest, uint32, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.latest.ConfigDigest, t.latest.Epoch, nil
+}
+
+func (t fakeTransmitter) FromAccount() (libocr.Account, error) { return "fake-transmitter", nil }
+
+type fakeReportCodec struct{}
+
+func (fakeReportCodec) BuildReport(observations []median.ParsedAttributedObservation) (libocr.Report, error) {
+	if len(observations) == 0 {
+		return nil, errors.New("no observations")
+	}
+	return libocr.Report(observations[len(observations)/2].Value.String()), nil
+}
+
+func (fakeReportCodec) MedianFromReport(report libocr.Report) (*big.Int, error) {
+	i, ok := new(big.Int).SetString(string(report), 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid report: %q", report)
+	}
+	return i, nil
+}
+
+func (fakeReportCodec) MaxReportLength(n int) (int, error) { return 32, nil }
+
+type fakeMedianContract struct {
+	*fakeChain
+}
+
+func (c fakeMedianContract) LatestTransmissionDetails(ctx context.Context) (libocr.ConfigDigest, uint32, uint8, *big.Int, time.Time, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	answer := big.NewInt(0)
+	if len(c.transmissions) > 0 {
+		var err error
+		answer, err = fakeReportCodec{}.MedianFromReport(c.transmissions[len(c.transmissions)-1])
+		if err != nil {
+			return libocr.ConfigDigest{}, 0, 0, nil, time.Time{}, err
+		}
+	}
+	return c.latest.ConfigDigest, c.latest.Epoch, c.latest.Round, answer, time.Now(), nil
+}
+
+func (c fakeMedianContract) LatestRoundRequested(ctx context.Context, lookback time.Duration) (libocr.ConfigDigest, uint32, uint8, error) {
+	return libocr.ConfigDigest{}, 0, 0, nil
+}
@@ -0,0 +1,105 @@
+// Package testutils has test helpers for LOOP plugins and hosts.
+package testutils
+
+import (
+	"testing"
+	"time"
+
+	"go.uber.org/goleak"
+)
+
+// leakTimeout is how long AssertNoLeaks waits for brokered connections to close.
+const leakTimeout = 10 * time.Second
+
+// ResourceCounter counts the brokered servers and connections of a broker, like [loop.ResourceCounts].
+type ResourceCounter interface {
+	// Get returns the current counts of servers, by name.
+	Get() map[string]int
+	// Dialed returns the number of dialed connections which have not been closed.
+	Dialed() int
+}
+
+// LeakOption configures [AssertNoLeaks].
+type LeakOption func(*leakConfig)
+
+type leakConfig struct {
+	resources []ResourceCounter
+	goleak    []goleak.Option
+}
+
+// WithResources also checks that no more servers or connections are counted by r once the test is complete than when
+// it started, e.g. by passing the [loop.ResourceCounts] configured as [loop.BrokerConfig.Resources].
+func WithResources(r ResourceCounter) LeakOption {
+	return func(c *leakConfig) { c.resources = append(c.resources, r) }
+}
+
+// IgnoreGoroutines passes additional options to [goleak.VerifyNone], e.g. to ignore goroutines started by the plugin
+// under test which outlive it by design.
+func IgnoreGoroutines(opts ...goleak.Option) LeakOption {
+	return func(c *leakConfig) { c.goleak = append(c.goleak, opts...) }
+}
+
+// AssertNoLeaks fails t if goroutines started during the test, or brokered connections counted by [WithResources],
+// are still running once it and its cleanups are complete. Call it first, so that its check runs after all other
+// cleanups.
+//
+// Goroutines are checked across the whole process, so tests using it must not run in parallel with others.
+func AssertNoLeaks(t *testing.T, opts ...LeakOption) {
+	var c leakConfig
+	for _, opt := range opts {
+		opt(&c)
+	}
+	before := make([]counts, len(c.resources))
+	for i, r := range c.resources {
+		before[i] = countsOf(r)
+	}
+	goleakOpts := append([]goleak.Option{goleak.IgnoreCurrent()}, pluginGoroutines...)
+	goleakOpts = append(goleakOpts, c.goleak...)
+	t.Cleanup(func() {
+		for i, r := range c.resources {
+			deadline := time.Now().Add(leakTimeout)
+			after := countsOf(r)
+			for after.leaked(before[i]) && time.Now().Before(deadline) {
+				time.Sleep(10 * time.Millisecond)
+				after = countsOf(r)
+			}
+			if after.leaked(before[i]) {
+				t.Errorf("Leaked brokered connections: before %+v, after %+v", before[i], after)
+			}
+		}
+		goleak.VerifyNone(t, goleakOpts...)
+	})
+}
+
+// pluginGoroutines are started asynchronously by go-plugin and gRPC when a plugin client connects, and live as long as
+// the plugin, so they may be missed by [goleak.IgnoreCurrent].
+var pluginGoroutines = []goleak.Option{
+	goleak.IgnoreTopFunction("github.com/hashicorp/go-plugin.(*gRPCBrokerClientImpl).StartStream.func1"),
+	goleak.IgnoreTopFunction("github.com/hashicorp/go-plugin.(*gRPCBrokerServer).StartStream.func1"),
+	goleak.IgnoreTopFunction("github.com/hashicorp/go-plugin.(*grpcStdioServer).StreamStdio"),
+	// the broker and stdio streams
+	goleak.IgnoreTopFunction("google.golang.org/grpc.newClientStreamWithParams.func4"),
+	goleak.IgnoreTopFunction("google.golang.org/grpc/internal/transport.(*recvBufferReader).read"),
+}
+
+type counts struct {
+	Served map[string]int
+	Dialed int
+}
+
+func countsOf(r ResourceCounter) counts {
+	return counts{r.Get(), r.Dialed()}
+}
+
+// leaked returns true if there are more servers or connections open than before.
+func (c counts) leaked(before counts) bool {
+	if c.Dialed > before.Dialed {
+		return true
+	}
+	for name, n := range c.Served {
+		if n > before.Served[name] {
+			return true
+		}
+	}
+	return false
+}
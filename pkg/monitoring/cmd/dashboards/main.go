@@ -0,0 +1,98 @@
+// Command dashboards generates the JSON model of the standard grafana dashboard for a chain's OCR monitor, with a
+// panel for each metric registered by the monitoring package.
+//
+// Usage:
+//
+//	go run ./pkg/monitoring/cmd/dashboards [flags] <params.json>
+//
+// The params file holds a JSON encoded monitoring.DashboardParams, with the network and the feeds to list, or "-" to
+// read from stdin. e.g.
+//
+//	{"title": "Solana Mainnet", "datasource": "prometheus", "networkName": "solana-mainnet", "networkID": "1",
+//	 "chainID": "mainnet", "feeds": [{"id": "<feed address>", "name": "LINK / USD"}]}
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/monitoring"
+)
+
+func main() {
+	var (
+		out        = flag.String("out", "", "output file (default stdout)")
+		title      = flag.String("title", "", "overrides the dashboard title")
+		datasource = flag.String("datasource", "", "overrides the prometheus datasource UID")
+	)
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] <params.json>\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	params, err := readParams(flag.Arg(0))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to read params: %v\n", err)
+		os.Exit(2)
+	}
+	if *title != "" {
+		params.Title = *title
+	}
+	if *datasource != "" {
+		params.Datasource = *datasource
+	}
+
+	if err := write(*out, params); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to generate dashboard: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func readParams(path string) (params monitoring.DashboardParams, err error) {
+	var r io.Reader = os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return params, err
+		}
+		defer f.Close()
+		r = f
+	}
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	err = dec.Decode(&params)
+	return
+}
+
+func write(path string, params monitoring.DashboardParams) error {
+	if path == "" {
+		w := bufio.NewWriter(os.Stdout)
+		if err := monitoring.GenerateDashboard(w, params, monitoring.DashboardMetrics); err != nil {
+			return err
+		}
+		return w.Flush()
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	if err := monitoring.GenerateDashboard(w, params, monitoring.DashboardMetrics); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,442 @@
+package config
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	relayconfig "github.com/smartcontractkit/chainlink-relay/pkg/config"
+)
+
+// field is a value of Config, which may be set by the key path in a config file, or by the environment variable env.
+type field struct {
+	path  string
+	env   string
+	value value
+}
+
+// value is the destination of a field.
+type value interface {
+	// set parses s, from an environment variable.
+	set(s string) error
+	// decode converts v, as decoded from a TOML or YAML config file.
+	decode(v any) error
+}
+
+// fields returns all of the fields of c which may be configured.
+func (c *Config) fields() []field {
+	return []field{
+		{"Kafka.Brokers", "KAFKA_BROKERS", (*stringValue)(&c.Kafka.Brokers)},
+		{"Kafka.ClientID", "KAFKA_CLIENT_ID", (*stringValue)(&c.Kafka.ClientID)},
+		{"Kafka.SecurityProtocol", "KAFKA_SECURITY_PROTOCOL", (*stringValue)(&c.Kafka.SecurityProtocol)},
+		{"Kafka.SaslMechanism", "KAFKA_SASL_MECHANISM", (*stringValue)(&c.Kafka.SaslMechanism)},
+		{"Kafka.SaslUsername", "KAFKA_SASL_USERNAME", (*stringValue)(&c.Kafka.SaslUsername)},
+		{"Kafka.SaslPassword", "KAFKA_SASL_PASSWORD", (*stringValue)(&c.Kafka.SaslPassword)},
+		{"Kafka.TransmissionTopic", "KAFKA_TRANSMISSION_TOPIC", (*stringValue)(&c.Kafka.TransmissionTopic)},
+		{"Kafka.ConfigSetSimplifiedTopic", "KAFKA_CONFIG_SET_SIMPLIFIED_TOPIC", (*stringValue)(&c.Kafka.ConfigSetSimplifiedTopic)},
+		{"Kafka.CreateTopics", "KAFKA_CREATE_TOPICS", (*boolValue)(&c.Kafka.CreateTopics)},
+		{"Kafka.DefaultTopicSettings.Partitions", "KAFKA_TOPIC_PARTITIONS", (*intValue)(&c.Kafka.DefaultTopicSettings.Partitions)},
+		{"Kafka.DefaultTopicSettings.ReplicationFactor", "KAFKA_TOPIC_REPLICATION_FACTOR", (*intValue)(&c.Kafka.DefaultTopicSettings.ReplicationFactor)},
+		{"Kafka.TopicSettings", "KAFKA_TOPIC_SETTINGS", (*topicSettingsValue)(&c.Kafka.TopicSettings)},
+		{"Kafka.KeyStrategies", "KAFKA_TOPIC_KEY_STRATEGIES", (*stringMapValue)(&c.Kafka.KeyStrategies)},
+		{"Kafka.WALPath", "KAFKA_WAL_PATH", (*stringValue)(&c.Kafka.WALPath)},
+		{"Kafka.WALReplayInterval", "KAFKA_WAL_REPLAY_INTERVAL", (*durationValue)(&c.Kafka.WALReplayInterval)},
+		{"Kafka.Linger", "KAFKA_LINGER", (*durationValue)(&c.Kafka.Linger)},
+		{"Kafka.BatchSize", "KAFKA_BATCH_SIZE", (*intValue)(&c.Kafka.BatchSize)},
+		{"Kafka.CompressionCodec", "KAFKA_COMPRESSION_CODEC", (*stringValue)(&c.Kafka.CompressionCodec)},
+		{"Kafka.Idempotent", "KAFKA_IDEMPOTENT", (*boolValue)(&c.Kafka.Idempotent)},
+		{"Kafka.MaxInFlight", "KAFKA_MAX_IN_FLIGHT", (*intValue)(&c.Kafka.MaxInFlight)},
+
+		{"SchemaRegistry.URL", "SCHEMA_REGISTRY_URL", (*stringValue)(&c.SchemaRegistry.URL)},
+		{"SchemaRegistry.Username", "SCHEMA_REGISTRY_USERNAME", (*stringValue)(&c.SchemaRegistry.Username)},
+		{"SchemaRegistry.Password", "SCHEMA_REGISTRY_PASSWORD", (*stringValue)(&c.SchemaRegistry.Password)},
+		{"SchemaRegistry.SnapshotPath", "SCHEMA_REGISTRY_SNAPSHOT_PATH", (*stringValue)(&c.SchemaRegistry.SnapshotPath)},
+		{"SchemaRegistry.RefreshInterval", "SCHEMA_REGISTRY_REFRESH_INTERVAL", (*durationValue)(&c.SchemaRegistry.RefreshInterval)},
+
+		{"Feeds.URL", "FEEDS_URL", (*stringValue)(&c.Feeds.URL)},
+		{"Feeds.RDDReadTimeout", "FEEDS_RDD_READ_TIMEOUT", (*durationValue)(&c.Feeds.RDDReadTimeout)},
+		{"Feeds.RDDPollInterval", "FEEDS_RDD_POLL_INTERVAL", (*durationValue)(&c.Feeds.RDDPollInterval)},
+		{"Feeds.IgnoreIDs", "FEEDS_IGNORE_IDS", (*listValue)(&c.Feeds.IgnoreIDs)},
+		{"Feeds.RDDRateLimit.Rate", "FEEDS_RDD_RATE_LIMIT", (*floatValue)(&c.Feeds.RDDRateLimit.Rate)},
+		{"Feeds.RDDRateLimit.Burst", "FEEDS_RDD_RATE_BURST", (*intValue)(&c.Feeds.RDDRateLimit.Burst)},
+
+		{"Nodes.URL", "NODES_URL", (*stringValue)(&c.Nodes.URL)},
+
+		{"HTTP.Address", "HTTP_ADDRESS", (*stringValue)(&c.HTTP.Address)},
+
+		{"Pushgateway.URL", "PUSHGATEWAY_URL", (*stringValue)(&c.Pushgateway.URL)},
+		{"Pushgateway.Job", "PUSHGATEWAY_JOB", (*stringValue)(&c.Pushgateway.Job)},
+		{"Pushgateway.Labels", "PUSHGATEWAY_LABELS", (*stringMapValue)(&c.Pushgateway.Labels)},
+		{"Pushgateway.Interval", "PUSHGATEWAY_INTERVAL", (*durationValue)(&c.Pushgateway.Interval)},
+
+		{"FeedMonitor.Workers", "FEED_MONITOR_WORKERS", (*intValue)(&c.FeedMonitor.Workers)},
+		{"FeedMonitor.QueueCapacity", "FEED_MONITOR_QUEUE_CAPACITY", (*intValue)(&c.FeedMonitor.QueueCapacity)},
+		{"FeedMonitor.FetchTimeout", "FEED_MONITOR_FETCH_TIMEOUT", (*durationValue)(&c.FeedMonitor.FetchTimeout)},
+		{"FeedMonitor.FetchTimeouts", "FEED_MONITOR_FETCH_TIMEOUTS", (*durationMapValue)(&c.FeedMonitor.FetchTimeouts)},
+		{"FeedMonitor.SkipDuplicateEnvelopes", "FEED_MONITOR_SKIP_DUPLICATE_ENVELOPES", (*boolValue)(&c.FeedMonitor.SkipDuplicateEnvelopes)},
+
+		{"Progress.StallThreshold", "PROGRESS_STALL_THRESHOLD", (*durationValue)(&c.Progress.StallThreshold)},
+		{"Progress.EpochJumpThreshold", "PROGRESS_EPOCH_JUMP_THRESHOLD", (*uint32Value)(&c.Progress.EpochJumpThreshold)},
+
+		{"Balances.LowThresholds", "BALANCES_LOW_THRESHOLDS", (*floatMapValue)(&c.Balances.LowThresholds)},
+	}
+}
+
+type stringValue string
+
+func (s *stringValue) set(v string) error {
+	*s = stringValue(v)
+	return nil
+}
+
+func (s *stringValue) decode(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return typeError("string", v)
+	}
+	*s = stringValue(str)
+	return nil
+}
+
+type boolValue bool
+
+func (b *boolValue) set(s string) error {
+	v, err := strconv.ParseBool(s)
+	if err != nil {
+		return err
+	}
+	*b = boolValue(v)
+	return nil
+}
+
+func (b *boolValue) decode(v any) error {
+	v2, ok := v.(bool)
+	if !ok {
+		return typeError("bool", v)
+	}
+	*b = boolValue(v2)
+	return nil
+}
+
+type intValue int
+
+func (i *intValue) set(s string) error {
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return err
+	}
+	*i = intValue(v)
+	return nil
+}
+
+func (i *intValue) decode(v any) error {
+	n, ok := toInt64(v)
+	if !ok || n < math.MinInt || n > math.MaxInt {
+		return typeError("int", v)
+	}
+	*i = intValue(n)
+	return nil
+}
+
+type floatValue float64
+
+func (f *floatValue) set(s string) error {
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return err
+	}
+	*f = floatValue(v)
+	return nil
+}
+
+func (f *floatValue) decode(v any) error {
+	v2, ok := toFloat64(v)
+	if !ok {
+		return typeError("number", v)
+	}
+	*f = floatValue(v2)
+	return nil
+}
+
+type uint32Value uint32
+
+func (u *uint32Value) set(s string) error {
+	v, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		return err
+	}
+	*u = uint32Value(v)
+	return nil
+}
+
+func (u *uint32Value) decode(v any) error {
+	n, ok := toInt64(v)
+	if !ok || n < 0 || n > math.MaxUint32 {
+		return typeError("uint32", v)
+	}
+	*u = uint32Value(n)
+	return nil
+}
+
+type durationValue time.Duration
+
+func (d *durationValue) set(s string) error {
+	v, err := parseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = durationValue(v)
+	return nil
+}
+
+func (d *durationValue) decode(v any) error {
+	s, ok := v.(string)
+	if !ok {
+		return typeError("duration string", v)
+	}
+	return d.set(s)
+}
+
+// listValue is a list of strings, which are comma separated in environment variables.
+type listValue []string
+
+func (l *listValue) set(s string) error {
+	var list []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	*l = list
+	return nil
+}
+
+func (l *listValue) decode(v any) error {
+	items, ok := v.([]any)
+	if !ok {
+		return typeError("list", v)
+	}
+	list := make([]string, 0, len(items))
+	for i, item := range items {
+		s, ok := item.(string)
+		if !ok {
+			return relayconfig.KeyError{Key: strconv.Itoa(i), Err: typeError("string", item)}
+		}
+		list = append(list, s)
+	}
+	*l = list
+	return nil
+}
+
+// topicSettingsValue is formatted as <topic>=<partitions>:<replication factor>,... in environment variables.
+type topicSettingsValue map[string]TopicSettings
+
+func (t *topicSettingsValue) set(s string) error {
+	settings := map[string]TopicSettings{}
+	err := parseEntries(s, "<topic>=<partitions>:<replication factor>", func(topic, rawSettings string) error {
+		rawPartitions, rawReplicationFactor, found := strings.Cut(rawSettings, ":")
+		if !found {
+			return fmt.Errorf("expected <topic>=<partitions>:<replication factor> but got '%s=%s'", topic, rawSettings)
+		}
+		partitions, err := strconv.Atoi(strings.TrimSpace(rawPartitions))
+		if err != nil {
+			return fmt.Errorf("failed to parse partitions: %w", err)
+		}
+		replicationFactor, err := strconv.Atoi(strings.TrimSpace(rawReplicationFactor))
+		if err != nil {
+			return fmt.Errorf("failed to parse replication factor: %w", err)
+		}
+		settings[topic] = TopicSettings{partitions, replicationFactor}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	*t = settings
+	return nil
+}
+
+func (t *topicSettingsValue) decode(v any) error {
+	topics, ok := v.(map[string]any)
+	if !ok {
+		return typeError("table", v)
+	}
+	settings := make(map[string]TopicSettings, len(topics))
+	for topic, raw := range topics {
+		var s TopicSettings
+		err := decodeTable(raw, map[string]value{
+			"Partitions":        (*intValue)(&s.Partitions),
+			"ReplicationFactor": (*intValue)(&s.ReplicationFactor),
+		})
+		if err != nil {
+			return relayconfig.KeyError{Key: topic, Err: err}
+		}
+		settings[topic] = s
+	}
+	*t = settings
+	return nil
+}
+
+// stringMapValue is formatted as <key>=<value>,... in environment variables.
+type stringMapValue map[string]string
+
+func (m *stringMapValue) set(s string) error {
+	values := map[string]string{}
+	err := parseEntries(s, "<topic>=<strategy>", func(k, v string) error {
+		values[k] = strings.TrimSpace(v)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	*m = values
+	return nil
+}
+
+func (m *stringMapValue) decode(v any) error {
+	table, ok := v.(map[string]any)
+	if !ok {
+		return typeError("table", v)
+	}
+	values := make(map[string]string, len(table))
+	for k, raw := range table {
+		s, ok := raw.(string)
+		if !ok {
+			return relayconfig.KeyError{Key: k, Err: typeError("string", raw)}
+		}
+		values[k] = s
+	}
+	*m = values
+	return nil
+}
+
+// durationMapValue is formatted as <key>=<duration>,... in environment variables.
+type durationMapValue map[string]time.Duration
+
+func (m *durationMapValue) set(s string) error {
+	durations := map[string]time.Duration{}
+	err := parseEntries(s, "<source type>=<duration>", func(k, v string) error {
+		d, err := parseDuration(strings.TrimSpace(v))
+		if err != nil {
+			return err
+		}
+		durations[k] = d
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	*m = durations
+	return nil
+}
+
+func (m *durationMapValue) decode(v any) error {
+	table, ok := v.(map[string]any)
+	if !ok {
+		return typeError("table", v)
+	}
+	durations := make(map[string]time.Duration, len(table))
+	for k, raw := range table {
+		var d durationValue
+		if err := d.decode(raw); err != nil {
+			return relayconfig.KeyError{Key: k, Err: err}
+		}
+		durations[k] = time.Duration(d)
+	}
+	*m = durations
+	return nil
+}
+
+// floatMapValue is formatted as <key>=<number>,... in environment variables.
+type floatMapValue map[string]float64
+
+func (m *floatMapValue) set(s string) error {
+	floats := map[string]float64{}
+	err := parseEntries(s, "<token>=<amount>", func(k, v string) error {
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return err
+		}
+		floats[k] = f
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	*m = floats
+	return nil
+}
+
+func (m *floatMapValue) decode(v any) error {
+	table, ok := v.(map[string]any)
+	if !ok {
+		return typeError("table", v)
+	}
+	floats := make(map[string]float64, len(table))
+	for k, raw := range table {
+		f, ok := toFloat64(raw)
+		if !ok {
+			return relayconfig.KeyError{Key: k, Err: typeError("number", raw)}
+		}
+		floats[k] = f
+	}
+	*m = floats
+	return nil
+}
+
+// parseEntries calls fn with the trimmed key and raw value of each comma separated <key>=<value> entry of s.
+// Empty entries are ignored. format describes the expected entries, for errors.
+func parseEntries(s, format string, fn func(k, v string) error) error {
+	for _, entry := range strings.Split(s, ",") {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+		k, v, found := strings.Cut(entry, "=")
+		if !found {
+			return fmt.Errorf("expected %s but got '%s'", format, entry)
+		}
+		if err := fn(strings.TrimSpace(k), v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseDuration(s string) (time.Duration, error) {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("%w, see https://pkg.go.dev/time#ParseDuration", err)
+	}
+	return d, nil
+}
+
+// toInt64 converts the integer types decoded from TOML and YAML.
+func toInt64(v any) (int64, bool) {
+	switch n := v.(type) {
+	case int:
+		return int64(n), true
+	case int64:
+		return n, true
+	case uint64:
+		if n > math.MaxInt64 {
+			return 0, false
+		}
+		return int64(n), true
+	}
+	return 0, false
+}
+
+// toFloat64 converts the numeric types decoded from TOML and YAML.
+func toFloat64(v any) (float64, bool) {
+	if f, ok := v.(float64); ok {
+		return f, true
+	}
+	n, ok := toInt64(v)
+	return float64(n), ok
+}
+
+func typeError(want string, got any) error {
+	return fmt.Errorf("expected %s but got %T: %v", want, got, got)
+}
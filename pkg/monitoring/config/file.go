@@ -0,0 +1,98 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+	"golang.org/x/exp/maps"
+	"gopkg.in/yaml.v3"
+
+	relayconfig "github.com/smartcontractkit/chainlink-relay/pkg/config"
+)
+
+// parseFile decodes the TOML or YAML config file at path into fields, by the key path of each field, e.g.:
+//
+//	[Kafka]
+//	Brokers = "localhost:9092"
+//	[Kafka.TopicSettings.transmissions]
+//	Partitions = 3
+//	ReplicationFactor = 2
+//	[FeedMonitor.FetchTimeouts]
+//	rpc = "5s"
+//
+// Durations are strings, as parsed by [time.ParseDuration]. Unknown keys are rejected, and all errors are returned
+// together.
+func parseFile(path string, fields []field) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+	var doc map[string]any
+	switch ext := filepath.Ext(path); ext {
+	case ".toml":
+		err = toml.Unmarshal(b, &doc)
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(b, &doc)
+	default:
+		return fmt.Errorf("unsupported config file extension %q: expected .toml, .yaml, or .yml", ext)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to decode config file %s: %w", path, err)
+	}
+	values := make(map[string]value, len(fields))
+	for _, f := range fields {
+		values[f.path] = f.value
+	}
+	return decodeKeys(doc, "", values)
+}
+
+// decodeTable decodes v, which must be a table, into values by key.
+func decodeTable(v any, values map[string]value) error {
+	table, ok := v.(map[string]any)
+	if !ok {
+		return typeError("table", v)
+	}
+	return decodeKeys(table, "", values)
+}
+
+// decodeKeys decodes each entry of table into the value with the same key path, below prefix. Tables without a value
+// are decoded recursively, if there are values below them.
+func decodeKeys(table map[string]any, prefix string, values map[string]value) error {
+	var errs []error
+	keys := maps.Keys(table)
+	sort.Strings(keys)
+	for _, k := range keys {
+		path := k
+		if prefix != "" {
+			path = prefix + "." + k
+		}
+		if v, ok := values[path]; ok {
+			if err := v.decode(table[k]); err != nil {
+				errs = append(errs, relayconfig.KeyError{Key: path, Err: err})
+			}
+			continue
+		}
+		if sub, ok := table[k].(map[string]any); ok && hasPrefix(values, path+".") {
+			if err := decodeKeys(sub, path, values); err != nil {
+				errs = append(errs, err)
+			}
+			continue
+		}
+		errs = append(errs, relayconfig.KeyError{Key: path, Err: errors.New("unknown key")})
+	}
+	return errors.Join(errs...)
+}
+
+func hasPrefix(values map[string]value, prefix string) bool {
+	for path := range values {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -1,99 +1,75 @@
 package config
 
 import (
+	"errors"
+	"flag"
 	"fmt"
+	"math"
 	"net/url"
 	"os"
+	"sort"
 	"strings"
 	"time"
-)
 
-func Parse() (Config, error) {
-	cfg := Config{}
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 
-	if err := parseEnvVars(&cfg); err != nil {
-		return cfg, err
-	}
+	relayconfig "github.com/smartcontractkit/chainlink-relay/pkg/config"
+)
 
-	applyDefaults(&cfg)
+// EnvConfigFile is the environment variable naming a TOML or YAML config file, like the -config flag of [ParseArgs].
+const EnvConfigFile = "CONFIG_FILE"
 
-	err := validateConfig(cfg)
-	return cfg, err
+// Parse is like [ParseArgs], without flags.
+func Parse() (Config, error) {
+	return ParseArgs(nil)
 }
 
-func parseEnvVars(cfg *Config) error {
-	if value, isPresent := os.LookupEnv("KAFKA_BROKERS"); isPresent {
-		cfg.Kafka.Brokers = value
-	}
-	if value, isPresent := os.LookupEnv("KAFKA_CLIENT_ID"); isPresent {
-		cfg.Kafka.ClientID = value
-	}
-	if value, isPresent := os.LookupEnv("KAFKA_SECURITY_PROTOCOL"); isPresent {
-		cfg.Kafka.SecurityProtocol = value
-	}
+// ParseArgs builds a Config in layers, each overriding the last: defaults, the config file named by the -config flag
+// in args or by [EnvConfigFile], and finally environment variables. Config file keys are the paths of the Config
+// fields (e.g. Kafka.Brokers), and unknown keys are rejected. All of the errors from each step are returned together,
+// prefixed by the paths of the fields they apply to.
+func ParseArgs(args []string) (Config, error) {
+	cfg := Config{}
 
-	if value, isPresent := os.LookupEnv("KAFKA_SASL_MECHANISM"); isPresent {
-		cfg.Kafka.SaslMechanism = value
-	}
-	if value, isPresent := os.LookupEnv("KAFKA_SASL_USERNAME"); isPresent {
-		cfg.Kafka.SaslUsername = value
-	}
-	if value, isPresent := os.LookupEnv("KAFKA_SASL_PASSWORD"); isPresent {
-		cfg.Kafka.SaslPassword = value
+	fs := flag.NewFlagSet("monitor", flag.ContinueOnError)
+	configFile := fs.String("config", os.Getenv(EnvConfigFile), "TOML or YAML config file, overridden by environment variables")
+	if err := fs.Parse(args); err != nil {
+		return cfg, err
 	}
 
-	if value, isPresent := os.LookupEnv("KAFKA_TRANSMISSION_TOPIC"); isPresent {
-		cfg.Kafka.TransmissionTopic = value
+	fields := cfg.fields()
+	if *configFile != "" {
+		if err := parseFile(*configFile, fields); err != nil {
+			return cfg, fmt.Errorf("invalid config file %s:\n%w", *configFile, err)
+		}
 	}
-	if value, isPresent := os.LookupEnv("KAFKA_CONFIG_SET_SIMPLIFIED_TOPIC"); isPresent {
-		cfg.Kafka.ConfigSetSimplifiedTopic = value
+	if err := parseEnvVars(fields); err != nil {
+		return cfg, err
 	}
 
-	if value, isPresent := os.LookupEnv("SCHEMA_REGISTRY_URL"); isPresent {
-		cfg.SchemaRegistry.URL = value
-	}
-	if value, isPresent := os.LookupEnv("SCHEMA_REGISTRY_USERNAME"); isPresent {
-		cfg.SchemaRegistry.Username = value
-	}
-	if value, isPresent := os.LookupEnv("SCHEMA_REGISTRY_PASSWORD"); isPresent {
-		cfg.SchemaRegistry.Password = value
-	}
+	err := Finalize(&cfg)
+	return cfg, err
+}
 
-	if value, isPresent := os.LookupEnv("FEEDS_URL"); isPresent {
-		cfg.Feeds.URL = value
-	}
-	if value, isPresent := os.LookupEnv("FEEDS_RDD_READ_TIMEOUT"); isPresent {
-		readTimeout, err := time.ParseDuration(value)
-		if err != nil {
-			return fmt.Errorf("failed to parse env var FEEDS_RDD_READ_TIMEOUT, see https://pkg.go.dev/time#ParseDuration: %w", err)
-		}
-		cfg.Feeds.RDDReadTimeout = readTimeout
-	}
-	if value, isPresent := os.LookupEnv("FEEDS_RDD_POLL_INTERVAL"); isPresent {
-		pollInterval, err := time.ParseDuration(value)
-		if err != nil {
-			return fmt.Errorf("failed to parse env var FEEDS_RDD_POLL_INTERVAL, see https://pkg.go.dev/time#ParseDuration: %w", err)
-		}
-		cfg.Feeds.RDDPollInterval = pollInterval
-	}
-	if value, isPresent := os.LookupEnv("FEEDS_IGNORE_IDS"); isPresent {
-		ids := strings.Split(value, ",")
-		for _, id := range ids {
-			if id == "" {
-				continue
+// Finalize applies defaults to the unset fields of cfg, and validates it, like [ParseArgs]. Binaries which build a
+// Config themselves must call it before use, since optional features like the Kafka write-ahead log depend on it.
+func Finalize(cfg *Config) error {
+	applyDefaults(cfg)
+	return validateConfig(*cfg)
+}
+
+// parseEnvVars sets each of fields from its environment variable, if present.
+func parseEnvVars(fields []field) error {
+	var errs []error
+	for _, f := range fields {
+		if value, isPresent := os.LookupEnv(f.env); isPresent {
+			if err := f.value.set(value); err != nil {
+				errs = append(errs, relayconfig.KeyError{Key: f.path, Err: fmt.Errorf("failed to parse env var %s: %w", f.env, err)})
 			}
-			cfg.Feeds.IgnoreIDs = append(cfg.Feeds.IgnoreIDs, strings.TrimSpace(id))
 		}
 	}
-	if value, isPresent := os.LookupEnv("NODES_URL"); isPresent {
-		cfg.Nodes.URL = value
-	}
-
-	if value, isPresent := os.LookupEnv("HTTP_ADDRESS"); isPresent {
-		cfg.HTTP.Address = value
-	}
-
-	return nil
+	return errors.Join(errs...)
 }
 
 func applyDefaults(cfg *Config) {
@@ -103,39 +79,148 @@ func applyDefaults(cfg *Config) {
 	if cfg.Feeds.RDDPollInterval == 0 {
 		cfg.Feeds.RDDPollInterval = 10 * time.Second
 	}
+	if cfg.Kafka.WALPath != "" && cfg.Kafka.WALReplayInterval == 0 {
+		cfg.Kafka.WALReplayInterval = 10 * time.Second
+	}
+	if cfg.SchemaRegistry.SnapshotPath != "" && cfg.SchemaRegistry.RefreshInterval == 0 {
+		cfg.SchemaRegistry.RefreshInterval = 1 * time.Minute
+	}
+	if cfg.Pushgateway.URL != "" && cfg.Pushgateway.Interval == 0 {
+		cfg.Pushgateway.Interval = 15 * time.Second
+	}
+	if cfg.FeedMonitor.Workers == 0 {
+		cfg.FeedMonitor.Workers = 50
+	}
+	if cfg.FeedMonitor.QueueCapacity == 0 {
+		cfg.FeedMonitor.QueueCapacity = 10
+	}
 }
 
+// validateConfig returns all of the errors in cfg, with the field paths and environment variables they apply to.
 func validateConfig(cfg Config) error {
+	names := map[string]string{}
+	for _, f := range cfg.fields() {
+		names[f.path] = fmt.Sprintf("%s (%s)", f.path, f.env)
+	}
+	var errs []error
 	// Required config
-	for envVarName, currentValue := range map[string]string{
-		"KAFKA_BROKERS":           cfg.Kafka.Brokers,
-		"KAFKA_CLIENT_ID":         cfg.Kafka.ClientID,
-		"KAFKA_SECURITY_PROTOCOL": cfg.Kafka.SecurityProtocol,
-		"KAFKA_SASL_MECHANISM":    cfg.Kafka.SaslMechanism,
+	for _, required := range []struct {
+		path, value string
+	}{
+		{"Kafka.Brokers", cfg.Kafka.Brokers},
+		{"Kafka.ClientID", cfg.Kafka.ClientID},
+		{"Kafka.SecurityProtocol", cfg.Kafka.SecurityProtocol},
+		{"Kafka.SaslMechanism", cfg.Kafka.SaslMechanism},
 
-		"KAFKA_TRANSMISSION_TOPIC":          cfg.Kafka.TransmissionTopic,
-		"KAFKA_CONFIG_SET_SIMPLIFIED_TOPIC": cfg.Kafka.ConfigSetSimplifiedTopic,
+		{"Kafka.TransmissionTopic", cfg.Kafka.TransmissionTopic},
+		{"Kafka.ConfigSetSimplifiedTopic", cfg.Kafka.ConfigSetSimplifiedTopic},
 
-		"SCHEMA_REGISTRY_URL": cfg.SchemaRegistry.URL,
+		{"SchemaRegistry.URL", cfg.SchemaRegistry.URL},
 
-		"FEEDS_URL": cfg.Feeds.URL,
-		"NODES_URL": cfg.Nodes.URL,
+		{"Feeds.URL", cfg.Feeds.URL},
+		{"Nodes.URL", cfg.Nodes.URL},
 
-		"HTTP_ADDRESS": cfg.HTTP.Address,
+		{"HTTP.Address", cfg.HTTP.Address},
 	} {
-		if currentValue == "" {
-			return fmt.Errorf("'%s' env var is required", envVarName)
+		if required.value == "" {
+			errs = append(errs, relayconfig.ErrMissing{Name: names[required.path], Msg: "required"})
 		}
 	}
 	// Validate URLs.
-	for envVarName, currentValue := range map[string]string{
-		"SCHEMA_REGISTRY_URL": cfg.SchemaRegistry.URL,
-		"FEEDS_URL":           cfg.Feeds.URL,
-		"NODES_URL":           cfg.Nodes.URL,
+	for _, u := range []struct {
+		path, value string
+	}{
+		{"SchemaRegistry.URL", cfg.SchemaRegistry.URL},
+		{"Feeds.URL", cfg.Feeds.URL},
+		{"Nodes.URL", cfg.Nodes.URL},
+		{"Pushgateway.URL", cfg.Pushgateway.URL},
+	} {
+		if u.value == "" {
+			continue // required, or optional
+		}
+		if _, err := url.ParseRequestURI(u.value); err != nil {
+			errs = append(errs, relayconfig.ErrInvalid{Name: names[u.path], Value: u.value, Msg: fmt.Sprintf("not a valid URL: %v", err)})
+		}
+	}
+	// Validate sizes.
+	for _, size := range []struct {
+		path  string
+		value int
+	}{
+		{"FeedMonitor.Workers", cfg.FeedMonitor.Workers},
+		{"FeedMonitor.QueueCapacity", cfg.FeedMonitor.QueueCapacity},
 	} {
-		if _, err := url.ParseRequestURI(currentValue); err != nil {
-			return fmt.Errorf("%s='%s' is not a valid URL: %w", envVarName, currentValue, err)
+		if size.value < 1 {
+			errs = append(errs, relayconfig.ErrInvalid{Name: names[size.path], Value: size.value, Msg: "must be positive"})
+		}
+	}
+	if r := cfg.Feeds.RDDRateLimit.Rate; !(r >= 0) || math.IsInf(r, 1) {
+		errs = append(errs, relayconfig.ErrInvalid{Name: names["Feeds.RDDRateLimit.Rate"], Value: r, Msg: "must be a non-negative number"})
+	}
+	if cfg.Feeds.RDDRateLimit.Burst < 0 {
+		errs = append(errs, relayconfig.ErrInvalid{Name: names["Feeds.RDDRateLimit.Burst"], Value: cfg.Feeds.RDDRateLimit.Burst, Msg: "must not be negative"})
+	}
+	if cfg.FeedMonitor.FetchTimeout < 0 {
+		errs = append(errs, relayconfig.ErrInvalid{Name: names["FeedMonitor.FetchTimeout"], Value: cfg.FeedMonitor.FetchTimeout, Msg: "must not be negative"})
+	}
+	if cfg.Kafka.CreateTopics {
+		for _, topic := range []string{cfg.Kafka.TransmissionTopic, cfg.Kafka.ConfigSetSimplifiedTopic} {
+			settings := cfg.Kafka.TopicSettingsFor(topic)
+			if settings.Partitions < 1 || settings.ReplicationFactor < 1 {
+				errs = append(errs, relayconfig.ErrInvalid{Name: names["Kafka.CreateTopics"],
+					Value: fmt.Sprintf("%d:%d", settings.Partitions, settings.ReplicationFactor),
+					Msg: fmt.Sprintf("requires positive partitions and replication factor for topic '%s', see %s, %s and %s", topic,
+						names["Kafka.DefaultTopicSettings.Partitions"], names["Kafka.DefaultTopicSettings.ReplicationFactor"], names["Kafka.TopicSettings"])})
+			}
+		}
+	}
+	if cfg.Kafka.WALPath != "" && cfg.Kafka.WALReplayInterval < 0 {
+		errs = append(errs, relayconfig.ErrInvalid{Name: names["Kafka.WALReplayInterval"], Value: cfg.Kafka.WALReplayInterval, Msg: "must be positive"})
+	}
+	if cfg.Kafka.Linger < 0 {
+		errs = append(errs, relayconfig.ErrInvalid{Name: names["Kafka.Linger"], Value: cfg.Kafka.Linger, Msg: "must not be negative"})
+	}
+	if cfg.Kafka.BatchSize < 0 {
+		errs = append(errs, relayconfig.ErrInvalid{Name: names["Kafka.BatchSize"], Value: cfg.Kafka.BatchSize, Msg: "must not be negative"})
+	}
+	if c := cfg.Kafka.CompressionCodec; c != "" && !slices.Contains(CompressionCodecs, c) {
+		errs = append(errs, relayconfig.ErrInvalid{Name: names["Kafka.CompressionCodec"], Value: c, Msg: fmt.Sprintf("must be one of %s", strings.Join(CompressionCodecs, ", "))})
+	}
+	if cfg.Kafka.MaxInFlight < 0 {
+		errs = append(errs, relayconfig.ErrInvalid{Name: names["Kafka.MaxInFlight"], Value: cfg.Kafka.MaxInFlight, Msg: "must not be negative"})
+	} else if cfg.Kafka.Idempotent && cfg.Kafka.MaxInFlight > MaxInFlightIdempotent {
+		errs = append(errs, relayconfig.ErrInvalid{Name: names["Kafka.MaxInFlight"], Value: cfg.Kafka.MaxInFlight,
+			Msg: fmt.Sprintf("must be at most %d with %s", MaxInFlightIdempotent, names["Kafka.Idempotent"])})
+	}
+	if cfg.Pushgateway.URL != "" {
+		if cfg.Pushgateway.Job == "" {
+			errs = append(errs, relayconfig.ErrMissing{Name: names["Pushgateway.Job"], Msg: fmt.Sprintf("required with %s", names["Pushgateway.URL"])})
+		}
+		if cfg.Pushgateway.Interval < 0 {
+			errs = append(errs, relayconfig.ErrInvalid{Name: names["Pushgateway.Interval"], Value: cfg.Pushgateway.Interval, Msg: "must be positive"})
+		}
+	}
+	if cfg.SchemaRegistry.SnapshotPath != "" && cfg.SchemaRegistry.RefreshInterval < 0 {
+		errs = append(errs, relayconfig.ErrInvalid{Name: names["SchemaRegistry.RefreshInterval"], Value: cfg.SchemaRegistry.RefreshInterval, Msg: "must be positive"})
+	}
+	if cfg.Progress.StallThreshold < 0 {
+		errs = append(errs, relayconfig.ErrInvalid{Name: names["Progress.StallThreshold"], Value: cfg.Progress.StallThreshold, Msg: "must not be negative"})
+	}
+	tokens := maps.Keys(cfg.Balances.LowThresholds)
+	sort.Strings(tokens)
+	for _, token := range tokens {
+		if threshold := cfg.Balances.LowThresholds[token]; threshold < 0 {
+			name := fmt.Sprintf("Balances.LowThresholds.%s (BALANCES_LOW_THRESHOLDS)", token)
+			errs = append(errs, relayconfig.ErrInvalid{Name: name, Value: threshold, Msg: "must not be negative"})
+		}
+	}
+	sourceTypes := maps.Keys(cfg.FeedMonitor.FetchTimeouts)
+	sort.Strings(sourceTypes)
+	for _, sourceType := range sourceTypes {
+		if fetchTimeout := cfg.FeedMonitor.FetchTimeouts[sourceType]; fetchTimeout <= 0 {
+			name := fmt.Sprintf("FeedMonitor.FetchTimeouts.%s (FEED_MONITOR_FETCH_TIMEOUTS)", sourceType)
+			errs = append(errs, relayconfig.ErrInvalid{Name: name, Value: fetchTimeout, Msg: "must be positive"})
 		}
 	}
-	return nil
+	return errors.Join(errs...)
 }
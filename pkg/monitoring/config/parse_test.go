@@ -0,0 +1,220 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
+)
+
+// setRequiredEnv sets the required environment variables.
+func setRequiredEnv(t *testing.T) {
+	for k, v := range map[string]string{
+		"KAFKA_BROKERS":                     "localhost:9092",
+		"KAFKA_CLIENT_ID":                   "client",
+		"KAFKA_SECURITY_PROTOCOL":           "PLAINTEXT",
+		"KAFKA_SASL_MECHANISM":              "PLAIN",
+		"KAFKA_TRANSMISSION_TOPIC":          "transmissions",
+		"KAFKA_CONFIG_SET_SIMPLIFIED_TOPIC": "config_set_simplified",
+		"SCHEMA_REGISTRY_URL":               "http://localhost:8989",
+		"FEEDS_URL":                         "http://localhost:4000/feeds.json",
+		"NODES_URL":                         "http://localhost:4000/nodes.json",
+		"HTTP_ADDRESS":                      "localhost:3000",
+	} {
+		t.Setenv(k, v)
+	}
+}
+
+func writeFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestParse(t *testing.T) {
+	setRequiredEnv(t)
+	t.Setenv(EnvConfigFile, "")
+	cfg, err := Parse()
+	require.NoError(t, err)
+	assert.Equal(t, "localhost:9092", cfg.Kafka.Brokers)
+	assert.Equal(t, 50, cfg.FeedMonitor.Workers)
+	assert.Equal(t, 10*time.Second, cfg.Feeds.RDDPollInterval)
+}
+
+func TestParseArgs_file(t *testing.T) {
+	for _, tt := range []struct {
+		name, content string
+	}{
+		{"config.toml", `
+[Kafka]
+Brokers = "file:9092"
+CreateTopics = true
+Linger = "5ms"
+CompressionCodec = "lz4"
+
+[Kafka.DefaultTopicSettings]
+Partitions = 1
+ReplicationFactor = 1
+
+[Kafka.TopicSettings."transmissions"]
+Partitions = 3
+ReplicationFactor = 2
+
+[Feeds]
+RDDPollInterval = "1m"
+IgnoreIDs = ["a", "b"]
+
+[Feeds.RDDRateLimit]
+Rate = 0.5
+Burst = 2
+
+[FeedMonitor]
+Workers = 5
+SkipDuplicateEnvelopes = true
+
+[FeedMonitor.FetchTimeouts]
+rpc = "5s"
+
+[Progress]
+EpochJumpThreshold = 7
+
+[Balances.LowThresholds]
+LINK = 10
+native = 0.5
+`},
+		{"config.yaml", `
+Kafka:
+  Brokers: file:9092
+  CreateTopics: true
+  Linger: 5ms
+  CompressionCodec: lz4
+  DefaultTopicSettings:
+    Partitions: 1
+    ReplicationFactor: 1
+  TopicSettings:
+    transmissions:
+      Partitions: 3
+      ReplicationFactor: 2
+Feeds:
+  RDDPollInterval: 1m
+  IgnoreIDs: [a, b]
+  RDDRateLimit:
+    Rate: 0.5
+    Burst: 2
+FeedMonitor:
+  Workers: 5
+  SkipDuplicateEnvelopes: true
+  FetchTimeouts:
+    rpc: 5s
+Progress:
+  EpochJumpThreshold: 7
+Balances:
+  LowThresholds:
+    LINK: 10
+    native: 0.5
+`},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			setRequiredEnv(t)
+			t.Setenv("FEED_MONITOR_WORKERS", "8") // overrides the file
+			t.Setenv(EnvConfigFile, "ignored.toml")
+
+			cfg, err := ParseArgs([]string{"-config", writeFile(t, tt.name, tt.content)})
+			require.NoError(t, err)
+			assert.Equal(t, "localhost:9092", cfg.Kafka.Brokers, "env must override file")
+			assert.True(t, cfg.Kafka.CreateTopics)
+			assert.Equal(t, 5*time.Millisecond, cfg.Kafka.Linger)
+			assert.Equal(t, "lz4", cfg.Kafka.CompressionCodec)
+			assert.Equal(t, TopicSettings{1, 1}, cfg.Kafka.DefaultTopicSettings)
+			assert.Equal(t, map[string]TopicSettings{"transmissions": {3, 2}}, cfg.Kafka.TopicSettings)
+			assert.Equal(t, time.Minute, cfg.Feeds.RDDPollInterval)
+			assert.Equal(t, []string{"a", "b"}, cfg.Feeds.IgnoreIDs)
+			assert.Equal(t, utils.RateLimit{Rate: 0.5, Burst: 2}, cfg.Feeds.RDDRateLimit)
+			assert.Equal(t, 8, cfg.FeedMonitor.Workers)
+			assert.Equal(t, map[string]time.Duration{"rpc": 5 * time.Second}, cfg.FeedMonitor.FetchTimeouts)
+			assert.True(t, cfg.FeedMonitor.SkipDuplicateEnvelopes)
+			assert.Equal(t, uint32(7), cfg.Progress.EpochJumpThreshold)
+			assert.Equal(t, map[string]float64{"LINK": 10, "native": 0.5}, cfg.Balances.LowThresholds)
+		})
+	}
+}
+
+func TestParseArgs_errors(t *testing.T) {
+	t.Run("file", func(t *testing.T) {
+		setRequiredEnv(t)
+		path := writeFile(t, "config.toml", `
+[Kafka]
+Brokers = 9092
+Unknown = true
+
+[Kafka.TopicSettings.transmissions]
+Partitions = "three"
+
+[Feeds]
+RDDPollInterval = "soon"
+
+[Other]
+Key = "value"
+`)
+		_, err := ParseArgs([]string{"-config", path})
+		require.Error(t, err)
+		for _, msg := range []string{
+			"Kafka.Brokers: expected string but got int64: 9092",
+			"Kafka.TopicSettings.transmissions: Partitions: expected int but got string: three",
+			"Kafka.Unknown: unknown key",
+			"Feeds.RDDPollInterval: time: invalid duration",
+			"Other: unknown key",
+		} {
+			assert.ErrorContains(t, err, msg)
+		}
+	})
+	t.Run("extension", func(t *testing.T) {
+		setRequiredEnv(t)
+		_, err := ParseArgs([]string{"-config", writeFile(t, "config.json", `{}`)})
+		assert.ErrorContains(t, err, `unsupported config file extension ".json"`)
+	})
+	t.Run("env", func(t *testing.T) {
+		setRequiredEnv(t)
+		t.Setenv("KAFKA_CREATE_TOPICS", "maybe")
+		t.Setenv("KAFKA_TOPIC_SETTINGS", "transmissions=3")
+		_, err := ParseArgs(nil)
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "Kafka.CreateTopics: failed to parse env var KAFKA_CREATE_TOPICS")
+		assert.ErrorContains(t, err, "Kafka.TopicSettings: failed to parse env var KAFKA_TOPIC_SETTINGS: expected <topic>=<partitions>:<replication factor>")
+	})
+	t.Run("validation", func(t *testing.T) {
+		setRequiredEnv(t)
+		t.Setenv("KAFKA_BROKERS", "")
+		t.Setenv("FEEDS_URL", "feeds")
+		t.Setenv("FEED_MONITOR_WORKERS", "-1")
+		t.Setenv("FEED_MONITOR_FETCH_TIMEOUTS", "rpc=-1s")
+		t.Setenv("BALANCES_LOW_THRESHOLDS", "LINK=-1")
+		t.Setenv("KAFKA_COMPRESSION_CODEC", "brotli")
+		t.Setenv("KAFKA_IDEMPOTENT", "true")
+		t.Setenv("KAFKA_MAX_IN_FLIGHT", "6")
+		t.Setenv("PUSHGATEWAY_URL", "http://pushgateway:9091")
+		t.Setenv("FEEDS_RDD_RATE_LIMIT", "-0.5")
+		t.Setenv("FEEDS_RDD_RATE_BURST", "-1")
+		_, err := ParseArgs(nil)
+		require.Error(t, err)
+		for _, msg := range []string{
+			"Kafka.Brokers (KAFKA_BROKERS): missing: required",
+			"Feeds.URL (FEEDS_URL): invalid value feeds: not a valid URL",
+			"FeedMonitor.Workers (FEED_MONITOR_WORKERS): invalid value -1: must be positive",
+			"FeedMonitor.FetchTimeouts.rpc (FEED_MONITOR_FETCH_TIMEOUTS): invalid value -1s: must be positive",
+			"Balances.LowThresholds.LINK (BALANCES_LOW_THRESHOLDS): invalid value -1: must not be negative",
+			"Kafka.CompressionCodec (KAFKA_COMPRESSION_CODEC): invalid value brotli: must be one of none, gzip, snappy, lz4, zstd",
+			"Kafka.MaxInFlight (KAFKA_MAX_IN_FLIGHT): invalid value 6: must be at most 5 with Kafka.Idempotent (KAFKA_IDEMPOTENT)",
+			"Pushgateway.Job (PUSHGATEWAY_JOB): missing: required with Pushgateway.URL (PUSHGATEWAY_URL)",
+			"Feeds.RDDRateLimit.Rate (FEEDS_RDD_RATE_LIMIT): invalid value -0.5: must be a non-negative number",
+			"Feeds.RDDRateLimit.Burst (FEEDS_RDD_RATE_BURST): invalid value -1: must not be negative",
+		} {
+			assert.ErrorContains(t, err, msg)
+		}
+	})
+}
@@ -1,9 +1,11 @@
-// package config parses flags, environment variables and json object to build
+// package config parses flags, environment variables and config files to build
 // a Config object that's used throughout the monitor.
 package config
 
 import (
 	"time"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
 )
 
 type Config struct {
@@ -12,6 +14,10 @@ type Config struct {
 	Feeds          Feeds
 	Nodes          Nodes
 	HTTP           HTTP
+	Pushgateway    Pushgateway
+	FeedMonitor    FeedMonitor
+	Progress       Progress
+	Balances       Balances
 	Feature        Feature
 }
 
@@ -26,12 +32,70 @@ type Kafka struct {
 
 	TransmissionTopic        string
 	ConfigSetSimplifiedTopic string
+
+	// CreateTopics enables creating missing topics at startup, via the admin API, instead of relying on the brokers'
+	// auto-creation defaults.
+	CreateTopics bool
+	// DefaultTopicSettings are used to create topics without an entry in TopicSettings.
+	DefaultTopicSettings TopicSettings
+	// TopicSettings override DefaultTopicSettings, keyed by topic name.
+	TopicSettings map[string]TopicSettings
+
+	// KeyStrategies name the strategy used to derive message keys, keyed by topic name.
+	// See monitoring.ParseKeyStrategy for the supported values. Topics without an entry are keyed by contract address.
+	KeyStrategies map[string]string
+
+	// WALPath enables a write-ahead log in front of the producer, stored in a file at WALPath. Messages are persisted
+	// before being produced, and replayed until the brokers acknowledge them, so broker outages don't lose messages.
+	WALPath string
+	// WALReplayInterval is the interval at which unacknowledged messages are replayed from the write-ahead log.
+	WALReplayInterval time.Duration
+
+	// The following tune the throughput of the producer, and default to the librdkafka defaults when unset.
+	// Linger is how long to wait for messages to accumulate into batches before sending them (linger.ms).
+	Linger time.Duration
+	// BatchSize is the maximum size of a batch of messages to a partition, in bytes (batch.size).
+	BatchSize int
+	// CompressionCodec compresses batches, with one of: none, gzip, snappy, lz4 or zstd (compression.codec).
+	CompressionCodec string
+	// Idempotent enables the idempotent producer, which delivers each message exactly once and in order, even when
+	// retried (enable.idempotence).
+	Idempotent bool
+	// MaxInFlight is the maximum number of requests in flight to each broker (max.in.flight.requests.per.connection).
+	// At most 5 with Idempotent.
+	MaxInFlight int
+}
+
+// CompressionCodecs are the supported values of Kafka.CompressionCodec.
+var CompressionCodecs = []string{"none", "gzip", "snappy", "lz4", "zstd"}
+
+// MaxInFlightIdempotent is the maximum Kafka.MaxInFlight with Kafka.Idempotent.
+const MaxInFlightIdempotent = 5
+
+// TopicSettingsFor returns the settings used to create topic.
+func (k Kafka) TopicSettingsFor(topic string) TopicSettings {
+	if settings, ok := k.TopicSettings[topic]; ok {
+		return settings
+	}
+	return k.DefaultTopicSettings
+}
+
+// TopicSettings configure the creation of a Kafka topic.
+type TopicSettings struct {
+	Partitions        int
+	ReplicationFactor int
 }
 
 type SchemaRegistry struct {
 	URL      string
 	Username string
 	Password string
+
+	// SnapshotPath enables saving the schemas resolved with the registry to a file at SnapshotPath, from which they
+	// are loaded while the registry is unavailable.
+	SnapshotPath string
+	// RefreshInterval is the interval at which schemas loaded from the snapshot are resolved again with the registry.
+	RefreshInterval time.Duration
 }
 
 type Feeds struct {
@@ -42,6 +106,9 @@ type Feeds struct {
 	// These get matched against the string returned by FeedConfig#GetID() for
 	// each feed in RDD. If equal, the feed will get ignored!
 	IgnoreIDs []string
+	// RDDRateLimit optionally limits the rate of requests to each RDD host, shared by the feeds and nodes URLs, so
+	// that short poll intervals or retries can't overload it.
+	RDDRateLimit utils.RateLimit
 }
 
 type Nodes struct {
@@ -52,6 +119,51 @@ type HTTP struct {
 	Address string
 }
 
+// Pushgateway configures pushing metrics to a Prometheus Pushgateway, for monitors which run as short-lived jobs and
+// can't be scraped. Metrics are served on /metrics instead when URL is unset.
+type Pushgateway struct {
+	URL string
+	// Job is the job label of the pushed metrics.
+	Job string
+	// Labels are added to Job to group the pushed metrics, e.g. to tell instances of the job apart.
+	Labels map[string]string
+	// Interval is the interval at which metrics are pushed. They are also pushed once more when the monitor stops.
+	Interval time.Duration
+}
+
+type FeedMonitor struct {
+	// Number of workers exporting updates, shared by all feeds.
+	Workers int
+	// Number of updates queued for each feed while waiting for a worker.
+	// When the queue is full, the oldest update is dropped.
+	QueueCapacity int
+	// Maximum duration of a single fetch from a feed's source.
+	// Zero falls back to the chain config's read timeout.
+	FetchTimeout time.Duration
+	// Overrides FetchTimeout for sources, keyed by the source factory type.
+	FetchTimeouts map[string]time.Duration
+	// SkipDuplicateEnvelopes enables skipping the Kafka export of envelopes which repeat the previous one, i.e. without
+	// a new round or config, which cuts the volume of the topics of slow feeds.
+	SkipDuplicateEnvelopes bool
+}
+
+// Progress configures the detection of anomalies in the progression of feeds' epochs and rounds.
+type Progress struct {
+	// Duration without the latest epoch and round of a feed advancing, after which the feed is reported as stalled.
+	// It should exceed the longest heartbeat of the monitored feeds. Zero disables stall detection.
+	StallThreshold time.Duration
+	// Increase of a feed's epoch between consecutive updates, above which it is reported as a jump. Many epochs
+	// without a round usually mean that leaders are failing. Zero disables epoch jump detection.
+	EpochJumpThreshold uint32
+}
+
+// Balances configures the monitoring of the balances read by a monitoring.BalanceReader.
+type Balances struct {
+	// Thresholds keyed by token symbol, in whole tokens, below which a balance is reported as low. Balances of tokens
+	// without a threshold are only exported.
+	LowThresholds map[string]float64
+}
+
 // Feature is used to add temporary feature flags to the binary.
 type Feature struct {
 }
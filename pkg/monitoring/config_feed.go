@@ -3,6 +3,8 @@ package monitoring
 import (
 	"io"
 	"math/big"
+
+	relaytypes "github.com/smartcontractkit/chainlink-relay/pkg/types"
 )
 
 // FeedParser is the interface for deserializing feed configuration data for each chain integration.
@@ -29,3 +31,11 @@ type FeedConfig interface {
 	// ToMapping() is useful when encoding kafka messages.
 	ToMapping() map[string]interface{}
 }
+
+// MercuryFeedConfig is optionally implemented by the FeedConfigs of mercury feeds, which are identified by a
+// [relaytypes.FeedID]. GetID must return its String form. Feeds whose ID has an unsupported version are dropped when
+// the RDD is read.
+type MercuryFeedConfig interface {
+	FeedConfig
+	GetFeedID() relaytypes.FeedID
+}
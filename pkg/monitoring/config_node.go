@@ -1,9 +1,13 @@
 package monitoring
 
 import (
+	"errors"
+	"fmt"
 	"io"
 
 	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	relaytypes "github.com/smartcontractkit/chainlink-relay/pkg/types"
 )
 
 // NodesParser extracts multiple nodes' configurations from the configuration server, eg. weiwatchers.com
@@ -14,3 +18,48 @@ type NodeConfig interface {
 	GetName() string
 	GetAccount() types.Account
 }
+
+// MultiAccountNodeConfig is optionally implemented by NodeConfigs for node operators which transmit from multiple accounts.
+type MultiAccountNodeConfig interface {
+	NodeConfig
+	// GetAccounts returns every transmitter account, including GetAccount.
+	GetAccounts() []types.Account
+}
+
+// ValidatingNodesParser returns a NodesParser which drops the nodes returned by parse with any account which is invalid
+// for family, as by [relaytypes.Account.Validate], and logs them, so that malformed accounts are caught before they are
+// matched against transmitters, without failing the other nodes. family must have a registered validator.
+func ValidatingNodesParser(log Logger, family string, parse NodesParser) NodesParser {
+	return func(buf io.ReadCloser) ([]NodeConfig, error) {
+		if !relaytypes.HasAccountValidator(family) {
+			return nil, fmt.Errorf("no account validator registered for %q", family)
+		}
+		nodes, err := parse(buf)
+		if err != nil {
+			return nil, err
+		}
+		valid := make([]NodeConfig, 0, len(nodes))
+		for _, node := range nodes {
+			if err := validateNodeAccounts(node, family); err != nil {
+				log.Errorw("Skipping node with invalid account", "node", node.GetName(), "error", err)
+				continue
+			}
+			valid = append(valid, node)
+		}
+		return valid, nil
+	}
+}
+
+func validateNodeAccounts(node NodeConfig, family string) error {
+	accounts := []types.Account{node.GetAccount()}
+	if multi, ok := node.(MultiAccountNodeConfig); ok {
+		accounts = multi.GetAccounts()
+	}
+	var errs []error
+	for _, account := range accounts {
+		if err := relaytypes.AccountFromOCR(account).Validate(family); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
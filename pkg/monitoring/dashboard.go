@@ -0,0 +1,210 @@
+package monitoring
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// MetricKind determines how a metric is charted.
+type MetricKind string
+
+const (
+	// MetricGauge is charted as is.
+	MetricGauge MetricKind = "gauge"
+	// MetricCounter is charted as a per-second rate.
+	MetricCounter MetricKind = "counter"
+	// MetricHistogram is charted as its 95th percentile.
+	MetricHistogram MetricKind = "histogram"
+)
+
+// DashboardMetric describes how a metric exported by the monitor is charted on generated dashboards.
+type DashboardMetric struct {
+	// Name of the prometheus metric.
+	Name  string
+	Title string
+	Kind  MetricKind
+	// Unit is a grafana unit, e.g. "short" or "ns".
+	Unit string
+	// PerFeed metrics have a feed_id label, and are filtered by the dashboard's feed variable.
+	PerFeed bool
+	// Legend optionally overrides the series legend, which defaults to the feed name for PerFeed metrics, and to the
+	// network name otherwise.
+	Legend string
+}
+
+// DashboardMetrics are the metrics registered by this package, as charted by default on generated dashboards. Every
+// metric must be listed, except for those in undashboardedMetrics.
+var DashboardMetrics = []DashboardMetric{
+	// Feeds
+	{Name: "offchain_aggregator_answers", Title: "Answer", Kind: MetricGauge, Unit: "short", PerFeed: true},
+	{Name: "offchain_aggregator_juels_per_fee_coin", Title: "Juels per fee coin", Kind: MetricGauge, Unit: "short", PerFeed: true},
+	{Name: "offchain_aggregator_answers_total", Title: "Answers", Kind: MetricCounter, Unit: "ops", PerFeed: true},
+	{Name: "offchain_aggregator_round_id", Title: "Aggregator round ID", Kind: MetricGauge, Unit: "none", PerFeed: true},
+	{Name: "offchain_aggregator_answer_stalled", Title: "Answer stalled", Kind: MetricGauge, Unit: "bool", PerFeed: true},
+	{Name: "offchain_aggregator_submission_received_values", Title: "Submitted values", Kind: MetricGauge, Unit: "short", PerFeed: true, Legend: "{{feed_name}} {{sender}}"},
+	{Name: "offchain_aggregator_juels_per_fee_coin_received_values", Title: "Submitted juels per fee coin", Kind: MetricGauge, Unit: "short", PerFeed: true, Legend: "{{feed_name}} {{sender}}"},
+	{Name: "offchain_aggregator_transmission_interval_seconds", Title: "Transmission interval (p95)", Kind: MetricHistogram, Unit: "s", PerFeed: true},
+	{Name: "offchain_aggregator_round_duration_seconds", Title: "Round duration (p95)", Kind: MetricHistogram, Unit: "s", PerFeed: true},
+	{Name: "feed_contract_link_balance", Title: "LINK balance", Kind: MetricGauge, Unit: "short", PerFeed: true},
+	{Name: "link_available_for_payments", Title: "LINK available for payments", Kind: MetricGauge, Unit: "short", PerFeed: true},
+	{Name: "feed_account_balance", Title: "Account balances", Kind: MetricGauge, Unit: "short", PerFeed: true, Legend: "{{feed_name}} {{role}} {{token}}"},
+	{Name: "feed_account_balance_low", Title: "Account balances low", Kind: MetricGauge, Unit: "bool", PerFeed: true, Legend: "{{feed_name}} {{role}} {{token}}"},
+	{Name: "feed_contract_transactions_succeeded", Title: "Transactions succeeded", Kind: MetricCounter, Unit: "ops", PerFeed: true},
+	{Name: "feed_contract_transactions_failed", Title: "Transactions failed", Kind: MetricCounter, Unit: "ops", PerFeed: true},
+	{Name: "feed_answer_deviation_percent", Title: "Deviation from reference", Kind: MetricGauge, Unit: "percent", PerFeed: true},
+	// Progress
+	{Name: "offchain_aggregator_progress_stalled", Title: "Epoch and round stalled", Kind: MetricGauge, Unit: "bool", PerFeed: true},
+	{Name: "offchain_aggregator_round_regressions", Title: "Round regressions", Kind: MetricCounter, Unit: "ops", PerFeed: true},
+	{Name: "offchain_aggregator_epoch_jumps", Title: "Epoch jumps", Kind: MetricCounter, Unit: "ops", PerFeed: true},
+	// Monitor
+	{Name: "fetch_from_source_duration", Title: "Fetch duration (p95)", Kind: MetricHistogram, Unit: "ns", PerFeed: true, Legend: "{{feed_name}} {{source_name}}"},
+	{Name: "fetch_from_source_succeeded", Title: "Fetches succeeded", Kind: MetricCounter, Unit: "ops", PerFeed: true, Legend: "{{feed_name}} {{source_name}}"},
+	{Name: "fetch_from_source_failed", Title: "Fetches failed", Kind: MetricCounter, Unit: "ops", PerFeed: true, Legend: "{{feed_name}} {{source_name}}"},
+	{Name: "fetch_from_source_timed_out", Title: "Fetches timed out", Kind: MetricCounter, Unit: "ops", PerFeed: true, Legend: "{{feed_name}} {{source_name}}"},
+	{Name: "feed_monitor_updates_dropped", Title: "Updates dropped", Kind: MetricCounter, Unit: "ops", PerFeed: true},
+	{Name: "feed_monitor_envelopes_skipped", Title: "Envelopes skipped", Kind: MetricCounter, Unit: "ops", PerFeed: true},
+	{Name: "feed_monitor_exporter_cleanup_failed", Title: "Exporter cleanups failed", Kind: MetricCounter, Unit: "ops", PerFeed: true, Legend: "{{feed_name}} {{exporter}} {{reason}}"},
+	{Name: "head_tracker_current_head", Title: "Current head", Kind: MetricGauge, Unit: "none"},
+	{Name: "new_feed_configs_detected", Title: "Feeds monitored", Kind: MetricGauge, Unit: "none"},
+	{Name: "send_message_to_kafka_failed", Title: "Kafka writes failed", Kind: MetricCounter, Unit: "ops", Legend: "{{topic}}"},
+	{Name: "send_message_to_kafka_succeeded", Title: "Kafka writes succeeded", Kind: MetricCounter, Unit: "ops", Legend: "{{topic}}"},
+	{Name: "send_message_to_kafka_bytes", Title: "Kafka bytes written", Kind: MetricCounter, Unit: "Bps", Legend: "{{topic}}"},
+	{Name: "kafka_wal_pending_messages", Title: "Kafka WAL pending messages", Kind: MetricGauge, Unit: "short"},
+	{Name: "kafka_wal_lag_seconds", Title: "Kafka WAL lag", Kind: MetricGauge, Unit: "s"},
+	{Name: "kafka_wal_replayed_messages", Title: "Kafka WAL messages replayed", Kind: MetricCounter, Unit: "ops", Legend: "{{topic}}"},
+	// Chain
+	{Name: "chain_head_height", Title: "Chain height", Kind: MetricGauge, Unit: "none"},
+	{Name: "chain_head_age_seconds", Title: "Latest block age", Kind: MetricGauge, Unit: "s"},
+	{Name: "chain_head_stalled_seconds", Title: "Chain height stalled", Kind: MetricGauge, Unit: "s"},
+}
+
+// undashboardedMetrics are registered by this package, but not charted: metadata metrics, which only carry labels for
+// joins, and raw values, which are charted scaled by the feed's multiplier instead.
+var undashboardedMetrics = map[string]struct{}{
+	"feed_contract_metadata":                     {},
+	"node_metadata":                              {},
+	"offchain_aggregator_answers_raw":            {},
+	"offchain_aggregator_juels_per_fee_coin_raw": {},
+}
+
+// DashboardFeed is a feed listed in the feed variable of a generated dashboard.
+type DashboardFeed struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// DashboardParams parameterize a generated dashboard.
+type DashboardParams struct {
+	Title string `json:"title"`
+	// Datasource is the UID of the prometheus datasource.
+	Datasource  string          `json:"datasource"`
+	NetworkName string          `json:"networkName"`
+	NetworkID   string          `json:"networkID"`
+	ChainID     string          `json:"chainID"`
+	Feeds       []DashboardFeed `json:"feeds"`
+}
+
+// NewDashboardParams returns DashboardParams for the network of chainConfig, listing feeds.
+func NewDashboardParams(title, datasource string, chainConfig ChainConfig, feeds []FeedConfig) DashboardParams {
+	params := DashboardParams{
+		Title:       title,
+		Datasource:  datasource,
+		NetworkName: chainConfig.GetNetworkName(),
+		NetworkID:   chainConfig.GetNetworkID(),
+		ChainID:     chainConfig.GetChainID(),
+	}
+	for _, feed := range feeds {
+		params.Feeds = append(params.Feeds, DashboardFeed{ID: feed.GetID(), Name: feed.GetName()})
+	}
+	return params
+}
+
+//go:embed dashboard.json.tmpl
+var dashboardTemplateText string
+
+var dashboardTemplate = template.Must(template.New("dashboard").Funcs(template.FuncMap{
+	"json": func(v interface{}) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+}).Parse(dashboardTemplateText))
+
+// dashboardPanel is a panel of a generated dashboard, ready for rendering.
+type dashboardPanel struct {
+	ID         int
+	Title      string
+	Expr       string
+	Legend     string
+	Unit       string
+	X, Y, W, H int
+}
+
+// GenerateDashboard writes the JSON model of a grafana dashboard to w, with a panel for each of metrics.
+func GenerateDashboard(w io.Writer, params DashboardParams, metrics []DashboardMetric) error {
+	const panelWidth, panelHeight = 12, 8
+	panels := make([]dashboardPanel, len(metrics))
+	for i, m := range metrics {
+		expr, err := dashboardExpr(m, params)
+		if err != nil {
+			return err
+		}
+		legend := m.Legend
+		if legend == "" {
+			if m.PerFeed {
+				legend = "{{feed_name}}"
+			} else {
+				legend = "{{network_name}}"
+			}
+		}
+		panels[i] = dashboardPanel{
+			ID:     i + 1,
+			Title:  m.Title,
+			Expr:   expr,
+			Legend: legend,
+			Unit:   m.Unit,
+			X:      (i % 2) * panelWidth,
+			Y:      (i / 2) * panelHeight,
+			W:      panelWidth,
+			H:      panelHeight,
+		}
+	}
+	return dashboardTemplate.Execute(w, struct {
+		DashboardParams
+		Panels      []dashboardPanel
+		FeedOptions string
+	}{params, panels, dashboardFeedOptions(params.Feeds)})
+}
+
+// dashboardExpr returns the PromQL expression charting m for the network of params.
+func dashboardExpr(m DashboardMetric, params DashboardParams) (string, error) {
+	selector := fmt.Sprintf("network_name=%s,network_id=%s,chain_id=%s",
+		strconv.Quote(params.NetworkName), strconv.Quote(params.NetworkID), strconv.Quote(params.ChainID))
+	if m.PerFeed {
+		selector += `,feed_id=~"$feed"`
+	}
+	switch m.Kind {
+	case MetricGauge:
+		return fmt.Sprintf("%s{%s}", m.Name, selector), nil
+	case MetricCounter:
+		return fmt.Sprintf("rate(%s{%s}[$__rate_interval])", m.Name, selector), nil
+	case MetricHistogram:
+		return fmt.Sprintf("histogram_quantile(0.95, rate(%s_bucket{%s}[$__rate_interval]))", m.Name, selector), nil
+	default:
+		return "", fmt.Errorf("metric %s: unknown kind %q", m.Name, m.Kind)
+	}
+}
+
+// dashboardFeedOptions returns the query of a custom grafana variable with an option for each feed.
+func dashboardFeedOptions(feeds []DashboardFeed) string {
+	escape := strings.NewReplacer(`\`, `\\`, `,`, `\,`).Replace
+	options := make([]string, len(feeds))
+	for i, feed := range feeds {
+		options[i] = escape(feed.Name) + " : " + escape(feed.ID)
+	}
+	return strings.Join(options, ",")
+}
@@ -0,0 +1,167 @@
+package monitoring
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testDashboard struct {
+	Title      string
+	Templating struct {
+		List []struct {
+			Name    string
+			Query   string
+			Options []struct {
+				Text  string
+				Value string
+			}
+		}
+	}
+	Panels []struct {
+		ID         int
+		Title      string
+		GridPos    struct{ X, Y, W, H int }
+		Datasource struct{ UID string }
+		Targets    []struct {
+			Expr         string
+			LegendFormat string
+		}
+	}
+}
+
+func TestGenerateDashboard(t *testing.T) {
+	params := DashboardParams{
+		Title:       `Test "Net"`,
+		Datasource:  "prom",
+		NetworkName: "test-net",
+		NetworkID:   "1",
+		ChainID:     "test",
+		Feeds: []DashboardFeed{
+			{ID: "0xaaa", Name: "LINK / USD"},
+			{ID: "0xbbb", Name: `ETH, "wrapped"`},
+		},
+	}
+	metrics := []DashboardMetric{
+		{Name: "gauge_metric", Title: "Gauge", Kind: MetricGauge, Unit: "short", PerFeed: true},
+		{Name: "counter_metric", Title: "Counter", Kind: MetricCounter, Unit: "ops", Legend: "{{topic}}"},
+		{Name: "histogram_metric", Title: "Histogram", Kind: MetricHistogram, Unit: "ns", PerFeed: true},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, GenerateDashboard(&buf, params, metrics))
+	var d testDashboard
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &d), buf.String())
+
+	assert.Equal(t, params.Title, d.Title)
+
+	require.Len(t, d.Templating.List, 1)
+	feed := d.Templating.List[0]
+	assert.Equal(t, "feed", feed.Name)
+	assert.Equal(t, `LINK / USD : 0xaaa,ETH\, "wrapped" : 0xbbb`, feed.Query)
+	require.Len(t, feed.Options, 3)
+	assert.Equal(t, "$__all", feed.Options[0].Value)
+	assert.Equal(t, `ETH, "wrapped"`, feed.Options[2].Text)
+	assert.Equal(t, "0xbbb", feed.Options[2].Value)
+
+	require.Len(t, d.Panels, len(metrics))
+	const selector = `network_name="test-net",network_id="1",chain_id="test"`
+	for i, expected := range []struct {
+		expr, legend string
+	}{
+		{`gauge_metric{` + selector + `,feed_id=~"$feed"}`, "{{feed_name}}"},
+		{`rate(counter_metric{` + selector + `}[$__rate_interval])`, "{{topic}}"},
+		{`histogram_quantile(0.95, rate(histogram_metric_bucket{` + selector + `,feed_id=~"$feed"}[$__rate_interval]))`, "{{feed_name}}"},
+	} {
+		p := d.Panels[i]
+		assert.Equal(t, i+1, p.ID)
+		assert.Equal(t, metrics[i].Title, p.Title)
+		assert.Equal(t, "prom", p.Datasource.UID)
+		require.Len(t, p.Targets, 1)
+		assert.Equal(t, expected.expr, p.Targets[0].Expr)
+		assert.Equal(t, expected.legend, p.Targets[0].LegendFormat)
+	}
+	// two panels per row
+	assert.Equal(t, d.Panels[0].GridPos.Y, d.Panels[1].GridPos.Y)
+	assert.NotEqual(t, d.Panels[0].GridPos.X, d.Panels[1].GridPos.X)
+	assert.Greater(t, d.Panels[2].GridPos.Y, d.Panels[0].GridPos.Y)
+}
+
+func TestGenerateDashboard_noFeeds(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, GenerateDashboard(&buf, DashboardParams{Title: "empty"}, nil))
+	var d testDashboard
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &d), buf.String())
+	assert.Empty(t, d.Panels)
+}
+
+func TestGenerateDashboard_unknownKind(t *testing.T) {
+	err := GenerateDashboard(&bytes.Buffer{}, DashboardParams{}, []DashboardMetric{{Name: "metric", Kind: "summary"}})
+	require.ErrorContains(t, err, `unknown kind "summary"`)
+}
+
+func TestDashboardMetrics(t *testing.T) {
+	var buf bytes.Buffer
+	params := NewDashboardParams("test", "prom", generateChainConfig(), []FeedConfig{generateFeedConfig()})
+	require.NoError(t, GenerateDashboard(&buf, params, DashboardMetrics))
+	require.True(t, json.Valid(buf.Bytes()), buf.String())
+
+	for _, m := range DashboardMetrics {
+		// Registering a different metric with the same name fails if, and only if, the name is registered.
+		probe := prometheus.NewGauge(prometheus.GaugeOpts{Name: m.Name, Help: "probe"})
+		if err := prometheus.Register(probe); err == nil {
+			prometheus.Unregister(probe)
+			t.Errorf("metric %s is not registered", m.Name)
+		}
+	}
+}
+
+// TestDashboardMetrics_complete checks that every metric registered by this package, with promauto, is listed in
+// DashboardMetrics, unless it is deliberately left out.
+func TestDashboardMetrics_complete(t *testing.T) {
+	listed := map[string]struct{}{}
+	for _, m := range DashboardMetrics {
+		listed[m.Name] = struct{}{}
+	}
+
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(fi fs.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	require.NoError(t, err)
+	var registered int
+	ast.Inspect(pkgs["monitoring"], func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) == 0 {
+			return true
+		}
+		if fn, ok := call.Fun.(*ast.SelectorExpr); !ok || fmt.Sprint(fn.X) != "promauto" {
+			return true
+		}
+		opts, ok := call.Args[0].(*ast.CompositeLit)
+		require.True(t, ok, "%s: unexpected promauto options", fset.Position(call.Pos()))
+		for _, elt := range opts.Elts {
+			if kv, ok := elt.(*ast.KeyValueExpr); ok && fmt.Sprint(kv.Key) == "Name" {
+				name, err := strconv.Unquote(kv.Value.(*ast.BasicLit).Value)
+				require.NoError(t, err)
+				registered++
+				_, isListed := listed[name]
+				_, isUndashboarded := undashboardedMetrics[name]
+				assert.True(t, isListed != isUndashboarded, "metric %s must be listed in either DashboardMetrics or undashboardedMetrics", name)
+			}
+		}
+		return true
+	})
+	assert.Equal(t, len(DashboardMetrics)+len(undashboardedMetrics), registered)
+}
@@ -2,16 +2,62 @@ package monitoring
 
 import (
 	"context"
+	"fmt"
+	"sync"
+	"time"
 )
 
 // Exporter methods can be executed out of order and should be thread safe.
 type Exporter interface {
 	// Export is executed on each update on a monitored feed
 	Export(ctx context.Context, data interface{})
-	// Cleanup is executed once a monitor for a specific feed is terminated.
+	// Cleanup is executed once a monitor for a specific feed is terminated. It should return once ctx is done, at the
+	// latest, since exporters which overrun their deadline are abandoned and reported as failed.
+	Cleanup(ctx context.Context) error
+}
+
+// DuplicateSkippingExporter is an optional extension of Exporter, for exporters which do not need envelopes that
+// repeat the previous one, e.g. to reduce the volume of Kafka topics. See config.FeedMonitor.SkipDuplicateEnvelopes.
+type DuplicateSkippingExporter interface {
+	Exporter
+	// SkipsDuplicates returns true if duplicate envelopes may be skipped.
+	SkipsDuplicates() bool
+}
+
+// LegacyExporter is the previous form of Exporter, whose Cleanup could not fail.
+//
+// Deprecated: implement Exporter, or adapt with NewLegacyExporter.
+type LegacyExporter interface {
+	Export(ctx context.Context, data interface{})
 	Cleanup(ctx context.Context)
 }
 
+// NewLegacyExporter returns an Exporter which delegates to exporter, whose Cleanup never fails. It implements
+// DuplicateSkippingExporter if exporter does.
+func NewLegacyExporter(exporter LegacyExporter) Exporter {
+	if _, ok := exporter.(interface{ SkipsDuplicates() bool }); ok {
+		return &legacyDuplicateSkippingExporter{legacyExporter{exporter}}
+	}
+	return &legacyExporter{exporter}
+}
+
+type legacyExporter struct {
+	LegacyExporter
+}
+
+func (l *legacyExporter) Cleanup(ctx context.Context) error {
+	l.LegacyExporter.Cleanup(ctx)
+	return nil
+}
+
+type legacyDuplicateSkippingExporter struct {
+	legacyExporter
+}
+
+func (l *legacyDuplicateSkippingExporter) SkipsDuplicates() bool {
+	return l.LegacyExporter.(interface{ SkipsDuplicates() bool }).SkipsDuplicates()
+}
+
 type ExporterParams struct {
 	ChainConfig ChainConfig
 	FeedConfig  FeedConfig
@@ -22,3 +68,77 @@ type ExporterParams struct {
 type ExporterFactory interface {
 	NewExporter(ExporterParams) (Exporter, error)
 }
+
+// exporterCleanupTimeout is the deadline of each exporter's Cleanup.
+const exporterCleanupTimeout = time.Second
+
+// Reasons for which Cleanup fails, reported by FeedMetrics.IncExporterCleanupFailed.
+const (
+	cleanupFailedError   = "error"
+	cleanupFailedPanic   = "panic"
+	cleanupFailedTimeout = "timeout"
+)
+
+// cleanupExporters calls Cleanup on all of the exporters concurrently, each with its own deadline, so that a stuck
+// exporter can not block shutdown. Failures are logged, and counted by metrics if not nil. It returns once each
+// exporter has either returned or timed out.
+func cleanupExporters(log Logger, metrics FeedMetrics, exporters []Exporter) {
+	var wg sync.WaitGroup
+	for index, exp := range exporters {
+		index, exp := index, exp
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			reason, err := cleanupExporter(exp, exporterCleanupTimeout)
+			if err == nil {
+				return
+			}
+			name := exporterName(exp)
+			log.Errorw("failed Cleanup", "error", err, "index", index, "exporter", name, "reason", reason)
+			if metrics != nil {
+				metrics.IncExporterCleanupFailed(name, reason)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// cleanupExporter calls exp.Cleanup, and returns the reason for any failure.
+func cleanupExporter(exp Exporter, timeout time.Duration) (reason string, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	type result struct {
+		reason string
+		err    error
+	}
+	done := make(chan result, 1) // abandoned on timeout
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				done <- result{cleanupFailedPanic, fmt.Errorf("panic: %v", r)}
+			}
+		}()
+		if err := exp.Cleanup(ctx); err != nil {
+			done <- result{cleanupFailedError, err}
+			return
+		}
+		done <- result{}
+	}()
+	select {
+	case r := <-done:
+		return r.reason, r.err
+	case <-ctx.Done():
+		return cleanupFailedTimeout, fmt.Errorf("timed out after %s: %w", timeout, ctx.Err())
+	}
+}
+
+// exporterName identifies the type of exp, for logs and metrics.
+func exporterName(exp Exporter) string {
+	switch l := exp.(type) {
+	case *legacyExporter:
+		return fmt.Sprintf("%T", l.LegacyExporter)
+	case *legacyDuplicateSkippingExporter:
+		return fmt.Sprintf("%T", l.LegacyExporter)
+	}
+	return fmt.Sprintf("%T", exp)
+}
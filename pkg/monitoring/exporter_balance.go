@@ -0,0 +1,170 @@
+package monitoring
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+	"github.com/smartcontractkit/chainlink-relay/pkg/monitoring/config"
+)
+
+var (
+	feedAccountBalance = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "feed_account_balance",
+			Help: "Reports the balance of an account of a feed, in whole tokens.",
+		},
+		[]string{"feed_id", "feed_name", "contract_status", "contract_type", "network_name", "network_id", "chain_id", "account", "role", "token"},
+	)
+	feedAccountBalanceLow = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "feed_account_balance_low",
+			Help: "Set to 1 when the balance of an account of a feed is below the configured threshold for its token. Set to 0 otherwise.",
+		},
+		[]string{"feed_id", "feed_name", "contract_status", "contract_type", "network_name", "network_id", "chain_id", "account", "role", "token"},
+	)
+)
+
+// BalanceMetrics records the balances of a feed's accounts.
+type BalanceMetrics interface {
+	SetBalance(account, role, token string, amount float64)
+	SetBalanceLow(account, role, token string, isSet bool)
+	// Cleanup deletes all the metrics of the feed.
+	Cleanup()
+}
+
+func NewBalanceMetrics(chainConfig ChainConfig, feedConfig FeedConfig) BalanceMetrics {
+	return &balanceMetrics{chainConfig, feedConfig}
+}
+
+type balanceMetrics struct {
+	chainConfig ChainConfig
+	feedConfig  FeedConfig
+}
+
+func (b *balanceMetrics) feedLabels() prometheus.Labels {
+	return prometheus.Labels{
+		"feed_id":         b.feedConfig.GetID(),
+		"feed_name":       b.feedConfig.GetName(),
+		"contract_status": b.feedConfig.GetContractStatus(),
+		"contract_type":   b.feedConfig.GetContractType(),
+		"network_name":    b.chainConfig.GetNetworkName(),
+		"network_id":      b.chainConfig.GetNetworkID(),
+		"chain_id":        b.chainConfig.GetChainID(),
+	}
+}
+
+func (b *balanceMetrics) labels(account, role, token string) prometheus.Labels {
+	labels := b.feedLabels()
+	labels["account"] = account
+	labels["role"] = role
+	labels["token"] = token
+	return labels
+}
+
+func (b *balanceMetrics) SetBalance(account, role, token string, amount float64) {
+	feedAccountBalance.With(b.labels(account, role, token)).Set(amount)
+}
+
+func (b *balanceMetrics) SetBalanceLow(account, role, token string, isSet bool) {
+	var value float64
+	if isSet {
+		value = 1
+	}
+	feedAccountBalanceLow.With(b.labels(account, role, token)).Set(value)
+}
+
+func (b *balanceMetrics) Cleanup() {
+	labels := b.feedLabels()
+	feedAccountBalance.DeletePartialMatch(labels)
+	feedAccountBalanceLow.DeletePartialMatch(labels)
+}
+
+// NewBalanceExporterFactory returns a factory for exporters of the balances read by the sources of
+// NewBalanceSourceFactory. Balances below the threshold configured for their token in cfg are reported as low, and
+// logged as warnings when they drop below it.
+func NewBalanceExporterFactory(log Logger, cfg config.Balances) ExporterFactory {
+	return &balanceExporterFactory{log, cfg, NewBalanceMetrics}
+}
+
+type balanceExporterFactory struct {
+	log        Logger
+	cfg        config.Balances
+	newMetrics func(ChainConfig, FeedConfig) BalanceMetrics
+}
+
+func (b *balanceExporterFactory) NewExporter(params ExporterParams) (Exporter, error) {
+	return &balanceExporter{
+		log:     logger.With(b.log, "feedID", params.FeedConfig.GetID(), "feedName", params.FeedConfig.GetName()),
+		cfg:     b.cfg,
+		metrics: b.newMetrics(params.ChainConfig, params.FeedConfig),
+		low:     map[balanceKey]bool{},
+	}, nil
+}
+
+type balanceKey struct {
+	account, token string
+}
+
+type balanceExporter struct {
+	log     Logger
+	cfg     config.Balances
+	metrics BalanceMetrics
+
+	mu  sync.Mutex
+	low map[balanceKey]bool // for balances with a threshold
+}
+
+func (b *balanceExporter) Export(_ context.Context, data interface{}) {
+	balances, ok := data.(Balances)
+	if !ok {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, balance := range balances {
+		if balance.Amount == nil {
+			b.log.Errorw("Missing balance amount", "account", balance.Account, "role", balance.Role, "token", balance.Token)
+			continue
+		}
+		amount := toWholeTokens(balance.Amount, balance.Decimals)
+		b.metrics.SetBalance(balance.Account, balance.Role, balance.Token, amount)
+		threshold, ok := b.cfg.LowThresholds[balance.Token]
+		if !ok {
+			continue
+		}
+		key := balanceKey{balance.Account, balance.Token}
+		isLow := amount < threshold
+		wasLow, seen := b.low[key]
+		if seen && wasLow == isLow {
+			continue
+		}
+		b.low[key] = isLow
+		b.metrics.SetBalanceLow(balance.Account, balance.Role, balance.Token, isLow)
+		if isLow {
+			b.log.Warnw("Balance is low",
+				"account", balance.Account, "role", balance.Role, "token", balance.Token,
+				"balance", amount, "threshold", threshold)
+		} else if seen {
+			b.log.Infow("Balance is no longer low",
+				"account", balance.Account, "role", balance.Role, "token", balance.Token,
+				"balance", amount, "threshold", threshold)
+		}
+	}
+}
+
+func (b *balanceExporter) Cleanup(_ context.Context) error {
+	b.metrics.Cleanup()
+	return nil
+}
+
+// toWholeTokens converts amount, in the smallest denomination of a token with decimals, to whole tokens.
+func toWholeTokens(amount *big.Int, decimals uint8) float64 {
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+	tokens, _ := new(big.Float).Quo(new(big.Float).SetInt(amount), new(big.Float).SetInt(scale)).Float64()
+	return tokens
+}
@@ -0,0 +1,83 @@
+package monitoring
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/monitoring/config"
+)
+
+type fakeBalanceMetrics struct {
+	balances  map[string]float64
+	low       map[string][]bool
+	cleanedUp bool
+}
+
+func (f *fakeBalanceMetrics) SetBalance(account, _, token string, amount float64) {
+	f.balances[account+"/"+token] = amount
+}
+
+func (f *fakeBalanceMetrics) SetBalanceLow(account, _, token string, isSet bool) {
+	f.low[account+"/"+token] = append(f.low[account+"/"+token], isSet)
+}
+
+func (f *fakeBalanceMetrics) Cleanup() { f.cleanedUp = true }
+
+type fakeBalanceReader struct {
+	balances Balances
+}
+
+func (f *fakeBalanceReader) ReadBalances(context.Context, ChainConfig, FeedConfig) (Balances, error) {
+	return f.balances, nil
+}
+
+func TestBalanceExporter(t *testing.T) {
+	ctx := context.Background()
+	metrics := &fakeBalanceMetrics{balances: map[string]float64{}, low: map[string][]bool{}}
+	factory := &balanceExporterFactory{
+		log:        newNullLogger(),
+		cfg:        config.Balances{LowThresholds: map[string]float64{"LINK": 10}},
+		newMetrics: func(ChainConfig, FeedConfig) BalanceMetrics { return metrics },
+	}
+	exporter, err := factory.NewExporter(ExporterParams{generateChainConfig(), generateFeedConfig(), nil})
+	require.NoError(t, err)
+
+	reader := &fakeBalanceReader{}
+	source, err := NewBalanceSourceFactory(reader).NewSource(generateChainConfig(), generateFeedConfig())
+	require.NoError(t, err)
+	export := func(link, native int64) {
+		reader.balances = Balances{
+			{Account: "aggregator", Role: "aggregator", Token: "LINK", Decimals: 18, Amount: new(big.Int).Mul(big.NewInt(link), big.NewInt(1e17))},
+			{Account: "transmitter", Role: "transmitter", Token: "native", Decimals: 9, Amount: big.NewInt(native)},
+		}
+		data, err := source.Fetch(ctx)
+		require.NoError(t, err)
+		exporter.Export(ctx, data)
+	}
+
+	export(150, 5e9)
+	assert.Equal(t, map[string]float64{"aggregator/LINK": 15, "transmitter/native": 5}, metrics.balances)
+	assert.Equal(t, map[string][]bool{"aggregator/LINK": {false}}, metrics.low, "only tokens with a threshold")
+
+	export(99, 1)
+	assert.Equal(t, 9.9, metrics.balances["aggregator/LINK"])
+	assert.Equal(t, []bool{false, true}, metrics.low["aggregator/LINK"])
+
+	// only changes are recorded
+	export(50, 1)
+	assert.Equal(t, []bool{false, true}, metrics.low["aggregator/LINK"])
+	export(100, 1)
+	assert.Equal(t, []bool{false, true, false}, metrics.low["aggregator/LINK"])
+
+	// other data and incomplete balances are ignored
+	exporter.Export(ctx, Envelope{})
+	exporter.Export(ctx, Balances{{Account: "aggregator", Token: "LINK"}})
+	assert.Equal(t, 10.0, metrics.balances["aggregator/LINK"])
+
+	exporter.Cleanup(ctx)
+	assert.True(t, metrics.cleanedUp)
+}
@@ -0,0 +1,159 @@
+package monitoring
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
+)
+
+var feedAnswerDeviation = promauto.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "feed_answer_deviation_percent",
+		Help: "Reports the deviation of the latest answer of a feed from its reference value, as a percentage of the reference, which is positive when the answer is higher.",
+	},
+	[]string{"feed_id", "feed_name", "contract_status", "contract_type", "network_name", "network_id", "chain_id"},
+)
+
+// ReferenceReader reads the reference value of a feed from outside the chain, e.g. a price from an off-chain API, to
+// compare with its answers. It is implemented by each integration which monitors deviation.
+type ReferenceReader interface {
+	// ReadReference returns the reference value in the units of the answers after division by the feed's multiply
+	// parameter, e.g. dollars. It returns ErrNoUpdate if there is no reference for the feed.
+	// ReadReference must be thread-safe!
+	ReadReference(ctx context.Context, chainConfig ChainConfig, feedConfig FeedConfig) (float64, error)
+}
+
+// DeviationMetrics records the deviation of a feed's answers from its reference value.
+type DeviationMetrics interface {
+	SetDeviation(percent float64)
+	// Cleanup deletes all the metrics of the feed.
+	Cleanup()
+}
+
+func NewDeviationMetrics(chainConfig ChainConfig, feedConfig FeedConfig) DeviationMetrics {
+	return &deviationMetrics{chainConfig, feedConfig}
+}
+
+type deviationMetrics struct {
+	chainConfig ChainConfig
+	feedConfig  FeedConfig
+}
+
+func (d *deviationMetrics) labels() prometheus.Labels {
+	return prometheus.Labels{
+		"feed_id":         d.feedConfig.GetID(),
+		"feed_name":       d.feedConfig.GetName(),
+		"contract_status": d.feedConfig.GetContractStatus(),
+		"contract_type":   d.feedConfig.GetContractType(),
+		"network_name":    d.chainConfig.GetNetworkName(),
+		"network_id":      d.chainConfig.GetNetworkID(),
+		"chain_id":        d.chainConfig.GetChainID(),
+	}
+}
+
+func (d *deviationMetrics) SetDeviation(percent float64) {
+	feedAnswerDeviation.With(d.labels()).Set(percent)
+}
+
+func (d *deviationMetrics) Cleanup() {
+	feedAnswerDeviation.Delete(d.labels())
+}
+
+// NewDeviationExporterFactory returns a factory for exporters which compare the latest answer of each envelope with
+// the reference value read by reader, and record their deviation. The reference of each feed is read at most once
+// per poll interval of the chain, and each read is bounded by its read timeout, so that a slow reference API does not
+// hold up the workers exporting envelopes.
+func NewDeviationExporterFactory(log Logger, reader ReferenceReader) ExporterFactory {
+	return &deviationExporterFactory{log, reader, NewDeviationMetrics, utils.RealClock}
+}
+
+type deviationExporterFactory struct {
+	log        Logger
+	reader     ReferenceReader
+	newMetrics func(ChainConfig, FeedConfig) DeviationMetrics
+	clock      utils.Clock
+}
+
+func (d *deviationExporterFactory) NewExporter(params ExporterParams) (Exporter, error) {
+	return &deviationExporter{
+		log:         logger.With(d.log, "feedID", params.FeedConfig.GetID(), "feedName", params.FeedConfig.GetName()),
+		reader:      d.reader,
+		chainConfig: params.ChainConfig,
+		feedConfig:  params.FeedConfig,
+		metrics:     d.newMetrics(params.ChainConfig, params.FeedConfig),
+		clock:       d.clock,
+	}, nil
+}
+
+type deviationExporter struct {
+	log         Logger
+	reader      ReferenceReader
+	chainConfig ChainConfig
+	feedConfig  FeedConfig
+	metrics     DeviationMetrics
+	clock       utils.Clock
+
+	mu        sync.Mutex
+	reference float64
+	err       error
+	readAt    time.Time // zero before the first read
+}
+
+func (d *deviationExporter) Export(ctx context.Context, data interface{}) {
+	envelope, ok := data.(Envelope)
+	if !ok || envelope.LatestAnswer == nil {
+		return
+	}
+	reference, err := d.readReference(ctx)
+	if errors.Is(err, ErrNoUpdate) {
+		return
+	} else if err != nil {
+		d.log.Errorw("Failed to read reference value", "error", err)
+		return
+	}
+	if reference == 0 {
+		d.log.Warnw("Reference value is zero, so deviation is undefined")
+		return
+	}
+	answer := new(big.Float).SetInt(envelope.LatestAnswer)
+	if multiply := d.feedConfig.GetMultiply(); multiply != nil && multiply.Sign() != 0 {
+		answer.Quo(answer, new(big.Float).SetInt(multiply))
+	}
+	value, _ := answer.Float64()
+	d.metrics.SetDeviation((value - reference) / math.Abs(reference) * 100)
+}
+
+// readReference returns the result of the last read of the reference value if it was within the poll interval, or
+// reads it again, for up to the read timeout.
+func (d *deviationExporter) readReference(ctx context.Context) (float64, error) {
+	now := d.clock.Now()
+	d.mu.Lock()
+	if !d.readAt.IsZero() && now.Sub(d.readAt) < d.chainConfig.GetPollInterval() {
+		defer d.mu.Unlock()
+		return d.reference, d.err
+	}
+	d.mu.Unlock()
+
+	ctx, cancel := utils.WithTimeout(ctx, d.clock, d.chainConfig.GetReadTimeout())
+	defer cancel()
+	reference, err := d.reader.ReadReference(ctx, d.chainConfig, d.feedConfig)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.reference, d.err, d.readAt = reference, err, now
+	return reference, err
+}
+
+func (d *deviationExporter) Cleanup(_ context.Context) error {
+	d.metrics.Cleanup()
+	return nil
+}
@@ -0,0 +1,100 @@
+package monitoring
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
+)
+
+type fakeDeviationMetrics struct {
+	deviations []float64
+	cleanedUp  bool
+}
+
+func (f *fakeDeviationMetrics) SetDeviation(percent float64) {
+	f.deviations = append(f.deviations, percent)
+}
+
+func (f *fakeDeviationMetrics) Cleanup() { f.cleanedUp = true }
+
+type fakeReferenceReader struct {
+	reference float64
+	err       error
+	reads     int
+	block     bool // until the context is done
+}
+
+func (f *fakeReferenceReader) ReadReference(ctx context.Context, _ ChainConfig, _ FeedConfig) (float64, error) {
+	f.reads++
+	if f.block {
+		<-ctx.Done()
+		return 0, ctx.Err()
+	}
+	return f.reference, f.err
+}
+
+func TestDeviationExporter(t *testing.T) {
+	ctx := context.Background()
+	clock := utils.NewFakeClock(time.Now())
+	metrics := &fakeDeviationMetrics{}
+	reader := &fakeReferenceReader{}
+	factory := &deviationExporterFactory{
+		log:        newNullLogger(),
+		reader:     reader,
+		newMetrics: func(ChainConfig, FeedConfig) DeviationMetrics { return metrics },
+		clock:      clock,
+	}
+	chainConfig := generateChainConfig()
+	feedConfig := generateFeedConfig().(fakeFeedConfig)
+	feedConfig.Multiply = big.NewInt(100)
+	exporter, err := factory.NewExporter(ExporterParams{chainConfig, feedConfig, nil})
+	require.NoError(t, err)
+	export := func(answer int64, reference float64, err error) {
+		clock.Advance(chainConfig.GetPollInterval()) // expire the last reference
+		reader.reference, reader.err = reference, err
+		exporter.Export(ctx, Envelope{LatestAnswer: big.NewInt(answer)})
+	}
+
+	export(10100, 100, nil) // 101.00
+	export(9900, 100, nil)
+	export(-9000, -10, nil)
+	assert.InDeltaSlice(t, []float64{1, -1, -800}, metrics.deviations, 1e-9)
+
+	// no deviation without a reference
+	export(10000, 0, ErrNoUpdate)
+	export(10000, 0, errors.New("api down"))
+	export(10000, 0, nil)
+	exporter.Export(ctx, Envelope{})
+	exporter.Export(ctx, Balances{})
+	assert.Len(t, metrics.deviations, 3)
+
+	// the reference is read once per poll interval
+	export(10200, 100, nil)
+	reader.reference = 200
+	exporter.Export(ctx, Envelope{LatestAnswer: big.NewInt(10300)})
+	assert.InDeltaSlice(t, []float64{1, -1, -800, 2, 3}, metrics.deviations, 1e-9)
+	assert.Equal(t, 7, reader.reads)
+
+	// reads time out
+	clock.Advance(chainConfig.GetPollInterval())
+	reader.block = true
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		exporter.Export(ctx, Envelope{LatestAnswer: big.NewInt(10000)})
+	}()
+	clock.BlockUntil(1)
+	clock.Advance(chainConfig.GetReadTimeout())
+	<-done
+	assert.Len(t, metrics.deviations, 5)
+
+	exporter.Cleanup(ctx)
+	assert.True(t, metrics.cleanedUp)
+}
@@ -0,0 +1,150 @@
+package monitoring
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
+)
+
+var (
+	chainHeadHeight = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "chain_head_height",
+			Help: "Reports the height of the latest block of the chain.",
+		},
+		[]string{"network_name", "network_id", "chain_id"},
+	)
+	chainHeadAge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "chain_head_age_seconds",
+			Help: "Reports the time since the timestamp of the latest block read from the chain.",
+		},
+		[]string{"network_name", "network_id", "chain_id"},
+	)
+	chainHeadStalled = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "chain_head_stalled_seconds",
+			Help: "Reports the time since the height of the chain last increased, as observed by the monitor.",
+		},
+		[]string{"network_name", "network_id", "chain_id"},
+	)
+)
+
+// HeadMetrics records the head of a chain.
+type HeadMetrics interface {
+	SetHeight(height float64)
+	SetAge(seconds float64)
+	SetStalled(seconds float64)
+	// Cleanup deletes all the metrics of the chain.
+	Cleanup()
+}
+
+func NewHeadMetrics(chainConfig ChainConfig) HeadMetrics {
+	return &headMetrics{chainConfig}
+}
+
+type headMetrics struct {
+	chainConfig ChainConfig
+}
+
+func (h *headMetrics) labels() prometheus.Labels {
+	return prometheus.Labels{
+		"network_name": h.chainConfig.GetNetworkName(),
+		"network_id":   h.chainConfig.GetNetworkID(),
+		"chain_id":     h.chainConfig.GetChainID(),
+	}
+}
+
+func (h *headMetrics) SetHeight(height float64) {
+	chainHeadHeight.With(h.labels()).Set(height)
+}
+
+func (h *headMetrics) SetAge(seconds float64) {
+	chainHeadAge.With(h.labels()).Set(seconds)
+}
+
+func (h *headMetrics) SetStalled(seconds float64) {
+	chainHeadStalled.With(h.labels()).Set(seconds)
+}
+
+func (h *headMetrics) Cleanup() {
+	labels := h.labels()
+	chainHeadHeight.Delete(labels)
+	chainHeadAge.Delete(labels)
+	chainHeadStalled.Delete(labels)
+}
+
+// NewHeadExporter returns an exporter of the heads read by the source of NewHeadSource, for alerting on stalled
+// chains: it records the height of the chain, the age of its latest block, and how long the height has not increased.
+// The age and stall keep increasing while heads cannot be read.
+func NewHeadExporter(log Logger, chainConfig ChainConfig) Exporter {
+	return newHeadExporter(log, NewHeadMetrics(chainConfig), utils.RealClock)
+}
+
+func newHeadExporter(log Logger, metrics HeadMetrics, clock utils.Clock) *headExporter {
+	return &headExporter{log: log, metrics: metrics, clock: clock}
+}
+
+type headExporter struct {
+	log     Logger
+	metrics HeadMetrics
+	clock   utils.Clock
+
+	mu        sync.Mutex
+	maxHeight uint64
+	increased time.Time // when maxHeight was last increased, zero before the first head
+	timestamp time.Time // of the last head, zero if it has none
+}
+
+func (h *headExporter) Export(_ context.Context, data interface{}) {
+	switch data := data.(type) {
+	case Head:
+		h.exportHead(data)
+	case HeadError:
+		h.exportError(data)
+	}
+}
+
+func (h *headExporter) exportHead(head Head) {
+	now := h.clock.Now()
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.increased.IsZero() || head.Height > h.maxHeight {
+		h.maxHeight = head.Height
+		h.increased = now
+	} else if head.Height < h.maxHeight {
+		h.log.Warnw("Chain height decreased", "height", head.Height, "maxHeight", h.maxHeight)
+	}
+	h.timestamp = head.Timestamp
+	h.metrics.SetHeight(float64(head.Height))
+	h.exportAge(now)
+}
+
+func (h *headExporter) exportError(headErr HeadError) {
+	h.log.Errorw("Failed to read head", "error", headErr.Err)
+	now := h.clock.Now()
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.increased.IsZero() {
+		return // nothing to age yet
+	}
+	h.exportAge(now)
+}
+
+// exportAge sets the age of the last head and the stall as of now. h.mu must be held.
+func (h *headExporter) exportAge(now time.Time) {
+	if !h.timestamp.IsZero() {
+		h.metrics.SetAge(now.Sub(h.timestamp).Seconds())
+	}
+	h.metrics.SetStalled(now.Sub(h.increased).Seconds())
+}
+
+func (h *headExporter) Cleanup(_ context.Context) error {
+	h.metrics.Cleanup()
+	return nil
+}
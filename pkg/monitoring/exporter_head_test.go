@@ -0,0 +1,78 @@
+package monitoring
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
+)
+
+type fakeHeadMetrics struct {
+	height, age, stalled []float64
+	cleanedUp            bool
+}
+
+func (f *fakeHeadMetrics) SetHeight(height float64)   { f.height = append(f.height, height) }
+func (f *fakeHeadMetrics) SetAge(seconds float64)     { f.age = append(f.age, seconds) }
+func (f *fakeHeadMetrics) SetStalled(seconds float64) { f.stalled = append(f.stalled, seconds) }
+func (f *fakeHeadMetrics) Cleanup()                   { f.cleanedUp = true }
+
+func TestHeadExporter(t *testing.T) {
+	ctx := context.Background()
+	start := time.Unix(1700000000, 0)
+	clock := utils.NewFakeClock(start)
+	metrics := &fakeHeadMetrics{}
+	exporter := newHeadExporter(newNullLogger(), metrics, clock)
+
+	var head Head
+	var readErr error
+	source := NewHeadSource(HeadReaderFunc(func(context.Context, ChainConfig) (Head, error) {
+		return head, readErr
+	}), generateChainConfig())
+	export := func(height uint64, timestamp time.Time) {
+		head = Head{Height: height, Timestamp: timestamp}
+		data, err := source.Fetch(ctx)
+		require.NoError(t, err)
+		exporter.Export(ctx, data)
+	}
+	fail := func() {
+		readErr = errors.New("rpc unavailable")
+		defer func() { readErr = nil }()
+		data, err := source.Fetch(ctx)
+		require.NoError(t, err)
+		require.Equal(t, HeadError{Err: readErr}, data)
+		exporter.Export(ctx, data)
+	}
+
+	fail() // before the first head
+	export(100, start.Add(-2*time.Second))
+	clock.Advance(5 * time.Second)
+	export(100, start.Add(-2*time.Second))
+	clock.Advance(5 * time.Second)
+	export(101, start.Add(9*time.Second))
+	clock.Advance(time.Second)
+	fail()
+	clock.Advance(time.Second)
+	export(99, start.Add(-3*time.Second)) // lagging RPC
+	clock.Advance(time.Second)
+	export(101, time.Time{}) // no timestamp
+
+	assert.Equal(t, []float64{100, 100, 101, 99, 101}, metrics.height)
+	assert.Equal(t, []float64{2, 7, 1, 2, 15}, metrics.age, "ages while failing")
+	assert.Equal(t, []float64{0, 5, 0, 1, 2, 3}, metrics.stalled, "only increases reset the stall, and failures do not")
+
+	readErr = ErrNoUpdate
+	_, err := source.Fetch(ctx)
+	assert.ErrorIs(t, err, ErrNoUpdate, "not exported")
+
+	exporter.Export(ctx, Balances{})
+	assert.Len(t, metrics.height, 5, "other data is ignored")
+
+	exporter.Cleanup(ctx)
+	assert.True(t, metrics.cleanedUp)
+}
@@ -14,6 +14,48 @@ type Pipeline struct {
 	Topic  string
 	Mapper Mapper
 	Schema Schema
+	// KeyStrategy derives the key of the messages published to Topic. Defaults to KeyByContractAddress.
+	KeyStrategy KeyStrategy
+}
+
+// KeyStrategy derives the Kafka message key for a feed's updates. Messages with the same key are written to the same
+// partition, so consumers receive each feed's updates in order.
+type KeyStrategy string
+
+const (
+	// KeyByContractAddress keys messages by the bytes of the feed's contract address.
+	KeyByContractAddress KeyStrategy = "contract_address"
+	// KeyByFeedID keys messages by the feed's ID.
+	KeyByFeedID KeyStrategy = "feed_id"
+	// KeyByChainAndFeed keys messages by the chain ID and the feed's ID, e.g. for topics shared by the monitors of
+	// multiple chains, where feed IDs may collide.
+	KeyByChainAndFeed KeyStrategy = "chain_feed"
+)
+
+// ParseKeyStrategy returns the KeyStrategy named s. Empty is KeyByContractAddress.
+func ParseKeyStrategy(s string) (KeyStrategy, error) {
+	switch k := KeyStrategy(s); k {
+	case "":
+		return KeyByContractAddress, nil
+	case KeyByContractAddress, KeyByFeedID, KeyByChainAndFeed:
+		return k, nil
+	default:
+		return "", fmt.Errorf("unknown key strategy '%s', expected one of %s, %s, or %s", s, KeyByContractAddress, KeyByFeedID, KeyByChainAndFeed)
+	}
+}
+
+// Key returns the message key for updates of the feed.
+func (k KeyStrategy) Key(chainConfig ChainConfig, feedConfig FeedConfig) ([]byte, error) {
+	switch k {
+	case "", KeyByContractAddress:
+		return feedConfig.GetContractAddressBytes(), nil
+	case KeyByFeedID:
+		return []byte(feedConfig.GetID()), nil
+	case KeyByChainAndFeed:
+		return []byte(chainConfig.GetChainID() + "/" + feedConfig.GetID()), nil
+	default:
+		return nil, fmt.Errorf("unknown key strategy '%s'", k)
+	}
 }
 
 // NewKafkaExporterFactory produces Kafka exporters which consume, format and publish source outputs to kafka.
@@ -27,6 +69,9 @@ func NewKafkaExporterFactory(
 		if SubjectFromTopic(pipeline.Topic) != pipeline.Schema.Subject() {
 			return nil, fmt.Errorf("topic '%s' does not match schema subject '%s'", pipeline.Topic, pipeline.Schema.Subject())
 		}
+		if _, err := ParseKeyStrategy(string(pipeline.KeyStrategy)); err != nil {
+			return nil, fmt.Errorf("topic '%s': %w", pipeline.Topic, err)
+		}
 	}
 	return &kafkaExporterFactory{
 		log,
@@ -44,6 +89,14 @@ type kafkaExporterFactory struct {
 func (k *kafkaExporterFactory) NewExporter(
 	params ExporterParams,
 ) (Exporter, error) {
+	keys := make([][]byte, len(k.pipelines))
+	for i, pipeline := range k.pipelines {
+		key, err := pipeline.KeyStrategy.Key(params.ChainConfig, params.FeedConfig)
+		if err != nil {
+			return nil, fmt.Errorf("topic '%s': %w", pipeline.Topic, err)
+		}
+		keys[i] = key
+	}
 	return &kafkaExporter{
 		params.ChainConfig,
 		params.FeedConfig,
@@ -52,9 +105,12 @@ func (k *kafkaExporterFactory) NewExporter(
 		k.producer,
 
 		k.pipelines,
+		keys,
 	}, nil
 }
 
+var _ DuplicateSkippingExporter = (*kafkaExporter)(nil)
+
 type kafkaExporter struct {
 	chainConfig ChainConfig
 	feedConfig  FeedConfig
@@ -63,6 +119,7 @@ type kafkaExporter struct {
 	producer Producer
 
 	pipelines []Pipeline
+	keys      [][]byte // for each pipeline
 }
 
 func (k *kafkaExporter) Export(_ context.Context, data interface{}) {
@@ -70,12 +127,10 @@ func (k *kafkaExporter) Export(_ context.Context, data interface{}) {
 	if !isEnvelope {
 		return
 	}
-	key := k.feedConfig.GetContractAddressBytes()
-
 	var subs utils.Subprocesses
 	defer subs.Wait()
-	for _, pipeline := range k.pipelines {
-		pipeline := pipeline
+	for i, pipeline := range k.pipelines {
+		pipeline, key := pipeline, k.keys[i]
 		subs.Go(func() {
 			envelopeMapping, err := pipeline.Mapper(envelope, k.chainConfig, k.feedConfig)
 			if err != nil {
@@ -95,4 +150,6 @@ func (k *kafkaExporter) Export(_ context.Context, data interface{}) {
 	}
 }
 
-func (k *kafkaExporter) Cleanup(_ context.Context) {} // noop
+func (k *kafkaExporter) Cleanup(_ context.Context) error { return nil } // noop
+
+func (k *kafkaExporter) SkipsDuplicates() bool { return true }
@@ -26,8 +26,8 @@ func TestKafkaExporter(t *testing.T) {
 		factory, err := NewKafkaExporterFactory(
 			log, producer,
 			[]Pipeline{
-				{cfg.Kafka.TransmissionTopic, MakeTransmissionMapping, transmissionSchema},
-				{cfg.Kafka.ConfigSetSimplifiedTopic, MakeConfigSetSimplifiedMapping, configSetSimplifiedSchema},
+				{cfg.Kafka.TransmissionTopic, MakeTransmissionMapping, transmissionSchema, KeyByContractAddress},
+				{cfg.Kafka.ConfigSetSimplifiedTopic, MakeConfigSetSimplifiedMapping, configSetSimplifiedSchema, KeyByContractAddress},
 			},
 		)
 		require.NoError(t, err)
@@ -80,3 +80,61 @@ func TestKafkaExporter(t *testing.T) {
 		require.Equal(t, configSetSimplified["block_number"], uint64ToBeBytes(envelope.BlockNumber))
 	})
 }
+
+func TestKeyStrategy(t *testing.T) {
+	chainConfig := generateChainConfig()
+	feedConfig := generateFeedConfig()
+
+	for _, tt := range []struct {
+		name     string
+		strategy string
+		key      []byte
+	}{
+		{"default", "", feedConfig.GetContractAddressBytes()},
+		{"contract address", "contract_address", feedConfig.GetContractAddressBytes()},
+		{"feed id", "feed_id", []byte(feedConfig.GetID())},
+		{"chain and feed", "chain_feed", []byte(chainConfig.GetChainID() + "/" + feedConfig.GetID())},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			strategy, err := ParseKeyStrategy(tt.strategy)
+			require.NoError(t, err)
+			key, err := strategy.Key(chainConfig, feedConfig)
+			require.NoError(t, err)
+			require.Equal(t, tt.key, key)
+		})
+	}
+
+	t.Run("unknown", func(t *testing.T) {
+		_, err := ParseKeyStrategy("round_id")
+		require.ErrorContains(t, err, "unknown key strategy 'round_id'")
+
+		schema := fakeSchema{transmissionCodec, SubjectFromTopic("transmissions")}
+		_, err = NewKafkaExporterFactory(newNullLogger(), fakeProducer{},
+			[]Pipeline{{"transmissions", MakeTransmissionMapping, schema, "round_id"}})
+		require.ErrorContains(t, err, "topic 'transmissions': unknown key strategy 'round_id'")
+	})
+
+	t.Run("exported messages use the pipeline's key", func(t *testing.T) {
+		defer goleak.VerifyNone(t)
+		ctx, cancel := context.WithTimeout(context.Background(), 1000*time.Millisecond)
+		defer cancel()
+		producer := fakeProducer{make(chan producerMessage), ctx}
+		schema := fakeSchema{transmissionCodec, SubjectFromTopic("transmissions")}
+		factory, err := NewKafkaExporterFactory(newNullLogger(), producer,
+			[]Pipeline{{"transmissions", MakeTransmissionMapping, schema, KeyByChainAndFeed}})
+		require.NoError(t, err)
+		exporter, err := factory.NewExporter(ExporterParams{chainConfig, feedConfig, []NodeConfig{generateNodeConfig()}})
+		require.NoError(t, err)
+		envelope, err := generateEnvelope()
+		require.NoError(t, err)
+
+		go exporter.Export(ctx, envelope)
+
+		select {
+		case message := <-producer.sendCh:
+			require.Equal(t, []byte(chainConfig.GetChainID()+"/"+feedConfig.GetID()), message.key)
+		case <-ctx.Done():
+			t.Fatal("no message exported")
+		}
+	})
+}
@@ -14,8 +14,17 @@ type ExporterMock struct {
 }
 
 // Cleanup provides a mock function with given fields: ctx
-func (_m *ExporterMock) Cleanup(ctx context.Context) {
-	_m.Called(ctx)
+func (_m *ExporterMock) Cleanup(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
 }
 
 // Export provides a mock function with given fields: ctx, data
@@ -0,0 +1,196 @@
+package monitoring
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+	"github.com/smartcontractkit/chainlink-relay/pkg/monitoring/config"
+)
+
+var (
+	offchainAggregatorRoundRegressions = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "offchain_aggregator_round_regressions",
+			Help: "number of updates in which the latest epoch and round decreased without a config change",
+		},
+		[]string{"feed_id", "feed_name", "contract_status", "contract_type", "network_name", "network_id", "chain_id"},
+	)
+	offchainAggregatorEpochJumps = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "offchain_aggregator_epoch_jumps",
+			Help: "number of updates in which the epoch increased by more than the configured threshold, usually because of failing leaders",
+		},
+		[]string{"feed_id", "feed_name", "contract_status", "contract_type", "network_name", "network_id", "chain_id"},
+	)
+	offchainAggregatorProgressStalled = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "offchain_aggregator_progress_stalled",
+			Help: "set to 1 when the latest epoch and round have not advanced for longer than the configured threshold",
+		},
+		[]string{"feed_id", "feed_name", "contract_status", "contract_type", "network_name", "network_id", "chain_id"},
+	)
+)
+
+// ProgressMetrics records anomalies in the progression of a feed's epochs and rounds.
+type ProgressMetrics interface {
+	IncRoundRegressions()
+	IncEpochJumps()
+	SetProgressStalled(isSet bool)
+	// Cleanup deletes all the metrics of the feed.
+	Cleanup()
+}
+
+func NewProgressMetrics(chainConfig ChainConfig, feedConfig FeedConfig) ProgressMetrics {
+	return &progressMetrics{chainConfig, feedConfig}
+}
+
+type progressMetrics struct {
+	chainConfig ChainConfig
+	feedConfig  FeedConfig
+}
+
+func (p *progressMetrics) labels() prometheus.Labels {
+	return prometheus.Labels{
+		"feed_id":         p.feedConfig.GetID(),
+		"feed_name":       p.feedConfig.GetName(),
+		"contract_status": p.feedConfig.GetContractStatus(),
+		"contract_type":   p.feedConfig.GetContractType(),
+		"network_name":    p.chainConfig.GetNetworkName(),
+		"network_id":      p.chainConfig.GetNetworkID(),
+		"chain_id":        p.chainConfig.GetChainID(),
+	}
+}
+
+func (p *progressMetrics) IncRoundRegressions() {
+	offchainAggregatorRoundRegressions.With(p.labels()).Inc()
+}
+
+func (p *progressMetrics) IncEpochJumps() {
+	offchainAggregatorEpochJumps.With(p.labels()).Inc()
+}
+
+func (p *progressMetrics) SetProgressStalled(isSet bool) {
+	var value float64
+	if isSet {
+		value = 1
+	}
+	offchainAggregatorProgressStalled.With(p.labels()).Set(value)
+}
+
+func (p *progressMetrics) Cleanup() {
+	labels := p.labels()
+	offchainAggregatorRoundRegressions.Delete(labels)
+	offchainAggregatorEpochJumps.Delete(labels)
+	offchainAggregatorProgressStalled.Delete(labels)
+}
+
+// NewProgressExporterFactory returns a factory for exporters which detect anomalies in the progression of the epochs
+// and rounds reported by envelopes. Rounds regressing without a config change point to a misbehaving source or
+// contract, while rounds stalling or epochs jumping ahead usually point to a loss of quorum or failing leaders.
+// Thresholds are set by cfg.
+func NewProgressExporterFactory(log Logger, cfg config.Progress) ExporterFactory {
+	return &progressExporterFactory{log, cfg, NewProgressMetrics, time.Now}
+}
+
+type progressExporterFactory struct {
+	log        Logger
+	cfg        config.Progress
+	newMetrics func(ChainConfig, FeedConfig) ProgressMetrics
+	now        func() time.Time
+}
+
+func (p *progressExporterFactory) NewExporter(params ExporterParams) (Exporter, error) {
+	return &progressExporter{
+		log:     logger.With(p.log, "feedID", params.FeedConfig.GetID(), "feedName", params.FeedConfig.GetName()),
+		cfg:     p.cfg,
+		metrics: p.newMetrics(params.ChainConfig, params.FeedConfig),
+		now:     p.now,
+	}, nil
+}
+
+type progressExporter struct {
+	log     Logger
+	cfg     config.Progress
+	metrics ProgressMetrics
+	now     func() time.Time
+
+	mu           sync.Mutex
+	seen         bool
+	configDigest types.ConfigDigest
+	epoch        uint32
+	round        uint8
+	lastProgress time.Time
+	stalled      bool
+}
+
+func (p *progressExporter) Export(_ context.Context, data interface{}) {
+	envelope, ok := data.(Envelope)
+	if !ok {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := p.now()
+	switch {
+	case !p.seen:
+		p.seen = true
+		p.metrics.SetProgressStalled(false)
+		p.progress(envelope, now)
+	case envelope.ConfigDigest != p.configDigest:
+		// Epochs and rounds restart with each new config.
+		p.progress(envelope, now)
+	case envelope.Epoch < p.epoch || (envelope.Epoch == p.epoch && envelope.Round < p.round):
+		p.log.Warnw("Epoch and round regressed without a config change",
+			"configDigest", envelope.ConfigDigest, "epoch", envelope.Epoch, "round", envelope.Round,
+			"previousEpoch", p.epoch, "previousRound", p.round)
+		p.metrics.IncRoundRegressions()
+		// Stale updates are not progress, so keep tracking the latest epoch and round.
+		p.checkStalled(now)
+	case envelope.Epoch == p.epoch && envelope.Round == p.round:
+		p.checkStalled(now)
+	default:
+		if jump := envelope.Epoch - p.epoch; p.cfg.EpochJumpThreshold > 0 && jump > p.cfg.EpochJumpThreshold {
+			p.log.Warnw("Epoch jumped ahead, leaders may be failing",
+				"configDigest", envelope.ConfigDigest, "epoch", envelope.Epoch, "previousEpoch", p.epoch,
+				"threshold", p.cfg.EpochJumpThreshold)
+			p.metrics.IncEpochJumps()
+		}
+		p.progress(envelope, now)
+	}
+}
+
+// progress records the epoch and round of envelope as the latest, and clears the stalled state.
+func (p *progressExporter) progress(envelope Envelope, now time.Time) {
+	p.configDigest, p.epoch, p.round = envelope.ConfigDigest, envelope.Epoch, envelope.Round
+	p.lastProgress = now
+	if p.stalled {
+		p.stalled = false
+		p.metrics.SetProgressStalled(false)
+		p.log.Infow("Epoch and round are advancing again", "epoch", p.epoch, "round", p.round)
+	}
+}
+
+// checkStalled sets the stalled state if there was no progress for longer than cfg.StallThreshold.
+func (p *progressExporter) checkStalled(now time.Time) {
+	if p.stalled || p.cfg.StallThreshold <= 0 {
+		return
+	}
+	if since := now.Sub(p.lastProgress); since > p.cfg.StallThreshold {
+		p.stalled = true
+		p.metrics.SetProgressStalled(true)
+		p.log.Warnw("Epoch and round stalled, quorum may be lost",
+			"configDigest", p.configDigest, "epoch", p.epoch, "round", p.round, "since", since,
+			"threshold", p.cfg.StallThreshold)
+	}
+}
+
+func (p *progressExporter) Cleanup(_ context.Context) error {
+	p.metrics.Cleanup()
+	return nil
+}
@@ -0,0 +1,131 @@
+package monitoring
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/monitoring/config"
+)
+
+type fakeProgressMetrics struct {
+	regressions int
+	epochJumps  int
+	stalled     []bool
+	cleanedUp   bool
+}
+
+func (f *fakeProgressMetrics) IncRoundRegressions()          { f.regressions++ }
+func (f *fakeProgressMetrics) IncEpochJumps()                { f.epochJumps++ }
+func (f *fakeProgressMetrics) SetProgressStalled(isSet bool) { f.stalled = append(f.stalled, isSet) }
+func (f *fakeProgressMetrics) Cleanup()                      { f.cleanedUp = true }
+
+func TestProgressExporter(t *testing.T) {
+	ctx := context.Background()
+	start := time.Unix(1700000000, 0)
+
+	setup := func(t *testing.T, cfg config.Progress) (Exporter, *fakeProgressMetrics, *time.Time, Envelope) {
+		metrics := &fakeProgressMetrics{}
+		now := start
+		factory := &progressExporterFactory{
+			log:        newNullLogger(),
+			cfg:        cfg,
+			newMetrics: func(ChainConfig, FeedConfig) ProgressMetrics { return metrics },
+			now:        func() time.Time { return now },
+		}
+		exporter, err := factory.NewExporter(ExporterParams{generateChainConfig(), generateFeedConfig(), nil})
+		require.NoError(t, err)
+		envelope, err := generateEnvelope()
+		require.NoError(t, err)
+		envelope.Epoch, envelope.Round = 10, 5
+		exporter.Export(ctx, envelope)
+		require.Equal(t, []bool{false}, metrics.stalled)
+		return exporter, metrics, &now, envelope
+	}
+
+	t.Run("regression", func(t *testing.T) {
+		exporter, metrics, _, envelope := setup(t, config.Progress{})
+
+		envelope.Round = 4
+		exporter.Export(ctx, envelope)
+		envelope.Epoch, envelope.Round = 9, 6
+		exporter.Export(ctx, envelope)
+		assert.Equal(t, 2, metrics.regressions)
+
+		// the latest epoch and round are still tracked
+		envelope.Epoch, envelope.Round = 10, 5
+		exporter.Export(ctx, envelope)
+		envelope.Round = 6
+		exporter.Export(ctx, envelope)
+		assert.Equal(t, 2, metrics.regressions)
+
+		// new configs restart from the beginning
+		envelope.ConfigDigest[0]++
+		envelope.Epoch, envelope.Round = 1, 1
+		exporter.Export(ctx, envelope)
+		assert.Equal(t, 2, metrics.regressions)
+
+		// other data is ignored
+		exporter.Export(ctx, TxResults{})
+		assert.Equal(t, 2, metrics.regressions)
+	})
+
+	t.Run("stall", func(t *testing.T) {
+		exporter, metrics, now, envelope := setup(t, config.Progress{StallThreshold: time.Minute})
+
+		*now = now.Add(time.Minute)
+		exporter.Export(ctx, envelope)
+		assert.Equal(t, []bool{false}, metrics.stalled)
+
+		*now = now.Add(time.Second)
+		exporter.Export(ctx, envelope)
+		exporter.Export(ctx, envelope)
+		assert.Equal(t, []bool{false, true}, metrics.stalled)
+
+		envelope.Round++
+		exporter.Export(ctx, envelope)
+		assert.Equal(t, []bool{false, true, false}, metrics.stalled)
+
+		// regressions are not progress
+		*now = now.Add(2 * time.Minute)
+		envelope.Round--
+		exporter.Export(ctx, envelope)
+		assert.Equal(t, []bool{false, true, false, true}, metrics.stalled)
+		assert.Equal(t, 1, metrics.regressions)
+	})
+
+	t.Run("stall disabled", func(t *testing.T) {
+		exporter, metrics, now, envelope := setup(t, config.Progress{})
+
+		*now = now.Add(24 * time.Hour)
+		exporter.Export(ctx, envelope)
+		assert.Equal(t, []bool{false}, metrics.stalled)
+	})
+
+	t.Run("epoch jump", func(t *testing.T) {
+		exporter, metrics, _, envelope := setup(t, config.Progress{EpochJumpThreshold: 3})
+
+		envelope.Epoch += 3
+		exporter.Export(ctx, envelope)
+		assert.Equal(t, 0, metrics.epochJumps)
+
+		envelope.Epoch += 4
+		exporter.Export(ctx, envelope)
+		assert.Equal(t, 1, metrics.epochJumps)
+
+		// a new config may start from any epoch
+		envelope.ConfigDigest[0]++
+		envelope.Epoch += 100
+		exporter.Export(ctx, envelope)
+		assert.Equal(t, 1, metrics.epochJumps)
+	})
+
+	t.Run("cleanup", func(t *testing.T) {
+		exporter, metrics, _, _ := setup(t, config.Progress{})
+		exporter.Cleanup(ctx)
+		assert.True(t, metrics.cleanedUp)
+	})
+}
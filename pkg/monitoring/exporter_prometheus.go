@@ -290,7 +290,7 @@ func (p *prometheusExporter) exportTxResults(res TxResults) {
 	)
 }
 
-func (p *prometheusExporter) Cleanup(_ context.Context) {
+func (p *prometheusExporter) Cleanup(_ context.Context) error {
 	p.labelsMu.Lock()
 	defer p.labelsMu.Unlock()
 	for sender := range p.labels.senders {
@@ -313,6 +313,7 @@ func (p *prometheusExporter) Cleanup(_ context.Context) {
 			p.labels.feedID,
 		)
 	}
+	return nil
 }
 
 // isNewTransmission considers four cases:
@@ -400,6 +401,13 @@ func getOracleName(account types.Account, nodes []NodeConfig) (string, bool) {
 		if node.GetAccount() == account {
 			return node.GetName(), true
 		}
+		if multi, ok := node.(MultiAccountNodeConfig); ok {
+			for _, a := range multi.GetAccounts() {
+				if a == account {
+					return node.GetName(), true
+				}
+			}
+		}
 	}
 	return "", false
 }
@@ -7,6 +7,8 @@ import (
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
 )
 
 func TestPrometheusExporter(t *testing.T) {
@@ -688,3 +690,31 @@ func TestPrometheusExporter(t *testing.T) {
 		mock.AssertExpectationsForObjects(t, metrics)
 	})
 }
+
+type fakeMultiAccountNodeConfig struct {
+	fakeNodeConfig
+	Accounts []types.Account
+}
+
+func (f fakeMultiAccountNodeConfig) GetAccounts() []types.Account { return f.Accounts }
+
+func TestGetOracleName(t *testing.T) {
+	single := fakeNodeConfig{Name: "single", Account: "0x01"}
+	multi := fakeMultiAccountNodeConfig{fakeNodeConfig{Name: "multi", Account: "0x02"}, []types.Account{"0x02", "0x03"}}
+	nodes := []NodeConfig{single, multi}
+
+	for _, tt := range []struct {
+		account types.Account
+		name    string
+		found   bool
+	}{
+		{"0x01", "single", true},
+		{"0x02", "multi", true},
+		{"0x03", "multi", true},
+		{"0x04", "", false},
+	} {
+		name, found := getOracleName(tt.account, nodes)
+		require.Equal(t, tt.found, found, tt.account)
+		require.Equal(t, tt.name, name, tt.account)
+	}
+}
@@ -0,0 +1,80 @@
+package monitoring
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type legacyFakeExporter struct {
+	cleanedUp bool
+}
+
+func (l *legacyFakeExporter) Export(context.Context, interface{}) {}
+func (l *legacyFakeExporter) Cleanup(context.Context)             { l.cleanedUp = true }
+
+type legacySkippingExporter struct {
+	legacyFakeExporter
+}
+
+func (l *legacySkippingExporter) SkipsDuplicates() bool { return true }
+
+func TestNewLegacyExporter(t *testing.T) {
+	legacy := &legacyFakeExporter{}
+	exporter := NewLegacyExporter(legacy)
+	require.NoError(t, exporter.Cleanup(context.Background()))
+	assert.True(t, legacy.cleanedUp)
+	_, ok := exporter.(DuplicateSkippingExporter)
+	assert.False(t, ok)
+	assert.Equal(t, "*monitoring.legacyFakeExporter", exporterName(exporter))
+
+	skipping := NewLegacyExporter(&legacySkippingExporter{})
+	dse, ok := skipping.(DuplicateSkippingExporter)
+	require.True(t, ok)
+	assert.True(t, dse.SkipsDuplicates())
+	assert.Equal(t, "*monitoring.legacySkippingExporter", exporterName(skipping))
+}
+
+func TestCleanupExporter(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		cleanup func(context.Context) error
+		reason  string
+	}{
+		{"ok", func(context.Context) error { return nil }, ""},
+		{"error", func(context.Context) error { return errors.New("flush failed") }, cleanupFailedError},
+		{"panic", func(context.Context) error { panic("boom") }, cleanupFailedPanic},
+		{"timeout", func(context.Context) error { time.Sleep(time.Second); return nil }, cleanupFailedTimeout},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			exporter := new(ExporterMock)
+			exporter.On("Cleanup", mock.Anything).Return(tt.cleanup).Once()
+			start := time.Now()
+			reason, err := cleanupExporter(exporter, 10*time.Millisecond)
+			assert.Less(t, time.Since(start), 500*time.Millisecond, "not blocked by Cleanup")
+			assert.Equal(t, tt.reason, reason)
+			if tt.reason == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestCleanupExporters(t *testing.T) {
+	failing := new(ExporterMock)
+	failing.On("Cleanup", mock.Anything).Return(errors.New("flush failed")).Once()
+	ok := new(ExporterMock)
+	ok.On("Cleanup", mock.Anything).Return(nil).Once()
+	metrics := &fakeFeedMetrics{}
+
+	cleanupExporters(newNullLogger(), metrics, []Exporter{failing, ok})
+	mock.AssertExpectationsForObjects(t, failing, ok)
+	assert.Equal(t, int64(1), metrics.cleanupsFailed.Load())
+}
@@ -2,7 +2,6 @@ package monitoring
 
 import (
 	"context"
-	"time"
 
 	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
 )
@@ -11,13 +10,17 @@ type FeedMonitor interface {
 	Run(ctx context.Context)
 }
 
+// NewFeedMonitor returns a FeedMonitor which exports the updates of pollers to exporters. metrics optionally counts
+// failures to clean up the exporters.
 func NewFeedMonitor(
 	log Logger,
+	metrics FeedMetrics,
 	pollers []Poller,
 	exporters []Exporter,
 ) FeedMonitor {
 	return &feedMonitor{
 		log,
+		metrics,
 		pollers,
 		exporters,
 	}
@@ -25,6 +28,7 @@ func NewFeedMonitor(
 
 type feedMonitor struct {
 	log       Logger
+	metrics   FeedMetrics
 	pollers   []Poller
 	exporters []Exporter
 }
@@ -80,19 +84,5 @@ CONSUME_LOOP:
 
 	// Cleanup happens after all the exporters have finished.
 	subs.Wait()
-	subs = utils.Subprocesses{}
-	defer subs.Wait()
-	cleanupContext, cancel := context.WithTimeout(context.Background(), 1*time.Second)
-	defer cancel()
-	for index, exp := range f.exporters {
-		index, exp := index, exp
-		subs.Go(func() {
-			defer func() {
-				if err := recover(); err != nil {
-					f.log.Errorw("failed Cleanup", "error", err, "index", index)
-				}
-			}()
-			exp.Cleanup(cleanupContext)
-		})
-	}
+	cleanupExporters(f.log, f.metrics, f.exporters)
 }
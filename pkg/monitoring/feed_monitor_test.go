@@ -17,7 +17,8 @@ func TestFeedMonitor(t *testing.T) {
 	t.Run("processes updates from multiple pollers", func(t *testing.T) {
 		defer goleak.VerifyNone(t)
 
-		ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+		// Midway between polls, which follow a fixed schedule, so that no update is in flight when it expires.
+		ctx, cancel := context.WithTimeout(context.Background(), 1050*time.Millisecond)
 		defer cancel()
 
 		cfg := config.Config{}
@@ -73,8 +74,8 @@ func TestFeedMonitor(t *testing.T) {
 			newNullLogger(),
 			producer,
 			[]Pipeline{
-				{cfg.Kafka.TransmissionTopic, MakeTransmissionMapping, transmissionSchema},
-				{cfg.Kafka.ConfigSetSimplifiedTopic, MakeConfigSetSimplifiedMapping, configSetSimplifiedSchema},
+				{cfg.Kafka.TransmissionTopic, MakeTransmissionMapping, transmissionSchema, KeyByContractAddress},
+				{cfg.Kafka.ConfigSetSimplifiedTopic, MakeConfigSetSimplifiedMapping, configSetSimplifiedSchema, KeyByContractAddress},
 			},
 		)
 		require.NoError(t, err)
@@ -95,6 +96,7 @@ func TestFeedMonitor(t *testing.T) {
 
 		monitor := NewFeedMonitor(
 			newNullLogger(),
+			nil,
 			[]Poller{poller1, poller2},
 			exporters,
 		)
@@ -135,6 +137,7 @@ func TestFeedMonitor(t *testing.T) {
 
 		monitor := NewFeedMonitor(
 			newNullLogger(),
+			nil,
 			[]Poller{poller},
 			[]Exporter{exporter1, exporter2},
 		)
@@ -147,10 +150,10 @@ func TestFeedMonitor(t *testing.T) {
 		})
 
 		exporter1.On("Export", mock.Anything, mock.Anything).Once()
-		exporter1.On("Cleanup", mock.Anything).Once()
+		exporter1.On("Cleanup", mock.Anything).Return(nil).Once()
 
 		exporter2.On("Export", mock.Anything, mock.Anything).Once()
-		exporter2.On("Cleanup", mock.Anything).Once()
+		exporter2.On("Cleanup", mock.Anything).Return(nil).Once()
 
 		poller.ch <- "update"
 		<-time.After(100 * time.Millisecond)
@@ -163,9 +166,11 @@ func TestFeedMonitor(t *testing.T) {
 	t.Run("panics during Export() or Cleanup() get reported but don't crash the monitor", func(t *testing.T) {
 		poller := &fakePoller{0, make(chan interface{})}
 		exporter := new(ExporterMock)
+		metrics := &fakeFeedMetrics{}
 
 		monitor := NewFeedMonitor(
 			newNullLogger(),
+			metrics,
 			[]Poller{poller},
 			[]Exporter{exporter},
 		)
@@ -192,5 +197,6 @@ func TestFeedMonitor(t *testing.T) {
 		subs.Wait()
 
 		mock.AssertExpectationsForObjects(t, exporter)
+		require.Equal(t, int64(1), metrics.cleanupsFailed.Load(), "the Cleanup() panic is counted")
 	})
 }
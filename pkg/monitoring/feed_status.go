@@ -0,0 +1,91 @@
+package monitoring
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// feedStatus describes an active feed monitor, for the HTTP debug endpoints.
+type feedStatus struct {
+	ID         string    `json:"id"`
+	Name       string    `json:"name"`
+	LastUpdate time.Time `json:"lastUpdate"` // zero until the first update is received
+	QueueDepth int       `json:"queueDepth"` // updates waiting to be exported
+	QueueCap   int       `json:"queueCapacity"`
+}
+
+// feedRegistry tracks the feeds of the running multi-feed monitor, so that they can be inspected over HTTP.
+// A nil registry tracks nothing.
+type feedRegistry struct {
+	log Logger
+
+	mu    sync.Mutex
+	feeds []registeredFeed
+}
+
+type registeredFeed struct {
+	config FeedConfig
+	queue  *feedQueue
+}
+
+func newFeedRegistry(log Logger) *feedRegistry {
+	return &feedRegistry{log: log}
+}
+
+// set replaces the tracked feeds, e.g. when the multi-feed monitor is restarted with new feed configurations.
+func (r *feedRegistry) set(feeds []registeredFeed) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.feeds = feeds
+}
+
+// statuses returns a snapshot of the tracked feeds.
+func (r *feedRegistry) statuses() []feedStatus {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	feeds := r.feeds
+	r.mu.Unlock()
+	statuses := make([]feedStatus, 0, len(feeds))
+	for _, feed := range feeds {
+		lastUpdate, depth := feed.queue.status()
+		statuses = append(statuses, feedStatus{
+			ID:         feed.config.GetID(),
+			Name:       feed.config.GetName(),
+			LastUpdate: lastUpdate,
+			QueueDepth: depth,
+			QueueCap:   feed.queue.capacity,
+		})
+	}
+	return statuses
+}
+
+// queueDepths returns the number of updates waiting to be exported, by feed ID.
+func (r *feedRegistry) queueDepths() map[string]int {
+	statuses := r.statuses()
+	if statuses == nil {
+		return nil
+	}
+	depths := make(map[string]int, len(statuses))
+	for _, s := range statuses {
+		depths[s.ID] = s.QueueDepth
+	}
+	return depths
+}
+
+// HTTPHandler serves the active feed monitors as JSON.
+func (r *feedRegistry) HTTPHandler() http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.Header().Set("content-type", "application/json")
+		encoder := json.NewEncoder(writer)
+		if err := encoder.Encode(r.statuses()); err != nil {
+			r.log.Errorw("failed to write feed monitors to the http handler", "error", err)
+		}
+	})
+}
@@ -0,0 +1,76 @@
+package monitoring
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/monitoring/config"
+	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
+)
+
+func TestFeedRegistry(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	var subs utils.Subprocesses
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	chainCfg := fakeChainConfig{}
+	chainCfg.ReadTimeout = 1 * time.Second
+	chainCfg.PollInterval = 10 * time.Millisecond
+	feed := generateFeedConfig()
+	nodes := []NodeConfig{generateNodeConfig()}
+
+	sourceFactory := &fakeRandomDataSourceFactory{make(chan interface{})}
+	exporterFactory := &fakeExporterFactory{make(chan interface{}), false}
+
+	registry := newFeedRegistry(newNullLogger())
+	monitor := newMultiFeedMonitor(
+		chainCfg,
+		newNullLogger(),
+		[]SourceFactory{sourceFactory},
+		[]ExporterFactory{exporterFactory},
+		config.FeedMonitor{Workers: 1, QueueCapacity: 10},
+		registry,
+	)
+	subs.Go(func() {
+		monitor.Run(ctx, RDDData{[]FeedConfig{feed}, nodes})
+	})
+
+	getStatuses := func() []feedStatus {
+		rec := httptest.NewRecorder()
+		registry.HTTPHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/feeds", nil))
+		var statuses []feedStatus
+		require.NoError(t, json.NewDecoder(rec.Body).Decode(&statuses))
+		return statuses
+	}
+
+	// The exporter blocks on the first update, so the next two are queued.
+	for i := 0; i < 3; i++ {
+		sourceFactory.updates <- i
+	}
+	require.Eventually(t, func() bool {
+		statuses := getStatuses()
+		return len(statuses) == 1 && statuses[0].QueueDepth == 2
+	}, 2*time.Second, 10*time.Millisecond)
+	status := getStatuses()[0]
+	require.Equal(t, feed.GetID(), status.ID)
+	require.Equal(t, feed.GetName(), status.Name)
+	require.Equal(t, 10, status.QueueCap)
+	require.False(t, status.LastUpdate.IsZero())
+	require.Equal(t, map[string]int{feed.GetID(): 2}, registry.queueDepths())
+
+	for i := 0; i < 3; i++ {
+		require.Equal(t, i, <-exporterFactory.data)
+	}
+	cancel()
+	subs.Wait()
+	require.Empty(t, getStatuses(), "feeds are untracked once the monitor stops")
+}
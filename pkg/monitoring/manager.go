@@ -2,10 +2,13 @@ package monitoring
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"sync"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -14,7 +17,8 @@ import (
 
 // Manager restarts the multi-feed monitor whenever the feed configuration list has changed.
 // In order to not be coupled with the MultiFeedMonitor component, it simply runs a function
-// every time the feed configuration has changed. This is hooked up to the MultiFeedMonitor.Run method in the Monitor.
+// every time the feed configuration has changed. The Monitor hooks this up to the MultiFeedMonitor.Update method, so
+// that only the feeds which have changed are restarted.
 type Manager interface {
 	Run(backgroundCtx context.Context, managed ManagedFunc)
 	HTTPHandler() http.Handler
@@ -25,23 +29,46 @@ type ManagedFunc func(localCtx context.Context, data RDDData)
 func NewManager(
 	log Logger,
 	rddPoller Poller,
+) Manager {
+	return newManager(log, rddPoller, nil)
+}
+
+// newManager is like NewManager, but also reports the queue depths of the feeds tracked in feeds, if not nil.
+func newManager(
+	log Logger,
+	rddPoller Poller,
+	feeds *feedRegistry,
 ) Manager {
 	return &managerImpl{
-		log,
-		rddPoller,
-		RDDData{},
-		sync.Mutex{},
+		log:       log,
+		rddPoller: rddPoller,
+		feeds:     feeds,
 	}
 }
 
 type managerImpl struct {
 	log       Logger
 	rddPoller Poller
+	feeds     *feedRegistry // optional
 
 	currentData   RDDData
+	currentHash   string
+	lastPoll      time.Time
+	lastChange    time.Time
 	currentDataMu sync.Mutex
 }
 
+// managerStatus is served by the Manager's HTTP handler.
+type managerStatus struct {
+	RDDHash     string         `json:"rddHash"` // hex encoded SHA-256 of the JSON encoded RDD data
+	FeedCount   int            `json:"feedCount"`
+	NodeCount   int            `json:"nodeCount"`
+	LastPoll    time.Time      `json:"lastPoll"`              // zero until the first poll
+	LastChange  time.Time      `json:"lastChange"`            // zero until the first poll
+	QueueDepths map[string]int `json:"queueDepths,omitempty"` // updates waiting to be exported, by feed ID
+	RDDData
+}
+
 func (m *managerImpl) Run(backgroundCtx context.Context, managed ManagedFunc) {
 	var localCtx context.Context
 	var localCtxCancel context.CancelFunc
@@ -58,9 +85,12 @@ func (m *managerImpl) Run(backgroundCtx context.Context, managed ManagedFunc) {
 			func() {
 				m.currentDataMu.Lock()
 				defer m.currentDataMu.Unlock()
+				m.lastPoll = time.Now()
 				shouldRestartMonitor = isDifferentData(m.currentData, updatedData)
 				if shouldRestartMonitor {
 					m.currentData = updatedData
+					m.currentHash = hashData(m.log, updatedData)
+					m.lastChange = m.lastPoll
 				}
 			}()
 			if !shouldRestartMonitor {
@@ -93,20 +123,39 @@ func (m *managerImpl) Run(backgroundCtx context.Context, managed ManagedFunc) {
 
 func (m *managerImpl) HTTPHandler() http.Handler {
 	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
-		var currentData RDDData
+		var status managerStatus
 		func() { // take a snaphost of the current feeds
 			m.currentDataMu.Lock()
 			defer m.currentDataMu.Unlock()
-			currentData = m.currentData
+			status = managerStatus{
+				RDDHash:    m.currentHash,
+				FeedCount:  len(m.currentData.Feeds),
+				NodeCount:  len(m.currentData.Nodes),
+				LastPoll:   m.lastPoll,
+				LastChange: m.lastChange,
+				RDDData:    m.currentData,
+			}
 		}()
+		status.QueueDepths = m.feeds.queueDepths()
 		writer.Header().Set("content-type", "application/json")
 		encoder := json.NewEncoder(writer)
-		if err := encoder.Encode(currentData); err != nil {
+		if err := encoder.Encode(status); err != nil {
 			m.log.Errorw("failed to write current feeds to the http handler", "error", err)
 		}
 	})
 }
 
+// hashData returns the hex encoded SHA-256 of the JSON encoding of data, or "" if it can not be encoded.
+func hashData(log Logger, data RDDData) string {
+	b, err := json.Marshal(data)
+	if err != nil {
+		log.Errorw("failed to encode feeds configuration for hashing", "error", err)
+		return ""
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
 // isDifferentData checks whether there is a difference between the current list of feeds and the new feeds - Manager
 func isDifferentData(current, updated RDDData) bool {
 	return !assert.ObjectsAreEqual(current, updated)
@@ -59,7 +59,7 @@ func BenchmarkManager(b *testing.B) {
 		newNullLogger(),
 		producer,
 		[]Pipeline{
-			{cfg.Kafka.TransmissionTopic, MakeTransmissionMapping, transmissionSchema},
+			{cfg.Kafka.TransmissionTopic, MakeTransmissionMapping, transmissionSchema, KeyByContractAddress},
 		},
 	)
 	if err != nil {
@@ -75,6 +75,7 @@ func BenchmarkManager(b *testing.B) {
 			kafkaExporterFactory,
 		},
 		0, // bufferCapacity for source pollers
+		config.FeedMonitor{Workers: 4, QueueCapacity: 100},
 	)
 
 	rddPoller := NewSourcePoller(
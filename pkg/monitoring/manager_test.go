@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
-	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -104,10 +103,9 @@ func TestManager(t *testing.T) {
 		feeds := []FeedConfig{generateFeedConfig()}
 		nodes := []NodeConfig{generateNodeConfig()}
 		manager := &managerImpl{
-			newNullLogger(),
-			&fakePoller{0, make(chan interface{})},
-			RDDData{feeds, nodes},
-			sync.Mutex{},
+			log:         newNullLogger(),
+			rddPoller:   &fakePoller{0, make(chan interface{})},
+			currentData: RDDData{feeds, nodes},
 		}
 		rec := httptest.NewRecorder()
 		req := httptest.NewRequest(http.MethodGet, "/debug", nil)
@@ -123,4 +121,44 @@ func TestManager(t *testing.T) {
 		require.Equal(t, len(decodedData.Feeds), len(feeds))
 		require.Equal(t, len(decodedData.Nodes), len(nodes))
 	})
+
+	t.Run("should expose the status of the feeds configuration to http", func(t *testing.T) {
+		var subs utils.Subprocesses
+		defer subs.Wait()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		rddPoller := &fakePoller{0, make(chan interface{})}
+		manager := newManager(newNullLogger(), rddPoller, newFeedRegistry(newNullLogger()))
+		getStatus := func() managerStatus {
+			rec := httptest.NewRecorder()
+			manager.HTTPHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug", nil))
+			var status managerStatus
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &struct {
+				*managerStatus
+				Feeds []fakeFeedConfig `json:"feeds"`
+				Nodes []fakeNodeConfig `json:"nodes"`
+			}{managerStatus: &status}))
+			return status
+		}
+		require.Empty(t, getStatus().RDDHash)
+		require.True(t, getStatus().LastPoll.IsZero())
+
+		subs.Go(func() {
+			manager.Run(ctx, func(context.Context, RDDData) {})
+		})
+		data := RDDData{[]FeedConfig{generateFeedConfig(), generateFeedConfig()}, []NodeConfig{generateNodeConfig()}}
+		rddPoller.ch <- data
+		require.Eventually(t, func() bool { return !getStatus().LastChange.IsZero() }, time.Second, 10*time.Millisecond)
+		status := getStatus()
+		require.Equal(t, 2, status.FeedCount)
+		require.Equal(t, 1, status.NodeCount)
+		require.Len(t, status.RDDHash, 64)
+		require.Equal(t, status.LastChange, status.LastPoll)
+
+		rddPoller.ch <- data // unchanged
+		require.Eventually(t, func() bool { return getStatus().LastPoll.After(status.LastChange) }, time.Second, 10*time.Millisecond)
+		require.Equal(t, status.LastChange, getStatus().LastChange)
+		require.Equal(t, status.RDDHash, getStatus().RDDHash)
+	})
 }
@@ -63,6 +63,30 @@ func MakeTransmissionMapping(
 		"link_balance_uint256": map[string]interface{}{
 			"link.chain.ocr2.transmission_link_balance": bigIntToBigRat(envelope.LinkBalance),
 		},
+		"transmitter":           nil,
+		"transmitter_accounts":  nil,
+		"proxy_address":         nil,
+		"transmission_interval": nil,
+		"round_duration":        nil,
+	}
+	if envelope.Transmitter != "" {
+		out["transmitter"] = map[string]interface{}{"string": string(envelope.Transmitter)}
+	}
+	if len(envelope.TransmitterAccounts) > 0 {
+		accounts := make([]interface{}, len(envelope.TransmitterAccounts))
+		for i, a := range envelope.TransmitterAccounts {
+			accounts[i] = string(a)
+		}
+		out["transmitter_accounts"] = map[string]interface{}{"array": accounts}
+	}
+	if envelope.ProxyAddress != "" {
+		out["proxy_address"] = map[string]interface{}{"string": envelope.ProxyAddress}
+	}
+	if envelope.TransmissionInterval != 0 {
+		out["transmission_interval"] = map[string]interface{}{"long": int64(envelope.TransmissionInterval)}
+	}
+	if envelope.RoundDuration != 0 {
+		out["round_duration"] = map[string]interface{}{"long": int64(envelope.RoundDuration)}
 	}
 	return out, nil
 }
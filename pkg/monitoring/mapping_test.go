@@ -4,8 +4,11 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
 )
 
 func TestMapping(t *testing.T) {
@@ -82,6 +85,35 @@ func TestMapping(t *testing.T) {
 		require.Equal(t, transmission["link_balance_uint256"], map[string]interface{}{
 			"link.chain.ocr2.transmission_link_balance": bigIntToBigRat(envelope.LinkBalance),
 		})
+
+		require.Equal(t, transmission["transmitter"], map[string]interface{}{"string": string(envelope.Transmitter)})
+		require.Nil(t, transmission["transmitter_accounts"])
+		require.Nil(t, transmission["proxy_address"])
+		require.Nil(t, transmission["transmission_interval"])
+		require.Nil(t, transmission["round_duration"])
+	})
+
+	t.Run("MakeTransmissionMapping with multiple transmitters and proxy", func(t *testing.T) {
+		envelope := envelope
+		envelope.TransmitterAccounts = []types.Account{envelope.Transmitter, "0x0000000000000000000000000000000000000042"}
+		envelope.ProxyAddress = "0x00000000000000000000000000000000000000aa"
+		envelope.TransmissionInterval, envelope.RoundDuration = time.Minute, 30*time.Second
+		mapping, err := MakeTransmissionMapping(envelope, chainConfig, feedConfig)
+		require.NoError(t, err)
+		serialized, err := transmissionCodec.BinaryFromNative(nil, mapping)
+		require.NoError(t, err)
+		deserialized, _, err := transmissionCodec.NativeFromBinary(serialized)
+		require.NoError(t, err)
+
+		transmission, ok := deserialized.(map[string]interface{})
+		require.True(t, ok)
+		require.Equal(t, transmission["transmitter"], map[string]interface{}{"string": string(envelope.Transmitter)})
+		require.Equal(t, transmission["transmitter_accounts"], map[string]interface{}{
+			"array": []interface{}{string(envelope.Transmitter), "0x0000000000000000000000000000000000000042"},
+		})
+		require.Equal(t, transmission["proxy_address"], map[string]interface{}{"string": envelope.ProxyAddress})
+		require.Equal(t, transmission["transmission_interval"], map[string]interface{}{"long": int64(time.Minute)})
+		require.Equal(t, transmission["round_duration"], map[string]interface{}{"long": int64(30 * time.Second)})
 	})
 
 	t.Run("MakeSimplifiedConfigSetMapping", func(t *testing.T) {
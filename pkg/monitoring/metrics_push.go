@@ -0,0 +1,66 @@
+package monitoring
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/push"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/monitoring/config"
+)
+
+// PushMetrics is a Metrics which pushes all the metrics of the process to a Prometheus Pushgateway, for monitors which
+// run as short-lived jobs and can't be scraped.
+type PushMetrics interface {
+	Metrics
+	// Run pushes the metrics at the configured interval until ctx is done, then once more before returning, so that
+	// the last values of a job are not lost.
+	Run(ctx context.Context)
+}
+
+// pushTimeout bounds each push to the Pushgateway.
+const pushTimeout = 10 * time.Second
+
+// NewPushMetrics returns a PushMetrics which pushes the default prometheus registry as configured by cfg.
+func NewPushMetrics(log Logger, cfg config.Pushgateway) PushMetrics {
+	return newPushMetrics(log, cfg, prometheus.DefaultGatherer)
+}
+
+func newPushMetrics(log Logger, cfg config.Pushgateway, gatherer prometheus.Gatherer) *pushMetrics {
+	pusher := push.New(cfg.URL, cfg.Job).Gatherer(gatherer)
+	for name, value := range cfg.Labels {
+		pusher = pusher.Grouping(name, value)
+	}
+	return &pushMetrics{NewMetrics(log), log, pusher, cfg.Interval}
+}
+
+type pushMetrics struct {
+	Metrics
+	log      Logger
+	pusher   *push.Pusher
+	interval time.Duration
+}
+
+func (p *pushMetrics) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			p.push(ctx)
+		case <-ctx.Done():
+			p.push(context.Background())
+			return
+		}
+	}
+}
+
+// push replaces the metrics of the job's group on the Pushgateway with the current values.
+func (p *pushMetrics) push(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, pushTimeout)
+	defer cancel()
+	if err := p.pusher.PushContext(ctx); err != nil {
+		p.log.Errorw("failed to push metrics", "error", err)
+	}
+}
@@ -0,0 +1,94 @@
+package monitoring
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/monitoring/config"
+)
+
+func TestPushMetrics(t *testing.T) {
+	type request struct {
+		method, path string
+		body         []byte
+	}
+	var mu sync.Mutex
+	var requests []request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		mu.Lock()
+		requests = append(requests, request{r.Method, r.URL.Path, body})
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+	received := func() []request {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]request(nil), requests...)
+	}
+
+	registry := prometheus.NewRegistry()
+	gauge := prometheus.NewGauge(prometheus.GaugeOpts{Name: "push_test_gauge", Help: "test"})
+	registry.MustRegister(gauge)
+	gauge.Set(42)
+
+	cfg := config.Pushgateway{
+		URL:      srv.URL,
+		Job:      "monitor",
+		Labels:   map[string]string{"network": "devnet", "instance": "a"},
+		Interval: 10 * time.Millisecond,
+	}
+	metrics := newPushMetrics(newNullLogger(), cfg, registry)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		metrics.Run(ctx)
+	}()
+	require.Eventually(t, func() bool { return len(received()) >= 2 }, time.Second, 5*time.Millisecond)
+	cancel()
+	<-done
+	n := len(received())
+	time.Sleep(50 * time.Millisecond)
+	assert.Len(t, received(), n, "no pushes after Run returns")
+
+	for _, r := range received() {
+		assert.Equal(t, http.MethodPut, r.method, "replaces the group's metrics")
+		assert.True(t, strings.HasPrefix(r.path, "/metrics/job/monitor/"), r.path)
+		assert.Contains(t, r.path, "/instance/a")
+		assert.Contains(t, r.path, "/network/devnet")
+		assert.NotEmpty(t, r.body)
+	}
+}
+
+func TestPushMetrics_final(t *testing.T) {
+	pushed := make(chan struct{}, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pushed <- struct{}{}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	metrics := newPushMetrics(newNullLogger(), config.Pushgateway{URL: srv.URL, Job: "monitor", Interval: time.Hour}, prometheus.NewRegistry())
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	metrics.Run(ctx)
+	select {
+	case <-pushed:
+	default:
+		t.Fatal("expected a final push when stopped")
+	}
+}
@@ -54,6 +54,13 @@ var (
 		},
 		[]string{"source_name", "feed_id", "feed_name", "contract_status", "contract_type", "network_name", "network_id", "chain_id"},
 	)
+	fetchFromSourceTimedOut = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "fetch_from_source_timed_out",
+			Help: "number of reads from the chain which were cancelled because they exceeded the fetch timeout",
+		},
+		[]string{"source_name", "feed_id", "feed_name", "contract_status", "contract_type", "network_name", "network_id", "chain_id"},
+	)
 	fetchFromSourceDuration = promauto.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Name: "fetch_from_source_duration",
@@ -70,6 +77,43 @@ var (
 		},
 		[]string{"source_name", "feed_id", "feed_name", "contract_status", "contract_type", "network_name", "network_id", "chain_id"},
 	)
+	offchainAggregatorTransmissionInterval = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "offchain_aggregator_transmission_interval_seconds",
+			Help:    "time between consecutive transmissions of a feed, to compare with its heartbeat",
+			Buckets: prometheus.ExponentialBuckets(1, 2, 16), // 1s to ~9h
+		},
+		[]string{"feed_id", "feed_name", "contract_status", "contract_type", "network_name", "network_id", "chain_id"},
+	)
+	offchainAggregatorRoundDuration = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "offchain_aggregator_round_duration_seconds",
+			Help:    "average duration of a feed's rounds between the transmissions observed by the monitor",
+			Buckets: prometheus.ExponentialBuckets(1, 2, 16), // 1s to ~9h
+		},
+		[]string{"feed_id", "feed_name", "contract_status", "contract_type", "network_name", "network_id", "chain_id"},
+	)
+	feedMonitorUpdatesDropped = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "feed_monitor_updates_dropped",
+			Help: "number of updates dropped before being exported because the feed's queue was full",
+		},
+		[]string{"feed_id", "feed_name", "contract_status", "contract_type", "network_name", "network_id", "chain_id"},
+	)
+	feedMonitorEnvelopesSkipped = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "feed_monitor_envelopes_skipped",
+			Help: "number of duplicate envelopes, without a new round or config, which were not exported to Kafka",
+		},
+		[]string{"feed_id", "feed_name", "contract_status", "contract_type", "network_name", "network_id", "chain_id"},
+	)
+	feedMonitorExporterCleanupFailed = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "feed_monitor_exporter_cleanup_failed",
+			Help: "number of exporters which failed to clean up once their feed was removed, by reason: error, panic or timeout",
+		},
+		[]string{"exporter", "reason", "feed_id", "feed_name", "contract_status", "contract_type", "network_name", "network_id", "chain_id"},
+	)
 )
 
 type ChainMetrics interface {
@@ -126,7 +170,13 @@ func (c *chainMetrics) AddSendMessageToKafkaBytes(bytes float64, topic string) {
 type FeedMetrics interface {
 	IncFetchFromSourceFailed(sourceName string)
 	IncFetchFromSourceSucceeded(sourceName string)
+	IncFetchFromSourceTimedOut(sourceName string)
 	ObserveFetchFromSourceDuraction(duration time.Duration, sourceName string)
+	IncFeedMonitorUpdatesDropped()
+	IncFeedMonitorEnvelopesSkipped()
+	IncExporterCleanupFailed(exporter, reason string)
+	ObserveTransmissionInterval(interval time.Duration)
+	ObserveRoundDuration(duration time.Duration)
 }
 
 func NewFeedMetrics(chainConfig ChainConfig, feedConfig FeedConfig) FeedMetrics {
@@ -164,6 +214,19 @@ func (f *feedMetrics) IncFetchFromSourceSucceeded(sourceName string) {
 	}).Inc()
 }
 
+func (f *feedMetrics) IncFetchFromSourceTimedOut(sourceName string) {
+	fetchFromSourceTimedOut.With(prometheus.Labels{
+		"source_name":     sourceName,
+		"feed_id":         f.feedConfig.GetID(),
+		"feed_name":       f.feedConfig.GetName(),
+		"contract_status": f.feedConfig.GetContractStatus(),
+		"contract_type":   f.feedConfig.GetContractType(),
+		"network_name":    f.chainConfig.GetNetworkName(),
+		"network_id":      f.chainConfig.GetNetworkID(),
+		"chain_id":        f.chainConfig.GetChainID(),
+	}).Inc()
+}
+
 func (f *feedMetrics) ObserveFetchFromSourceDuraction(duration time.Duration, sourceName string) {
 	fetchFromSourceDuration.With(prometheus.Labels{
 		"source_name":     sourceName,
@@ -176,3 +239,65 @@ func (f *feedMetrics) ObserveFetchFromSourceDuraction(duration time.Duration, so
 		"chain_id":        f.chainConfig.GetChainID(),
 	}).Observe(float64(duration))
 }
+
+func (f *feedMetrics) IncFeedMonitorUpdatesDropped() {
+	feedMonitorUpdatesDropped.With(prometheus.Labels{
+		"feed_id":         f.feedConfig.GetID(),
+		"feed_name":       f.feedConfig.GetName(),
+		"contract_status": f.feedConfig.GetContractStatus(),
+		"contract_type":   f.feedConfig.GetContractType(),
+		"network_name":    f.chainConfig.GetNetworkName(),
+		"network_id":      f.chainConfig.GetNetworkID(),
+		"chain_id":        f.chainConfig.GetChainID(),
+	}).Inc()
+}
+
+func (f *feedMetrics) IncFeedMonitorEnvelopesSkipped() {
+	feedMonitorEnvelopesSkipped.With(prometheus.Labels{
+		"feed_id":         f.feedConfig.GetID(),
+		"feed_name":       f.feedConfig.GetName(),
+		"contract_status": f.feedConfig.GetContractStatus(),
+		"contract_type":   f.feedConfig.GetContractType(),
+		"network_name":    f.chainConfig.GetNetworkName(),
+		"network_id":      f.chainConfig.GetNetworkID(),
+		"chain_id":        f.chainConfig.GetChainID(),
+	}).Inc()
+}
+
+func (f *feedMetrics) IncExporterCleanupFailed(exporter, reason string) {
+	feedMonitorExporterCleanupFailed.With(prometheus.Labels{
+		"exporter":        exporter,
+		"reason":          reason,
+		"feed_id":         f.feedConfig.GetID(),
+		"feed_name":       f.feedConfig.GetName(),
+		"contract_status": f.feedConfig.GetContractStatus(),
+		"contract_type":   f.feedConfig.GetContractType(),
+		"network_name":    f.chainConfig.GetNetworkName(),
+		"network_id":      f.chainConfig.GetNetworkID(),
+		"chain_id":        f.chainConfig.GetChainID(),
+	}).Inc()
+}
+
+func (f *feedMetrics) ObserveTransmissionInterval(interval time.Duration) {
+	offchainAggregatorTransmissionInterval.With(prometheus.Labels{
+		"feed_id":         f.feedConfig.GetID(),
+		"feed_name":       f.feedConfig.GetName(),
+		"contract_status": f.feedConfig.GetContractStatus(),
+		"contract_type":   f.feedConfig.GetContractType(),
+		"network_name":    f.chainConfig.GetNetworkName(),
+		"network_id":      f.chainConfig.GetNetworkID(),
+		"chain_id":        f.chainConfig.GetChainID(),
+	}).Observe(interval.Seconds())
+}
+
+func (f *feedMetrics) ObserveRoundDuration(duration time.Duration) {
+	offchainAggregatorRoundDuration.With(prometheus.Labels{
+		"feed_id":         f.feedConfig.GetID(),
+		"feed_name":       f.feedConfig.GetName(),
+		"contract_status": f.feedConfig.GetContractStatus(),
+		"contract_type":   f.feedConfig.GetContractType(),
+		"network_name":    f.chainConfig.GetNetworkName(),
+		"network_id":      f.chainConfig.GetNetworkID(),
+		"chain_id":        f.chainConfig.GetChainID(),
+	}).Observe(duration.Seconds())
+}
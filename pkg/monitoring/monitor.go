@@ -2,20 +2,28 @@ package monitoring
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
 	"github.com/smartcontractkit/chainlink-relay/pkg/monitoring/config"
+	"github.com/smartcontractkit/chainlink-relay/pkg/services"
+	relaytypes "github.com/smartcontractkit/chainlink-relay/pkg/types"
 	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
 )
 
+const healthCheckInterval = 5 * time.Second
+
 // Monitor is the entrypoint for an on-chain monitor integration.
-// Monitors should only be created via NewMonitor()
+// Monitors should only be created via NewMonitor(), or NewMonitorWithDeps() when embedded into another binary.
 type Monitor struct {
+	services.StateMachine
+
 	RootContext context.Context
 
 	ChainConfig ChainConfig
@@ -33,9 +41,24 @@ type Monitor struct {
 	RDDSource Source
 	RDDPoller Poller
 
+	// HeadPoller and HeadExporter monitor the head of the chain, if enabled by MonitorDependencies.HeadReader.
+	HeadPoller   Poller
+	HeadExporter Exporter
+
 	Manager Manager
 
 	HTTPServer HTTPServer
+
+	// HealthChecker serves /health. The Monitor is registered by NewMonitor.
+	HealthChecker *services.HealthChecker
+
+	feeds         *feedRegistry           // active feed monitors, served on /debug/feeds
+	handlers      map[string]http.Handler // by path, see HTTPHandlers
+	handleSignals bool                    // stop on SIGINT and SIGTERM, unless embedded
+
+	stop   context.CancelFunc
+	done   chan struct{}
+	runErr error // set before done is closed
 }
 
 // NewMonitor builds a new Monitor instance using dependency injection.
@@ -55,19 +78,178 @@ func NewMonitor(
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse generic configuration: %w", err)
 	}
+	return NewMonitorWithDeps(rootCtx, log, MonitorOptions{
+		Config:                 cfg,
+		ChainConfig:            chainConfig,
+		EnvelopeSourceFactory:  envelopeSourceFactory,
+		TxResultsSourceFactory: txResultsSourceFactory,
+		FeedsParser:            feedsParser,
+		NodesParser:            nodesParser,
+		HandleSignals:          true,
+	})
+}
 
-	metrics := NewMetrics(logger.With(log, "component", "metrics"))
-	chainMetrics := NewChainMetrics(chainConfig)
+// MonitorDependencies replace the external services a Monitor connects to, e.g. with the in-memory fakes of
+// package monitoringtest. Nil fields are created from the configuration.
+type MonitorDependencies struct {
+	// Metrics replaces the prometheus metrics, e.g. to serve them from the registry of an embedding node. The
+	// Pushgateway configuration is ignored when set.
+	Metrics Metrics
+	// Producer replaces the kafka producer. Topics are not created when set.
+	Producer Producer
+	// SchemaRegistry replaces the schema registry client.
+	SchemaRegistry SchemaRegistry
+	// BalanceReader enables monitoring the balances of feeds' accounts. Unlike the other fields, it is not created
+	// from the configuration: balances are not monitored when nil.
+	BalanceReader BalanceReader
+	// HeadReader enables monitoring the head of the chain, e.g. to alert when it stalls. Like BalanceReader, it is not
+	// created from the configuration: the head is not monitored when nil.
+	HeadReader HeadReader
+	// ReferenceReader enables monitoring the deviation of feeds' answers from reference values, e.g. off-chain
+	// prices. Deviation is not monitored when nil.
+	ReferenceReader ReferenceReader
+}
+
+// NewMonitorWithDependencies builds a new Monitor like NewMonitor, from an already parsed configuration and
+// with the dependencies in deps. Like NewMonitor, it stops when the process is signalled.
+//
+// Deprecated: use NewMonitorWithDeps, with MonitorOptions.HandleSignals set to stop when the process is signalled.
+func NewMonitorWithDependencies(
+	rootCtx context.Context,
+	log Logger,
+	cfg config.Config,
+	deps MonitorDependencies,
+	chainConfig ChainConfig,
+	envelopeSourceFactory SourceFactory,
+	txResultsSourceFactory SourceFactory,
+	feedsParser FeedsParser,
+	nodesParser NodesParser,
+) (*Monitor, error) {
+	return NewMonitorWithDeps(rootCtx, log, MonitorOptions{
+		Config:                 cfg,
+		ChainConfig:            chainConfig,
+		EnvelopeSourceFactory:  envelopeSourceFactory,
+		TxResultsSourceFactory: txResultsSourceFactory,
+		FeedsParser:            feedsParser,
+		NodesParser:            nodesParser,
+		Dependencies:           deps,
+		HandleSignals:          true,
+	})
+}
 
-	sourceFactories := []SourceFactory{envelopeSourceFactory, txResultsSourceFactory}
+// MonitorOptions are the arguments to NewMonitorWithDeps.
+type MonitorOptions struct {
+	// Config is the generic configuration, e.g. built by the embedding binary. It is not parsed from the environment,
+	// but defaults are applied and it is validated, like by config.Parse.
+	Config config.Config
+
+	// ChainConfig, EnvelopeSourceFactory, TxResultsSourceFactory, FeedsParser, and NodesParser are required, and
+	// are the chain integration's arguments to NewMonitor.
+	ChainConfig            ChainConfig
+	EnvelopeSourceFactory  SourceFactory
+	TxResultsSourceFactory SourceFactory
+	FeedsParser            FeedsParser
+	NodesParser            NodesParser
+
+	// AccountFamily optionally validates the accounts of the nodes from NodesParser with ValidatingNodesParser, so
+	// that nodes with malformed accounts are skipped. It must have a validator registered by
+	// [relaytypes.RegisterAccountValidator].
+	AccountFamily string
+
+	// Dependencies optionally replace the external services of the Monitor, like the kafka Producer and the Metrics.
+	Dependencies MonitorDependencies
+
+	// DisableHTTPServer skips serving on Config.HTTP.Address, for binaries which serve the Monitor's HTTPHandlers
+	// themselves.
+	DisableHTTPServer bool
+
+	// HandleSignals stops the Monitor on SIGINT and SIGTERM, like NewMonitor. Binaries embedding a Monitor handle
+	// signals themselves, and should leave it unset.
+	HandleSignals bool
+}
 
-	producer, err := NewProducer(rootCtx, logger.With(log, "component", "producer"), cfg.Kafka)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create kafka producer: %w", err)
+func (o MonitorOptions) validate() error {
+	var err error
+	if o.ChainConfig == nil {
+		err = errors.Join(err, errors.New("ChainConfig is required"))
+	}
+	if o.EnvelopeSourceFactory == nil {
+		err = errors.Join(err, errors.New("EnvelopeSourceFactory is required"))
+	}
+	if o.TxResultsSourceFactory == nil {
+		err = errors.Join(err, errors.New("TxResultsSourceFactory is required"))
+	}
+	if o.FeedsParser == nil {
+		err = errors.Join(err, errors.New("FeedsParser is required"))
+	}
+	if o.NodesParser == nil {
+		err = errors.Join(err, errors.New("NodesParser is required"))
+	}
+	if o.AccountFamily != "" && !relaytypes.HasAccountValidator(o.AccountFamily) {
+		err = errors.Join(err, fmt.Errorf("AccountFamily has no registered validator: %q", o.AccountFamily))
+	}
+	return err
+}
+
+// NewMonitorWithDeps builds a new Monitor for embedding into another binary, e.g. a chainlink node or a LOOP relayer
+// plugin, so that it may monitor in-process. Unlike NewMonitor, it does not parse the environment, nor handle signals
+// unless opts.HandleSignals is set: the Monitor runs from Start until Close, or until rootCtx is cancelled.
+func NewMonitorWithDeps(rootCtx context.Context, log Logger, opts MonitorOptions) (*Monitor, error) {
+	if err := opts.validate(); err != nil {
+		return nil, fmt.Errorf("invalid MonitorOptions: %w", err)
+	}
+	return newMonitor(rootCtx, log, opts)
+}
+
+func newMonitor(rootCtx context.Context, log Logger, opts MonitorOptions) (*Monitor, error) {
+	cfg, deps, chainConfig := opts.Config, opts.Dependencies, opts.ChainConfig
+	if err := config.Finalize(&cfg); err != nil {
+		return nil, fmt.Errorf("invalid Config: %w", err)
+	}
+	metrics := deps.Metrics
+	if metrics == nil {
+		if cfg.Pushgateway.URL != "" {
+			metrics = NewPushMetrics(logger.With(log, "component", "metrics"), cfg.Pushgateway)
+		} else {
+			metrics = NewMetrics(logger.With(log, "component", "metrics"))
+		}
+	}
+	chainMetrics := NewChainMetrics(chainConfig)
+
+	sourceFactories := []SourceFactory{opts.EnvelopeSourceFactory, opts.TxResultsSourceFactory}
+
+	var err error
+	producer := deps.Producer
+	if producer == nil {
+		if cfg.Kafka.CreateTopics {
+			err = CreateTopics(rootCtx, logger.With(log, "component", "kafka-admin"), cfg.Kafka,
+				cfg.Kafka.TransmissionTopic, cfg.Kafka.ConfigSetSimplifiedTopic)
+			if err != nil {
+				return nil, err
+			}
+		}
+		producer, err = NewProducer(rootCtx, logger.With(log, "component", "producer"), cfg.Kafka)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create kafka producer: %w", err)
+		}
+	}
+	if cfg.Kafka.WALPath != "" {
+		producer, err = NewWALProducer(rootCtx, logger.With(log, "component", "kafka-wal"), producer,
+			cfg.Kafka.WALPath, cfg.Kafka.WALReplayInterval, NewWALMetrics(chainConfig))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create kafka write-ahead log: %w", err)
+		}
 	}
 	producer = NewInstrumentedProducer(producer, chainMetrics)
 
-	schemaRegistry := NewSchemaRegistry(cfg.SchemaRegistry, log)
+	schemaRegistry := deps.SchemaRegistry
+	if schemaRegistry == nil {
+		schemaRegistry = NewSchemaRegistry(cfg.SchemaRegistry, log)
+	}
+	if cfg.SchemaRegistry.SnapshotPath != "" {
+		schemaRegistry = NewSnapshotSchemaRegistry(rootCtx, logger.With(log, "component", "schema-registry-snapshot"),
+			schemaRegistry, cfg.SchemaRegistry.SnapshotPath, cfg.SchemaRegistry.RefreshInterval)
+	}
 
 	transmissionSchema, err := schemaRegistry.EnsureSchema(
 		SubjectFromTopic(cfg.Kafka.TransmissionTopic), TransmissionAvroSchema)
@@ -84,23 +266,69 @@ func NewMonitor(
 		logger.With(log, "component", "prometheus-exporter"),
 		metrics,
 	)
+	transmissionKey, err := ParseKeyStrategy(cfg.Kafka.KeyStrategies[cfg.Kafka.TransmissionTopic])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse key strategy of topic '%s': %w", cfg.Kafka.TransmissionTopic, err)
+	}
+	configSetSimplifiedKey, err := ParseKeyStrategy(cfg.Kafka.KeyStrategies[cfg.Kafka.ConfigSetSimplifiedTopic])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse key strategy of topic '%s': %w", cfg.Kafka.ConfigSetSimplifiedTopic, err)
+	}
 	kafkaExporterFactory, err := NewKafkaExporterFactory(
 		logger.With(log, "component", "kafka-exporter"),
 		producer,
 		[]Pipeline{
-			{cfg.Kafka.TransmissionTopic, MakeTransmissionMapping, transmissionSchema},
-			{cfg.Kafka.ConfigSetSimplifiedTopic, MakeConfigSetSimplifiedMapping, configSetSimplifiedSchema},
+			{cfg.Kafka.TransmissionTopic, MakeTransmissionMapping, transmissionSchema, transmissionKey},
+			{cfg.Kafka.ConfigSetSimplifiedTopic, MakeConfigSetSimplifiedMapping, configSetSimplifiedSchema, configSetSimplifiedKey},
 		},
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create kafka exporter: %w", err)
 	}
 
-	exporterFactories := []ExporterFactory{prometheusExporterFactory, kafkaExporterFactory}
+	progressExporterFactory := NewProgressExporterFactory(
+		logger.With(log, "component", "progress-exporter"),
+		cfg.Progress,
+	)
+
+	exporterFactories := []ExporterFactory{prometheusExporterFactory, kafkaExporterFactory, progressExporterFactory}
+
+	if deps.BalanceReader != nil {
+		sourceFactories = append(sourceFactories, NewBalanceSourceFactory(deps.BalanceReader))
+		exporterFactories = append(exporterFactories, NewBalanceExporterFactory(
+			logger.With(log, "component", "balance-exporter"),
+			cfg.Balances,
+		))
+	}
+
+	if deps.ReferenceReader != nil {
+		exporterFactories = append(exporterFactories, NewDeviationExporterFactory(
+			logger.With(log, "component", "deviation-exporter"),
+			deps.ReferenceReader,
+		))
+	}
+
+	var headPoller Poller
+	var headExporter Exporter
+	if deps.HeadReader != nil {
+		headPoller = NewSourcePoller(
+			NewHeadSource(deps.HeadReader, chainConfig),
+			logger.With(log, "component", "head-poller"),
+			chainConfig.GetPollInterval(),
+			chainConfig.GetReadTimeout(),
+			0, // only the latest head matters
+		)
+		headExporter = NewHeadExporter(logger.With(log, "component", "head-exporter"), chainConfig)
+	}
 
-	rddSource := NewRDDSource(
-		cfg.Feeds.URL, feedsParser, cfg.Feeds.IgnoreIDs,
+	nodesParser := opts.NodesParser
+	if opts.AccountFamily != "" {
+		nodesParser = ValidatingNodesParser(logger.With(log, "component", "nodes-parser"), opts.AccountFamily, nodesParser)
+	}
+	rddSource := NewRateLimitedRDDSource(
+		cfg.Feeds.URL, opts.FeedsParser, cfg.Feeds.IgnoreIDs,
 		cfg.Nodes.URL, nodesParser,
+		utils.NewRateLimiter(cfg.Feeds.RDDRateLimit, nil),
 		logger.With(log, "component", "rdd-source"),
 	)
 
@@ -112,55 +340,176 @@ func NewMonitor(
 		0, // no buffering!
 	)
 
-	manager := NewManager(
+	feeds := newFeedRegistry(logger.With(log, "component", "feed-registry"))
+
+	manager := newManager(
 		logger.With(log, "component", "manager"),
 		rddPoller,
+		feeds,
 	)
 
-	// Configure HTTP server
-	httpServer := NewHTTPServer(rootCtx, cfg.HTTP.Address, logger.With(log, "component", "http-server"))
-	httpServer.Handle("/metrics", metrics.HTTPHandler())
-	httpServer.Handle("/debug", manager.HTTPHandler())
+	healthChecker := services.NewHealthChecker(logger.With(log, "component", "health-checker"), healthCheckInterval)
+
+	handlers := map[string]http.Handler{
+		"/debug":       manager.HTTPHandler(),
+		"/debug/feeds": feeds.HTTPHandler(),
+	}
+	if _, ok := metrics.(PushMetrics); !ok {
+		handlers["/metrics"] = metrics.HTTPHandler()
+	}
 	// Required for k8s.
-	httpServer.Handle("/health", http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+	handlers["/health"] = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if healthy, report := healthChecker.IsHealthy(); !healthy {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			for name, err := range report {
+				if err != nil {
+					fmt.Fprintf(w, "%s: %v\n", name, err)
+				}
+			}
+			return
+		}
 		w.WriteHeader(http.StatusOK)
-	}))
+	})
+
+	// Configure HTTP server
+	var httpServer HTTPServer
+	if !opts.DisableHTTPServer {
+		httpServer = NewHTTPServer(rootCtx, cfg.HTTP.Address, logger.With(log, "component", "http-server"))
+		for path, handler := range handlers {
+			httpServer.Handle(path, handler)
+		}
+	}
 
-	return &Monitor{
-		rootCtx,
+	m := &Monitor{
+		RootContext: rootCtx,
 
-		chainConfig,
-		cfg,
+		ChainConfig: chainConfig,
+		Config:      cfg,
 
-		log,
-		producer,
-		metrics,
-		chainMetrics,
-		schemaRegistry,
+		Log:            log,
+		Producer:       producer,
+		Metrics:        metrics,
+		ChainMetrics:   chainMetrics,
+		SchemaRegistry: schemaRegistry,
 
-		sourceFactories,
-		exporterFactories,
+		SourceFactories:   sourceFactories,
+		ExporterFactories: exporterFactories,
 
-		rddSource,
-		rddPoller,
+		RDDSource: rddSource,
+		RDDPoller: rddPoller,
+
+		HeadPoller:   headPoller,
+		HeadExporter: headExporter,
+
+		Manager: manager,
 
-		manager,
+		HTTPServer: httpServer,
 
-		httpServer,
-	}, nil
+		HealthChecker: healthChecker,
+
+		feeds:         feeds,
+		handlers:      handlers,
+		handleSignals: opts.HandleSignals,
+	}
+	if err := healthChecker.Register(m); err != nil {
+		return nil, fmt.Errorf("failed to register monitor health: %w", err)
+	}
+	return m, nil
+}
+
+func (m *Monitor) Name() string { return "Monitor" }
+
+// HTTPHandlers returns the handlers served by the HTTPServer, by path, for binaries which serve them themselves. See
+// MonitorOptions.DisableHTTPServer.
+func (m *Monitor) HTTPHandlers() map[string]http.Handler {
+	handlers := make(map[string]http.Handler, len(m.handlers))
+	for path, handler := range m.handlers {
+		handlers[path] = handler
+	}
+	return handlers
 }
 
-// Run() starts all the goroutines needed by a Monitor. The lifecycle of these routines
-// is controlled by the context passed to the NewMonitor constructor.
-func (m Monitor) Run() {
-	rootCtx, cancel := context.WithCancel(m.RootContext)
+func (m *Monitor) HealthReport() map[string]error {
+	return map[string]error{m.Name(): m.Healthy()}
+}
+
+// Start starts all the goroutines needed by a Monitor, which run until Close is called, the context passed to the
+// NewMonitor constructor is cancelled, or the process is signalled (unless embedded, see NewMonitorWithDeps).
+func (m *Monitor) Start(context.Context) error {
+	return m.StartOnce("Monitor", func() error {
+		if m.HealthChecker != nil {
+			if err := m.HealthChecker.Start(m.RootContext); err != nil {
+				return fmt.Errorf("failed to start health checker: %w", err)
+			}
+		}
+		var ctx context.Context
+		ctx, m.stop = context.WithCancel(m.RootContext)
+		m.done = make(chan struct{})
+		go func() {
+			defer close(m.done)
+			m.runErr = m.run(ctx)
+		}()
+		return nil
+	})
+}
+
+// Close stops all the goroutines started by Start, and waits for them to return. It returns the panics of any of
+// them, like Run.
+func (m *Monitor) Close() error {
+	return m.StopOnce("Monitor", func() error {
+		var err error
+		if m.stop != nil {
+			m.stop()
+			<-m.done
+			err = m.runErr
+		}
+		if m.HealthChecker != nil {
+			err = errors.Join(err, m.HealthChecker.Close())
+		}
+		return err
+	})
+}
+
+// Run() starts all the goroutines needed by a Monitor, and blocks until they return. The lifecycle of these routines
+// is controlled by the context passed to the NewMonitor constructor. It returns an error if the Monitor fails to
+// start, or if any of the goroutines panicked, which stops the others, so that callers can exit with a non-zero status.
+func (m *Monitor) Run() error {
+	if err := m.Start(m.RootContext); err != nil {
+		return fmt.Errorf("failed to start monitor: %w", err)
+	}
+	<-m.done
+	// Fails harmlessly if already closed by the caller.
+	_ = m.Close()
+	return m.runErr
+}
+
+// run returns the panics recovered from the goroutines, if any.
+func (m *Monitor) run(rootCtx context.Context) error {
+	rootCtx, cancel := context.WithCancel(rootCtx)
 	defer cancel()
-	var subs utils.Subprocesses
+	subs := utils.Subprocesses{Logger: m.Log}
 
-	subs.Go(func() {
+	subs.GoNamed("rdd-poller", func() {
 		m.RDDPoller.Run(rootCtx)
 	})
 
+	if m.HeadPoller != nil && m.HeadExporter != nil {
+		subs.GoNamed("head-poller", func() {
+			m.HeadPoller.Run(rootCtx)
+		})
+		subs.GoNamed("head-exporter", func() {
+			defer cleanupExporters(m.Log, nil, []Exporter{m.HeadExporter})
+			for {
+				select {
+				case head := <-m.HeadPoller.Updates():
+					m.HeadExporter.Export(rootCtx, head)
+				case <-rootCtx.Done():
+					return
+				}
+			}
+		})
+	}
+
 	// Instrument all source factories
 	instrumentedSourceFactories := []SourceFactory{}
 	for _, factory := range m.SourceFactories {
@@ -168,37 +517,57 @@ func (m Monitor) Run() {
 			NewInstrumentedSourceFactory(factory, m.ChainMetrics))
 	}
 
-	monitor := NewMultiFeedMonitor(
+	monitor := newMultiFeedMonitor(
 		m.ChainConfig,
 		m.Log,
 		instrumentedSourceFactories,
 		m.ExporterFactories,
-		100, // bufferCapacity for source pollers
+		m.Config.FeedMonitor,
+		m.feeds,
 	)
 
-	subs.Go(func() {
-		m.Manager.Run(rootCtx, func(localCtx context.Context, data RDDData) {
+	// The multi-feed monitor runs for the lifetime of the Monitor, and only the feeds which change are restarted.
+	subs.GoNamed("multi-feed-monitor", func() {
+		monitor.Run(rootCtx, RDDData{})
+	})
+	subs.GoNamed("manager", func() {
+		m.Manager.Run(rootCtx, func(_ context.Context, data RDDData) {
 			m.ChainMetrics.SetNewFeedConfigsDetected(float64(len(data.Feeds)))
-			monitor.Run(localCtx, data)
+			monitor.Update(data)
 		})
 	})
 
-	subs.Go(func() {
-		m.HTTPServer.Run(rootCtx)
-	})
+	if m.HTTPServer != nil {
+		subs.GoNamed("http-server", func() {
+			m.HTTPServer.Run(rootCtx)
+		})
+	}
+
+	if pusher, ok := m.Metrics.(PushMetrics); ok {
+		subs.GoNamed("metrics-pusher", func() {
+			pusher.Run(rootCtx)
+		})
+	}
 
-	// Handle signals from the OS
-	subs.Go(func() {
+	// Handle signals from the OS, unless embedded, and failures of the other subprocesses.
+	subs.GoNamed("signals", func() {
 		osSignalsCh := make(chan os.Signal, 1)
-		signal.Notify(osSignalsCh, syscall.SIGINT, syscall.SIGTERM)
+		if m.handleSignals {
+			signal.Notify(osSignalsCh, syscall.SIGINT, syscall.SIGTERM)
+			defer signal.Stop(osSignalsCh)
+		}
 		var sig os.Signal
 		select {
 		case sig = <-osSignalsCh:
 			m.Log.Infow("received signal. Stopping", "signal", sig)
 			cancel()
+		case <-subs.Failed():
+			m.Log.Errorw("subprocess failed. Stopping", "error", subs.Err())
+			cancel()
 		case <-rootCtx.Done():
 		}
 	})
 
 	subs.Wait()
+	return subs.Err()
 }
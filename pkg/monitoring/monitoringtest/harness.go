@@ -0,0 +1,180 @@
+// Package monitoringtest is an integration test harness for chain integrations of [monitoring]. It runs a full
+// [monitoring.Monitor], wired like [monitoring.NewMonitor], with in-memory fakes of the kafka producer and the schema
+// registry and an HTTP server standing in for the RDD, so that tests can assert the exact messages and metrics
+// produced from synthetic sources without live infrastructure. Simulation generates realistic envelopes for such
+// sources, and for testing exporters directly.
+package monitoringtest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+	"github.com/smartcontractkit/chainlink-relay/pkg/monitoring"
+	"github.com/smartcontractkit/chainlink-relay/pkg/monitoring/config"
+)
+
+// Topics of the messages published by the Monitor.
+const (
+	TransmissionTopic        = "transmission"
+	ConfigSetSimplifiedTopic = "config_set_simplified"
+)
+
+// Params are the chain integration's arguments to [monitoring.NewMonitor].
+type Params struct {
+	ChainConfig            monitoring.ChainConfig
+	EnvelopeSourceFactory  monitoring.SourceFactory
+	TxResultsSourceFactory monitoring.SourceFactory
+	FeedsParser            monitoring.FeedsParser
+	NodesParser            monitoring.NodesParser
+	// BalanceReader optionally enables monitoring balances, see [monitoring.MonitorDependencies].
+	BalanceReader monitoring.BalanceReader
+	// HeadReader optionally enables monitoring the head of the chain, see [monitoring.MonitorDependencies].
+	HeadReader monitoring.HeadReader
+	// ReferenceReader optionally enables monitoring deviation, see [monitoring.MonitorDependencies].
+	ReferenceReader monitoring.ReferenceReader
+
+	// Configure optionally modifies the default configuration, see NewConfig.
+	Configure func(*config.Config)
+}
+
+// Harness holds a Monitor and its fake dependencies.
+type Harness struct {
+	Config         config.Config
+	Producer       *Producer
+	SchemaRegistry *SchemaRegistry
+	RDD            *RDD
+	Monitor        *monitoring.Monitor
+}
+
+// NewConfig returns a valid configuration for a Monitor reading from rdd, which polls frequently to speed up tests.
+func NewConfig(rdd *RDD) config.Config {
+	var cfg config.Config
+	cfg.Kafka.Brokers = "in-memory"
+	cfg.Kafka.ClientID = "monitoringtest"
+	cfg.Kafka.SecurityProtocol = "PLAINTEXT"
+	cfg.Kafka.SaslMechanism = "PLAIN"
+	cfg.Kafka.TransmissionTopic = TransmissionTopic
+	cfg.Kafka.ConfigSetSimplifiedTopic = ConfigSetSimplifiedTopic
+	cfg.SchemaRegistry.URL = "http://in-memory"
+	cfg.Feeds.URL = rdd.FeedsURL()
+	cfg.Feeds.RDDReadTimeout = time.Second
+	cfg.Feeds.RDDPollInterval = 100 * time.Millisecond
+	cfg.Nodes.URL = rdd.NodesURL()
+	cfg.HTTP.Address = "127.0.0.1:0"
+	cfg.FeedMonitor.Workers = 4
+	cfg.FeedMonitor.QueueCapacity = 10
+	return cfg
+}
+
+// NewHarness creates a Monitor with fake dependencies. It is started by Start.
+func NewHarness(tb testing.TB, params Params) *Harness {
+	h := &Harness{
+		Producer:       NewProducer(),
+		SchemaRegistry: NewSchemaRegistry(),
+		RDD:            NewRDD(tb),
+	}
+	h.Config = NewConfig(h.RDD)
+	if params.Configure != nil {
+		params.Configure(&h.Config)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	tb.Cleanup(cancel)
+	monitor, err := monitoring.NewMonitorWithDeps(ctx, logger.Test(tb), monitoring.MonitorOptions{
+		Config:                 h.Config,
+		ChainConfig:            params.ChainConfig,
+		EnvelopeSourceFactory:  params.EnvelopeSourceFactory,
+		TxResultsSourceFactory: params.TxResultsSourceFactory,
+		FeedsParser:            params.FeedsParser,
+		NodesParser:            params.NodesParser,
+		Dependencies: monitoring.MonitorDependencies{
+			Producer:        h.Producer,
+			SchemaRegistry:  h.SchemaRegistry,
+			BalanceReader:   params.BalanceReader,
+			HeadReader:      params.HeadReader,
+			ReferenceReader: params.ReferenceReader,
+		},
+	})
+	require.NoError(tb, err)
+	h.Monitor = monitor
+	return h
+}
+
+// Start starts the Monitor, which is closed during cleanup.
+func (h *Harness) Start(tb testing.TB) {
+	require.NoError(tb, h.Monitor.Start(context.Background()))
+	tb.Cleanup(func() { require.NoError(tb, h.Monitor.Close()) })
+}
+
+// WaitForMessages returns the decoded values of the messages published to topic, once there are at least n of them.
+// The test fails if they are not published within timeout.
+func (h *Harness) WaitForMessages(tb testing.TB, topic string, n int, timeout time.Duration) []map[string]interface{} {
+	tb.Helper()
+	messages := h.Producer.WaitForMessages(tb, topic, n, timeout)
+	values := make([]map[string]interface{}, len(messages))
+	for i, message := range messages {
+		value, err := h.SchemaRegistry.Decode(topic, message.Value)
+		require.NoError(tb, err)
+		values[i] = value
+	}
+	return values
+}
+
+// Metric returns the value of the gauge or counter name with labels, which may be a subset of the metric's labels.
+// Metrics are registered globally, so values may have been set by other tests in the same process.
+func (h *Harness) Metric(tb testing.TB, name string, labels map[string]string) (float64, bool) {
+	tb.Helper()
+	families, err := prometheus.DefaultGatherer.Gather()
+	require.NoError(tb, err)
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			if !hasLabels(metric, labels) {
+				continue
+			}
+			switch {
+			case metric.Gauge != nil:
+				return metric.Gauge.GetValue(), true
+			case metric.Counter != nil:
+				return metric.Counter.GetValue(), true
+			case metric.Untyped != nil:
+				return metric.Untyped.GetValue(), true
+			}
+		}
+	}
+	return 0, false
+}
+
+// WaitForMetric returns the value of the metric, see Metric, once it satisfies cond. The test fails if it does not
+// within timeout.
+func (h *Harness) WaitForMetric(tb testing.TB, name string, labels map[string]string, cond func(float64) bool, timeout time.Duration) float64 {
+	tb.Helper()
+	var value float64
+	require.Eventually(tb, func() bool {
+		var found bool
+		value, found = h.Metric(tb, name, labels)
+		return found && cond(value)
+	}, timeout, 10*time.Millisecond, "metric %s%v", name, labels)
+	return value
+}
+
+func hasLabels(metric *dto.Metric, labels map[string]string) bool {
+	matched := 0
+	for _, pair := range metric.GetLabel() {
+		if value, ok := labels[pair.GetName()]; ok {
+			if value != pair.GetValue() {
+				return false
+			}
+			matched++
+		}
+	}
+	return matched == len(labels)
+}
@@ -0,0 +1,286 @@
+package monitoringtest_test
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+	"github.com/smartcontractkit/chainlink-relay/pkg/monitoring"
+	"github.com/smartcontractkit/chainlink-relay/pkg/monitoring/monitoringtest"
+	"github.com/smartcontractkit/chainlink-relay/pkg/monitoring/pb"
+	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
+)
+
+const timeout = 10 * time.Second
+
+func TestHarness(t *testing.T) {
+	feed := feedConfig{ID: "feed-1", Name: "LINK / USD", Address: hex.EncodeToString([]byte("0123456789abcdef0123456789abcdef"))}
+	envelope := newEnvelope(t)
+
+	h := monitoringtest.NewHarness(t, monitoringtest.Params{
+		ChainConfig: chainConfig{},
+		EnvelopeSourceFactory: monitoringtest.SourceFactory{
+			Type: "envelope",
+			Fetch: func(context.Context, monitoring.ChainConfig, monitoring.FeedConfig) (interface{}, error) {
+				return envelope, nil
+			},
+		},
+		TxResultsSourceFactory: monitoringtest.SourceFactory{
+			Type: "txresults",
+			Fetch: func(context.Context, monitoring.ChainConfig, monitoring.FeedConfig) (interface{}, error) {
+				return monitoring.TxResults{NumSucceeded: 3, NumFailed: 1}, nil
+			},
+		},
+		FeedsParser: parseFeeds,
+		NodesParser: func(io.ReadCloser) ([]monitoring.NodeConfig, error) { return nil, nil },
+		HeadReader: monitoring.HeadReaderFunc(func(context.Context, monitoring.ChainConfig) (monitoring.Head, error) {
+			return monitoring.Head{Height: 42, Timestamp: time.Now()}, nil
+		}),
+	})
+	h.RDD.SetFeeds(t, []feedConfig{feed})
+	h.Start(t)
+
+	transmissions := h.Producer.WaitForMessages(t, monitoringtest.TransmissionTopic, 1, timeout)
+	assert.Equal(t, feed.GetContractAddressBytes(), transmissions[0].Key)
+	transmission, err := h.SchemaRegistry.Decode(monitoringtest.TransmissionTopic, transmissions[0].Value)
+	require.NoError(t, err)
+	answer := transmission["answer"].(map[string]interface{})
+	assert.Equal(t, envelope.LatestAnswer.Bytes(), answer["data"])
+	assert.Equal(t, map[string]interface{}{"long": int64(envelope.Epoch)}, answer["epoch"])
+	assert.Equal(t, map[string]interface{}{"int": int32(envelope.Round)}, answer["round"])
+	assert.Equal(t, feed.Name, transmission["feed_config"].(map[string]interface{})["feed_name"])
+
+	configSets := h.WaitForMessages(t, monitoringtest.ConfigSetSimplifiedTopic, 1, timeout)
+	assert.Equal(t, feed.GetContractAddress(), configSets[0]["feed_state_account"])
+	assert.Equal(t, int32(envelope.ContractConfig.F), configSets[0]["f"])
+
+	labels := map[string]string{"feed_id": feed.ID, "chain_id": "test-chain"}
+	h.WaitForMetric(t, "offchain_aggregator_answers_raw", labels, func(v float64) bool { return v == 12345 }, timeout)
+	h.WaitForMetric(t, "offchain_aggregator_answers", labels, func(v float64) bool { return v == 123.45 }, timeout)
+	h.WaitForMetric(t, "feed_contract_transactions_succeeded", labels, func(v float64) bool { return v == 3 }, timeout)
+	h.WaitForMetric(t, "chain_head_height", map[string]string{"chain_id": "test-chain"}, func(v float64) bool { return v == 42 }, timeout)
+	_, found := h.Metric(t, "offchain_aggregator_answers_raw", map[string]string{"feed_id": "unknown"})
+	assert.False(t, found)
+}
+
+func TestNewMonitorWithDeps(t *testing.T) {
+	ctx := context.Background()
+	_, err := monitoring.NewMonitorWithDeps(ctx, logger.Test(t), monitoring.MonitorOptions{})
+	assert.ErrorContains(t, err, "ChainConfig is required")
+	assert.ErrorContains(t, err, "NodesParser is required")
+
+	metrics := monitoring.NewMetrics(logger.Test(t))
+	fetch := func(context.Context, monitoring.ChainConfig, monitoring.FeedConfig) (interface{}, error) {
+		return nil, monitoring.ErrNoUpdate
+	}
+	opts := monitoring.MonitorOptions{
+		Config:                 monitoringtest.NewConfig(monitoringtest.NewRDD(t)),
+		ChainConfig:            chainConfig{},
+		EnvelopeSourceFactory:  monitoringtest.SourceFactory{Type: "envelope", Fetch: fetch},
+		TxResultsSourceFactory: monitoringtest.SourceFactory{Type: "txresults", Fetch: fetch},
+		FeedsParser:            parseFeeds,
+		NodesParser:            func(io.ReadCloser) ([]monitoring.NodeConfig, error) { return nil, nil },
+		Dependencies: monitoring.MonitorDependencies{
+			Producer:       monitoringtest.NewProducer(),
+			SchemaRegistry: monitoringtest.NewSchemaRegistry(),
+			Metrics:        metrics,
+		},
+		DisableHTTPServer: true,
+	}
+	monitor, err := monitoring.NewMonitorWithDeps(ctx, logger.Test(t), opts)
+	require.NoError(t, err)
+	assert.Equal(t, metrics, monitor.Metrics)
+	assert.Nil(t, monitor.HTTPServer)
+	handlers := monitor.HTTPHandlers()
+	for _, path := range []string{"/metrics", "/debug", "/debug/feeds", "/health"} {
+		assert.Contains(t, handlers, path)
+	}
+
+	require.NoError(t, monitor.Start(ctx))
+	require.NoError(t, monitor.Close())
+
+	// Built configs get the defaults of parsed configs, and are validated.
+	opts.Config = monitoringtest.NewConfig(monitoringtest.NewRDD(t))
+	opts.Config.Kafka.WALPath = filepath.Join(t.TempDir(), "wal.db")
+	monitor, err = monitoring.NewMonitorWithDeps(ctx, logger.Test(t), opts)
+	require.NoError(t, err)
+	assert.Equal(t, 10*time.Second, monitor.Config.Kafka.WALReplayInterval)
+	require.NoError(t, monitor.Start(ctx))
+	require.NoError(t, monitor.Close())
+
+	opts.Config.FeedMonitor.Workers = -1
+	_, err = monitoring.NewMonitorWithDeps(ctx, logger.Test(t), opts)
+	assert.ErrorContains(t, err, "FeedMonitor.Workers")
+}
+
+func TestMonitor_Run_panic(t *testing.T) {
+	fetch := func(context.Context, monitoring.ChainConfig, monitoring.FeedConfig) (interface{}, error) {
+		return nil, monitoring.ErrNoUpdate
+	}
+	opts := monitoring.MonitorOptions{
+		Config:                 monitoringtest.NewConfig(monitoringtest.NewRDD(t)),
+		ChainConfig:            chainConfig{},
+		EnvelopeSourceFactory:  monitoringtest.SourceFactory{Type: "envelope", Fetch: fetch},
+		TxResultsSourceFactory: monitoringtest.SourceFactory{Type: "txresults", Fetch: fetch},
+		FeedsParser:            parseFeeds,
+		NodesParser:            func(io.ReadCloser) ([]monitoring.NodeConfig, error) { return nil, nil },
+		Dependencies: monitoring.MonitorDependencies{
+			Producer:       monitoringtest.NewProducer(),
+			SchemaRegistry: monitoringtest.NewSchemaRegistry(),
+			Metrics:        monitoring.NewMetrics(logger.Test(t)),
+		},
+	}
+	monitor, err := monitoring.NewMonitorWithDeps(context.Background(), logger.Test(t), opts)
+	require.NoError(t, err)
+	monitor.HTTPServer = panickingHTTPServer{}
+
+	err = monitor.Run()
+	var panicErr *utils.PanicError
+	require.ErrorAs(t, err, &panicErr)
+	assert.Equal(t, "http-server", panicErr.Name)
+}
+
+// panickingHTTPServer panics when run, as a stand-in for any of the monitor's goroutines.
+type panickingHTTPServer struct{}
+
+func (panickingHTTPServer) Handle(string, http.Handler) {}
+
+func (panickingHTTPServer) Run(context.Context) { panic("test") }
+
+func TestSchemaRegistry(t *testing.T) {
+	const (
+		spec         = `{"name": "person", "type": "record", "fields": [{"name": "name", "type": "string"}]}`
+		extendedSpec = `{"name": "person", "type": "record", "fields": [{"name": "name", "type": "string"}, {"name": "age", "default": null, "type": ["null", "int"]}]}`
+	)
+	registry := monitoringtest.NewSchemaRegistry()
+	created, err := registry.EnsureSchema("person-value", spec)
+	require.NoError(t, err)
+	existing, err := registry.EnsureSchema("person-value", `{"type": "record", "name": "person", "fields": [{"type": "string", "name": "name"}]}`)
+	require.NoError(t, err)
+	assert.Equal(t, created, existing)
+	extended, err := registry.EnsureSchema("person-value", extendedSpec)
+	require.NoError(t, err)
+	assert.Equal(t, created.ID()+1, extended.ID())
+	assert.Equal(t, created.Version()+1, extended.Version())
+
+	encoded, err := extended.Encode(map[string]interface{}{"name": "test", "age": map[string]interface{}{"int": int32(42)}})
+	require.NoError(t, err)
+	decoded, err := registry.Decode("person", encoded)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"name": "test", "age": map[string]interface{}{"int": int32(42)}}, decoded)
+
+	_, err = created.Decode(encoded)
+	require.ErrorContains(t, err, "different schema")
+	_, err = registry.Decode("unknown", encoded)
+	require.ErrorContains(t, err, "no schema registered for subject 'unknown-value'")
+}
+
+func newEnvelope(t *testing.T) monitoring.Envelope {
+	offchainConfig, err := proto.Marshal(&pb.OffchainConfigProto{
+		DeltaProgressNanoseconds: uint64(time.Minute),
+		DeltaResendNanoseconds:   uint64(10 * time.Second),
+		DeltaRoundNanoseconds:    uint64(time.Second),
+		DeltaGraceNanoseconds:    uint64(time.Second),
+		DeltaStageNanoseconds:    uint64(10 * time.Second),
+		RMax:                     3,
+		S:                        []uint32{1},
+		OffchainPublicKeys:       [][]byte{{1}},
+		PeerIds:                  []string{"peer"},
+	})
+	require.NoError(t, err)
+	digest := types.ConfigDigest{1, 2, 3}
+	return monitoring.Envelope{
+		ConfigDigest:    digest,
+		Epoch:           2,
+		Round:           3,
+		LatestAnswer:    big.NewInt(12345),
+		LatestTimestamp: time.Unix(1700000000, 0),
+		ContractConfig: types.ContractConfig{
+			ConfigDigest:   digest,
+			Signers:        []types.OnchainPublicKey{{2}},
+			Transmitters:   []types.Account{"0xabc"},
+			F:              1,
+			OffchainConfig: offchainConfig,
+		},
+		BlockNumber:             99,
+		Transmitter:             "0xabc",
+		LinkBalance:             big.NewInt(1000),
+		LinkAvailableForPayment: big.NewInt(500),
+		JuelsPerFeeCoin:         big.NewInt(7),
+		AggregatorRoundID:       4,
+	}
+}
+
+type chainConfig struct{}
+
+func (chainConfig) GetRPCEndpoint() string         { return "http://chain" }
+func (chainConfig) GetNetworkName() string         { return "testnet" }
+func (chainConfig) GetNetworkID() string           { return "1" }
+func (chainConfig) GetChainID() string             { return "test-chain" }
+func (chainConfig) GetReadTimeout() time.Duration  { return 100 * time.Millisecond }
+func (chainConfig) GetPollInterval() time.Duration { return 100 * time.Millisecond }
+func (c chainConfig) ToMapping() map[string]interface{} {
+	return map[string]interface{}{
+		"network_name": c.GetNetworkName(),
+		"network_id":   c.GetNetworkID(),
+		"chain_id":     c.GetChainID(),
+	}
+}
+
+type feedConfig struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Address string `json:"address"`
+}
+
+func (f feedConfig) GetID() string              { return f.ID }
+func (f feedConfig) GetName() string            { return f.Name }
+func (f feedConfig) GetPath() string            { return f.ID }
+func (f feedConfig) GetSymbol() string          { return "$" }
+func (f feedConfig) GetHeartbeatSec() int64     { return 1 }
+func (f feedConfig) GetContractType() string    { return "ocr2" }
+func (f feedConfig) GetContractStatus() string  { return "active" }
+func (f feedConfig) GetContractAddress() string { return f.Address }
+func (f feedConfig) GetContractAddressBytes() []byte {
+	buf, _ := hex.DecodeString(f.Address)
+	return buf
+}
+func (f feedConfig) GetMultiply() *big.Int { return big.NewInt(100) }
+func (f feedConfig) ToMapping() map[string]interface{} {
+	return map[string]interface{}{
+		"feed_name":               f.Name,
+		"feed_path":               f.GetPath(),
+		"symbol":                  f.GetSymbol(),
+		"heartbeat_sec":           f.GetHeartbeatSec(),
+		"contract_type":           f.GetContractType(),
+		"contract_status":         f.GetContractStatus(),
+		"contract_address":        f.GetContractAddressBytes(),
+		"contract_address_string": map[string]interface{}{"string": f.Address},
+		"transmissions_account":   []byte{},
+		"state_account":           []byte{},
+	}
+}
+
+func parseFeeds(buf io.ReadCloser) ([]monitoring.FeedConfig, error) {
+	var raw []feedConfig
+	if err := json.NewDecoder(buf).Decode(&raw); err != nil {
+		return nil, err
+	}
+	feeds := make([]monitoring.FeedConfig, len(raw))
+	for i, feed := range raw {
+		feeds[i] = feed
+	}
+	return feeds, nil
+}
@@ -0,0 +1,80 @@
+package monitoringtest
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/monitoring"
+)
+
+// Message is a kafka message published with a Producer.
+type Message struct {
+	Topic      string
+	Key, Value []byte
+}
+
+var _ monitoring.Producer = (*Producer)(nil)
+
+// Producer is an in-memory [monitoring.Producer], which records every published message.
+type Producer struct {
+	mu       sync.Mutex
+	messages []Message
+	// closed and replaced when a message is published.
+	published chan struct{}
+}
+
+func NewProducer() *Producer {
+	return &Producer{published: make(chan struct{})}
+}
+
+func (p *Producer) Produce(key, value []byte, topic string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.messages = append(p.messages, Message{
+		Topic: topic,
+		Key:   append([]byte(nil), key...),
+		Value: append([]byte(nil), value...),
+	})
+	close(p.published)
+	p.published = make(chan struct{})
+	return nil
+}
+
+// Messages returns the messages published to topic, in order.
+func (p *Producer) Messages(topic string) []Message {
+	messages, _ := p.messagesAndWait(topic)
+	return messages
+}
+
+func (p *Producer) messagesAndWait(topic string) ([]Message, <-chan struct{}) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var messages []Message
+	for _, message := range p.messages {
+		if message.Topic == topic {
+			messages = append(messages, message)
+		}
+	}
+	return messages, p.published
+}
+
+// WaitForMessages returns the messages published to topic, once there are at least n of them. The test fails if
+// they are not published within timeout.
+func (p *Producer) WaitForMessages(tb testing.TB, topic string, n int, timeout time.Duration) []Message {
+	tb.Helper()
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	for {
+		messages, published := p.messagesAndWait(topic)
+		if len(messages) >= n {
+			return messages
+		}
+		select {
+		case <-published:
+		case <-deadline.C:
+			tb.Fatalf("timed out waiting for %d messages on topic '%s', got %d", n, topic, len(messages))
+			return nil
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package monitoringtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// RDD is an HTTP server standing in for the RDD, which serves the feed and node configurations at FeedsURL and
+// NodesURL. Both are empty JSON lists until set.
+type RDD struct {
+	server *httptest.Server
+
+	mu           sync.Mutex
+	feeds, nodes []byte
+}
+
+// NewRDD starts an RDD server, which is closed during cleanup.
+func NewRDD(tb testing.TB) *RDD {
+	r := &RDD{feeds: []byte("[]"), nodes: []byte("[]")}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/feeds", func(w http.ResponseWriter, _ *http.Request) { r.serve(w, &r.feeds) })
+	mux.HandleFunc("/nodes", func(w http.ResponseWriter, _ *http.Request) { r.serve(w, &r.nodes) })
+	r.server = httptest.NewServer(mux)
+	tb.Cleanup(r.server.Close)
+	return r
+}
+
+func (r *RDD) serve(w http.ResponseWriter, body *[]byte) {
+	r.mu.Lock()
+	buf := *body
+	r.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(buf)
+}
+
+func (r *RDD) FeedsURL() string { return r.server.URL + "/feeds" }
+func (r *RDD) NodesURL() string { return r.server.URL + "/nodes" }
+
+// SetFeeds serves feeds, encoded as JSON, at FeedsURL.
+func (r *RDD) SetFeeds(tb testing.TB, feeds interface{}) {
+	r.set(tb, &r.feeds, feeds)
+}
+
+// SetNodes serves nodes, encoded as JSON, at NodesURL.
+func (r *RDD) SetNodes(tb testing.TB, nodes interface{}) {
+	r.set(tb, &r.nodes, nodes)
+}
+
+func (r *RDD) set(tb testing.TB, body *[]byte, value interface{}) {
+	buf, err := json.Marshal(value)
+	require.NoError(tb, err)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	*body = buf
+}
@@ -0,0 +1,112 @@
+package monitoringtest
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/linkedin/goavro/v2"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/monitoring"
+)
+
+var _ monitoring.SchemaRegistry = (*SchemaRegistry)(nil)
+
+// SchemaRegistry is an in-memory [monitoring.SchemaRegistry]. Like the real registry, it registers a new version of
+// a subject for each distinct spec, with a globally unique ID, and encodes values in the confluent wire format.
+type SchemaRegistry struct {
+	mu       sync.Mutex
+	lastID   int
+	subjects map[string][]*schema // versions, oldest first
+}
+
+func NewSchemaRegistry() *SchemaRegistry {
+	return &SchemaRegistry{subjects: map[string][]*schema{}}
+}
+
+func (s *SchemaRegistry) EnsureSchema(subject, spec string) (monitoring.Schema, error) {
+	var parsedSpec interface{}
+	if err := json.Unmarshal([]byte(spec), &parsedSpec); err != nil {
+		return nil, fmt.Errorf("failed to parse schema for subject '%s': %w", subject, err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	versions := s.subjects[subject]
+	if len(versions) > 0 {
+		if latest := versions[len(versions)-1]; reflect.DeepEqual(latest.parsedSpec, parsedSpec) {
+			return latest, nil
+		}
+	}
+	codec, err := goavro.NewCodec(spec)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create new schema with subject '%s': %w", subject, err)
+	}
+	s.lastID++
+	created := &schema{s.lastID, len(versions) + 1, subject, parsedSpec, codec}
+	s.subjects[subject] = append(versions, created)
+	return created, nil
+}
+
+// Schema returns the latest version of subject, or nil if it was never registered.
+func (s *SchemaRegistry) Schema(subject string) monitoring.Schema {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	versions := s.subjects[subject]
+	if len(versions) == 0 {
+		return nil
+	}
+	return versions[len(versions)-1]
+}
+
+// Decode decodes the value of a message published to topic, with the latest schema of the topic's subject.
+func (s *SchemaRegistry) Decode(topic string, value []byte) (map[string]interface{}, error) {
+	subject := monitoring.SubjectFromTopic(topic)
+	registered := s.Schema(subject)
+	if registered == nil {
+		return nil, fmt.Errorf("no schema registered for subject '%s'", subject)
+	}
+	decoded, err := registered.Decode(value)
+	if err != nil {
+		return nil, err
+	}
+	record, ok := decoded.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected a record for subject '%s' but got %T", subject, decoded)
+	}
+	return record, nil
+}
+
+type schema struct {
+	id, version int
+	subject     string
+	parsedSpec  interface{}
+	codec       *goavro.Codec
+}
+
+func (s *schema) ID() int         { return s.id }
+func (s *schema) Version() int    { return s.version }
+func (s *schema) Subject() string { return s.subject }
+
+func (s *schema) Encode(value interface{}) ([]byte, error) {
+	payload, err := s.codec.BinaryFromNative(nil, value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode value in avro: %w", err)
+	}
+	// Magic 0 byte + 4 bytes of schema ID + the data bytes
+	buf := make([]byte, 5, 5+len(payload))
+	binary.BigEndian.PutUint32(buf[1:], uint32(s.id))
+	return append(buf, payload...), nil
+}
+
+func (s *schema) Decode(buf []byte) (interface{}, error) {
+	if len(buf) < 5 || buf[0] != 0 {
+		return nil, fmt.Errorf("missing magic byte and schema id")
+	}
+	if schemaID := int(binary.BigEndian.Uint32(buf[1:5])); schemaID != s.id {
+		return nil, fmt.Errorf("decoding message for a different schema, found schema id is %d but expected %d", schemaID, s.id)
+	}
+	value, _, err := s.codec.NativeFromBinary(buf[5:])
+	return value, err
+}
@@ -0,0 +1,284 @@
+package monitoringtest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"time"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/monitoring"
+)
+
+// Event is what happened on chain between two polls of a simulated feed.
+type Event string
+
+const (
+	// EventTransmission is a single new transmission.
+	EventTransmission Event = "transmission"
+	// EventNoTransmission is a poll which reads the same transmission as the previous one.
+	EventNoTransmission Event = "no_transmission"
+	// EventMissedRounds is several transmissions between polls, of which only the latest is read.
+	EventMissedRounds Event = "missed_rounds"
+	// EventConfigChange is a new contract configuration. The next transmission uses it, starting from the first epoch.
+	EventConfigChange Event = "config_change"
+)
+
+// SimulationConfig configures a Simulation. Zero values are replaced by defaults.
+type SimulationConfig struct {
+	// Seed of the simulation: the same configuration always produces the same envelopes.
+	Seed int64
+	// Start is the timestamp of the first transmission. Defaults to 2023-01-01 UTC.
+	Start time.Time
+	// RoundInterval is the time between transmissions. Defaults to a minute.
+	RoundInterval time.Duration
+	// RoundsPerEpoch is the number of rounds before the epoch changes. Defaults to 10.
+	RoundsPerEpoch uint8
+	// Oracles is the number of signers and transmitters of each config. Defaults to 4, and F is a third of it.
+	Oracles int
+	// Answer is the first answer. Defaults to 2000e8. Following answers move by up to 0.5% per transmission.
+	Answer *big.Int
+
+	// Probabilities of the events of each poll, other than EventTransmission.
+	NoTransmissionProbability float64
+	MissedRoundsProbability   float64
+	ConfigChangeProbability   float64
+}
+
+// Simulation deterministically generates the envelopes read by successive polls of an OCR2 feed, for validating
+// exporters against realistic sequences: rounds progress within epochs, transmissions are missed by the poller, and
+// configs change. Use InvariantChecker to validate sequences of envelopes, simulated or not.
+// A Simulation is not thread-safe.
+type Simulation struct {
+	cfg SimulationConfig
+	rng *rand.Rand
+
+	config   types.ContractConfig
+	envelope monitoring.Envelope
+	started  bool
+}
+
+// NewSimulation returns a simulation whose first poll reads the first transmission of the first config.
+func NewSimulation(cfg SimulationConfig) *Simulation {
+	if cfg.Start.IsZero() {
+		cfg.Start = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	}
+	if cfg.RoundInterval == 0 {
+		cfg.RoundInterval = time.Minute
+	}
+	if cfg.RoundsPerEpoch == 0 {
+		cfg.RoundsPerEpoch = 10
+	}
+	if cfg.Oracles == 0 {
+		cfg.Oracles = 4
+	}
+	if cfg.Answer == nil {
+		cfg.Answer = big.NewInt(2000e8)
+	}
+	s := &Simulation{cfg: cfg, rng: rand.New(rand.NewSource(cfg.Seed))} //nolint:gosec
+	s.changeConfig()
+	s.envelope = monitoring.Envelope{
+		LatestAnswer:            new(big.Int).Set(cfg.Answer),
+		LatestTimestamp:         cfg.Start,
+		BlockNumber:             1000,
+		LinkBalance:             new(big.Int).Mul(big.NewInt(1000), big.NewInt(1e18)),
+		LinkAvailableForPayment: new(big.Int).Mul(big.NewInt(900), big.NewInt(1e18)),
+		JuelsPerFeeCoin:         big.NewInt(1e18),
+	}
+	s.transmit(1)
+	return s
+}
+
+// Next returns the envelope read by the next poll, and the event which happened since the previous one.
+func (s *Simulation) Next() (monitoring.Envelope, Event) {
+	if !s.started {
+		s.started = true
+		return s.current(), EventTransmission
+	}
+	event := EventTransmission
+	switch p := s.rng.Float64(); {
+	case p < s.cfg.NoTransmissionProbability:
+		event = EventNoTransmission
+	case p < s.cfg.NoTransmissionProbability+s.cfg.MissedRoundsProbability:
+		event = EventMissedRounds
+	case p < s.cfg.NoTransmissionProbability+s.cfg.MissedRoundsProbability+s.cfg.ConfigChangeProbability:
+		event = EventConfigChange
+	}
+	switch event {
+	case EventTransmission:
+		s.transmit(1)
+	case EventMissedRounds:
+		s.transmit(2 + s.rng.Intn(3))
+	case EventConfigChange:
+		s.changeConfig()
+	case EventNoTransmission:
+	}
+	return s.current(), event
+}
+
+// Envelopes returns the envelopes of the next n polls.
+func (s *Simulation) Envelopes(n int) []monitoring.Envelope {
+	envelopes := make([]monitoring.Envelope, 0, n)
+	for len(envelopes) < n {
+		envelope, _ := s.Next()
+		envelopes = append(envelopes, envelope)
+	}
+	return envelopes
+}
+
+// Fetch implements [monitoring.Source], returning the envelope of the next poll.
+func (s *Simulation) Fetch(context.Context) (interface{}, error) {
+	envelope, _ := s.Next()
+	return envelope, nil
+}
+
+// current returns a copy of the envelope, so that callers can not modify the simulation.
+func (s *Simulation) current() monitoring.Envelope {
+	envelope := s.envelope
+	envelope.LatestAnswer = new(big.Int).Set(s.envelope.LatestAnswer)
+	envelope.LinkBalance = new(big.Int).Set(s.envelope.LinkBalance)
+	envelope.LinkAvailableForPayment = new(big.Int).Set(s.envelope.LinkAvailableForPayment)
+	envelope.JuelsPerFeeCoin = new(big.Int).Set(s.envelope.JuelsPerFeeCoin)
+	return envelope
+}
+
+// transmit simulates count transmissions, of which the envelope holds the latest.
+func (s *Simulation) transmit(count int) {
+	e := &s.envelope
+	for i := 0; i < count; i++ {
+		if e.ConfigDigest != s.config.ConfigDigest {
+			e.ConfigDigest = s.config.ConfigDigest
+			e.Epoch, e.Round = 1, 1
+		} else if e.Round >= s.cfg.RoundsPerEpoch {
+			e.Epoch, e.Round = e.Epoch+1, 1
+		} else {
+			e.Round++
+		}
+		e.AggregatorRoundID++
+		if e.AggregatorRoundID > 1 {
+			e.LatestTimestamp = e.LatestTimestamp.Add(s.cfg.RoundInterval)
+			e.BlockNumber += 1 + uint64(s.rng.Intn(10))
+			// move the answer by up to 0.5%
+			move := new(big.Int).Mul(e.LatestAnswer, big.NewInt(int64(s.rng.Intn(11)-5)))
+			e.LatestAnswer = new(big.Int).Add(e.LatestAnswer, move.Quo(move, big.NewInt(1000)))
+			payment := big.NewInt(1e17)
+			e.LinkBalance = new(big.Int).Sub(e.LinkBalance, payment)
+			e.LinkAvailableForPayment = new(big.Int).Sub(e.LinkAvailableForPayment, payment)
+		}
+		e.Transmitter = s.config.Transmitters[s.rng.Intn(len(s.config.Transmitters))]
+	}
+	e.ContractConfig = s.config
+}
+
+// changeConfig sets a new config with new oracles.
+func (s *Simulation) changeConfig() {
+	count := s.config.ConfigCount + 1
+	config := types.ContractConfig{
+		ConfigCount:           count,
+		Signers:               make([]types.OnchainPublicKey, s.cfg.Oracles),
+		Transmitters:          make([]types.Account, s.cfg.Oracles),
+		F:                     uint8((s.cfg.Oracles - 1) / 3),
+		OnchainConfig:         []byte{1},
+		OffchainConfigVersion: 2,
+		OffchainConfig:        []byte(fmt.Sprintf("config %d", count)),
+	}
+	_, _ = s.rng.Read(config.ConfigDigest[:])
+	config.ConfigDigest[0], config.ConfigDigest[1] = 0, 1 // prefix of EVM digests
+	for i := range config.Signers {
+		config.Signers[i] = make(types.OnchainPublicKey, 20)
+		_, _ = s.rng.Read(config.Signers[i])
+		transmitter := make([]byte, 20)
+		_, _ = s.rng.Read(transmitter)
+		config.Transmitters[i] = types.Account(fmt.Sprintf("0x%x", transmitter))
+	}
+	s.config = config
+	s.envelope.ContractConfig = config
+}
+
+// InvariantChecker flags envelopes which can not follow the previous ones in a sequence polled from a single OCR2 feed.
+// Envelopes without any transmission, ie. with an empty ConfigDigest, are not checked.
+type InvariantChecker struct {
+	previous *monitoring.Envelope
+	digests  map[types.ConfigDigest]struct{} // of transmissions, which can not be reused once the config changes
+}
+
+// Check returns an error describing the invariants broken by envelope, or nil. Envelopes are remembered either way.
+func (c *InvariantChecker) Check(envelope monitoring.Envelope) error {
+	if envelope.ConfigDigest == (types.ConfigDigest{}) {
+		return nil
+	}
+	if c.digests == nil {
+		c.digests = map[types.ConfigDigest]struct{}{}
+	}
+	var errs []error
+	if envelope.Epoch == 0 || envelope.Round == 0 {
+		errs = append(errs, fmt.Errorf("epoch %d and round %d must be positive", envelope.Epoch, envelope.Round))
+	}
+	if prev := c.previous; prev != nil {
+		errs = append(errs, c.checkTransition(*prev, envelope)...)
+	}
+	c.digests[envelope.ConfigDigest] = struct{}{}
+	c.previous = &envelope
+	if len(errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("envelope of epoch %d round %d is impossible: %w", envelope.Epoch, envelope.Round, errors.Join(errs...))
+}
+
+func (c *InvariantChecker) checkTransition(prev, next monitoring.Envelope) (errs []error) {
+	if next.ContractConfig.ConfigCount < prev.ContractConfig.ConfigCount {
+		errs = append(errs, fmt.Errorf("config count decreased from %d to %d", prev.ContractConfig.ConfigCount, next.ContractConfig.ConfigCount))
+	} else if next.ContractConfig.ConfigCount == prev.ContractConfig.ConfigCount && next.ContractConfig.ConfigDigest != prev.ContractConfig.ConfigDigest {
+		errs = append(errs, fmt.Errorf("config digest changed without a new config count %d", next.ContractConfig.ConfigCount))
+	}
+	if next.BlockNumber < prev.BlockNumber {
+		errs = append(errs, fmt.Errorf("block number decreased from %d to %d", prev.BlockNumber, next.BlockNumber))
+	}
+
+	sameTransmission := next.ConfigDigest == prev.ConfigDigest && next.Epoch == prev.Epoch && next.Round == prev.Round
+	switch {
+	case sameTransmission:
+		if !equalInts(next.LatestAnswer, prev.LatestAnswer) || !next.LatestTimestamp.Equal(prev.LatestTimestamp) || next.AggregatorRoundID != prev.AggregatorRoundID {
+			errs = append(errs, fmt.Errorf("answer, timestamp or aggregator round changed without a new round"))
+		}
+		return errs
+	case next.ConfigDigest == prev.ConfigDigest:
+		if next.Epoch < prev.Epoch || (next.Epoch == prev.Epoch && next.Round < prev.Round) {
+			errs = append(errs, fmt.Errorf("epoch and round decreased from %d.%d", prev.Epoch, prev.Round))
+		}
+	default:
+		if _, found := c.digests[next.ConfigDigest]; found {
+			errs = append(errs, fmt.Errorf("transmission with the config digest %s of a previous config", next.ConfigDigest))
+		}
+	}
+	if next.LatestTimestamp.Before(prev.LatestTimestamp) {
+		errs = append(errs, fmt.Errorf("timestamp of a new round decreased from %s to %s", prev.LatestTimestamp, next.LatestTimestamp))
+	}
+	if (next.AggregatorRoundID != 0 || prev.AggregatorRoundID != 0) && next.AggregatorRoundID <= prev.AggregatorRoundID {
+		errs = append(errs, fmt.Errorf("aggregator round of a new round did not increase from %d", prev.AggregatorRoundID))
+	}
+	return errs
+}
+
+// CheckEnvelopes checks a sequence of envelopes with a new InvariantChecker, and returns the errors of all the
+// envelopes breaking invariants.
+func CheckEnvelopes(envelopes []monitoring.Envelope) error {
+	var c InvariantChecker
+	var errs []error
+	for i, envelope := range envelopes {
+		if err := c.Check(envelope); err != nil {
+			errs = append(errs, fmt.Errorf("envelope %d: %w", i, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func equalInts(a, b *big.Int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Cmp(b) == 0
+}
@@ -0,0 +1,145 @@
+package monitoringtest_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/monitoring"
+	"github.com/smartcontractkit/chainlink-relay/pkg/monitoring/monitoringtest"
+)
+
+func TestSimulation(t *testing.T) {
+	cfg := monitoringtest.SimulationConfig{
+		Seed:                      42,
+		RoundsPerEpoch:            3,
+		NoTransmissionProbability: 0.2,
+		MissedRoundsProbability:   0.1,
+		ConfigChangeProbability:   0.05,
+	}
+	sim := monitoringtest.NewSimulation(cfg)
+	events := map[monitoringtest.Event]int{}
+	var checker monitoringtest.InvariantChecker
+	var prev monitoring.Envelope
+	for i := 0; i < 1000; i++ {
+		envelope, event := sim.Next()
+		events[event]++
+		require.NoError(t, checker.Check(envelope), "poll %d", i)
+
+		switch event {
+		case monitoringtest.EventTransmission:
+			if i == 0 {
+				assert.Equal(t, uint32(1), envelope.AggregatorRoundID)
+			} else {
+				assert.Equal(t, prev.AggregatorRoundID+1, envelope.AggregatorRoundID)
+				assert.Equal(t, time.Minute, envelope.LatestTimestamp.Sub(prev.LatestTimestamp))
+			}
+			if envelope.ConfigDigest != prev.ConfigDigest {
+				assert.Equal(t, envelope.ContractConfig.ConfigDigest, envelope.ConfigDigest)
+				assert.Equal(t, uint32(1), envelope.Epoch, "first epoch of a new config")
+				assert.Equal(t, uint8(1), envelope.Round)
+			}
+			assert.LessOrEqual(t, envelope.Round, cfg.RoundsPerEpoch)
+		case monitoringtest.EventMissedRounds:
+			assert.Greater(t, envelope.AggregatorRoundID, prev.AggregatorRoundID+1)
+		case monitoringtest.EventNoTransmission:
+			assert.Equal(t, prev, envelope)
+		case monitoringtest.EventConfigChange:
+			assert.Equal(t, prev.ContractConfig.ConfigCount+1, envelope.ContractConfig.ConfigCount)
+			assert.Equal(t, prev.ConfigDigest, envelope.ConfigDigest, "no transmission with the new config yet")
+		}
+		prev = envelope
+	}
+	for _, event := range []monitoringtest.Event{
+		monitoringtest.EventTransmission,
+		monitoringtest.EventNoTransmission,
+		monitoringtest.EventMissedRounds,
+		monitoringtest.EventConfigChange,
+	} {
+		assert.Greater(t, events[event], 10, "event %s", event)
+	}
+
+	t.Run("deterministic", func(t *testing.T) {
+		envelopes := monitoringtest.NewSimulation(cfg).Envelopes(100)
+		assert.Equal(t, envelopes, monitoringtest.NewSimulation(cfg).Envelopes(100))
+		require.NoError(t, monitoringtest.CheckEnvelopes(envelopes))
+
+		other := cfg
+		other.Seed++
+		assert.NotEqual(t, envelopes, monitoringtest.NewSimulation(other).Envelopes(100))
+	})
+}
+
+func TestInvariantChecker(t *testing.T) {
+	transmissions := monitoringtest.NewSimulation(monitoringtest.SimulationConfig{Seed: 1}).Envelopes(2)
+	configs := monitoringtest.NewSimulation(monitoringtest.SimulationConfig{Seed: 1, ConfigChangeProbability: 0.5}).Envelopes(10)
+	require.NoError(t, monitoringtest.CheckEnvelopes(transmissions))
+	require.NoError(t, monitoringtest.CheckEnvelopes(configs))
+	var configChange int // index of the first envelope with a new config
+	for i := 1; i < len(configs); i++ {
+		if configs[i].ContractConfig.ConfigCount > configs[i-1].ContractConfig.ConfigCount {
+			configChange = i
+			break
+		}
+	}
+	require.NotZero(t, configChange)
+
+	for _, test := range []struct {
+		name      string
+		envelopes []monitoring.Envelope
+		modify    func(envelopes []monitoring.Envelope) []monitoring.Envelope
+	}{
+		{"epoch decreased", transmissions, func(e []monitoring.Envelope) []monitoring.Envelope {
+			return []monitoring.Envelope{e[1], e[0]}
+		}},
+		{"round zero", transmissions, func(e []monitoring.Envelope) []monitoring.Envelope {
+			e[0].Round = 0
+			return e[:1]
+		}},
+		{"answer changed without a new round", transmissions, func(e []monitoring.Envelope) []monitoring.Envelope {
+			e[1] = e[0]
+			e[1].LatestAnswer = new(big.Int).Add(e[0].LatestAnswer, big.NewInt(1))
+			return e[:2]
+		}},
+		{"timestamp decreased", transmissions, func(e []monitoring.Envelope) []monitoring.Envelope {
+			e[1].LatestTimestamp = e[0].LatestTimestamp.Add(-time.Second)
+			return e[:2]
+		}},
+		{"aggregator round did not increase", transmissions, func(e []monitoring.Envelope) []monitoring.Envelope {
+			e[1].AggregatorRoundID = e[0].AggregatorRoundID
+			return e[:2]
+		}},
+		{"block number decreased", transmissions, func(e []monitoring.Envelope) []monitoring.Envelope {
+			e[1].BlockNumber = e[0].BlockNumber - 1
+			return e[:2]
+		}},
+		{"config count decreased", configs, func(e []monitoring.Envelope) []monitoring.Envelope {
+			return append(e[:configChange+1:configChange+1], e[configChange-1])
+		}},
+		{"config digest changed without a new config", configs, func(e []monitoring.Envelope) []monitoring.Envelope {
+			e[configChange].ContractConfig.ConfigCount--
+			return e[:configChange+1]
+		}},
+		{"transmission with a previous config", configs, func(e []monitoring.Envelope) []monitoring.Envelope {
+			for i := configChange + 1; i < len(e); i++ {
+				if e[i].ConfigDigest != e[i-1].ConfigDigest {
+					next := e[i]
+					next.ConfigDigest = e[configChange-1].ConfigDigest
+					next.AggregatorRoundID++
+					next.LatestTimestamp = next.LatestTimestamp.Add(time.Minute)
+					return []monitoring.Envelope{e[configChange-1], e[i], next}
+				}
+			}
+			panic("no transmission with the new config")
+		}},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			modified := test.modify(append([]monitoring.Envelope{}, test.envelopes...))
+			assert.Error(t, monitoringtest.CheckEnvelopes(modified))
+		})
+	}
+}
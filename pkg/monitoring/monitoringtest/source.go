@@ -0,0 +1,31 @@
+package monitoringtest
+
+import (
+	"context"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/monitoring"
+)
+
+var _ monitoring.SourceFactory = SourceFactory{}
+
+// SourceFactory creates synthetic sources, which call Fetch with the source's chain and feed configurations.
+type SourceFactory struct {
+	Type  string
+	Fetch func(ctx context.Context, chainConfig monitoring.ChainConfig, feedConfig monitoring.FeedConfig) (interface{}, error)
+}
+
+func (s SourceFactory) GetType() string { return s.Type }
+
+func (s SourceFactory) NewSource(chainConfig monitoring.ChainConfig, feedConfig monitoring.FeedConfig) (monitoring.Source, error) {
+	return &source{s.Fetch, chainConfig, feedConfig}, nil
+}
+
+type source struct {
+	fetch       func(context.Context, monitoring.ChainConfig, monitoring.FeedConfig) (interface{}, error)
+	chainConfig monitoring.ChainConfig
+	feedConfig  monitoring.FeedConfig
+}
+
+func (s *source) Fetch(ctx context.Context) (interface{}, error) {
+	return s.fetch(ctx, s.chainConfig, s.feedConfig)
+}
@@ -3,17 +3,33 @@ package monitoring
 import (
 	"context"
 	"fmt"
+	"time"
+
+	"github.com/stretchr/testify/assert"
 
 	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+	"github.com/smartcontractkit/chainlink-relay/pkg/monitoring/config"
 	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
 )
 
 // MultiFeedMonitor manages the flow of data from multiple sources to
 // multiple exporters for each feed in the configuration.
+// Updates are queued per feed and exported by a fixed number of workers shared
+// by all feeds, so memory use is bounded regardless of the number of feeds.
 type MultiFeedMonitor interface {
+	// Run monitors the feeds of data, and applies updates, until ctx is cancelled.
+	// The exporters of all the feeds are cleaned up before Run returns.
 	Run(ctx context.Context, data RDDData)
+	// Update changes the feeds monitored by Run to those of data, without interrupting the feeds whose configuration
+	// has not changed, so they keep their sources, exporters and metrics. New feeds are started, and removed feeds are
+	// stopped and cleaned up. Changed feeds are restarted, and so are all the feeds if the nodes have changed.
+	// Update does not block: only the latest update is kept until Run applies it, which happens when Run starts, if it
+	// is not executing.
+	Update(data RDDData)
 }
 
+// NewMultiFeedMonitor returns a new MultiFeedMonitor. bufferCapacity is unused, since the pollers of each feed deliver
+// their updates straight to its queue, of feedMonitorConfig.QueueCapacity.
 func NewMultiFeedMonitor(
 	chainConfig ChainConfig,
 	log Logger,
@@ -22,6 +38,21 @@ func NewMultiFeedMonitor(
 	exporterFactories []ExporterFactory,
 
 	bufferCapacity uint32,
+	feedMonitorConfig config.FeedMonitor,
+) MultiFeedMonitor {
+	return newMultiFeedMonitor(chainConfig, log, sourceFactories, exporterFactories, feedMonitorConfig, nil)
+}
+
+// newMultiFeedMonitor is like NewMultiFeedMonitor, but also tracks the feeds of each run in feeds, if not nil.
+func newMultiFeedMonitor(
+	chainConfig ChainConfig,
+	log Logger,
+
+	sourceFactories []SourceFactory,
+	exporterFactories []ExporterFactory,
+
+	feedMonitorConfig config.FeedMonitor,
+	feeds *feedRegistry,
 ) MultiFeedMonitor {
 	return &multiFeedMonitor{
 		chainConfig,
@@ -30,7 +61,9 @@ func NewMultiFeedMonitor(
 		sourceFactories,
 		exporterFactories,
 
-		bufferCapacity,
+		feedMonitorConfig,
+		feeds,
+		make(chan RDDData, 1),
 	}
 }
 
@@ -41,75 +74,205 @@ type multiFeedMonitor struct {
 	sourceFactories   []SourceFactory
 	exporterFactories []ExporterFactory
 
-	bufferCapacity uint32
+	feedMonitorConfig config.FeedMonitor
+
+	feeds *feedRegistry // optional
+
+	updates chan RDDData // holds the latest update only
+}
+
+func (m *multiFeedMonitor) Update(data RDDData) {
+	for {
+		select {
+		case m.updates <- data:
+			return
+		default:
+		}
+		select { // replace the pending update
+		case <-m.updates:
+		default:
+		}
+	}
 }
 
 // Run should be executed as a goroutine.
 func (m *multiFeedMonitor) Run(ctx context.Context, data RDDData) {
+	defer m.feeds.set(nil) // after all goroutines have stopped
 	var subs utils.Subprocesses
 	defer subs.Wait()
 
-FEED_LOOP:
-	for _, feedConfig := range data.Feeds {
-		feedLogger := logger.With(m.log,
-			"feed_name", feedConfig.GetName(),
-			"feed_id", feedConfig.GetID(),
-			"network", m.chainConfig.GetNetworkName(),
-		)
-		// Create data sources
-		pollers := []Poller{}
-		for _, sourceFactory := range m.sourceFactories {
-			source, err := sourceFactory.NewSource(m.chainConfig, feedConfig)
-			if err != nil {
-				feedLogger.Errorw("failed to create source", "error", err, "source-type", fmt.Sprintf("%T", sourceFactory))
-				continue
+	pool := newWorkerPool(m.feedMonitorConfig.Workers, nil)
+	subs.Go(func() {
+		pool.Run(ctx)
+	})
+
+	running := map[string]*runningFeed{}
+	m.update(ctx, pool, running, true, data)
+	nodes := data.Nodes
+	for {
+		select {
+		case data := <-m.updates:
+			m.update(ctx, pool, running, isDifferentNodes(nodes, data.Nodes), data)
+			nodes = data.Nodes
+		case <-ctx.Done():
+			// The pool cleans up the queues once it stops.
+			for _, feed := range running {
+				feed.stop()
 			}
-			poller := NewSourcePoller(
-				source,
-				logger.With(m.log, "component", "chain-poller", "source", sourceFactory.GetType()),
-				m.chainConfig.GetPollInterval(),
-				m.chainConfig.GetReadTimeout(),
-				m.bufferCapacity,
-			)
-			pollers = append(pollers, poller)
+			return
+		}
+	}
+}
+
+// runningFeed is a feed monitored by a multiFeedMonitor, whose pollers run until stopped.
+type runningFeed struct {
+	config FeedConfig
+	queue  *feedQueue
+	cancel context.CancelFunc
+	subs   *utils.Subprocesses
+}
+
+// stop cancels the pollers of the feed, and waits for them to return.
+func (f *runningFeed) stop() {
+	f.cancel()
+	f.subs.Wait()
+}
+
+// update diffs the feeds of data with the running feeds, by ID. Removed and changed feeds are stopped, or all feeds if
+// restartAll is true, then new and changed feeds are started.
+func (m *multiFeedMonitor) update(ctx context.Context, pool *workerPool, running map[string]*runningFeed, restartAll bool, data RDDData) {
+	updated := make(map[string]FeedConfig, len(data.Feeds))
+	for _, feedConfig := range data.Feeds {
+		if _, found := updated[feedConfig.GetID()]; !found {
+			updated[feedConfig.GetID()] = feedConfig
 		}
-		if len(pollers) == 0 {
-			feedLogger.Errorw("not tracking feed because all sources failed to initialize")
-			continue FEED_LOOP
+	}
+	stopped := []*runningFeed{}
+	for id, feed := range running {
+		if feedConfig, found := updated[id]; found && !restartAll && !isDifferentFeed(feed.config, feedConfig) {
+			continue
+		}
+		feed.cancel() // stop all the feeds concurrently
+		stopped = append(stopped, feed)
+		delete(running, id)
+	}
+	for _, feed := range stopped {
+		feed.stop()
+		pool.remove(feed.queue)
+	}
+
+	started := 0
+	registered := make([]registeredFeed, 0, len(data.Feeds))
+	seen := make(map[string]struct{}, len(data.Feeds))
+	for _, feedConfig := range data.Feeds {
+		if _, found := seen[feedConfig.GetID()]; found {
+			m.log.Errorw("not tracking feed because its ID is duplicated", "feed_name", feedConfig.GetName(), "feed_id", feedConfig.GetID())
+			continue
 		}
-		// Create exporters
-		exporters := []Exporter{}
-		for _, exporterFactory := range m.exporterFactories {
-			exporter, err := exporterFactory.NewExporter(ExporterParams{
-				m.chainConfig,
-				feedConfig,
-				data.Nodes,
-			})
-			if err != nil {
-				feedLogger.Errorw("failed to create new exporter", "error", err, "exporter-type", fmt.Sprintf("%T", exporterFactory))
+		seen[feedConfig.GetID()] = struct{}{}
+		feed, found := running[feedConfig.GetID()]
+		if !found {
+			if feed = m.startFeed(ctx, pool, feedConfig, data.Nodes); feed == nil {
 				continue
 			}
-			exporters = append(exporters, exporter)
+			running[feedConfig.GetID()] = feed
+			started++
 		}
-		if len(exporters) == 0 {
-			feedLogger.Errorw("not tracking feed because all exporters failed to initialize")
-			continue FEED_LOOP
+		registered = append(registered, registeredFeed{feed.config, feed.queue})
+	}
+	m.feeds.set(registered)
+	m.log.Infow("updated feeds", "started", started, "stopped", len(stopped), "running", len(running))
+}
+
+// startFeed creates the sources and exporters of a feed, and runs its pollers. It returns nil if the feed can not be
+// monitored.
+func (m *multiFeedMonitor) startFeed(ctx context.Context, pool *workerPool, feedConfig FeedConfig, nodes []NodeConfig) *runningFeed {
+	feedLogger := logger.With(m.log,
+		"feed_name", feedConfig.GetName(),
+		"feed_id", feedConfig.GetID(),
+		"network", m.chainConfig.GetNetworkName(),
+	)
+	// Create data sources
+	type feedSource struct {
+		source     Source
+		sourceType string
+	}
+	sources := []feedSource{}
+	for _, sourceFactory := range m.sourceFactories {
+		source, err := sourceFactory.NewSource(m.chainConfig, feedConfig)
+		if err != nil {
+			feedLogger.Errorw("failed to create source", "error", err, "source-type", fmt.Sprintf("%T", sourceFactory))
+			continue
 		}
-		// Run poller goroutines.
-		for _, poller := range pollers {
-			poller := poller
-			subs.Go(func() {
-				poller.Run(ctx)
-			})
+		sources = append(sources, feedSource{source, sourceFactory.GetType()})
+	}
+	if len(sources) == 0 {
+		feedLogger.Errorw("not tracking feed because all sources failed to initialize")
+		return nil
+	}
+	// Create exporters
+	exporters := []Exporter{}
+	for _, exporterFactory := range m.exporterFactories {
+		exporter, err := exporterFactory.NewExporter(ExporterParams{
+			m.chainConfig,
+			feedConfig,
+			nodes,
+		})
+		if err != nil {
+			feedLogger.Errorw("failed to create new exporter", "error", err, "exporter-type", fmt.Sprintf("%T", exporterFactory))
+			continue
 		}
-		// Run feed monitor.
-		feedMonitor := NewFeedMonitor(
-			logger.With(m.log, "component", "feed-monitor"),
-			pollers,
-			exporters,
+		exporters = append(exporters, exporter)
+	}
+	if len(exporters) == 0 {
+		feedLogger.Errorw("not tracking feed because all exporters failed to initialize")
+		return nil
+	}
+	queue := newFeedQueue(
+		logger.With(m.log, "component", "feed-monitor"),
+		exporters,
+		NewFeedMetrics(m.chainConfig, feedConfig),
+		m.feedMonitorConfig.QueueCapacity,
+		m.feedMonitorConfig.SkipDuplicateEnvelopes,
+	)
+	pool.add(queue)
+
+	feedCtx, cancel := context.WithCancel(ctx)
+	feed := &runningFeed{feedConfig, queue, cancel, &utils.Subprocesses{}}
+	// Run poller goroutines, which queue their updates.
+	for _, source := range sources {
+		poller := newDeliveringSourcePoller(
+			source.source,
+			logger.With(m.log, "component", "chain-poller", "source", source.sourceType),
+			m.chainConfig.GetPollInterval(),
+			m.fetchTimeout(source.sourceType),
+			utils.RealClock,
+			func(update interface{}) { pool.enqueue(queue, update) },
 		)
-		subs.Go(func() {
-			feedMonitor.Run(ctx)
+		feed.subs.Go(func() {
+			poller.Run(feedCtx)
 		})
 	}
+	return feed
+}
+
+// isDifferentFeed checks whether the configuration of a feed has changed.
+func isDifferentFeed(current, updated FeedConfig) bool {
+	return !assert.ObjectsAreEqual(current, updated)
+}
+
+// isDifferentNodes checks whether the list of nodes has changed, which requires recreating the exporters of all feeds.
+func isDifferentNodes(current, updated []NodeConfig) bool {
+	return !assert.ObjectsAreEqual(current, updated)
+}
+
+// fetchTimeout returns the maximum duration of each Fetch() for sources of the given type.
+func (m *multiFeedMonitor) fetchTimeout(sourceType string) time.Duration {
+	if timeout, found := m.feedMonitorConfig.FetchTimeouts[sourceType]; found {
+		return timeout
+	}
+	if m.feedMonitorConfig.FetchTimeout != 0 {
+		return m.feedMonitorConfig.FetchTimeout
+	}
+	return m.chainConfig.GetReadTimeout()
 }
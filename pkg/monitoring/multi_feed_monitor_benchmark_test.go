@@ -55,8 +55,8 @@ func BenchmarkMultiFeedMonitor(b *testing.B) {
 		newNullLogger(),
 		producer,
 		[]Pipeline{
-			{cfg.Kafka.TransmissionTopic, MakeTransmissionMapping, transmissionSchema},
-			{cfg.Kafka.ConfigSetSimplifiedTopic, MakeConfigSetSimplifiedMapping, configSetSimplifiedSchema},
+			{cfg.Kafka.TransmissionTopic, MakeTransmissionMapping, transmissionSchema, KeyByContractAddress},
+			{cfg.Kafka.ConfigSetSimplifiedTopic, MakeConfigSetSimplifiedMapping, configSetSimplifiedSchema, KeyByContractAddress},
 		},
 	)
 	if err != nil {
@@ -72,6 +72,7 @@ func BenchmarkMultiFeedMonitor(b *testing.B) {
 			kafkaExporterFactory,
 		},
 		100, // bufferCapacity for source pollers
+		config.FeedMonitor{Workers: 4, QueueCapacity: 100},
 	)
 	subs.Go(func() {
 		monitor.Run(ctx, RDDData{feeds, nodes})
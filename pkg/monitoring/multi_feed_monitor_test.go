@@ -3,10 +3,12 @@ package monitoring
 import (
 	"context"
 	"fmt"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/goleak"
 
@@ -49,8 +51,8 @@ func TestMultiFeedMonitorSynchronousMode(t *testing.T) {
 		newNullLogger(),
 		producer,
 		[]Pipeline{
-			{cfg.Kafka.TransmissionTopic, MakeTransmissionMapping, transmissionSchema},
-			{cfg.Kafka.ConfigSetSimplifiedTopic, MakeConfigSetSimplifiedMapping, configSetSimplifiedSchema},
+			{cfg.Kafka.TransmissionTopic, MakeTransmissionMapping, transmissionSchema, KeyByContractAddress},
+			{cfg.Kafka.ConfigSetSimplifiedTopic, MakeConfigSetSimplifiedMapping, configSetSimplifiedSchema, KeyByContractAddress},
 		},
 	)
 	require.NoError(t, err)
@@ -61,6 +63,7 @@ func TestMultiFeedMonitorSynchronousMode(t *testing.T) {
 		[]SourceFactory{factory},
 		[]ExporterFactory{prometheusExporterFactory, kafkaExporterFactory},
 		100, // bufferCapacity for source pollers
+		config.FeedMonitor{Workers: 4, QueueCapacity: 100},
 	)
 	subs.Go(func() {
 		monitor.Run(ctx, RDDData{feeds, nodes})
@@ -132,8 +135,8 @@ func TestMultiFeedMonitorForPerformance(t *testing.T) {
 		newNullLogger(),
 		producer,
 		[]Pipeline{
-			{cfg.Kafka.TransmissionTopic, MakeTransmissionMapping, transmissionSchema},
-			{cfg.Kafka.ConfigSetSimplifiedTopic, MakeConfigSetSimplifiedMapping, configSetSimplifiedSchema},
+			{cfg.Kafka.TransmissionTopic, MakeTransmissionMapping, transmissionSchema, KeyByContractAddress},
+			{cfg.Kafka.ConfigSetSimplifiedTopic, MakeConfigSetSimplifiedMapping, configSetSimplifiedSchema, KeyByContractAddress},
 		},
 	)
 	require.NoError(t, err)
@@ -144,6 +147,7 @@ func TestMultiFeedMonitorForPerformance(t *testing.T) {
 		[]SourceFactory{factory},
 		[]ExporterFactory{prometheusExporterFactory, kafkaExporterFactory},
 		100, // bufferCapacity for source pollers
+		config.FeedMonitor{Workers: 4, QueueCapacity: 100},
 	)
 	subs.Go(func() {
 		monitor.Run(ctx, RDDData{feeds, nodes})
@@ -212,6 +216,7 @@ func TestMultiFeedMonitorErroringFactories(t *testing.T) {
 			[]SourceFactory{sourceFactory1, sourceFactory2},
 			[]ExporterFactory{exporterFactory1, exporterFactory2},
 			10, // bufferCapacity for source pollers
+			config.FeedMonitor{Workers: 4, QueueCapacity: 100},
 		)
 
 		sourceFactory1.On("NewSource", chainConfig, feeds[0]).Return(nil, fmt.Errorf("source_factory1/feed1 failed"))
@@ -253,6 +258,7 @@ func TestMultiFeedMonitorErroringFactories(t *testing.T) {
 			[]SourceFactory{sourceFactory1, sourceFactory2, sourceFactory3},
 			[]ExporterFactory{exporterFactory1, exporterFactory2, exporterFactory3},
 			100, // bufferCapacity for source pollers
+			config.FeedMonitor{Workers: 4, QueueCapacity: 100},
 		)
 
 		envelope, err := generateEnvelope()
@@ -312,3 +318,120 @@ func TestMultiFeedMonitorErroringFactories(t *testing.T) {
 		require.GreaterOrEqual(t, countMessages, int64(10*2*2))
 	})
 }
+
+func TestMultiFeedMonitorUpdate(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	var subs utils.Subprocesses
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	chainCfg := fakeChainConfig{}
+	chainCfg.ReadTimeout = 1 * time.Second
+	chainCfg.PollInterval = 10 * time.Millisecond
+	feedA := generateFeedConfig().(fakeFeedConfig)
+	feedB := generateFeedConfig().(fakeFeedConfig)
+	feedC := generateFeedConfig().(fakeFeedConfig)
+	nodes := []NodeConfig{generateNodeConfig()}
+
+	factory := &countingFactory{}
+	registry := newFeedRegistry(newNullLogger())
+	monitor := newMultiFeedMonitor(
+		chainCfg,
+		newNullLogger(),
+		[]SourceFactory{factory},
+		[]ExporterFactory{factory},
+		config.FeedMonitor{Workers: 2, QueueCapacity: 10},
+		registry,
+	)
+	subs.Go(func() {
+		monitor.Run(ctx, RDDData{[]FeedConfig{feedA, feedB}, nodes})
+	})
+	requireCounts := func(expected map[string]counts) {
+		require.Eventually(t, func() bool {
+			return assert.ObjectsAreEqual(expected, factory.snapshot())
+		}, 4*time.Second, 10*time.Millisecond, "expected %v", expected)
+	}
+	a, b, c := feedA.GetID(), feedB.GetID(), feedC.GetID()
+	requireCounts(map[string]counts{a: {1, 1, 0}, b: {1, 1, 0}})
+
+	// feed A is removed, feed B is unchanged and feed C is added
+	monitor.Update(RDDData{[]FeedConfig{feedB, feedC}, nodes})
+	requireCounts(map[string]counts{a: {1, 1, 1}, b: {1, 1, 0}, c: {1, 1, 0}})
+	require.Len(t, registry.statuses(), 2)
+
+	// feed B is changed
+	feedB.Name = "changed"
+	monitor.Update(RDDData{[]FeedConfig{feedB, feedC}, nodes})
+	requireCounts(map[string]counts{a: {1, 1, 1}, b: {2, 2, 1}, c: {1, 1, 0}})
+
+	// all the feeds are restarted when the nodes change
+	monitor.Update(RDDData{[]FeedConfig{feedB, feedC}, append(nodes, generateNodeConfig())})
+	requireCounts(map[string]counts{a: {1, 1, 1}, b: {3, 3, 2}, c: {2, 2, 1}})
+
+	cancel()
+	subs.Wait()
+	require.Equal(t, map[string]counts{a: {1, 1, 1}, b: {3, 3, 3}, c: {2, 2, 2}}, factory.snapshot())
+	require.Empty(t, registry.statuses())
+}
+
+// counts tracks the lifecycle of a feed's sources and exporters.
+type counts struct {
+	sources, exporters, cleanups int
+}
+
+// countingFactory creates sources which never have updates, and exporters which record when they are cleaned up.
+type countingFactory struct {
+	mu     sync.Mutex
+	counts map[string]counts
+}
+
+func (c *countingFactory) update(feedID string, f func(*counts)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.counts == nil {
+		c.counts = map[string]counts{}
+	}
+	feed := c.counts[feedID]
+	f(&feed)
+	c.counts[feedID] = feed
+}
+
+func (c *countingFactory) snapshot() map[string]counts {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	snapshot := make(map[string]counts, len(c.counts))
+	for id, feed := range c.counts {
+		snapshot[id] = feed
+	}
+	return snapshot
+}
+
+func (c *countingFactory) NewSource(_ ChainConfig, feedConfig FeedConfig) (Source, error) {
+	c.update(feedConfig.GetID(), func(feed *counts) { feed.sources++ })
+	return noUpdateSource{}, nil
+}
+
+func (c *countingFactory) GetType() string { return "counting" }
+
+func (c *countingFactory) NewExporter(params ExporterParams) (Exporter, error) {
+	feedID := params.FeedConfig.GetID()
+	c.update(feedID, func(feed *counts) { feed.exporters++ })
+	return &countingExporter{c, feedID}, nil
+}
+
+type noUpdateSource struct{}
+
+func (noUpdateSource) Fetch(context.Context) (interface{}, error) { return nil, ErrNoUpdate }
+
+type countingExporter struct {
+	factory *countingFactory
+	feedID  string
+}
+
+func (c *countingExporter) Export(context.Context, interface{}) {}
+
+func (c *countingExporter) Cleanup(context.Context) error {
+	c.factory.update(c.feedID, func(feed *counts) { feed.cleanups++ })
+	return nil
+}
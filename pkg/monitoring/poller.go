@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"time"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
 )
 
 // Poller implements Updater by periodically invoking a Source's Fetch() method.
@@ -16,6 +18,10 @@ type Poller interface {
 // If the Source's Fetch() returns an error it will be reported.
 // If it panics, the panic will be recovered and reported as an error and the program will resume operation.
 // If the error is ErrNoUpdate, it will not be reported and the Poller will skip this round.
+// Each Fetch() is cancelled after fetchTimeout. Fetches which do not return shortly after being cancelled are
+// abandoned, and no new fetch is started until they return, so that slow sources can not pile up goroutines.
+// Fetches start every pollInterval from the initial one, regardless of how long each takes. Like time.Ticker, fetches
+// which would start while the previous one is still running are skipped.
 func NewSourcePoller(
 	source Source,
 	log Logger,
@@ -23,12 +29,46 @@ func NewSourcePoller(
 	fetchTimeout time.Duration,
 	bufferCapacity uint32,
 ) Poller {
+	return NewSourcePollerWithClock(source, log, pollInterval, fetchTimeout, bufferCapacity, utils.RealClock)
+}
+
+// NewSourcePollerWithClock is like NewSourcePoller, except that intervals and timeouts are measured by clock, e.g. a
+// utils.FakeClock for tests.
+func NewSourcePollerWithClock(
+	source Source,
+	log Logger,
+	pollInterval time.Duration,
+	fetchTimeout time.Duration,
+	bufferCapacity uint32,
+	clock utils.Clock,
+) Poller {
+	return &sourcePoller{
+		log:          log,
+		source:       source,
+		updates:      make(chan interface{}, bufferCapacity),
+		pollInterval: pollInterval,
+		fetchTimeout: fetchTimeout,
+		clock:        clock,
+	}
+}
+
+// newDeliveringSourcePoller is like NewSourcePollerWithClock, except that updates are passed to deliver, which must not
+// block, instead of being sent on the Updates channel.
+func newDeliveringSourcePoller(
+	source Source,
+	log Logger,
+	pollInterval time.Duration,
+	fetchTimeout time.Duration,
+	clock utils.Clock,
+	deliver func(update interface{}),
+) *sourcePoller {
 	return &sourcePoller{
-		log,
-		source,
-		make(chan interface{}, bufferCapacity),
-		pollInterval,
-		fetchTimeout,
+		log:          log,
+		source:       source,
+		deliver:      deliver,
+		pollInterval: pollInterval,
+		fetchTimeout: fetchTimeout,
+		clock:        clock,
 	}
 }
 
@@ -36,15 +76,33 @@ type sourcePoller struct {
 	log     Logger
 	source  Source
 	updates chan interface{}
+	deliver func(update interface{}) // optional, instead of updates
 
 	pollInterval time.Duration
 	fetchTimeout time.Duration
+	clock        utils.Clock
+
+	abandoned chan fetchResult // non-nil while an abandoned fetch is still running
+}
+
+type fetchResult struct {
+	data interface{}
+	err  error
+}
+
+// abandonFetchGracePeriod is how long a fetch has to return once its context is cancelled, before it is abandoned.
+const abandonFetchGracePeriod = 100 * time.Millisecond
+
+// fetchTimeoutObserver is implemented by sources which report timed out fetches, see NewInstrumentedSourceFactory.
+type fetchTimeoutObserver interface {
+	observeFetchTimeout()
 }
 
 // Run should be executed as a goroutine
 func (s *sourcePoller) Run(ctx context.Context) {
 	s.log.Debugw("poller started")
 	defer s.log.Debugw("poller closed")
+	next := s.clock.Now()
 	// Initial fetch.
 	data, err := s.executeFetch(ctx)
 	if err != nil {
@@ -55,61 +113,110 @@ func (s *sourcePoller) Run(ctx context.Context) {
 		} else {
 			s.log.Errorw("failed initial fetch", "error", err)
 		}
-	} else {
-		select {
-		case s.updates <- data:
-		case <-ctx.Done():
-			return
-		}
+	} else if !s.send(ctx, data) {
+		return
 	}
 
-	reusedTimer := time.NewTimer(s.pollInterval)
+	next = s.nextFetch(next)
+	timer := s.clock.NewTimer(next.Sub(s.clock.Now()))
+	defer timer.Stop()
 	for {
 		select {
-		case <-reusedTimer.C:
+		case <-timer.C():
 			data, err := s.executeFetch(ctx)
 			if err != nil {
 				if errors.Is(err, ErrNoUpdate) {
 					s.log.Debugw("no update found")
-					reusedTimer.Reset(s.pollInterval)
-					continue
 				} else if errors.Is(err, context.Canceled) {
 					return
 				} else {
 					s.log.Errorw("failed to fetch from source", "error", err)
-					reusedTimer.Reset(s.pollInterval)
-					continue
 				}
-			}
-			select {
-			case s.updates <- data:
-			case <-ctx.Done():
+			} else if !s.send(ctx, data) {
 				return
 			}
-			reusedTimer.Reset(s.pollInterval)
+			next = s.nextFetch(next)
+			timer.Reset(next.Sub(s.clock.Now()))
 		case <-ctx.Done():
-			if !reusedTimer.Stop() {
-				<-reusedTimer.C
-			}
 			return
 		}
 	}
 }
 
+// nextFetch returns the first time on the schedule after both prev and now, so that slow fetches do not delay the
+// following ones.
+func (s *sourcePoller) nextFetch(prev time.Time) time.Time {
+	now := s.clock.Now()
+	if s.pollInterval <= 0 {
+		return now
+	}
+	next := prev.Add(s.pollInterval)
+	if !next.After(now) {
+		missed := now.Sub(prev) / s.pollInterval
+		next = prev.Add((missed + 1) * s.pollInterval)
+	}
+	return next
+}
+
 func (s *sourcePoller) Updates() <-chan interface{} {
 	return s.updates
 }
 
+// send delivers data, and returns false if ctx is done first.
+func (s *sourcePoller) send(ctx context.Context, data interface{}) bool {
+	if s.deliver != nil {
+		s.deliver(data)
+		return true
+	}
+	select {
+	case s.updates <- data:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
 // executeFetch runs Source#Fetch() with a timeout.
 // It also captures the error if Fetch() panics and returns it.
-func (s *sourcePoller) executeFetch(ctx context.Context) (data interface{}, err error) {
-	ctx, cancel := context.WithTimeout(ctx, s.fetchTimeout)
-	defer cancel()
-	defer func() {
-		if recoveredErr := recover(); recoveredErr != nil {
-			err = fmt.Errorf("Fetch() panicked: %v", recoveredErr)
+func (s *sourcePoller) executeFetch(ctx context.Context) (interface{}, error) {
+	if s.abandoned != nil {
+		select {
+		case <-s.abandoned:
+			s.abandoned = nil
+		default:
+			return nil, fmt.Errorf("skipping Fetch() because a previous call has not returned after timing out")
 		}
+	}
+	fetchCtx, cancel := utils.WithTimeout(ctx, s.clock, s.fetchTimeout)
+	defer cancel()
+	resultCh := make(chan fetchResult, 1)
+	go func() {
+		var result fetchResult
+		defer func() {
+			if recoveredErr := recover(); recoveredErr != nil {
+				result = fetchResult{nil, fmt.Errorf("Fetch() panicked: %v", recoveredErr)}
+			}
+			resultCh <- result
+		}()
+		result.data, result.err = s.source.Fetch(fetchCtx)
 	}()
-	data, err = s.source.Fetch(ctx)
-	return data, err
+	var result fetchResult
+	select {
+	case result = <-resultCh:
+	case <-fetchCtx.Done():
+		grace := s.clock.NewTimer(abandonFetchGracePeriod)
+		select {
+		case result = <-resultCh:
+		case <-grace.C():
+			s.abandoned = resultCh
+			result.err = fmt.Errorf("abandoned Fetch() which did not return after being cancelled: %w", fetchCtx.Err())
+		}
+		grace.Stop()
+	}
+	if errors.Is(fetchCtx.Err(), context.DeadlineExceeded) && ctx.Err() == nil {
+		if observer, ok := s.source.(fetchTimeoutObserver); ok {
+			observer.observeFetchTimeout()
+		}
+	}
+	return result.data, result.err
 }
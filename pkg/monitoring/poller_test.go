@@ -8,6 +8,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/goleak"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
 )
 
 func TestPoller(t *testing.T) {
@@ -232,4 +234,162 @@ func TestPoller(t *testing.T) {
 		default:
 		}
 	})
+	t.Run("abandons fetches which ignore the timeout", func(t *testing.T) {
+		defer goleak.VerifyNone(t)
+		var subs utils.Subprocesses
+		defer subs.Wait()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		clock := utils.NewFakeClock(time.Unix(0, 0))
+		source := &fakeSourceIgnoringContext{release: make(chan struct{}), calls: make(chan struct{}, 10), timeouts: make(chan struct{}, 10)}
+		poller := NewSourcePollerWithClock(
+			source,
+			newNullLogger(),
+			10*time.Millisecond, // poll interval
+			10*time.Millisecond, // read timeout
+			1,                   // buffer capacity
+			clock,
+		)
+		subs.Go(func() {
+			poller.Run(ctx)
+		})
+
+		// The first fetch is abandoned after its timeout, and grace period.
+		<-source.calls
+		clock.BlockUntil(1)
+		clock.Advance(10 * time.Millisecond)
+		clock.BlockUntil(1)
+		clock.Advance(abandonFetchGracePeriod)
+		<-source.timeouts
+
+		// While it is stuck, no other fetches are started.
+		clock.BlockUntil(1)
+		clock.Advance(10 * time.Millisecond)
+		clock.BlockUntil(1)
+		select {
+		case <-source.calls:
+			t.Fatal("fetched while a previous fetch was stuck")
+		default:
+		}
+
+		// Once it returns, polling resumes.
+		close(source.release)
+		var update interface{}
+		for update == nil {
+			clock.BlockUntil(1)
+			clock.Advance(10 * time.Millisecond)
+			select {
+			case update = <-poller.Updates():
+			default:
+			}
+		}
+		require.Equal(t, "released", update)
+		cancel()
+	})
+	t.Run("polls and times out on the clock", func(t *testing.T) {
+		defer goleak.VerifyNone(t)
+		var subs utils.Subprocesses
+		defer subs.Wait()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		clock := utils.NewFakeClock(time.Unix(0, 0))
+		source := &fakeSourceWaitingForContext{calls: make(chan struct{}, 10), timeouts: make(chan struct{}, 10)}
+		poller := NewSourcePollerWithClock(
+			source,
+			newNullLogger(),
+			time.Minute,    // poll interval
+			10*time.Second, // read timeout
+			0,              // buffer capacity
+			clock,
+		)
+		subs.Go(func() {
+			poller.Run(ctx)
+		})
+
+		// The initial fetch waits for its timeout.
+		<-source.calls
+		clock.BlockUntil(1)
+		clock.Advance(10 * time.Second)
+		<-source.timeouts
+
+		// The next fetch waits for the poll interval.
+		clock.BlockUntil(1)
+		select {
+		case <-source.calls:
+			t.Fatal("fetched before the poll interval")
+		default:
+		}
+		clock.Advance(time.Minute)
+		<-source.calls
+		require.Len(t, source.timeouts, 0)
+		cancel()
+	})
+	t.Run("the poll interval does not drift with slow sources", func(t *testing.T) {
+		defer goleak.VerifyNone(t)
+		var subs utils.Subprocesses
+		defer subs.Wait()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		start := time.Unix(0, 0)
+		clock := utils.NewFakeClock(start)
+		source := &fakeSourceWaitingForContext{calls: make(chan struct{}, 10), timeouts: make(chan struct{}, 10)}
+		poller := NewSourcePollerWithClock(
+			source,
+			newNullLogger(),
+			time.Minute,    // poll interval
+			20*time.Second, // read timeout
+			0,              // buffer capacity
+			clock,
+		)
+		subs.Go(func() {
+			poller.Run(ctx)
+		})
+
+		<-source.calls
+		for i := 1; i <= 3; i++ {
+			// Every fetch takes until its timeout.
+			clock.BlockUntil(1)
+			clock.Advance(20 * time.Second)
+			<-source.timeouts
+
+			clock.BlockUntil(1)
+			clock.Advance(40 * time.Second)
+			<-source.calls
+			require.Equal(t, start.Add(time.Duration(i)*time.Minute), clock.Now(), "fetch %d", i)
+		}
+		cancel()
+	})
+}
+
+// fakeSourceWaitingForContext blocks in Fetch() until the context is done.
+type fakeSourceWaitingForContext struct {
+	calls    chan struct{}
+	timeouts chan struct{}
+}
+
+func (f *fakeSourceWaitingForContext) Fetch(ctx context.Context) (interface{}, error) {
+	f.calls <- struct{}{}
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func (f *fakeSourceWaitingForContext) observeFetchTimeout() {
+	f.timeouts <- struct{}{}
+}
+
+// fakeSourceIgnoringContext blocks in Fetch() until released, regardless of the context.
+type fakeSourceIgnoringContext struct {
+	release  chan struct{}
+	calls    chan struct{}
+	timeouts chan struct{}
+}
+
+func (f *fakeSourceIgnoringContext) Fetch(_ context.Context) (interface{}, error) {
+	f.calls <- struct{}{}
+	<-f.release
+	return "released", nil
+}
+
+func (f *fakeSourceIgnoringContext) observeFetchTimeout() {
+	f.timeouts <- struct{}{}
 }
@@ -2,7 +2,9 @@ package monitoring
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/confluentinc/confluent-kafka-go/kafka"
 
@@ -14,6 +16,18 @@ type Producer interface {
 	Produce(key, value []byte, topic string) error
 }
 
+// DedupeKeyHeader is the header of messages which carries their dedupe key, see DeliveryReportingProducer.
+const DedupeKeyHeader = "dedupe_key"
+
+// DeliveryReportingProducer is optionally implemented by Producers which report whether each message was delivered
+// to the brokers. Producers which don't implement it are assumed to deliver each message accepted by Produce.
+type DeliveryReportingProducer interface {
+	Producer
+	// ProduceWithDeliveryReport is like Produce, and calls report with the outcome of delivering the message.
+	// The dedupeKey, if any, is set as the DedupeKeyHeader of the message, so that consumers can drop redeliveries.
+	ProduceWithDeliveryReport(key, value []byte, topic, dedupeKey string, report func(error)) error
+}
+
 type producer struct {
 	log          Logger
 	backend      *kafka.Producer
@@ -21,22 +35,47 @@ type producer struct {
 	cfg          config.Kafka
 }
 
-func NewProducer(ctx context.Context, log Logger, cfg config.Kafka) (Producer, error) {
-	backend, err := kafka.NewProducer(&kafka.ConfigMap{
+func newKafkaConfigMap(cfg config.Kafka) *kafka.ConfigMap {
+	m := kafka.ConfigMap{
 		"bootstrap.servers": cfg.Brokers,
 		"client.id":         cfg.ClientID,
 		"security.protocol": cfg.SecurityProtocol,
 		"sasl.mechanisms":   cfg.SaslMechanism,
 		"sasl.username":     cfg.SaslUsername,
 		"sasl.password":     cfg.SaslPassword,
-	})
+	}
+	// Throughput settings are only set when configured, to keep the librdkafka defaults otherwise.
+	if cfg.Linger > 0 {
+		m["linger.ms"] = int(cfg.Linger.Milliseconds())
+	}
+	if cfg.BatchSize > 0 {
+		m["batch.size"] = cfg.BatchSize
+	}
+	if cfg.CompressionCodec != "" {
+		m["compression.codec"] = cfg.CompressionCodec
+	}
+	if cfg.Idempotent {
+		m["enable.idempotence"] = true
+	}
+	if cfg.MaxInFlight > 0 {
+		m["max.in.flight.requests.per.connection"] = cfg.MaxInFlight
+	}
+	return &m
+}
+
+// deliveryChanCapacity buffers delivery reports, so that a burst of them doesn't block librdkafka from sending the
+// next batches while they are handled.
+const deliveryChanCapacity = 1024
+
+func NewProducer(ctx context.Context, log Logger, cfg config.Kafka) (Producer, error) {
+	backend, err := kafka.NewProducer(newKafkaConfigMap(cfg))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create kafka producer: %w", err)
 	}
 	p := &producer{
 		log,
 		backend,
-		make(chan kafka.Event),
+		make(chan kafka.Event, deliveryChanCapacity),
 		cfg,
 	}
 	go p.drainDeliveryChan(ctx)
@@ -49,6 +88,11 @@ func (p *producer) drainDeliveryChan(ctx context.Context) {
 		select {
 		case event := <-p.deliveryChan:
 			p.log.Debugw("received delivery event", "event", event.String())
+			if message, ok := event.(*kafka.Message); ok {
+				if report, ok := message.Opaque.(func(error)); ok {
+					report(message.TopicPartition.Error)
+				}
+			}
 		case <-ctx.Done():
 			p.backend.Close()
 			return
@@ -66,3 +110,68 @@ func (p *producer) Produce(key, value []byte, topic string) error {
 		Value: value,
 	}, p.deliveryChan)
 }
+
+func (p *producer) ProduceWithDeliveryReport(key, value []byte, topic, dedupeKey string, report func(error)) error {
+	var headers []kafka.Header
+	if dedupeKey != "" {
+		headers = []kafka.Header{{Key: DedupeKeyHeader, Value: []byte(dedupeKey)}}
+	}
+	return p.backend.Produce(&kafka.Message{
+		TopicPartition: kafka.TopicPartition{
+			Topic:     &topic,
+			Partition: kafka.PartitionAny,
+		},
+		Key:     key,
+		Value:   value,
+		Headers: headers,
+		Opaque:  report,
+	}, p.deliveryChan)
+}
+
+// TopicAdmin is an abstraction on top of the Kafka admin API to aid with tests.
+type TopicAdmin interface {
+	CreateTopics(ctx context.Context, topics []kafka.TopicSpecification, options ...kafka.CreateTopicsAdminOption) ([]kafka.TopicResult, error)
+}
+
+// topicCreationTimeout bounds waiting for the brokers to create topics.
+const topicCreationTimeout = 30 * time.Second
+
+// CreateTopics creates each of topics which does not exist yet, with the settings from cfg, via the Kafka admin API.
+func CreateTopics(ctx context.Context, log Logger, cfg config.Kafka, topics ...string) error {
+	admin, err := kafka.NewAdminClient(newKafkaConfigMap(cfg))
+	if err != nil {
+		return fmt.Errorf("failed to create kafka admin client: %w", err)
+	}
+	defer admin.Close()
+	return EnsureTopics(ctx, log, admin, cfg, topics...)
+}
+
+// EnsureTopics creates each of topics which does not exist yet, with the settings from cfg.
+func EnsureTopics(ctx context.Context, log Logger, admin TopicAdmin, cfg config.Kafka, topics ...string) error {
+	specs := make([]kafka.TopicSpecification, len(topics))
+	for i, topic := range topics {
+		settings := cfg.TopicSettingsFor(topic)
+		specs[i] = kafka.TopicSpecification{
+			Topic:             topic,
+			NumPartitions:     settings.Partitions,
+			ReplicationFactor: settings.ReplicationFactor,
+		}
+	}
+	ctx, cancel := context.WithTimeout(ctx, topicCreationTimeout)
+	defer cancel()
+	results, err := admin.CreateTopics(ctx, specs, kafka.SetAdminOperationTimeout(topicCreationTimeout))
+	if err != nil {
+		return fmt.Errorf("failed to create kafka topics: %w", err)
+	}
+	for _, result := range results {
+		switch result.Error.Code() {
+		case kafka.ErrNoError:
+			log.Infow("created kafka topic", "topic", result.Topic)
+		case kafka.ErrTopicAlreadyExists:
+			log.Debugw("kafka topic already exists", "topic", result.Topic)
+		default:
+			err = errors.Join(err, fmt.Errorf("failed to create kafka topic '%s': %w", result.Topic, result.Error))
+		}
+	}
+	return err
+}
@@ -0,0 +1,88 @@
+package monitoring
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/monitoring/config"
+)
+
+// This benchmark measures the throughput of the kafka producer with different batching and compression settings,
+// against a mock cluster run in-process by librdkafka. Each op is a 512 byte message, delivered and acknowledged.
+func BenchmarkProducer(b *testing.B) {
+	cluster, err := kafka.NewMockCluster(1)
+	if err != nil {
+		b.Skipf("kafka mock cluster unavailable: %v", err)
+	}
+	defer cluster.Close()
+
+	for _, bc := range []struct {
+		name   string
+		modify func(*config.Kafka)
+	}{
+		{"defaults", func(*config.Kafka) {}},
+		{"linger", func(c *config.Kafka) {
+			c.Linger = 5 * time.Millisecond
+			c.BatchSize = 1 << 20
+		}},
+		{"lz4", func(c *config.Kafka) {
+			c.Linger = 5 * time.Millisecond
+			c.CompressionCodec = "lz4"
+		}},
+		{"idempotent", func(c *config.Kafka) {
+			c.Linger = 5 * time.Millisecond
+			c.Idempotent = true
+			c.MaxInFlight = config.MaxInFlightIdempotent
+		}},
+	} {
+		bc := bc
+		b.Run(bc.name, func(b *testing.B) {
+			cfg := config.Kafka{Brokers: cluster.BootstrapServers(), ClientID: "benchmark", SecurityProtocol: "plaintext"}
+			bc.modify(&cfg)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			producer, err := NewProducer(ctx, newNullLogger(), cfg)
+			if err != nil {
+				b.Fatalf("failed to create producer: %v", err)
+			}
+			p := producer.(DeliveryReportingProducer)
+
+			value := make([]byte, 512)
+			var wg sync.WaitGroup
+			var failed error
+			var failedOnce sync.Once
+			report := func(err error) {
+				if err != nil {
+					failedOnce.Do(func() { failed = err })
+				}
+				wg.Done()
+			}
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				wg.Add(1)
+				for {
+					err = p.ProduceWithDeliveryReport(nil, value, "benchmark", "", report)
+					var kafkaErr kafka.Error
+					if !errors.As(err, &kafkaErr) || kafkaErr.Code() != kafka.ErrQueueFull {
+						break
+					}
+					time.Sleep(time.Millisecond) // wait for the queue to drain
+				}
+				if err != nil {
+					b.Fatalf("failed to produce: %v", err)
+				}
+			}
+			wg.Wait()
+			b.StopTimer()
+			if failed != nil {
+				b.Fatalf("failed to deliver: %v", failed)
+			}
+		})
+	}
+}
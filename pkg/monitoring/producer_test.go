@@ -0,0 +1,93 @@
+package monitoring
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/monitoring/config"
+)
+
+type fakeTopicAdmin struct {
+	specs   []kafka.TopicSpecification
+	results []kafka.TopicResult
+	err     error
+}
+
+func (f *fakeTopicAdmin) CreateTopics(ctx context.Context, topics []kafka.TopicSpecification, options ...kafka.CreateTopicsAdminOption) ([]kafka.TopicResult, error) {
+	f.specs = topics
+	return f.results, f.err
+}
+
+func TestEnsureTopics(t *testing.T) {
+	ctx := context.Background()
+	cfg := config.Kafka{
+		DefaultTopicSettings: config.TopicSettings{Partitions: 3, ReplicationFactor: 2},
+		TopicSettings: map[string]config.TopicSettings{
+			"config_set_simplified": {Partitions: 1, ReplicationFactor: 3},
+		},
+	}
+
+	t.Run("settings", func(t *testing.T) {
+		admin := &fakeTopicAdmin{results: []kafka.TopicResult{
+			{Topic: "transmission", Error: kafka.NewError(kafka.ErrNoError, "", false)},
+			{Topic: "config_set_simplified", Error: kafka.NewError(kafka.ErrTopicAlreadyExists, "topic exists", false)},
+		}}
+		require.NoError(t, EnsureTopics(ctx, newNullLogger(), admin, cfg, "transmission", "config_set_simplified"))
+		assert.Equal(t, []kafka.TopicSpecification{
+			{Topic: "transmission", NumPartitions: 3, ReplicationFactor: 2},
+			{Topic: "config_set_simplified", NumPartitions: 1, ReplicationFactor: 3},
+		}, admin.specs)
+	})
+
+	t.Run("topic error", func(t *testing.T) {
+		admin := &fakeTopicAdmin{results: []kafka.TopicResult{
+			{Topic: "transmission", Error: kafka.NewError(kafka.ErrNoError, "", false)},
+			{Topic: "config_set_simplified", Error: kafka.NewError(kafka.ErrorCode(38), "invalid replication factor", false)},
+		}}
+		err := EnsureTopics(ctx, newNullLogger(), admin, cfg, "transmission", "config_set_simplified")
+		require.ErrorContains(t, err, "failed to create kafka topic 'config_set_simplified'")
+		assert.ErrorContains(t, err, "invalid replication factor")
+	})
+
+	t.Run("request error", func(t *testing.T) {
+		admin := &fakeTopicAdmin{err: errors.New("brokers unavailable")}
+		err := EnsureTopics(ctx, newNullLogger(), admin, cfg, "transmission")
+		require.ErrorContains(t, err, "brokers unavailable")
+	})
+}
+
+func TestNewKafkaConfigMap(t *testing.T) {
+	cfg := config.Kafka{Brokers: "localhost:9092", ClientID: "client", SecurityProtocol: "plaintext"}
+	base := kafka.ConfigMap{
+		"bootstrap.servers": "localhost:9092",
+		"client.id":         "client",
+		"security.protocol": "plaintext",
+		"sasl.mechanisms":   "",
+		"sasl.username":     "",
+		"sasl.password":     "",
+	}
+	assert.Equal(t, &base, newKafkaConfigMap(cfg), "librdkafka defaults")
+
+	cfg.Linger = 5 * time.Millisecond
+	cfg.BatchSize = 1 << 20
+	cfg.CompressionCodec = "zstd"
+	cfg.Idempotent = true
+	cfg.MaxInFlight = 5
+	tuned := kafka.ConfigMap{
+		"linger.ms":                             5,
+		"batch.size":                            1 << 20,
+		"compression.codec":                     "zstd",
+		"enable.idempotence":                    true,
+		"max.in.flight.requests.per.connection": 5,
+	}
+	for k, v := range base {
+		tuned[k] = v
+	}
+	assert.Equal(t, &tuned, newKafkaConfigMap(cfg))
+}
@@ -0,0 +1,352 @@
+package monitoring
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	kafkaWALPendingMessages = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kafka_wal_pending_messages",
+			Help: "number of messages in the write-ahead log which were not acknowledged by the Kafka brokers yet",
+		},
+		[]string{"network_name", "network_id", "chain_id"},
+	)
+	kafkaWALLagSeconds = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kafka_wal_lag_seconds",
+			Help: "age of the oldest message in the write-ahead log which was not acknowledged by the Kafka brokers yet",
+		},
+		[]string{"network_name", "network_id", "chain_id"},
+	)
+	kafkaWALReplayedMessages = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kafka_wal_replayed_messages",
+			Help: "number of messages replayed from the write-ahead log after failing to be delivered to Kafka",
+		},
+		[]string{"topic", "network_name", "network_id", "chain_id"},
+	)
+)
+
+// WALMetrics records the lag of the Kafka write-ahead log.
+type WALMetrics interface {
+	SetPendingMessages(count float64)
+	SetLag(lag time.Duration)
+	IncReplayedMessages(topic string)
+}
+
+func NewWALMetrics(chainConfig ChainConfig) WALMetrics {
+	return &walMetrics{chainConfig}
+}
+
+type walMetrics struct {
+	chainConfig ChainConfig
+}
+
+func (w *walMetrics) labels() prometheus.Labels {
+	return prometheus.Labels{
+		"network_name": w.chainConfig.GetNetworkName(),
+		"network_id":   w.chainConfig.GetNetworkID(),
+		"chain_id":     w.chainConfig.GetChainID(),
+	}
+}
+
+func (w *walMetrics) SetPendingMessages(count float64) {
+	kafkaWALPendingMessages.With(w.labels()).Set(count)
+}
+
+func (w *walMetrics) SetLag(lag time.Duration) {
+	kafkaWALLagSeconds.With(w.labels()).Set(lag.Seconds())
+}
+
+func (w *walMetrics) IncReplayedMessages(topic string) {
+	labels := w.labels()
+	labels["topic"] = topic
+	kafkaWALReplayedMessages.With(labels).Inc()
+}
+
+var (
+	walMessagesBucket = []byte("messages")
+	walMetaBucket     = []byte("meta")
+	walIDKey          = []byte("id")
+)
+
+// walReplayBatchSize bounds the number of messages replayed at each interval.
+const walReplayBatchSize = 1000
+
+type walRecord struct {
+	Topic     string    `json:"topic"`
+	Key       []byte    `json:"key"`
+	Value     []byte    `json:"value"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type walProducer struct {
+	log      Logger
+	producer Producer
+	db       *bolt.DB
+	// id identifies the log in dedupe keys. It is kept across restarts.
+	id      string
+	metrics WALMetrics
+
+	inflightMu sync.Mutex
+	// messages produced, whose delivery was not reported yet.
+	inflight map[uint64]struct{}
+
+	done chan struct{}
+}
+
+// NewWALProducer wraps producer with a write-ahead log, stored in a file at path, for at-least-once delivery.
+// Messages are persisted before being produced, and deleted once delivered, see DeliveryReportingProducer.
+// Undelivered messages, including the ones left over by a previous process, are replayed every replayInterval
+// with the same dedupe key. Messages produced and delivered concurrently are committed together, see bolt.DB.Batch,
+// rather than syncing the file for each one. The log is closed once ctx is done.
+func NewWALProducer(
+	ctx context.Context,
+	log Logger,
+	producer Producer,
+	path string,
+	replayInterval time.Duration,
+	metrics WALMetrics,
+) (Producer, error) {
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open write-ahead log '%s': %w", path, err)
+	}
+	var id string
+	err = db.Update(func(tx *bolt.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists(walMessagesBucket); err != nil {
+			return err
+		}
+		meta, err := tx.CreateBucketIfNotExists(walMetaBucket)
+		if err != nil {
+			return err
+		}
+		if existing := meta.Get(walIDKey); existing != nil {
+			id = string(existing)
+			return nil
+		}
+		id = uuid.NewString()
+		return meta.Put(walIDKey, []byte(id))
+	})
+	if err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to initialize write-ahead log '%s': %w", path, err)
+	}
+	w := &walProducer{
+		log,
+		producer,
+		db,
+		id,
+		metrics,
+		sync.Mutex{},
+		map[uint64]struct{}{},
+		make(chan struct{}),
+	}
+	go w.run(ctx, replayInterval)
+	return w, nil
+}
+
+func (w *walProducer) Produce(key, value []byte, topic string) error {
+	record := walRecord{topic, key, value, time.Now()}
+	seq, err := w.append(record)
+	if err != nil {
+		return fmt.Errorf("failed to write message to the write-ahead log: %w", err)
+	}
+	return w.send(seq, record)
+}
+
+// append persists record, which is marked inflight until sent.
+func (w *walProducer) append(record walRecord) (seq uint64, err error) {
+	buf, err := json.Marshal(record)
+	if err != nil {
+		return 0, err
+	}
+	err = w.db.Batch(func(tx *bolt.Tx) error {
+		if seq != 0 {
+			// Batch retries the func on its own if another one in the batch failed.
+			w.setInflight(seq, false)
+		}
+		messages := tx.Bucket(walMessagesBucket)
+		seq, err = messages.NextSequence()
+		if err != nil {
+			return err
+		}
+		// Before committing, so that replay can't pick the message up.
+		w.setInflight(seq, true)
+		return messages.Put(walKey(seq), buf)
+	})
+	if err != nil && seq != 0 {
+		w.setInflight(seq, false)
+	}
+	return seq, err
+}
+
+// send produces the record, which must be marked inflight.
+func (w *walProducer) send(seq uint64, record walRecord) error {
+	report := func(err error) { w.delivered(seq, record.Topic, err) }
+	var err error
+	if reporting, ok := w.producer.(DeliveryReportingProducer); ok {
+		dedupeKey := fmt.Sprintf("%s-%d", w.id, seq)
+		err = reporting.ProduceWithDeliveryReport(record.Key, record.Value, record.Topic, dedupeKey, report)
+	} else if err = w.producer.Produce(record.Key, record.Value, record.Topic); err == nil {
+		report(nil)
+	}
+	if err != nil {
+		w.setInflight(seq, false)
+	}
+	return err
+}
+
+func (w *walProducer) delivered(seq uint64, topic string, deliveryErr error) {
+	defer w.setInflight(seq, false)
+	if deliveryErr != nil {
+		w.log.Warnw("failed to deliver message, it will be replayed from the write-ahead log", "topic", topic, "error", deliveryErr)
+		return
+	}
+	err := w.db.Batch(func(tx *bolt.Tx) error {
+		return tx.Bucket(walMessagesBucket).Delete(walKey(seq))
+	})
+	if errors.Is(err, bolt.ErrDatabaseNotOpen) {
+		w.log.Debugw("write-ahead log closed before message delivery, it will be replayed", "topic", topic)
+	} else if err != nil {
+		w.log.Errorw("failed to delete delivered message from the write-ahead log", "topic", topic, "error", err)
+	}
+}
+
+func (w *walProducer) setInflight(seq uint64, isInflight bool) {
+	w.inflightMu.Lock()
+	defer w.inflightMu.Unlock()
+	if isInflight {
+		w.inflight[seq] = struct{}{}
+	} else {
+		delete(w.inflight, seq)
+	}
+}
+
+// run should be executed as a goroutine.
+func (w *walProducer) run(ctx context.Context, replayInterval time.Duration) {
+	defer close(w.done)
+	ticker := time.NewTicker(replayInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			w.replay()
+			w.updateLag()
+		case <-ctx.Done():
+			if err := w.db.Close(); err != nil {
+				w.log.Errorw("failed to close write-ahead log", "error", err)
+			}
+			return
+		}
+	}
+}
+
+// replay sends the oldest undelivered messages, in order, until one fails.
+func (w *walProducer) replay() {
+	type pending struct {
+		seq    uint64
+		record walRecord
+	}
+	var batch []pending
+	var corrupt []uint64
+	err := w.db.View(func(tx *bolt.Tx) error {
+		cursor := tx.Bucket(walMessagesBucket).Cursor()
+		for key, value := cursor.First(); key != nil && len(batch) < walReplayBatchSize; key, value = cursor.Next() {
+			seq := binary.BigEndian.Uint64(key)
+			w.inflightMu.Lock()
+			_, isInflight := w.inflight[seq]
+			if !isInflight {
+				w.inflight[seq] = struct{}{}
+			}
+			w.inflightMu.Unlock()
+			if isInflight {
+				continue
+			}
+			var record walRecord
+			if err := json.Unmarshal(value, &record); err != nil {
+				w.log.Errorw("failed to decode message from the write-ahead log, deleting it", "seq", seq, "error", err)
+				corrupt = append(corrupt, seq)
+				continue
+			}
+			batch = append(batch, pending{seq, record})
+		}
+		return nil
+	})
+	if err != nil {
+		w.log.Errorw("failed to read messages from the write-ahead log", "error", err)
+	}
+	w.deleteCorrupt(corrupt)
+	for i, p := range batch {
+		if err := w.send(p.seq, p.record); err != nil {
+			w.log.Warnw("failed to replay messages from the write-ahead log, retrying later", "pending", len(batch)-i, "error", err)
+			for _, skipped := range batch[i+1:] {
+				w.setInflight(skipped.seq, false)
+			}
+			return
+		}
+		w.metrics.IncReplayedMessages(p.record.Topic)
+	}
+}
+
+// deleteCorrupt deletes the messages which failed to decode, and were marked inflight so that they are not replayed
+// meanwhile, so that they neither block the lag metric nor get logged again.
+func (w *walProducer) deleteCorrupt(seqs []uint64) {
+	if len(seqs) == 0 {
+		return
+	}
+	err := w.db.Update(func(tx *bolt.Tx) error {
+		messages := tx.Bucket(walMessagesBucket)
+		for _, seq := range seqs {
+			if err := messages.Delete(walKey(seq)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		w.log.Errorw("failed to delete undecodable messages from the write-ahead log", "count", len(seqs), "error", err)
+	}
+	for _, seq := range seqs {
+		w.setInflight(seq, false)
+	}
+}
+
+func (w *walProducer) updateLag() {
+	err := w.db.View(func(tx *bolt.Tx) error {
+		messages := tx.Bucket(walMessagesBucket)
+		w.metrics.SetPendingMessages(float64(messages.Stats().KeyN))
+		_, oldest := messages.Cursor().First()
+		if oldest == nil {
+			w.metrics.SetLag(0)
+			return nil
+		}
+		var record walRecord
+		if err := json.Unmarshal(oldest, &record); err != nil {
+			return err
+		}
+		w.metrics.SetLag(time.Since(record.CreatedAt))
+		return nil
+	})
+	if err != nil {
+		w.log.Errorw("failed to measure the lag of the write-ahead log", "error", err)
+	}
+}
+
+func walKey(seq uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, seq)
+	return key
+}
@@ -0,0 +1,248 @@
+package monitoring
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
+	"go.uber.org/goleak"
+)
+
+type reportedMessage struct {
+	key, value []byte
+	topic      string
+	dedupeKey  string
+}
+
+// fakeReportingProducer reports the delivery of each message synchronously, failing while deliveryErr or produceErr
+// is set.
+type fakeReportingProducer struct {
+	mu          sync.Mutex
+	sent        []reportedMessage
+	produceErr  error
+	deliveryErr error
+}
+
+var _ DeliveryReportingProducer = (*fakeReportingProducer)(nil)
+
+func (f *fakeReportingProducer) Produce(key, value []byte, topic string) error {
+	return errors.New("unexpected call to Produce")
+}
+
+func (f *fakeReportingProducer) ProduceWithDeliveryReport(key, value []byte, topic, dedupeKey string, report func(error)) error {
+	f.mu.Lock()
+	if f.produceErr != nil {
+		defer f.mu.Unlock()
+		return f.produceErr
+	}
+	f.sent = append(f.sent, reportedMessage{key, value, topic, dedupeKey})
+	deliveryErr := f.deliveryErr
+	f.mu.Unlock()
+	report(deliveryErr)
+	return nil
+}
+
+func (f *fakeReportingProducer) set(produceErr, deliveryErr error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.produceErr, f.deliveryErr = produceErr, deliveryErr
+}
+
+func (f *fakeReportingProducer) messages() []reportedMessage {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]reportedMessage(nil), f.sent...)
+}
+
+type fakeWALMetrics struct {
+	mu       sync.Mutex
+	pending  float64
+	replayed map[string]int
+}
+
+func (f *fakeWALMetrics) SetPendingMessages(count float64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.pending = count
+}
+
+func (f *fakeWALMetrics) SetLag(time.Duration) {}
+
+func (f *fakeWALMetrics) IncReplayedMessages(topic string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.replayed == nil {
+		f.replayed = map[string]int{}
+	}
+	f.replayed[topic]++
+}
+
+func (f *fakeWALMetrics) get() (float64, map[string]int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	replayed := map[string]int{}
+	for topic, count := range f.replayed {
+		replayed[topic] = count
+	}
+	return f.pending, replayed
+}
+
+// newTestWALProducer returns a WAL producer, which is closed during cleanup.
+func newTestWALProducer(t *testing.T, path string, producer Producer, metrics WALMetrics) *walProducer {
+	ctx, cancel := context.WithCancel(context.Background())
+	wal, err := NewWALProducer(ctx, newNullLogger(), producer, path, 10*time.Millisecond, metrics)
+	require.NoError(t, err)
+	w := wal.(*walProducer)
+	t.Cleanup(func() {
+		cancel()
+		<-w.done
+	})
+	return w
+}
+
+func countWALRecords(t *testing.T, w *walProducer) (count int) {
+	require.NoError(t, w.db.View(func(tx *bolt.Tx) error {
+		count = tx.Bucket(walMessagesBucket).Stats().KeyN
+		return nil
+	}))
+	return
+}
+
+func TestWALProducer(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	t.Run("delivered messages are deleted", func(t *testing.T) {
+		producer := &fakeReportingProducer{}
+		w := newTestWALProducer(t, filepath.Join(t.TempDir(), "wal.db"), producer, &fakeWALMetrics{})
+
+		require.NoError(t, w.Produce([]byte("key1"), []byte("value1"), "transmission"))
+		require.NoError(t, w.Produce([]byte("key2"), []byte("value2"), "config_set_simplified"))
+
+		sent := producer.messages()
+		require.Len(t, sent, 2)
+		assert.Equal(t, reportedMessage{[]byte("key1"), []byte("value1"), "transmission", w.id + "-1"}, sent[0])
+		assert.Equal(t, reportedMessage{[]byte("key2"), []byte("value2"), "config_set_simplified", w.id + "-2"}, sent[1])
+		assert.Equal(t, 0, countWALRecords(t, w))
+	})
+
+	t.Run("undelivered messages are replayed with the same dedupe key", func(t *testing.T) {
+		producer := &fakeReportingProducer{deliveryErr: errors.New("broker unavailable")}
+		metrics := &fakeWALMetrics{}
+		w := newTestWALProducer(t, filepath.Join(t.TempDir(), "wal.db"), producer, metrics)
+
+		require.NoError(t, w.Produce([]byte("key"), []byte("value"), "transmission"))
+		require.Eventually(t, func() bool {
+			pending, _ := metrics.get()
+			return pending == 1
+		}, time.Second, 5*time.Millisecond)
+
+		producer.set(nil, nil)
+		require.Eventually(t, func() bool { return countWALRecords(t, w) == 0 }, time.Second, 5*time.Millisecond)
+
+		sent := producer.messages()
+		require.GreaterOrEqual(t, len(sent), 2)
+		for _, message := range sent {
+			assert.Equal(t, reportedMessage{[]byte("key"), []byte("value"), "transmission", w.id + "-1"}, message)
+		}
+		// the replayed message may be delivered, and deleted, before it is counted
+		require.Eventually(t, func() bool {
+			_, replayed := metrics.get()
+			return replayed["transmission"] == len(sent)-1
+		}, time.Second, 5*time.Millisecond)
+	})
+
+	t.Run("messages which fail to be produced are kept", func(t *testing.T) {
+		producer := &fakeReportingProducer{produceErr: errors.New("queue full")}
+		w := newTestWALProducer(t, filepath.Join(t.TempDir(), "wal.db"), producer, &fakeWALMetrics{})
+
+		require.ErrorContains(t, w.Produce([]byte("key1"), []byte("value1"), "transmission"), "queue full")
+		require.ErrorContains(t, w.Produce([]byte("key2"), []byte("value2"), "transmission"), "queue full")
+		assert.Equal(t, 2, countWALRecords(t, w))
+
+		producer.set(nil, nil)
+		require.Eventually(t, func() bool { return countWALRecords(t, w) == 0 }, time.Second, 5*time.Millisecond)
+		sent := producer.messages()
+		require.Len(t, sent, 2)
+		assert.Equal(t, []byte("key1"), sent[0].key, "replayed in order")
+		assert.Equal(t, []byte("key2"), sent[1].key, "replayed in order")
+	})
+
+	t.Run("pending messages survive restarts", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "wal.db")
+
+		ctx, cancel := context.WithCancel(context.Background())
+		failing := &fakeReportingProducer{produceErr: errors.New("broker unavailable")}
+		wal, err := NewWALProducer(ctx, newNullLogger(), failing, path, time.Hour, &fakeWALMetrics{})
+		require.NoError(t, err)
+		require.Error(t, wal.Produce([]byte("key"), []byte("value"), "transmission"))
+		id := wal.(*walProducer).id
+		cancel()
+		<-wal.(*walProducer).done
+
+		producer := &fakeReportingProducer{}
+		w := newTestWALProducer(t, path, producer, &fakeWALMetrics{})
+		assert.Equal(t, id, w.id)
+		require.Eventually(t, func() bool { return countWALRecords(t, w) == 0 }, time.Second, 5*time.Millisecond)
+		assert.Equal(t, []reportedMessage{{[]byte("key"), []byte("value"), "transmission", id + "-1"}}, producer.messages())
+	})
+
+	t.Run("concurrent messages", func(t *testing.T) {
+		producer := &fakeReportingProducer{}
+		w := newTestWALProducer(t, filepath.Join(t.TempDir(), "wal.db"), producer, &fakeWALMetrics{})
+
+		var wg sync.WaitGroup
+		for i := 0; i < 100; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				assert.NoError(t, w.Produce([]byte(fmt.Sprintf("key%d", i)), []byte("value"), "transmission"))
+			}(i)
+		}
+		wg.Wait()
+
+		dedupeKeys := map[string]struct{}{}
+		for _, message := range producer.messages() {
+			dedupeKeys[message.dedupeKey] = struct{}{}
+		}
+		assert.Len(t, dedupeKeys, 100, "every message has its own sequence number")
+		assert.Equal(t, 0, countWALRecords(t, w))
+	})
+
+	t.Run("undecodable messages are deleted", func(t *testing.T) {
+		producer := &fakeReportingProducer{produceErr: errors.New("broker unavailable")}
+		w := newTestWALProducer(t, filepath.Join(t.TempDir(), "wal.db"), producer, &fakeWALMetrics{})
+
+		require.NoError(t, w.db.Update(func(tx *bolt.Tx) error {
+			messages := tx.Bucket(walMessagesBucket)
+			seq, err := messages.NextSequence()
+			if err != nil {
+				return err
+			}
+			return messages.Put(walKey(seq), []byte("not json"))
+		}))
+		require.Error(t, w.Produce([]byte("key"), []byte("value"), "transmission"))
+		require.Eventually(t, func() bool { return countWALRecords(t, w) == 1 }, time.Second, 5*time.Millisecond)
+
+		producer.set(nil, nil)
+		require.Eventually(t, func() bool { return countWALRecords(t, w) == 0 }, time.Second, 5*time.Millisecond)
+		assert.Equal(t, []reportedMessage{{[]byte("key"), []byte("value"), "transmission", w.id + "-2"}}, producer.messages())
+	})
+
+	t.Run("producers without delivery reports", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		producer := fakeProducer{make(chan producerMessage, 1), ctx}
+		w := newTestWALProducer(t, filepath.Join(t.TempDir(), "wal.db"), producer, &fakeWALMetrics{})
+
+		require.NoError(t, w.Produce([]byte("key"), []byte("value"), "transmission"))
+		assert.Equal(t, producerMessage{[]byte("key"), []byte("value"), "transmission"}, <-producer.sendCh)
+		assert.Equal(t, 0, countWALRecords(t, w))
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 	"fmt"
 
+	"github.com/linkedin/goavro/v2"
 	"github.com/riferrei/srclient"
 )
 
@@ -35,12 +36,24 @@ func (w wrapSchema) Subject() string {
 }
 
 func (w wrapSchema) Encode(value interface{}) ([]byte, error) {
-	payload, err := w.Schema.Codec().BinaryFromNative(nil, value)
+	return encodeWithSchemaID(w.Schema.Codec(), w.Schema.ID(), value)
+}
+
+func (w wrapSchema) Decode(buf []byte) (interface{}, error) {
+	return decodeWithSchemaID(w.Schema.Codec(), w.Schema.ID(), buf)
+}
+
+func (w wrapSchema) String() string {
+	return fmt.Sprintf("schema(subject=%s,id=%d,version=%d)", w.subject, w.Schema.ID(), w.Schema.Version())
+}
+
+func encodeWithSchemaID(codec *goavro.Codec, schemaID int, value interface{}) ([]byte, error) {
+	payload, err := codec.BinaryFromNative(nil, value)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode value in avro: %w", err)
 	}
 	schemaIDBytes := make([]byte, 4)
-	binary.BigEndian.PutUint32(schemaIDBytes, uint32(w.Schema.ID()))
+	binary.BigEndian.PutUint32(schemaIDBytes, uint32(schemaID))
 
 	// Magic 0 byte + 4 bytes of schema ID + the data bytes
 	bytes := []byte{0}
@@ -49,22 +62,18 @@ func (w wrapSchema) Encode(value interface{}) ([]byte, error) {
 	return bytes, nil
 }
 
-func (w wrapSchema) Decode(buf []byte) (interface{}, error) {
+func decodeWithSchemaID(codec *goavro.Codec, schemaID int, buf []byte) (interface{}, error) {
 	if buf[0] != 0 {
 		return nil, fmt.Errorf("magic byte not 0, instead is %d", buf[0])
 	}
-	schemaID := int(binary.BigEndian.Uint32(buf[1:5]))
-	if schemaID != w.ID() {
-		return nil, fmt.Errorf("decoding message for a different schema, found schema id is %d but expected %d", schemaID, w.ID())
+	foundID := int(binary.BigEndian.Uint32(buf[1:5]))
+	if foundID != schemaID {
+		return nil, fmt.Errorf("decoding message for a different schema, found schema id is %d but expected %d", foundID, schemaID)
 	}
-	value, _, err := w.Schema.Codec().NativeFromBinary(buf[5:])
+	value, _, err := codec.NativeFromBinary(buf[5:])
 	return value, err
 }
 
-func (w wrapSchema) String() string {
-	return fmt.Sprintf("schema(subject=%s,id=%d,version=%d)", w.subject, w.Schema.ID(), w.Schema.Version())
-}
-
 // SubjectFromTopic computes the associated AVRO schema subject name from a kafka topic name.
 func SubjectFromTopic(topic string) string {
 	return fmt.Sprintf("%s-value", topic)
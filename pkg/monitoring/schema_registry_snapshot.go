@@ -0,0 +1,296 @@
+package monitoring
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/linkedin/goavro/v2"
+)
+
+// SchemaSnapshot is the content of a schema registry snapshot file.
+type SchemaSnapshot struct {
+	Subjects map[string]SnapshotSchema `json:"subjects"`
+}
+
+// SnapshotSchema is a schema resolved with the registry.
+type SnapshotSchema struct {
+	ID      int    `json:"id"`
+	Version int    `json:"version"`
+	Spec    string `json:"spec"`
+}
+
+type snapshotSchemaRegistry struct {
+	registry     SchemaRegistry
+	snapshotPath string
+	log          Logger
+
+	mu sync.Mutex
+	// schemas resolved so far, keyed by subject.
+	resolved map[string]*refreshableSchema
+	// schemas loaded from the snapshot, which have to be resolved with the registry, keyed by subject.
+	pending map[string]*refreshableSchema
+}
+
+// NewSnapshotSchemaRegistry wraps registry with a local cache, which is saved to a snapshot file at snapshotPath.
+// While the registry is unavailable, EnsureSchema returns the schemas from the snapshot, as long as their spec did not
+// change. These are resolved with the registry again every refreshInterval, until ctx is done, after which they use
+// the IDs assigned by the registry.
+func NewSnapshotSchemaRegistry(
+	ctx context.Context,
+	log Logger,
+	registry SchemaRegistry,
+	snapshotPath string,
+	refreshInterval time.Duration,
+) SchemaRegistry {
+	s := &snapshotSchemaRegistry{
+		registry,
+		snapshotPath,
+		log,
+		sync.Mutex{},
+		map[string]*refreshableSchema{},
+		map[string]*refreshableSchema{},
+	}
+	go s.run(ctx, refreshInterval)
+	return s
+}
+
+// EnsureSchema does not hold mu while calling the registry, like refresh.
+func (s *snapshotSchemaRegistry) EnsureSchema(subject, spec string) (Schema, error) {
+	s.mu.Lock()
+	if cached, ok := s.resolved[subject]; ok && cached.spec == spec {
+		s.mu.Unlock()
+		return cached, nil
+	}
+	s.mu.Unlock()
+
+	schema, err := s.registry.EnsureSchema(subject, spec)
+	if err == nil {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		resolved, ok := s.resolved[subject]
+		if ok && resolved.spec == spec {
+			// Loaded from the snapshot in the meantime, so update it for the callers which got it.
+			resolved.set(schema)
+		} else {
+			resolved = &refreshableSchema{spec: spec, schema: schema}
+			s.resolved[subject] = resolved
+		}
+		delete(s.pending, subject)
+		if saveErr := s.saveSnapshot(); saveErr != nil {
+			s.log.Errorw("failed to save schema registry snapshot", "path", s.snapshotPath, "error", saveErr)
+		}
+		return resolved, nil
+	}
+	offline, snapshotErr := s.loadFromSnapshot(subject, spec)
+	if snapshotErr != nil {
+		return nil, errors.Join(err, snapshotErr)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if cached, ok := s.resolved[subject]; ok && cached.spec == spec {
+		// Resolved, or loaded, by another caller in the meantime.
+		return cached, nil
+	}
+	s.log.Warnw("schema registry unavailable, using schema from snapshot",
+		"subject", subject, "id", offline.ID(), "version", offline.Version(), "error", err)
+	loaded := &refreshableSchema{spec: spec, schema: offline}
+	s.resolved[subject] = loaded
+	s.pending[subject] = loaded
+	return loaded, nil
+}
+
+func (s *snapshotSchemaRegistry) loadFromSnapshot(subject, spec string) (Schema, error) {
+	snapshot, err := ReadSchemaSnapshot(s.snapshotPath)
+	if err != nil {
+		return nil, err
+	}
+	saved, ok := snapshot.Subjects[subject]
+	if !ok {
+		return nil, fmt.Errorf("subject '%s' not found in schema registry snapshot", subject)
+	}
+	isEqualSchemas, err := isEqualJSON(saved.Spec, spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compare schema in snapshot with local schema: %w", err)
+	}
+	if !isEqualSchemas {
+		return nil, fmt.Errorf("schema for subject '%s' in snapshot differs from the local schema", subject)
+	}
+	return newSnapshotSchema(subject, saved)
+}
+
+// saveSnapshot must be called with mu held.
+func (s *snapshotSchemaRegistry) saveSnapshot() error {
+	snapshot := SchemaSnapshot{Subjects: map[string]SnapshotSchema{}}
+	if existing, err := ReadSchemaSnapshot(s.snapshotPath); err == nil {
+		// Keep the subjects of other monitors sharing the snapshot.
+		snapshot = existing
+	}
+	for subject, schema := range s.resolved {
+		if _, isPending := s.pending[subject]; isPending {
+			continue
+		}
+		snapshot.Subjects[subject] = SnapshotSchema{schema.ID(), schema.Version(), schema.spec}
+	}
+	buf, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(s.snapshotPath), filepath.Base(s.snapshotPath)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(buf); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.snapshotPath)
+}
+
+// run should be executed as a goroutine.
+func (s *snapshotSchemaRegistry) run(ctx context.Context, refreshInterval time.Duration) {
+	ticker := time.NewTicker(refreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.refresh(); err != nil {
+				s.log.Debugw("schema registry still unavailable", "error", err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// refresh resolves the schemas loaded from the snapshot with the registry. It does not hold mu while calling the
+// registry, and returns the errors of all the subjects which failed to resolve.
+func (s *snapshotSchemaRegistry) refresh() error {
+	s.mu.Lock()
+	pending := make(map[string]*refreshableSchema, len(s.pending))
+	for subject, loaded := range s.pending {
+		pending[subject] = loaded
+	}
+	s.mu.Unlock()
+	if len(pending) == 0 {
+		return nil
+	}
+
+	var errs []error
+	resolved := map[string]Schema{}
+	for subject, loaded := range pending {
+		schema, err := s.registry.EnsureSchema(subject, loaded.spec)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to resolve subject '%s': %w", subject, err))
+			continue
+		}
+		resolved[subject] = schema
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var reconciled bool
+	for subject, schema := range resolved {
+		loaded := pending[subject]
+		if s.pending[subject] != loaded {
+			continue // resolved by EnsureSchema in the meantime
+		}
+		if schema.ID() != loaded.ID() {
+			s.log.Warnw("schema ID in registry differs from snapshot, using the registry's",
+				"subject", subject, "snapshotID", loaded.ID(), "registryID", schema.ID())
+		} else {
+			s.log.Infow("schema from snapshot reconciled with registry", "subject", subject, "id", schema.ID())
+		}
+		loaded.set(schema)
+		delete(s.pending, subject)
+		reconciled = true
+	}
+	if reconciled {
+		if err := s.saveSnapshot(); err != nil {
+			s.log.Errorw("failed to save schema registry snapshot", "path", s.snapshotPath, "error", err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ReadSchemaSnapshot reads the schema registry snapshot file at path.
+func ReadSchemaSnapshot(path string) (SchemaSnapshot, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return SchemaSnapshot{}, fmt.Errorf("failed to read schema registry snapshot: %w", err)
+	}
+	var snapshot SchemaSnapshot
+	if err := json.Unmarshal(buf, &snapshot); err != nil {
+		return SchemaSnapshot{}, fmt.Errorf("failed to parse schema registry snapshot '%s': %w", path, err)
+	}
+	if snapshot.Subjects == nil {
+		snapshot.Subjects = map[string]SnapshotSchema{}
+	}
+	return snapshot, nil
+}
+
+// refreshableSchema delegates to a Schema, which is replaced once a schema loaded from a snapshot is resolved with
+// the registry.
+type refreshableSchema struct {
+	spec string
+
+	mu     sync.RWMutex
+	schema Schema
+}
+
+func (r *refreshableSchema) get() Schema {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.schema
+}
+
+func (r *refreshableSchema) set(schema Schema) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.schema = schema
+}
+
+func (r *refreshableSchema) ID() int                                  { return r.get().ID() }
+func (r *refreshableSchema) Version() int                             { return r.get().Version() }
+func (r *refreshableSchema) Subject() string                          { return r.get().Subject() }
+func (r *refreshableSchema) Encode(value interface{}) ([]byte, error) { return r.get().Encode(value) }
+func (r *refreshableSchema) Decode(buf []byte) (interface{}, error)   { return r.get().Decode(buf) }
+
+type snapshotSchema struct {
+	subject string
+	saved   SnapshotSchema
+	codec   *goavro.Codec
+}
+
+func newSnapshotSchema(subject string, saved SnapshotSchema) (Schema, error) {
+	codec, err := goavro.NewCodec(saved.Spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse schema for subject '%s' from snapshot: %w", subject, err)
+	}
+	return snapshotSchema{subject, saved, codec}, nil
+}
+
+func (s snapshotSchema) ID() int         { return s.saved.ID }
+func (s snapshotSchema) Version() int    { return s.saved.Version }
+func (s snapshotSchema) Subject() string { return s.subject }
+
+func (s snapshotSchema) Encode(value interface{}) ([]byte, error) {
+	return encodeWithSchemaID(s.codec, s.saved.ID, value)
+}
+
+func (s snapshotSchema) Decode(buf []byte) (interface{}, error) {
+	return decodeWithSchemaID(s.codec, s.saved.ID, buf)
+}
+
+func (s snapshotSchema) String() string {
+	return fmt.Sprintf("snapshot(subject=%s,id=%d,version=%d)", s.subject, s.saved.ID, s.saved.Version)
+}
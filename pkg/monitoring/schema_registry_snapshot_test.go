@@ -0,0 +1,195 @@
+package monitoring
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+)
+
+type fakeSchemaRegistry struct {
+	mu    sync.Mutex
+	id    int
+	err   error
+	calls int
+	// subjectErrs optionally fail individual subjects.
+	subjectErrs map[string]error
+	// block optionally delays replies until it is closed.
+	block chan struct{}
+}
+
+func (f *fakeSchemaRegistry) EnsureSchema(subject, spec string) (Schema, error) {
+	f.mu.Lock()
+	f.calls++
+	id, err, block := f.id, f.err, f.block
+	if err == nil {
+		err = f.subjectErrs[subject]
+	}
+	f.mu.Unlock()
+	if block != nil {
+		<-block
+	}
+	if err != nil {
+		return nil, err
+	}
+	return newSnapshotSchema(subject, SnapshotSchema{id, 1, spec})
+}
+
+func (f *fakeSchemaRegistry) set(id int, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.id, f.err = id, err
+}
+
+func (f *fakeSchemaRegistry) numCalls() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls
+}
+
+func TestSnapshotSchemaRegistry(t *testing.T) {
+	defer goleak.VerifyNone(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	t.Run("resolved schemas are cached and saved", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "schemas.json")
+		backend := &fakeSchemaRegistry{id: 3}
+		registry := NewSnapshotSchemaRegistry(ctx, newNullLogger(), backend, path, time.Hour)
+
+		schema, err := registry.EnsureSchema("person-value", baseSchema)
+		require.NoError(t, err)
+		assert.Equal(t, 3, schema.ID())
+		cached, err := registry.EnsureSchema("person-value", baseSchema)
+		require.NoError(t, err)
+		assert.Equal(t, schema, cached)
+		assert.Equal(t, 1, backend.numCalls())
+
+		snapshot, err := ReadSchemaSnapshot(path)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]SnapshotSchema{"person-value": {3, 1, baseSchema}}, snapshot.Subjects)
+	})
+
+	t.Run("schemas are loaded from the snapshot while the registry is unavailable", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "schemas.json")
+		_, err := NewSnapshotSchemaRegistry(ctx, newNullLogger(), &fakeSchemaRegistry{id: 3}, path, time.Hour).
+			EnsureSchema("person-value", baseSchema)
+		require.NoError(t, err)
+
+		backend := &fakeSchemaRegistry{err: errors.New("registry unavailable")}
+		registry := NewSnapshotSchemaRegistry(ctx, newNullLogger(), backend, path, 10*time.Millisecond)
+		schema, err := registry.EnsureSchema("person-value", baseSchema)
+		require.NoError(t, err)
+		assert.Equal(t, 3, schema.ID())
+		assert.Equal(t, "person-value", schema.Subject())
+		encoded, err := schema.Encode(map[string]interface{}{"name": "test"})
+		require.NoError(t, err)
+		assert.Equal(t, []byte{0x0, 0x0, 0x0, 0x0, 0x3, 0x8, 0x74, 0x65, 0x73, 0x74}, encoded)
+
+		// The registry returns, with a different ID.
+		backend.set(5, nil)
+		require.Eventually(t, func() bool { return schema.ID() == 5 }, time.Second, 5*time.Millisecond)
+		encoded, err = schema.Encode(map[string]interface{}{"name": "test"})
+		require.NoError(t, err)
+		assert.Equal(t, []byte{0x0, 0x0, 0x0, 0x0, 0x5, 0x8, 0x74, 0x65, 0x73, 0x74}, encoded)
+
+		snapshot, err := ReadSchemaSnapshot(path)
+		require.NoError(t, err)
+		assert.Equal(t, 5, snapshot.Subjects["person-value"].ID)
+	})
+
+	t.Run("refresh resolves every subject it can without blocking readers", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "schemas.json")
+		initial := NewSnapshotSchemaRegistry(ctx, newNullLogger(), &fakeSchemaRegistry{id: 3}, path, time.Hour)
+		for _, subject := range []string{"other-value", "person-value"} {
+			_, err := initial.EnsureSchema(subject, baseSchema)
+			require.NoError(t, err)
+		}
+
+		backend := &fakeSchemaRegistry{err: errors.New("registry unavailable")}
+		registry := NewSnapshotSchemaRegistry(ctx, newNullLogger(), backend, path, time.Hour)
+		schemas := map[string]Schema{}
+		for _, subject := range []string{"other-value", "person-value"} {
+			schema, err := registry.EnsureSchema(subject, baseSchema)
+			require.NoError(t, err)
+			schemas[subject] = schema
+		}
+
+		backend.mu.Lock()
+		backend.id, backend.err = 5, nil
+		backend.subjectErrs = map[string]error{"other-value": errors.New("incompatible schema")}
+		backend.block = make(chan struct{})
+		calls := backend.calls
+		backend.mu.Unlock()
+		refreshed := make(chan error, 1)
+		go func() { refreshed <- registry.(*snapshotSchemaRegistry).refresh() }()
+		require.Eventually(t, func() bool { return backend.numCalls() > calls }, time.Second, 5*time.Millisecond)
+
+		// The registry is being called, but cached schemas are still available.
+		schema, err := registry.EnsureSchema("person-value", baseSchema)
+		require.NoError(t, err)
+		assert.Equal(t, 3, schema.ID())
+
+		close(backend.block)
+		err = <-refreshed
+		require.ErrorContains(t, err, "failed to resolve subject 'other-value': incompatible schema")
+		assert.Equal(t, 5, schemas["person-value"].ID())
+		assert.Equal(t, 3, schemas["other-value"].ID())
+	})
+
+	t.Run("EnsureSchema does not block readers while calling the registry", func(t *testing.T) {
+		backend := &fakeSchemaRegistry{id: 3}
+		registry := NewSnapshotSchemaRegistry(ctx, newNullLogger(), backend, filepath.Join(t.TempDir(), "schemas.json"), time.Hour)
+		_, err := registry.EnsureSchema("person-value", baseSchema)
+		require.NoError(t, err)
+
+		backend.mu.Lock()
+		backend.block = make(chan struct{})
+		calls := backend.calls
+		backend.mu.Unlock()
+		ensured := make(chan error, 1)
+		go func() {
+			_, err := registry.EnsureSchema("other-value", baseSchema)
+			ensured <- err
+		}()
+		require.Eventually(t, func() bool { return backend.numCalls() > calls }, time.Second, 5*time.Millisecond)
+
+		schema, err := registry.EnsureSchema("person-value", baseSchema)
+		require.NoError(t, err)
+		assert.Equal(t, 3, schema.ID())
+
+		close(backend.block)
+		require.NoError(t, <-ensured)
+	})
+
+	t.Run("schemas in the snapshot must match", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "schemas.json")
+		_, err := NewSnapshotSchemaRegistry(ctx, newNullLogger(), &fakeSchemaRegistry{id: 3}, path, time.Hour).
+			EnsureSchema("person-value", baseSchema)
+		require.NoError(t, err)
+
+		backend := &fakeSchemaRegistry{err: errors.New("registry unavailable")}
+		registry := NewSnapshotSchemaRegistry(ctx, newNullLogger(), backend, path, time.Hour)
+		_, err = registry.EnsureSchema("person-value", extendedSchema)
+		require.ErrorContains(t, err, "registry unavailable")
+		require.ErrorContains(t, err, "differs from the local schema")
+		_, err = registry.EnsureSchema("other-value", baseSchema)
+		require.ErrorContains(t, err, "subject 'other-value' not found in schema registry snapshot")
+	})
+
+	t.Run("missing snapshot", func(t *testing.T) {
+		backend := &fakeSchemaRegistry{err: errors.New("registry unavailable")}
+		registry := NewSnapshotSchemaRegistry(ctx, newNullLogger(), backend, filepath.Join(t.TempDir(), "schemas.json"), time.Hour)
+		_, err := registry.EnsureSchema("person-value", baseSchema)
+		require.ErrorContains(t, err, "registry unavailable")
+		require.ErrorContains(t, err, "failed to read schema registry snapshot")
+	})
+
+	cancel()
+}
@@ -68,6 +68,16 @@ var transmissionAvroSchema = avro.Record("transmission", avro.Opts{Namespace: "l
 		avro.Null,
 		avro.Decimal("transmission_link_balance", 32, 78, 0),
 	}),
+	// These fields are "optional", and only set for feeds with multiple transmitter accounts, or fronted by a proxy.
+	avro.Field("transmitter", avro.Opts{Default: avro.NullValue, Doc: "sender of the latest transmission"}, avro.Union{avro.Null, avro.String}),
+	avro.Field("transmitter_accounts", avro.Opts{Default: avro.NullValue, Doc: "every account which may transmit"}, avro.Union{
+		avro.Null,
+		avro.Array(avro.String),
+	}),
+	avro.Field("proxy_address", avro.Opts{Default: avro.NullValue}, avro.Union{avro.Null, avro.String}),
+	// These fields are "optional", and only set for new transmissions.
+	avro.Field("transmission_interval", avro.Opts{Default: avro.NullValue, Doc: "nanoseconds since the previous transmission"}, avro.Union{avro.Null, avro.Long}),
+	avro.Field("round_duration", avro.Opts{Default: avro.NullValue, Doc: "average nanoseconds per round since the last transmission observed"}, avro.Union{avro.Null, avro.Long}),
 })
 
 var configSetSimplifiedAvroSchema = avro.Record("config_set_simplified", avro.Opts{Namespace: "link.chain.ocr2"}, avro.Fields{
@@ -0,0 +1,58 @@
+package monitoring
+
+import (
+	"context"
+	"math/big"
+)
+
+// Balance is the amount of a token held by an account of a feed.
+type Balance struct {
+	// Address of the account.
+	Account string
+	// Role of the account for the feed, e.g. "aggregator" or "transmitter".
+	Role string
+	// Symbol of the token, e.g. "LINK", or the symbol of the chain's native token.
+	Token string
+	// Decimals of the token, which convert Amount to whole tokens.
+	Decimals uint8
+	// Amount in the smallest denomination of the token.
+	Amount *big.Int
+}
+
+// Balances is the output of the sources created by NewBalanceSourceFactory.
+type Balances []Balance
+
+// BalanceReader reads the balances of the accounts of a feed, usually the LINK balance of its aggregator contract and
+// the native balances of its transmitters. It is implemented by each chain integration.
+type BalanceReader interface {
+	// ReadBalances must be thread-safe!
+	ReadBalances(ctx context.Context, chainConfig ChainConfig, feedConfig FeedConfig) (Balances, error)
+}
+
+// NewBalanceSourceFactory returns a factory for sources which read the balances of feeds with reader.
+// Use NewBalanceExporterFactory to export them.
+func NewBalanceSourceFactory(reader BalanceReader) SourceFactory {
+	return &balanceSourceFactory{reader}
+}
+
+type balanceSourceFactory struct {
+	reader BalanceReader
+}
+
+func (b *balanceSourceFactory) NewSource(chainConfig ChainConfig, feedConfig FeedConfig) (Source, error) {
+	return &balanceSource{b.reader, chainConfig, feedConfig}, nil
+}
+
+func (b *balanceSourceFactory) GetType() string {
+	return "balances"
+}
+
+type balanceSource struct {
+	reader      BalanceReader
+	chainConfig ChainConfig
+	feedConfig  FeedConfig
+}
+
+func (b *balanceSource) Fetch(ctx context.Context) (interface{}, error) {
+	return b.reader.ReadBalances(ctx, b.chainConfig, b.feedConfig)
+}
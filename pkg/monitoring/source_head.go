@@ -0,0 +1,55 @@
+package monitoring
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Head is the latest block of a chain.
+type Head struct {
+	Height uint64
+	// Timestamp of the block. Zero if the chain does not have block timestamps.
+	Timestamp time.Time
+}
+
+// HeadError is fetched by the source of NewHeadSource in place of a Head when the head cannot be read, so that the
+// exporter keeps updating the stall and age of the chain while its RPC is failing.
+type HeadError struct {
+	Err error
+}
+
+// HeadReader reads the latest head of a chain. It is implemented by each chain integration.
+type HeadReader interface {
+	// ReadHead must be thread-safe!
+	ReadHead(ctx context.Context, chainConfig ChainConfig) (Head, error)
+}
+
+// HeadReaderFunc adapts a function to a HeadReader.
+type HeadReaderFunc func(ctx context.Context, chainConfig ChainConfig) (Head, error)
+
+func (f HeadReaderFunc) ReadHead(ctx context.Context, chainConfig ChainConfig) (Head, error) {
+	return f(ctx, chainConfig)
+}
+
+// NewHeadSource returns a source which reads the head of the chain of chainConfig with reader. Unlike the sources of
+// a SourceFactory, there is one per chain rather than per feed. Use NewHeadExporter to export the heads.
+func NewHeadSource(reader HeadReader, chainConfig ChainConfig) Source {
+	return &headSource{reader, chainConfig}
+}
+
+type headSource struct {
+	reader      HeadReader
+	chainConfig ChainConfig
+}
+
+func (h *headSource) Fetch(ctx context.Context) (interface{}, error) {
+	head, err := h.reader.ReadHead(ctx, h.chainConfig)
+	if err != nil {
+		if errors.Is(err, ErrNoUpdate) || errors.Is(ctx.Err(), context.Canceled) {
+			return nil, err
+		}
+		return HeadError{Err: err}, nil
+	}
+	return head, nil
+}
@@ -48,3 +48,7 @@ func (i *instrumentedSource) Fetch(ctx context.Context) (interface{}, error) {
 	}
 	return data, err
 }
+
+func (i *instrumentedSource) observeFetchTimeout() {
+	i.feedMetrics.IncFetchFromSourceTimedOut(i.sourceType)
+}
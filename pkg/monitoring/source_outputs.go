@@ -29,6 +29,19 @@ type Envelope struct {
 	// The "fee coin" is different for each chain.
 	JuelsPerFeeCoin   *big.Int
 	AggregatorRoundID uint32
+
+	// optional, for feeds with multiple transmitter accounts per oracle.
+	// Lists every account which may transmit, including Transmitter, which is always the sender of the latest transmission.
+	TransmitterAccounts []types.Account
+	// optional, for feeds fronted by a proxy contract, which aggregates rounds across the underlying contracts.
+	ProxyAddress string
+
+	// set by the monitor, when the envelope holds a new transmission of the feed.
+	// TransmissionInterval is the time since the previous transmission. It is only set if the previous transmission
+	// was observed, ie. AggregatorRoundID advanced by one, or is not used by the chain.
+	TransmissionInterval time.Duration
+	// RoundDuration is the average duration of the rounds since the last transmission observed by the monitor.
+	RoundDuration time.Duration
 }
 
 // TxResults counts the number of successful and failed transactions in a predetermined window of time.
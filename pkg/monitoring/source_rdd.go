@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"sync"
 
+	"github.com/smartcontractkit/chainlink-relay/pkg/httpclient"
 	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
 )
 
@@ -24,6 +25,7 @@ type rddSource struct {
 	nodesURL       string
 	nodesParser    NodesParser
 	httpClient     *http.Client
+	limiter        *utils.RateLimiter // optional, keyed by host
 	log            Logger
 }
 
@@ -35,13 +37,30 @@ func NewRDDSource(
 	nodesParser NodesParser,
 	log Logger,
 ) Source {
+	return NewRateLimitedRDDSource(feedsURL, feedsParser, feedsIgnoreIDs, nodesURL, nodesParser, nil, log)
+}
+
+// NewRateLimitedRDDSource is like NewRDDSource, except that requests to each host wait for limiter, which may be
+// shared with other sources.
+func NewRateLimitedRDDSource(
+	feedsURL string,
+	feedsParser FeedsParser,
+	feedsIgnoreIDs []string,
+	nodesURL string,
+	nodesParser NodesParser,
+	limiter *utils.RateLimiter,
+	log Logger,
+) Source {
+	// DefaultConfig is always valid.
+	httpClient, _ := httpclient.New(httpclient.DefaultConfig, log)
 	return &rddSource{
 		feedsURL,
 		feedsParser,
 		makeSet(feedsIgnoreIDs),
 		nodesURL,
 		nodesParser,
-		&http.Client{},
+		httpClient,
+		limiter,
 		log,
 	}
 }
@@ -80,7 +99,7 @@ func (r *rddSource) fetchFeeds(ctx context.Context) ([]FeedConfig, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to build a request to get feeds from the RDD: %w", err)
 	}
-	res, err := r.httpClient.Do(readFeedsReq)
+	res, err := r.do(readFeedsReq)
 	if err != nil {
 		return nil, fmt.Errorf("unable to fetch feeds RDD data: %w", err)
 	}
@@ -96,6 +115,7 @@ func (r *rddSource) fetchFeeds(ctx context.Context) ([]FeedConfig, error) {
 // filterFeeds removes feeds that:
 // - have status=="dead"
 // - have their ID specified in FEEDS_IGNORE_IDS env var.
+// - are mercury feeds with an invalid feed ID.
 func (r *rddSource) filterFeeds(feeds []FeedConfig) []FeedConfig {
 	out := []FeedConfig{}
 	for _, feed := range feeds {
@@ -103,6 +123,12 @@ func (r *rddSource) filterFeeds(feeds []FeedConfig) []FeedConfig {
 			r.log.Infow("ignoring feed because of contract_status=dead", "feed_id", feed.GetID())
 			continue
 		}
+		if mercury, ok := feed.(MercuryFeedConfig); ok {
+			if err := mercury.GetFeedID().Validate(); err != nil {
+				r.log.Errorw("ignoring mercury feed with an invalid feed ID", "feed_id", feed.GetID(), "error", err)
+				continue
+			}
+		}
 		if _, isIgnored := r.feedsIgnoreIDs[feed.GetID()]; isIgnored {
 			r.log.Debugw("skipping feed because of it is marked as ignored in the FEEDS_IGNORE_IDS env var", "feed_id", feed.GetID())
 			continue
@@ -117,7 +143,7 @@ func (r *rddSource) fetchNodes(ctx context.Context) ([]NodeConfig, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to build a request to get nodes from the RDD: %w", err)
 	}
-	res, err := r.httpClient.Do(readFeedsReq)
+	res, err := r.do(readFeedsReq)
 	if err != nil {
 		return nil, fmt.Errorf("unable to fetch nodes RDD data: %w", err)
 	}
@@ -129,6 +155,14 @@ func (r *rddSource) fetchNodes(ctx context.Context) ([]NodeConfig, error) {
 	return nodes, nil
 }
 
+// do sends req once the rate limit of its host allows.
+func (r *rddSource) do(req *http.Request) (*http.Response, error) {
+	if err := r.limiter.Wait(req.Context(), req.URL.Host); err != nil {
+		return nil, fmt.Errorf("rate limited: %w", err)
+	}
+	return r.httpClient.Do(req)
+}
+
 // Helpers
 
 func makeSet(ids []string) map[string]struct{} {
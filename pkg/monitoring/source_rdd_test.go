@@ -2,15 +2,21 @@ package monitoring
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink-relay/pkg/monitoring/config"
+	relaytypes "github.com/smartcontractkit/chainlink-relay/pkg/types"
+	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
 )
 
 func TestRDDSource(t *testing.T) {
@@ -42,6 +48,15 @@ func TestRDDSource(t *testing.T) {
 			require.NotContains(t, cfg.Feeds.IgnoreIDs, feed.GetID())
 		}
 	})
+	t.Run("should filter out mercury feeds with invalid feed IDs", func(t *testing.T) {
+		source := NewRDDSource("no-feeds", fakeFeedsParser, nil, "no-nodes", fakeNodesParser, newNullLogger()).(*rddSource)
+		valid := fakeMercuryFeedConfig{feedID: relaytypes.FeedID{1: byte(relaytypes.FeedV3), 31: 1}}
+		invalid := fakeMercuryFeedConfig{feedID: relaytypes.FeedID{1: 42, 31: 2}}
+		feeds := source.filterFeeds([]FeedConfig{valid, invalid, fakeFeedConfig{ContractAddressEncoded: "other"}})
+		require.Len(t, feeds, 2)
+		require.Equal(t, valid.feedID.String(), feeds[0].GetID())
+		require.Equal(t, "other", feeds[1].GetID())
+	})
 	t.Run("should fetch feeds and nodes data", func(t *testing.T) {
 		feedsSrv := serveJSON(t, "./fixtures/feeds.json")
 		defer feedsSrv.Close()
@@ -64,10 +79,46 @@ func TestRDDSource(t *testing.T) {
 		require.Len(t, data.Feeds, 4)
 		require.Len(t, data.Nodes, 2)
 	})
+	t.Run("should wait for the rate limit of the host", func(t *testing.T) {
+		srv := serveJSON(t, "./fixtures/feeds.json")
+		defer srv.Close()
+		clock := utils.NewFakeClock(time.Now())
+		limiter := utils.NewRateLimiter(utils.RateLimit{Rate: 1}, clock)
+		source := NewRateLimitedRDDSource(srv.URL, fakeFeedsParser, nil, srv.URL, fakeNodesParser, limiter, newNullLogger()).(*rddSource)
+		_, err := source.fetchFeeds(context.Background())
+		require.NoError(t, err)
+
+		fetched := make(chan error)
+		go func() {
+			_, err := source.fetchNodes(context.Background())
+			fetched <- err
+		}()
+		clock.BlockUntil(1)
+		select {
+		case <-fetched:
+			t.Fatal("fetched before the rate limit allowed")
+		default:
+		}
+		clock.Advance(time.Second)
+		require.NoError(t, <-fetched)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err = source.fetchFeeds(ctx)
+		require.ErrorIs(t, err, context.Canceled)
+	})
 }
 
 // Helpers
 
+type fakeMercuryFeedConfig struct {
+	fakeFeedConfig
+	feedID relaytypes.FeedID
+}
+
+func (f fakeMercuryFeedConfig) GetID() string                { return f.feedID.String() }
+func (f fakeMercuryFeedConfig) GetFeedID() relaytypes.FeedID { return f.feedID }
+
 func serveJSON(t *testing.T, path string) *httptest.Server {
 	data, err := os.ReadFile(path)
 	require.NoError(t, err)
@@ -77,3 +128,26 @@ func serveJSON(t *testing.T, path string) *httptest.Server {
 		require.NoError(t, err)
 	}))
 }
+
+func TestValidatingNodesParser(t *testing.T) {
+	require.NoError(t, relaytypes.RegisterAccountValidator("monitoring-test-prefix", func(account string) error {
+		if !strings.HasPrefix(account, "6rR") {
+			return errors.New("unexpected prefix")
+		}
+		return nil
+	}))
+	parse := func(family string) ([]NodeConfig, error) {
+		f, err := os.Open("./fixtures/nodes.json")
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = f.Close() })
+		return ValidatingNodesParser(newNullLogger(), family, fakeNodesParser)(f)
+	}
+
+	_, err := parse("monitoring-test-unregistered")
+	require.ErrorContains(t, err, "no account validator registered")
+
+	nodes, err := parse("monitoring-test-prefix")
+	require.NoError(t, err)
+	require.Len(t, nodes, 1, "the invalid node is skipped")
+	assert.Equal(t, types.Account("6rRiMihF7UdJz25t5QvS7PgP9yzfubN7TBRv26ZBVAhE"), nodes[0].GetAccount())
+}
@@ -193,7 +193,8 @@ func (f *fakeExporter) Export(ctx context.Context, data interface{}) {
 	}
 }
 
-func (f *fakeExporter) Cleanup(_ context.Context) {
+func (f *fakeExporter) Cleanup(_ context.Context) error {
+	return nil
 }
 
 // Generators
@@ -0,0 +1,36 @@
+package monitoring
+
+import (
+	"time"
+)
+
+// transmissionTracker measures the time between the transmissions of a feed, from its envelopes.
+// It is not thread-safe: envelopes must be tracked in order, as they are exported.
+type transmissionTracker struct {
+	seen      bool
+	timestamp time.Time
+	roundID   uint32
+}
+
+// track sets the TransmissionInterval and RoundDuration of envelope, and returns true, if it holds a new transmission.
+func (t *transmissionTracker) track(envelope *Envelope) bool {
+	if !t.seen {
+		t.seen = true
+		t.timestamp, t.roundID = envelope.LatestTimestamp, envelope.AggregatorRoundID
+		return false
+	}
+	if !envelope.LatestTimestamp.After(t.timestamp) {
+		return false
+	}
+	interval := envelope.LatestTimestamp.Sub(t.timestamp)
+	rounds := uint32(1)
+	if envelope.AggregatorRoundID > t.roundID {
+		rounds = envelope.AggregatorRoundID - t.roundID
+	}
+	if rounds == 1 {
+		envelope.TransmissionInterval = interval
+	}
+	envelope.RoundDuration = interval / time.Duration(rounds)
+	t.timestamp, t.roundID = envelope.LatestTimestamp, envelope.AggregatorRoundID
+	return true
+}
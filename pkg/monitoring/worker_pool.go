@@ -0,0 +1,320 @@
+package monitoring
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
+)
+
+// feedQueue holds the pending updates for a single feed, up to capacity.
+// A feedQueue is held by at most one worker at a time, so exporters receive a feed's updates in order.
+type feedQueue struct {
+	log       Logger
+	exporters []Exporter
+	metrics   FeedMetrics
+	capacity  int
+
+	mu         sync.Mutex
+	updates    []interface{}
+	scheduled  bool // true while waiting for, or held by, a worker
+	closed     bool // true once the feed is removed from the pool
+	lastUpdate time.Time
+
+	// only used by the worker holding the queue
+	transmissions  transmissionTracker
+	skipDuplicates bool
+	lastRound      *envelopeRound
+	skipped        int // since lastSkipLog
+	lastSkipLog    time.Time
+}
+
+// skipLogInterval throttles logging skipped duplicate envelopes.
+const skipLogInterval = time.Minute
+
+// envelopeRound identifies the round and config reported by an envelope, to detect duplicates.
+type envelopeRound struct {
+	configDigest         types.ConfigDigest
+	epoch                uint32
+	round                uint8
+	latestTimestamp      int64
+	contractConfigDigest types.ConfigDigest
+}
+
+func newEnvelopeRound(envelope Envelope) *envelopeRound {
+	return &envelopeRound{
+		configDigest:         envelope.ConfigDigest,
+		epoch:                envelope.Epoch,
+		round:                envelope.Round,
+		latestTimestamp:      envelope.LatestTimestamp.UnixNano(),
+		contractConfigDigest: envelope.ContractConfig.ConfigDigest,
+	}
+}
+
+// newFeedQueue returns a queue for the updates of a feed. If skipDuplicates is true, envelopes which repeat the
+// previous one are not exported by exporters implementing [DuplicateSkippingExporter].
+func newFeedQueue(log Logger, exporters []Exporter, metrics FeedMetrics, capacity int, skipDuplicates bool) *feedQueue {
+	return &feedQueue{
+		log:            log,
+		exporters:      exporters,
+		metrics:        metrics,
+		capacity:       capacity,
+		updates:        make([]interface{}, 0, capacity),
+		skipDuplicates: skipDuplicates,
+	}
+}
+
+// push adds an update to the queue, dropping the oldest update if the queue is full.
+// It returns true if the queue must be scheduled for a worker.
+func (q *feedQueue) push(update interface{}) (schedule bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return false
+	}
+	if len(q.updates) >= q.capacity {
+		q.updates[0] = nil
+		q.updates = q.updates[1:]
+		q.metrics.IncFeedMonitorUpdatesDropped()
+		q.log.Debugw("dropped update because the feed queue is full", "capacity", q.capacity)
+	}
+	q.updates = append(q.updates, update)
+	q.lastUpdate = time.Now()
+	if q.scheduled {
+		return false
+	}
+	q.scheduled = true
+	return true
+}
+
+// pop removes the oldest update from the queue.
+// If the queue is empty or closed, it is unscheduled and ok is false.
+func (q *feedQueue) pop() (update interface{}, ok bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed || len(q.updates) == 0 {
+		q.scheduled = false
+		return nil, false
+	}
+	update = q.updates[0]
+	q.updates[0] = nil
+	q.updates = q.updates[1:]
+	return update, true
+}
+
+// close drops the pending updates, and stops accepting new ones.
+// It returns true if the queue is not held by a worker, in which case it can be cleaned up immediately. Otherwise, the
+// worker holding it cleans it up once it is released.
+func (q *feedQueue) close() (idle bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.closed = true
+	q.updates = nil
+	return !q.scheduled
+}
+
+func (q *feedQueue) isClosed() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.closed
+}
+
+// status returns the time of the latest update, and the number of updates waiting to be exported.
+func (q *feedQueue) status() (lastUpdate time.Time, depth int) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.lastUpdate, len(q.updates)
+}
+
+func (q *feedQueue) export(ctx context.Context, update interface{}) {
+	var duplicate bool
+	if envelope, ok := update.(Envelope); ok {
+		if q.transmissions.track(&envelope) {
+			if envelope.TransmissionInterval != 0 {
+				q.metrics.ObserveTransmissionInterval(envelope.TransmissionInterval)
+			}
+			q.metrics.ObserveRoundDuration(envelope.RoundDuration)
+			update = envelope
+		}
+		duplicate = q.isDuplicate(envelope)
+	}
+	for index, exp := range q.exporters {
+		if duplicate {
+			if s, ok := exp.(DuplicateSkippingExporter); ok && s.SkipsDuplicates() {
+				continue
+			}
+		}
+		func() {
+			defer func() {
+				if err := recover(); err != nil {
+					q.log.Errorw("failed Export", "error", err, "index", index)
+				}
+			}()
+			exp.Export(ctx, update)
+		}()
+	}
+}
+
+// isDuplicate returns true if envelope repeats the previous one, and duplicates are skipped. Skipped envelopes are
+// counted, and logged periodically.
+func (q *feedQueue) isDuplicate(envelope Envelope) bool {
+	if !q.skipDuplicates {
+		return false
+	}
+	round := newEnvelopeRound(envelope)
+	if q.lastRound == nil || *round != *q.lastRound {
+		q.lastRound = round
+		return false
+	}
+	q.metrics.IncFeedMonitorEnvelopesSkipped()
+	q.skipped++
+	if now := time.Now(); now.Sub(q.lastSkipLog) >= skipLogInterval {
+		q.log.Debugw("skipped exporting duplicate envelopes", "count", q.skipped, "epoch", round.epoch, "round", round.round)
+		q.skipped = 0
+		q.lastSkipLog = now
+	}
+	return true
+}
+
+func (q *feedQueue) cleanup() {
+	cleanupExporters(q.log, q.metrics, q.exporters)
+}
+
+// workerPool exports the updates of many feeds with a fixed number of workers.
+// Feeds with pending updates take turns, one update at a time, so a busy feed can not starve the others.
+// Feeds can be added and removed while the pool runs.
+type workerPool struct {
+	workers int
+	wake    chan struct{} // signalled when a queue becomes ready
+
+	mu     sync.Mutex
+	queues map[*feedQueue]struct{} // until cleaned up
+	ready  []*feedQueue            // each queue is added at most once while scheduled
+}
+
+func newWorkerPool(workers int, queues []*feedQueue) *workerPool {
+	p := &workerPool{
+		workers: workers,
+		wake:    make(chan struct{}, 1),
+		queues:  make(map[*feedQueue]struct{}, len(queues)),
+	}
+	for _, q := range queues {
+		p.queues[q] = struct{}{}
+	}
+	return p
+}
+
+// add makes the pool export the updates of q, and clean it up when the pool stops.
+func (p *workerPool) add(q *feedQueue) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.queues[q] = struct{}{}
+}
+
+// remove stops exporting the updates of q, and cleans it up once no worker holds it.
+func (p *workerPool) remove(q *feedQueue) {
+	if q.close() {
+		p.cleanup(q)
+	}
+}
+
+// enqueue must only be called with one of the pool's queues.
+func (p *workerPool) enqueue(q *feedQueue, update interface{}) {
+	if q.push(update) {
+		p.schedule(q)
+	}
+}
+
+func (p *workerPool) schedule(q *feedQueue) {
+	p.mu.Lock()
+	p.ready = append(p.ready, q)
+	p.mu.Unlock()
+	p.signal()
+}
+
+func (p *workerPool) signal() {
+	select {
+	case p.wake <- struct{}{}:
+	default: // a worker is already being woken up
+	}
+}
+
+// next returns the oldest ready queue, if any.
+func (p *workerPool) next() (*feedQueue, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.ready) == 0 {
+		return nil, false
+	}
+	q := p.ready[0]
+	p.ready[0] = nil
+	p.ready = p.ready[1:]
+	if len(p.ready) > 0 {
+		p.signal() // wake up another worker for the remaining queues
+	}
+	return q, true
+}
+
+// cleanup cleans up q, unless it already was.
+func (p *workerPool) cleanup(q *feedQueue) {
+	p.mu.Lock()
+	_, found := p.queues[q]
+	delete(p.queues, q)
+	p.mu.Unlock()
+	if found {
+		q.cleanup()
+	}
+}
+
+// Run should be executed as a goroutine.
+// Signal termination by cancelling ctx; exporters are cleaned up before Run returns.
+func (p *workerPool) Run(ctx context.Context) {
+	var subs utils.Subprocesses
+	for i := 0; i < p.workers; i++ {
+		subs.Go(func() {
+			for ctx.Err() == nil {
+				q, ok := p.next()
+				if !ok {
+					select {
+					case <-p.wake:
+					case <-ctx.Done():
+					}
+					continue
+				}
+				update, ok := q.pop()
+				if !ok {
+					if q.isClosed() {
+						p.cleanup(q)
+					}
+					continue
+				}
+				q.export(ctx, update)
+				p.schedule(q)
+			}
+		})
+	}
+	subs.Wait()
+
+	// Cleanup happens after all the exporters have finished.
+	p.mu.Lock()
+	queues := p.queues
+	p.queues = map[*feedQueue]struct{}{}
+	p.mu.Unlock()
+	cleanupCh := make(chan *feedQueue)
+	subs = utils.Subprocesses{}
+	for i := 0; i < p.workers; i++ {
+		subs.Go(func() {
+			for q := range cleanupCh {
+				q.cleanup()
+			}
+		})
+	}
+	for q := range queues {
+		cleanupCh <- q
+	}
+	close(cleanupCh)
+	subs.Wait()
+}
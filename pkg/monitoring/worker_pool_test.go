@@ -0,0 +1,233 @@
+package monitoring
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
+)
+
+func TestFeedQueue(t *testing.T) {
+	t.Run("drops the oldest update when full", func(t *testing.T) {
+		metrics := &fakeFeedMetrics{}
+		queue := newFeedQueue(newNullLogger(), nil, metrics, 3, false)
+
+		require.True(t, queue.push(1), "first push should schedule the queue")
+		for i := 2; i <= 5; i++ {
+			require.False(t, queue.push(i), "queue is already scheduled")
+		}
+		require.Equal(t, int64(2), metrics.updatesDropped.Load())
+
+		for _, expected := range []int{3, 4, 5} {
+			update, ok := queue.pop()
+			require.True(t, ok)
+			require.Equal(t, expected, update)
+		}
+		_, ok := queue.pop()
+		require.False(t, ok)
+		require.True(t, queue.push(6), "empty queue should be rescheduled")
+	})
+	t.Run("measures the time between transmissions", func(t *testing.T) {
+		metrics := &fakeFeedMetrics{}
+		exporter := &orderedExporter{concurrency: &limitedConcurrency{}}
+		queue := newFeedQueue(newNullLogger(), []Exporter{exporter}, metrics, 10, false)
+
+		start := time.Unix(1700000000, 0)
+		for _, transmission := range []struct {
+			offset  time.Duration
+			roundID uint32
+		}{
+			{0, 10},
+			{0, 10},               // same transmission
+			{time.Minute, 11},     // next round
+			{4 * time.Minute, 14}, // missed two rounds
+			{5 * time.Minute, 0},  // chain without round ids
+			{-time.Minute, 20},    // stale
+		} {
+			envelope, err := generateEnvelope()
+			require.NoError(t, err)
+			envelope.LatestTimestamp = start.Add(transmission.offset)
+			envelope.AggregatorRoundID = transmission.roundID
+			queue.export(context.Background(), envelope)
+		}
+		var intervals, rounds []time.Duration
+		for _, update := range exporter.received() {
+			envelope := update.(Envelope)
+			intervals = append(intervals, envelope.TransmissionInterval)
+			rounds = append(rounds, envelope.RoundDuration)
+		}
+		require.Equal(t, []time.Duration{0, 0, time.Minute, 0, time.Minute, 0}, intervals)
+		require.Equal(t, []time.Duration{0, 0, time.Minute, time.Minute, time.Minute, 0}, rounds)
+		require.Equal(t, []time.Duration{time.Minute, time.Minute}, metrics.transmissionIntervals)
+		require.Equal(t, []time.Duration{time.Minute, time.Minute, time.Minute}, metrics.roundDurations)
+	})
+	t.Run("skips duplicate envelopes", func(t *testing.T) {
+		for _, skip := range []bool{false, true} {
+			metrics := &fakeFeedMetrics{}
+			exporter := &orderedExporter{concurrency: &limitedConcurrency{}}
+			skipping := &skippingExporter{orderedExporter{concurrency: &limitedConcurrency{}}}
+			queue := newFeedQueue(newNullLogger(), []Exporter{exporter, skipping}, metrics, 10, skip)
+
+			envelope, err := generateEnvelope()
+			require.NoError(t, err)
+			newRound := envelope
+			newRound.Round++
+			newRound.LatestTimestamp = newRound.LatestTimestamp.Add(time.Minute)
+			newConfig := newRound
+			newConfig.ContractConfig.ConfigDigest[0]++
+			updates := []interface{}{envelope, envelope, newRound, newRound, newRound, newConfig, "not an envelope"}
+			for _, update := range updates {
+				queue.export(context.Background(), update)
+			}
+			require.Len(t, exporter.received(), len(updates), "only DuplicateSkippingExporters skip")
+			if !skip {
+				require.Len(t, skipping.received(), len(updates))
+				require.Zero(t, metrics.envelopesSkipped.Load())
+				continue
+			}
+			require.Len(t, skipping.received(), 4)
+			require.Equal(t, int64(3), metrics.envelopesSkipped.Load())
+		}
+	})
+}
+
+func TestWorkerPool(t *testing.T) {
+	t.Run("exports each feed in order with bounded concurrency", func(t *testing.T) {
+		defer goleak.VerifyNone(t)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		const numFeeds, numUpdates, numWorkers = 20, 50, 3
+		exporters := make([]*orderedExporter, numFeeds)
+		queues := make([]*feedQueue, numFeeds)
+		var concurrency limitedConcurrency
+		for i := range queues {
+			exporters[i] = &orderedExporter{concurrency: &concurrency}
+			queues[i] = newFeedQueue(newNullLogger(), []Exporter{exporters[i]}, &fakeFeedMetrics{}, numUpdates, false)
+		}
+		pool := newWorkerPool(numWorkers, queues)
+
+		var subs utils.Subprocesses
+		subs.Go(func() {
+			pool.Run(ctx)
+		})
+		for u := 0; u < numUpdates; u++ {
+			for _, queue := range queues {
+				pool.enqueue(queue, u)
+			}
+		}
+		require.Eventually(t, func() bool {
+			for _, exporter := range exporters {
+				if len(exporter.received()) != numUpdates {
+					return false
+				}
+			}
+			return true
+		}, 4*time.Second, 10*time.Millisecond)
+		cancel()
+		subs.Wait()
+
+		for _, exporter := range exporters {
+			received := exporter.received()
+			for u := range received {
+				require.Equal(t, u, received[u], "updates should be exported in order")
+			}
+			require.True(t, exporter.cleanedUp.Load())
+		}
+		require.LessOrEqual(t, concurrency.max.Load(), int64(numWorkers))
+	})
+}
+
+type fakeFeedMetrics struct {
+	updatesDropped   atomic.Int64
+	fetchesTimedOut  atomic.Int64
+	envelopesSkipped atomic.Int64
+	cleanupsFailed   atomic.Int64
+
+	// only set by single worker tests
+	transmissionIntervals, roundDurations []time.Duration
+}
+
+func (f *fakeFeedMetrics) IncFetchFromSourceFailed(sourceName string)    {}
+func (f *fakeFeedMetrics) IncFetchFromSourceSucceeded(sourceName string) {}
+func (f *fakeFeedMetrics) IncFetchFromSourceTimedOut(sourceName string) {
+	f.fetchesTimedOut.Add(1)
+}
+func (f *fakeFeedMetrics) ObserveFetchFromSourceDuraction(duration time.Duration, sourceName string) {
+}
+func (f *fakeFeedMetrics) IncFeedMonitorUpdatesDropped() {
+	f.updatesDropped.Add(1)
+}
+func (f *fakeFeedMetrics) IncFeedMonitorEnvelopesSkipped() {
+	f.envelopesSkipped.Add(1)
+}
+func (f *fakeFeedMetrics) IncExporterCleanupFailed(exporter, reason string) {
+	f.cleanupsFailed.Add(1)
+}
+func (f *fakeFeedMetrics) ObserveTransmissionInterval(interval time.Duration) {
+	f.transmissionIntervals = append(f.transmissionIntervals, interval)
+}
+func (f *fakeFeedMetrics) ObserveRoundDuration(duration time.Duration) {
+	f.roundDurations = append(f.roundDurations, duration)
+}
+
+// limitedConcurrency records the maximum number of concurrent exports.
+type limitedConcurrency struct {
+	current, max atomic.Int64
+}
+
+func (l *limitedConcurrency) enter() {
+	current := l.current.Add(1)
+	for {
+		max := l.max.Load()
+		if current <= max || l.max.CompareAndSwap(max, current) {
+			return
+		}
+	}
+}
+
+func (l *limitedConcurrency) exit() {
+	l.current.Add(-1)
+}
+
+type orderedExporter struct {
+	concurrency *limitedConcurrency
+	cleanedUp   atomic.Bool
+
+	mu      sync.Mutex
+	updates []interface{}
+}
+
+func (o *orderedExporter) Export(_ context.Context, data interface{}) {
+	o.concurrency.enter()
+	defer o.concurrency.exit()
+	time.Sleep(time.Millisecond)
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.updates = append(o.updates, data)
+}
+
+func (o *orderedExporter) Cleanup(_ context.Context) error {
+	o.cleanedUp.Store(true)
+	return nil
+}
+
+func (o *orderedExporter) received() []interface{} {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return append([]interface{}{}, o.updates...)
+}
+
+// skippingExporter is an orderedExporter which skips duplicate envelopes.
+type skippingExporter struct {
+	orderedExporter
+}
+
+func (s *skippingExporter) SkipsDuplicates() bool { return true }
@@ -0,0 +1,113 @@
+// Package median provides a [types.PluginMedian] backed by the libocr numerical median reporting plugin.
+package median
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+	"github.com/smartcontractkit/chainlink-relay/pkg/loop"
+	"github.com/smartcontractkit/chainlink-relay/pkg/services"
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
+)
+
+var _ types.PluginMedian = (*Plugin)(nil)
+
+// Plugin is a [types.PluginMedian] which creates [median.NumericalMedianFactory] instances.
+type Plugin struct {
+	lggr logger.Logger
+}
+
+// NewPlugin returns a new Plugin, for use with [loop.ServeMedian].
+func NewPlugin(lggr logger.Logger) *Plugin {
+	return &Plugin{lggr: lggr}
+}
+
+func (p *Plugin) NewMedianFactory(ctx context.Context, provider types.MedianProvider, dataSource, juelsPerFeeCoin median.DataSource, errorLog types.ErrorLog) (types.ReportingPluginFactory, error) {
+	var ctxVals loop.ContextValues
+	ctxVals.SetValues(ctx)
+	lggr := logger.Named(logger.With(p.lggr, ctxVals.Args()...), "ReportingPluginFactory")
+
+	s := &reportingPluginFactoryService{lggr: lggr, stopCh: make(utils.StopChan)}
+	if r, ok := errorLog.(types.ResolvableErrorLog); ok {
+		dataSource = &resolvingDataSource{DataSource: dataSource, key: errorKeyDataSource, errorLog: r, lggr: lggr}
+		juelsPerFeeCoin = &resolvingDataSource{DataSource: juelsPerFeeCoin, key: errorKeyJuelsPerFeeCoin, errorLog: r, lggr: lggr}
+	}
+	s.NumericalMedianFactory = median.NumericalMedianFactory{
+		ContractTransmitter:       provider.MedianContract(),
+		DataSource:                dataSource,
+		JuelsPerFeeCoinDataSource: juelsPerFeeCoin,
+		Logger: logger.NewOCRWrapper(lggr, true, func(msg string) {
+			ctx, cancel := s.stopCh.NewCtx()
+			defer cancel()
+			if err := errorLog.SaveError(ctx, msg); err != nil {
+				lggr.Errorw("Unable to save error", "msg", msg, "err", err)
+			}
+		}),
+		OnchainConfigCodec: provider.OnchainConfigCodec(),
+		ReportCodec:        provider.ReportCodec(),
+	}
+	// LOOP services are expected to start automatically.
+	if err := s.StartOnce("ReportingPluginFactory", func() error { return nil }); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Keys of the errors saved by a resolvingDataSource.
+const (
+	errorKeyDataSource      = "median/data-source"
+	errorKeyJuelsPerFeeCoin = "median/juels-per-fee-coin-data-source"
+)
+
+// resolvingDataSource is a [median.DataSource] which saves its failures under key, and resolves them once it succeeds
+// again, so that a failing data source is reported once rather than for every observation.
+type resolvingDataSource struct {
+	median.DataSource
+	key      string
+	errorLog types.ResolvableErrorLog
+	lggr     logger.Logger
+}
+
+func (r *resolvingDataSource) Observe(ctx context.Context, repts ocrtypes.ReportTimestamp) (*big.Int, error) {
+	val, err := r.DataSource.Observe(ctx, repts)
+	var lerr error
+	if err != nil {
+		lerr = r.errorLog.SaveKeyedError(ctx, r.key, err.Error())
+	} else {
+		lerr = r.errorLog.ResolveError(ctx, r.key)
+	}
+	if lerr != nil {
+		r.lggr.Errorw("Unable to update error log", "key", r.key, "err", lerr)
+	}
+	return val, err
+}
+
+// reportingPluginFactoryService is a [types.ReportingPluginFactory] wrapping a [median.NumericalMedianFactory].
+type reportingPluginFactoryService struct {
+	services.StateMachine
+	lggr   logger.Logger
+	stopCh utils.StopChan
+	median.NumericalMedianFactory
+}
+
+func (r *reportingPluginFactoryService) Name() string { return r.lggr.Name() }
+
+func (r *reportingPluginFactoryService) Start(ctx context.Context) error {
+	return nil // no-op: started by NewMedianFactory
+}
+
+func (r *reportingPluginFactoryService) Close() error {
+	return r.StopOnce("ReportingPluginFactory", func() error {
+		close(r.stopCh)
+		return nil
+	})
+}
+
+func (r *reportingPluginFactoryService) HealthReport() map[string]error {
+	return map[string]error{r.Name(): r.Healthy()}
+}
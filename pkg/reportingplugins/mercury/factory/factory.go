@@ -0,0 +1,69 @@
+// Package factory creates mercury plugin factories per feed, so that one process can serve many feeds, each with its
+// own DataSource and ReportCodec of the report schema version given by its feed ID.
+package factory
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+	"github.com/smartcontractkit/chainlink-relay/pkg/reportingplugins/mercury"
+	mercury_v1 "github.com/smartcontractkit/chainlink-relay/pkg/reportingplugins/mercury/v1"
+	mercury_v2 "github.com/smartcontractkit/chainlink-relay/pkg/reportingplugins/mercury/v2"
+	mercury_v3 "github.com/smartcontractkit/chainlink-relay/pkg/reportingplugins/mercury/v3"
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+// Feed has the DataSource and ReportCodec of a feed, for one version of the report schema. Create with [FeedV1],
+// [FeedV2], or [FeedV3].
+type Feed struct {
+	version    types.FeedVersion
+	newFactory func(logger.Logger, mercury.OnchainConfigCodec) ocr3types.MercuryPluginFactory
+}
+
+// FeedV1 returns a Feed for v1 reports.
+func FeedV1(ds mercury_v1.DataSource, rc mercury_v1.ReportCodec) Feed {
+	return Feed{types.FeedV1, func(lggr logger.Logger, occ mercury.OnchainConfigCodec) ocr3types.MercuryPluginFactory {
+		return mercury_v1.NewFactory(ds, lggr, occ, rc)
+	}}
+}
+
+// FeedV2 returns a Feed for v2 reports.
+func FeedV2(ds mercury_v2.DataSource, rc mercury_v2.ReportCodec) Feed {
+	return Feed{types.FeedV2, func(lggr logger.Logger, occ mercury.OnchainConfigCodec) ocr3types.MercuryPluginFactory {
+		return mercury_v2.NewFactory(ds, lggr, occ, rc)
+	}}
+}
+
+// FeedV3 returns a Feed for v3 reports.
+func FeedV3(ds mercury_v3.DataSource, rc mercury_v3.ReportCodec) Feed {
+	return Feed{types.FeedV3, func(lggr logger.Logger, occ mercury.OnchainConfigCodec) ocr3types.MercuryPluginFactory {
+		return mercury_v3.NewFactory(ds, lggr, occ, rc)
+	}}
+}
+
+// Version returns the version of the report schema of the feed.
+func (f Feed) Version() types.FeedVersion { return f.version }
+
+func (f Feed) validate(feedID types.FeedID) error {
+	if f.newFactory == nil {
+		return errors.New("invalid Feed: must be created with FeedV1, FeedV2, or FeedV3")
+	}
+	if err := feedID.Validate(); err != nil {
+		return err
+	}
+	if v := feedID.Version(); v != f.version {
+		return fmt.Errorf("feed ID %s has version %d, but Feed has version %d", feedID, v, f.version)
+	}
+	return nil
+}
+
+// NewFactory returns a mercury plugin factory for a single feed, logging with its feedID.
+func NewFactory(feedID types.FeedID, feed Feed, lggr logger.Logger, occ mercury.OnchainConfigCodec) (ocr3types.MercuryPluginFactory, error) {
+	if err := feed.validate(feedID); err != nil {
+		return nil, err
+	}
+	return feed.newFactory(logger.With(lggr, "feedID", feedID.String()), occ), nil
+}
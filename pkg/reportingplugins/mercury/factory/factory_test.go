@@ -0,0 +1,124 @@
+package factory_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+	"github.com/smartcontractkit/chainlink-relay/pkg/reportingplugins/mercury"
+	"github.com/smartcontractkit/chainlink-relay/pkg/reportingplugins/mercury/factory"
+	mercury_v1 "github.com/smartcontractkit/chainlink-relay/pkg/reportingplugins/mercury/v1"
+	mercury_v3 "github.com/smartcontractkit/chainlink-relay/pkg/reportingplugins/mercury/v3"
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
+)
+
+const (
+	feedV1 = "0x0001111111111111111111111111111111111111111111111111111111111111"
+	feedV3 = "0x0003333333333333333333333333333333333333333333333333333333333333"
+)
+
+func TestNewFactory(t *testing.T) {
+	lggr := logger.Test(t)
+	occ := mercury.StandardOnchainConfigCodec{}
+	idV1, idV3 := types.MustParseFeedID(feedV1), types.MustParseFeedID(feedV3)
+
+	_, err := factory.NewFactory(idV3, factory.FeedV3(priceDataSource{1}, reportCodec{}), lggr, occ)
+	require.NoError(t, err)
+
+	_, err = factory.NewFactory(idV1, factory.FeedV3(priceDataSource{1}, reportCodec{}), lggr, occ)
+	assert.ErrorContains(t, err, "has version 1, but Feed has version 3")
+
+	_, err = factory.NewFactory(idV3, factory.Feed{}, lggr, occ)
+	assert.ErrorContains(t, err, "invalid Feed")
+
+	_, err = factory.NewFactory(types.FeedID{}, factory.FeedV3(priceDataSource{1}, reportCodec{}), lggr, occ)
+	assert.ErrorContains(t, err, "unsupported version 0")
+}
+
+func TestRegistry(t *testing.T) {
+	r := factory.NewRegistry(logger.Test(t), mercury.StandardOnchainConfigCodec{})
+	idV1 := types.MustParseFeedID(feedV1)
+	ids := []types.FeedID{
+		types.MustParseFeedID("0x0003aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"),
+		types.MustParseFeedID(feedV3),
+	}
+	for i, id := range ids {
+		require.NoError(t, r.Register(id, factory.FeedV3(priceDataSource{int64(i + 1)}, reportCodec{})))
+	}
+	assert.ErrorContains(t, r.Register(ids[0], factory.FeedV3(priceDataSource{}, reportCodec{})), "already registered")
+	assert.ErrorContains(t, r.Register(idV1, factory.FeedV3(priceDataSource{}, reportCodec{})), "has version 1")
+	require.NoError(t, r.Register(idV1, factory.FeedV1(v1DataSource{}, nil)))
+	assert.Equal(t, []types.FeedID{idV1, ids[1], ids[0]}, r.FeedIDs())
+
+	r.Unregister(idV1)
+	_, err := r.NewFactory(idV1)
+	assert.ErrorContains(t, err, "is not registered")
+
+	factories, err := r.NewFactories()
+	require.NoError(t, err)
+	require.Len(t, factories, len(ids))
+	for i, id := range ids {
+		single, err := r.NewFactory(id)
+		require.NoError(t, err)
+		for _, f := range []ocr3types.MercuryPluginFactory{factories[id], single} {
+			// each feed observes with its own DataSource
+			assert.Equal(t, big.NewInt(int64(i+1)), observeBenchmarkPrice(t, f))
+		}
+	}
+}
+
+// observeBenchmarkPrice creates a plugin from f, and returns the benchmark price of its observation.
+func observeBenchmarkPrice(t *testing.T, f ocr3types.MercuryPluginFactory) *big.Int {
+	onchainConfig, err := mercury.StandardOnchainConfigCodec{}.Encode(mercury.OnchainConfig{Min: big.NewInt(0), Max: big.NewInt(1000)})
+	require.NoError(t, err)
+	plugin, _, err := f.NewMercuryPlugin(ocr3types.MercuryPluginConfig{
+		OnchainConfig:  onchainConfig,
+		OffchainConfig: []byte(`{}`),
+		N:              4,
+		F:              1,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, plugin.Close()) })
+	b, err := plugin.Observation(utils.Context(t), ocrtypes.ReportTimestamp{}, nil)
+	require.NoError(t, err)
+	var obs mercury_v3.MercuryObservationProto
+	require.NoError(t, proto.Unmarshal(b, &obs))
+	price, err := mercury.DecodeValueInt192(obs.BenchmarkPrice)
+	require.NoError(t, err)
+	return price
+}
+
+// priceDataSource observes a fixed price.
+type priceDataSource struct {
+	price int64
+}
+
+func (p priceDataSource) Observe(context.Context, ocrtypes.ReportTimestamp, bool) (mercury_v3.Observation, error) {
+	price := big.NewInt(p.price)
+	return mercury_v3.Observation{
+		BenchmarkPrice: mercury.ObsResult[*big.Int]{Val: price},
+		Bid:            mercury.ObsResult[*big.Int]{Val: price},
+		Ask:            mercury.ObsResult[*big.Int]{Val: price},
+		LinkPrice:      mercury.ObsResult[*big.Int]{Val: mercury_v3.MissingPrice},
+		NativePrice:    mercury.ObsResult[*big.Int]{Val: mercury_v3.MissingPrice},
+	}, nil
+}
+
+type v1DataSource struct {
+	mercury_v1.DataSource
+}
+
+type reportCodec struct {
+	mercury_v3.ReportCodec // only MaxReportLength is implemented
+}
+
+func (reportCodec) MaxReportLength(n int) (int, error) { return 9 * 32, nil }
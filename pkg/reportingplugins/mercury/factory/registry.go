@@ -0,0 +1,88 @@
+package factory
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+	"github.com/smartcontractkit/chainlink-relay/pkg/reportingplugins/mercury"
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+// Registry has the [Feed] of each feed served by a process, and creates their plugin factories with a shared logger
+// and OnchainConfigCodec. It is safe for concurrent use.
+type Registry struct {
+	lggr logger.Logger
+	occ  mercury.OnchainConfigCodec
+
+	mu    sync.RWMutex
+	feeds map[types.FeedID]Feed
+}
+
+// NewRegistry returns a new, empty Registry.
+func NewRegistry(lggr logger.Logger, occ mercury.OnchainConfigCodec) *Registry {
+	return &Registry{lggr: lggr, occ: occ, feeds: make(map[types.FeedID]Feed)}
+}
+
+// Register adds feed for feedID, which must be valid for its version, and not already registered.
+func (r *Registry) Register(feedID types.FeedID, feed Feed) error {
+	if err := feed.validate(feedID); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.feeds[feedID]; ok {
+		return fmt.Errorf("feed %s is already registered", feedID)
+	}
+	r.feeds[feedID] = feed
+	return nil
+}
+
+// Unregister removes the feed for feedID, if registered. Factories already created for it are unaffected.
+func (r *Registry) Unregister(feedID types.FeedID) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.feeds, feedID)
+}
+
+// FeedIDs returns the IDs of the registered feeds, in order.
+func (r *Registry) FeedIDs() []types.FeedID {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	ids := make([]types.FeedID, 0, len(r.feeds))
+	for id := range r.feeds {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return bytes.Compare(ids[i][:], ids[j][:]) < 0 })
+	return ids
+}
+
+// NewFactory returns a mercury plugin factory for the registered feed with feedID.
+func (r *Registry) NewFactory(feedID types.FeedID) (ocr3types.MercuryPluginFactory, error) {
+	r.mu.RLock()
+	feed, ok := r.feeds[feedID]
+	r.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("feed %s is not registered", feedID)
+	}
+	return NewFactory(feedID, feed, r.lggr, r.occ)
+}
+
+// NewFactories returns a mercury plugin factory for each registered feed.
+func (r *Registry) NewFactories() (map[types.FeedID]ocr3types.MercuryPluginFactory, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	factories := make(map[types.FeedID]ocr3types.MercuryPluginFactory, len(r.feeds))
+	for id, feed := range r.feeds {
+		f, err := NewFactory(id, feed, r.lggr, r.occ)
+		if err != nil {
+			return nil, err
+		}
+		factories[id] = f
+	}
+	return factories, nil
+}
@@ -0,0 +1,66 @@
+// Package fees computes the link and native fees of mercury reports. Every oracle must compute identical fees from
+// identical inputs, so the arithmetic is done on integers, with a single rounding step.
+//
+// The v2 and v3 plugins still use mercury.CalculateFee, since switching would change the fees agreed on by oracles.
+package fees
+
+import (
+	"fmt"
+	"math/big"
+)
+
+var (
+	// PriceScalingFactor is the multiplier applied to token prices by data sources.
+	// e.g. for a 1e8 multiplier, a LINK/USD price of 7.42 is represented as 742000000.
+	PriceScalingFactor = big.NewInt(1e8)
+
+	// FeeScalingFactor is the multiplier applied to fees in reports.
+	// e.g. for a 1e18 multiplier, a fee of 7.42 LINK is represented as 7.42e18.
+	FeeScalingFactor = big.NewInt(1e18)
+
+	centsPerDollar = big.NewInt(100)
+)
+
+// Fees are the fees of a report, scaled by FeeScalingFactor.
+type Fees struct {
+	LinkFee   *big.Int
+	NativeFee *big.Int
+}
+
+// Calculate returns the fees equivalent to baseUSDFeeCents, given the LINK and native token prices in USD, scaled by
+// PriceScalingFactor.
+func Calculate(linkPriceInUSD, nativePriceInUSD *big.Int, baseUSDFeeCents uint32) (f Fees, err error) {
+	f.LinkFee, err = CalculateFee(linkPriceInUSD, baseUSDFeeCents)
+	if err != nil {
+		return Fees{}, fmt.Errorf("failed to calculate link fee: %w", err)
+	}
+	f.NativeFee, err = CalculateFee(nativePriceInUSD, baseUSDFeeCents)
+	if err != nil {
+		return Fees{}, fmt.Errorf("failed to calculate native fee: %w", err)
+	}
+	return
+}
+
+// CalculateFee returns the amount of a token equivalent to baseUSDFeeCents, scaled by FeeScalingFactor, given the
+// token price in USD, scaled by PriceScalingFactor:
+//
+//	fee = (baseUSDFeeCents / 100) / (tokenPriceInUSD / PriceScalingFactor) * FeeScalingFactor
+//
+// The result is rounded to the nearest integer, with halves rounded up.
+func CalculateFee(tokenPriceInUSD *big.Int, baseUSDFeeCents uint32) (*big.Int, error) {
+	if tokenPriceInUSD == nil {
+		return nil, fmt.Errorf("token price is nil")
+	}
+	if tokenPriceInUSD.Sign() <= 0 {
+		return nil, fmt.Errorf("token price must be positive: %s", tokenPriceInUSD)
+	}
+	num := new(big.Int).SetUint64(uint64(baseUSDFeeCents))
+	num.Mul(num, PriceScalingFactor)
+	num.Mul(num, FeeScalingFactor)
+	den := new(big.Int).Mul(tokenPriceInUSD, centsPerDollar)
+
+	// round(num/den) = floor((2*num + den) / (2*den)), since both are positive
+	num.Lsh(num, 1).Add(num, den)
+	den.Lsh(den, 1)
+	return num.Quo(num, den), nil
+}
@@ -0,0 +1,66 @@
+package fees
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustBigInt(t *testing.T, s string) *big.Int {
+	i, ok := new(big.Int).SetString(s, 10)
+	require.True(t, ok, s)
+	return i
+}
+
+func TestCalculateFee(t *testing.T) {
+	for _, tt := range []struct {
+		name            string
+		tokenPriceInUSD string
+		baseUSDFeeCents uint32
+		fee             string
+	}{
+		{"one dollar", "100000000", 100, "1000000000000000000"},
+		{"zero base fee", "100000000", 0, "0"},
+		{"link", "655000000", 100, "152671755725190840"},
+		{"rounds down", "300000000", 100, "333333333333333333"},
+		{"rounds up", "150000000", 100, "666666666666666667"},
+		{"rounds half up", "400000000000000000000000", 1, "3"},
+		{"one cent", "700000000", 1, "1428571428571429"},
+		{"expensive token", "200000000000", 50, "250000000000000"},
+		{"cheap token", "1", 1, "1000000000000000000000000"},
+		{"max base fee", "100000000", 4294967295, "42949672950000000000000000"},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			fee, err := CalculateFee(mustBigInt(t, tt.tokenPriceInUSD), tt.baseUSDFeeCents)
+			require.NoError(t, err)
+			assert.Equal(t, tt.fee, fee.String())
+		})
+	}
+
+	t.Run("invalid price", func(t *testing.T) {
+		for _, price := range []*big.Int{nil, big.NewInt(0), big.NewInt(-1)} {
+			_, err := CalculateFee(price, 100)
+			assert.Error(t, err, price)
+		}
+	})
+
+	t.Run("does not modify price", func(t *testing.T) {
+		price := big.NewInt(655000000)
+		_, err := CalculateFee(price, 100)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(655000000), price)
+	})
+}
+
+func TestCalculate(t *testing.T) {
+	f, err := Calculate(big.NewInt(655000000), big.NewInt(180000000000), 100)
+	require.NoError(t, err)
+	assert.Equal(t, "152671755725190840", f.LinkFee.String())
+	assert.Equal(t, "555555555555556", f.NativeFee.String())
+
+	_, err = Calculate(big.NewInt(655000000), big.NewInt(0), 100)
+	require.ErrorContains(t, err, "native fee")
+}
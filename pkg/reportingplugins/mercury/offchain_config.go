@@ -5,7 +5,10 @@ import (
 )
 
 type OffchainConfig struct {
-	ExpirationWindow uint32 // Integer number of seconds
+	// ExpirationWindow is the number of seconds after the observation timestamp that a report expires at. Expired
+	// reports are neither accepted nor transmitted. Zero disables the offchain expiry checks, but reports still carry
+	// expiresAt equal to their observation timestamp.
+	ExpirationWindow uint32
 	BaseUSDFeeCents  uint32
 }
 
@@ -2,6 +2,8 @@ package mercury
 
 import (
 	"context"
+	"fmt"
+	"math"
 	"math/big"
 
 	"github.com/smartcontractkit/libocr/commontypes"
@@ -32,6 +34,29 @@ type MercuryServerFetcher interface {
 	LatestTimestamp(context.Context) (int64, error)
 }
 
+// ExpiresAtCodec is optionally implemented by report codecs which can decode the expiresAt field of a report. Plugins
+// otherwise derive it from the observation timestamp and the ExpirationWindow of the OffchainConfig, as it was built.
+type ExpiresAtCodec interface {
+	ExpiresAtFromReport(ocrtypes.Report) (uint32, error)
+}
+
+// ReportExpiresAt returns the expiresAt field of report, using codec if it implements ExpiresAtCodec.
+func ReportExpiresAt(codec interface {
+	ObservationTimestampFromReport(ocrtypes.Report) (uint32, error)
+}, report ocrtypes.Report, expirationWindow uint32) (uint32, error) {
+	if c, ok := codec.(ExpiresAtCodec); ok {
+		return c.ExpiresAtFromReport(report)
+	}
+	ts, err := codec.ObservationTimestampFromReport(report)
+	if err != nil {
+		return 0, err
+	}
+	if int64(ts)+int64(expirationWindow) > math.MaxUint32 {
+		return 0, fmt.Errorf("timestamp %d + expiration window %d overflows uint32", ts, expirationWindow)
+	}
+	return ts + expirationWindow, nil
+}
+
 type Transmitter interface {
 	MercuryServerFetcher
 	// NOTE: Mercury doesn't actually transmit on-chain, so there is no
@@ -0,0 +1,55 @@
+package mercury_v1
+
+import (
+	"context"
+
+	pkgerrors "github.com/pkg/errors"
+
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/reportingplugins/mercury"
+)
+
+// ChainHead is a block of the chain which v1 reports are anchored to, as their current block.
+type ChainHead struct {
+	Number    int64
+	Hash      []byte
+	Timestamp uint64 // seconds since the unix epoch
+}
+
+// ChainHeadProvider returns the latest head of the chain, e.g. from the head tracker of a relayer.
+type ChainHeadProvider interface {
+	LatestChainHead(ctx context.Context) (ChainHead, error)
+}
+
+var _ DataSource = chainHeadDataSource{}
+
+type chainHeadDataSource struct {
+	DataSource
+	heads ChainHeadProvider
+}
+
+// NewChainHeadDataSource returns a DataSource which observes the current block from heads, overriding any observed by
+// ds, so that data sources need not query the chain themselves.
+func NewChainHeadDataSource(ds DataSource, heads ChainHeadProvider) DataSource {
+	return chainHeadDataSource{DataSource: ds, heads: heads}
+}
+
+func (c chainHeadDataSource) Observe(ctx context.Context, repts ocrtypes.ReportTimestamp, fetchMaxFinalizedBlockNum bool) (Observation, error) {
+	obs, err := c.DataSource.Observe(ctx, repts, fetchMaxFinalizedBlockNum)
+	if err != nil {
+		return Observation{}, err
+	}
+	head, err := c.heads.LatestChainHead(ctx)
+	if err != nil {
+		err = pkgerrors.Wrap(err, "failed to get latest chain head")
+		obs.CurrentBlockNum = mercury.ObsResult[int64]{Err: err}
+		obs.CurrentBlockHash = mercury.ObsResult[[]byte]{Err: err}
+		obs.CurrentBlockTimestamp = mercury.ObsResult[uint64]{Err: err}
+		return obs, nil
+	}
+	obs.CurrentBlockNum = mercury.ObsResult[int64]{Val: head.Number}
+	obs.CurrentBlockHash = mercury.ObsResult[[]byte]{Val: head.Hash}
+	obs.CurrentBlockTimestamp = mercury.ObsResult[uint64]{Val: head.Timestamp}
+	return obs, nil
+}
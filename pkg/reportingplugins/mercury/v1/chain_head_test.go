@@ -0,0 +1,64 @@
+package mercury_v1
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/reportingplugins/mercury"
+)
+
+type staticChainHeadProvider struct {
+	head ChainHead
+	err  error
+}
+
+func (s staticChainHeadProvider) LatestChainHead(context.Context) (ChainHead, error) {
+	return s.head, s.err
+}
+
+type erroringDataSource struct{ err error }
+
+func (e erroringDataSource) Observe(context.Context, ocrtypes.ReportTimestamp, bool) (Observation, error) {
+	return Observation{}, e.err
+}
+
+func TestChainHeadDataSource(t *testing.T) {
+	ctx := context.Background()
+	ds := mockDataSource{obs: Observation{
+		BenchmarkPrice:  mercury.ObsResult[*big.Int]{Val: big.NewInt(42)},
+		CurrentBlockNum: mercury.ObsResult[int64]{Val: 1},
+	}}
+
+	t.Run("head", func(t *testing.T) {
+		head := ChainHead{Number: 100, Hash: []byte{1, 2, 3}, Timestamp: 1234}
+		obs, err := NewChainHeadDataSource(ds, staticChainHeadProvider{head: head}).Observe(ctx, ocrtypes.ReportTimestamp{}, false)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(42), obs.BenchmarkPrice.Val)
+		assert.Equal(t, mercury.ObsResult[int64]{Val: 100}, obs.CurrentBlockNum)
+		assert.Equal(t, mercury.ObsResult[[]byte]{Val: []byte{1, 2, 3}}, obs.CurrentBlockHash)
+		assert.Equal(t, mercury.ObsResult[uint64]{Val: 1234}, obs.CurrentBlockTimestamp)
+	})
+
+	t.Run("head error", func(t *testing.T) {
+		headErr := errors.New("no head")
+		obs, err := NewChainHeadDataSource(ds, staticChainHeadProvider{err: headErr}).Observe(ctx, ocrtypes.ReportTimestamp{}, false)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(42), obs.BenchmarkPrice.Val)
+		assert.ErrorIs(t, obs.CurrentBlockNum.Err, headErr)
+		assert.ErrorIs(t, obs.CurrentBlockHash.Err, headErr)
+		assert.ErrorIs(t, obs.CurrentBlockTimestamp.Err, headErr)
+	})
+
+	t.Run("data source error", func(t *testing.T) {
+		dsErr := errors.New("observe failed")
+		_, err := NewChainHeadDataSource(erroringDataSource{dsErr}, staticChainHeadProvider{}).Observe(ctx, ocrtypes.ReportTimestamp{}, false)
+		assert.ErrorIs(t, err, dsErr)
+	})
+}
@@ -628,3 +628,45 @@ func newUnparseableAttributedObservation() ocrtypes.AttributedObservation {
 		Observer:    commontypes.OracleID(42),
 	}
 }
+
+type expiresAtReportCodec struct {
+	testReportCodec
+	expiresAt uint32
+}
+
+func (rc expiresAtReportCodec) ExpiresAtFromReport(ocrtypes.Report) (uint32, error) {
+	return rc.expiresAt, nil
+}
+
+func Test_Plugin_expiry(t *testing.T) {
+	now := uint32(time.Now().Unix())
+	report := ocrtypes.Report{1, 2, 3}
+	for _, tt := range []struct {
+		name             string
+		codec            ReportCodec
+		expirationWindow uint32
+		expired          bool
+	}{
+		{"fresh", &testReportCodec{observationTimestamp: now}, 60, false},
+		{"expired", &testReportCodec{observationTimestamp: now - 120}, 60, true},
+		{"disabled", &testReportCodec{observationTimestamp: now - 120}, 0, false},
+		{"codec fresh", &expiresAtReportCodec{testReportCodec{observationTimestamp: now - 120}, now + 60}, 60, false},
+		{"codec expired", &expiresAtReportCodec{testReportCodec{observationTimestamp: now}, now - 60}, 60, true},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			rp := newTestReportPlugin(t, &testReportCodec{}, &testDataSource{})
+			rp.reportCodec = tt.codec
+			rp.offchainConfig.ExpirationWindow = tt.expirationWindow
+			repts := ocrtypes.ReportTimestamp{Epoch: 1, Round: 1}
+
+			accept, err := rp.ShouldAcceptFinalizedReport(context.Background(), repts, report)
+			require.NoError(t, err)
+			assert.Equal(t, !tt.expired, accept)
+
+			transmit, err := rp.ShouldTransmitAcceptedReport(context.Background(), repts, report)
+			require.NoError(t, err)
+			assert.Equal(t, !tt.expired, transmit)
+		})
+	}
+}
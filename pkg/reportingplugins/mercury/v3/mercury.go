@@ -424,6 +424,14 @@ func (rp *reportingPlugin) ShouldAcceptFinalizedReport(ctx context.Context, rept
 		return false, nil
 	}
 
+	if err := rp.validateNotExpired(report); err != nil {
+		rp.logger.Warnw("ShouldAcceptFinalizedReport() = false, report has expired",
+			"reportEpochRound", reportEpochRound,
+			"err", err,
+		)
+		return false, nil
+	}
+
 	rp.logger.Debugw("ShouldAcceptFinalizedReport() = true",
 		"reportEpochRound", reportEpochRound,
 		"latestAcceptedEpochRound", rp.latestAcceptedEpochRound,
@@ -435,9 +443,29 @@ func (rp *reportingPlugin) ShouldAcceptFinalizedReport(ctx context.Context, rept
 }
 
 func (rp *reportingPlugin) ShouldTransmitAcceptedReport(ctx context.Context, repts ocrtypes.ReportTimestamp, report ocrtypes.Report) (bool, error) {
+	if err := rp.validateNotExpired(report); err != nil {
+		rp.logger.Warnw("ShouldTransmitAcceptedReport() = false, report has expired",
+			"reportEpochRound", mercury.EpochRound{Epoch: repts.Epoch, Round: repts.Round},
+			"err", err,
+		)
+		return false, nil
+	}
 	return true, nil
 }
 
+// validateNotExpired returns an error if report has expired, so that it is not transmitted late. Reports are never
+// considered expired if the ExpirationWindow is zero.
+func (rp *reportingPlugin) validateNotExpired(report ocrtypes.Report) error {
+	if rp.offchainConfig.ExpirationWindow == 0 {
+		return nil
+	}
+	expiresAt, err := mercury.ReportExpiresAt(rp.reportCodec, report, rp.offchainConfig.ExpirationWindow)
+	if err != nil {
+		return pkgerrors.Wrap(err, "failed to get expiresAt from report")
+	}
+	return mercury.ValidateNotExpired(time.Now(), expiresAt)
+}
+
 func (rp *reportingPlugin) Close() error {
 	return nil
 }
@@ -3,6 +3,7 @@ package mercury
 import (
 	"fmt"
 	"math/big"
+	"time"
 
 	pkgerrors "github.com/pkg/errors"
 )
@@ -38,6 +39,15 @@ func ValidateExpiresAt(observationTimestamp uint32, expiresAt uint32) error {
 	return nil
 }
 
+// ValidateNotExpired checks that a report which expires at expiresAt may still be used at now.
+func ValidateNotExpired(now time.Time, expiresAt uint32) error {
+	if now.Unix() > int64(expiresAt) {
+		return pkgerrors.Errorf("report expired at %d (current time: %d)", expiresAt, now.Unix())
+	}
+
+	return nil
+}
+
 func ValidateFee(name string, answer *big.Int) error {
 	return ValidateBetween(name, answer, big.NewInt(0), MaxInt192)
 }
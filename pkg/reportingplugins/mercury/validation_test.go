@@ -3,6 +3,7 @@ package mercury
 import (
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -39,6 +40,12 @@ func TestValidation(t *testing.T) {
 			assert.EqualError(t, err, "expiresAt (Value: 111) must be ahead of observation timestamp (Value: 112)")
 		})
 	})
+	t.Run("ValidateNotExpired", func(t *testing.T) {
+		now := time.Unix(123, 0)
+		assert.NoError(t, ValidateNotExpired(now, 123))
+		assert.NoError(t, ValidateNotExpired(now, 124))
+		assert.EqualError(t, ValidateNotExpired(now, 122), "report expired at 122 (current time: 123)")
+	})
 	t.Run("ValidateBetween", func(t *testing.T) {
 		bm := big.NewInt(346)
 		err := ValidateBetween("test foo", bm, min, max)
@@ -0,0 +1,142 @@
+package vectors
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	mercury_v1 "github.com/smartcontractkit/chainlink-relay/pkg/reportingplugins/mercury/v1"
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+// ReportV1 is a golden vector of a v1 report.
+type ReportV1 struct {
+	Name   string
+	FeedID [32]byte
+	Fields mercury_v1.ReportFields
+	Report ocrtypes.Report
+}
+
+// ObservationV1 is a golden vector of a v1 observation.
+type ObservationV1 struct {
+	Name        string
+	Observation ocrtypes.Observation
+	Proto       *mercury_v1.MercuryObservationProto
+}
+
+type jsonReportV1 struct {
+	Name   string       `json:"name"`
+	FeedID types.FeedID `json:"feedID"`
+	Fields struct {
+		Timestamp             uint32   `json:"timestamp"`
+		BenchmarkPrice        decimal  `json:"benchmarkPrice"`
+		Bid                   decimal  `json:"bid"`
+		Ask                   decimal  `json:"ask"`
+		CurrentBlockNum       int64    `json:"currentBlockNum,string"`
+		CurrentBlockHash      hexBytes `json:"currentBlockHash"`
+		ValidFromBlockNum     int64    `json:"validFromBlockNum,string"`
+		CurrentBlockTimestamp uint64   `json:"currentBlockTimestamp,string"`
+	} `json:"fields"`
+	Report hexBytes `json:"report"`
+}
+
+type jsonObservationV1 struct {
+	Name   string `json:"name"`
+	Fields struct {
+		Timestamp                    uint32   `json:"timestamp"`
+		BenchmarkPrice               decimal  `json:"benchmarkPrice"`
+		Bid                          decimal  `json:"bid"`
+		Ask                          decimal  `json:"ask"`
+		PricesValid                  bool     `json:"pricesValid"`
+		CurrentBlockNum              int64    `json:"currentBlockNum,string"`
+		CurrentBlockHash             hexBytes `json:"currentBlockHash"`
+		CurrentBlockTimestamp        uint64   `json:"currentBlockTimestamp,string"`
+		CurrentBlockValid            bool     `json:"currentBlockValid"`
+		MaxFinalizedBlockNumber      int64    `json:"maxFinalizedBlockNumber,string"`
+		MaxFinalizedBlockNumberValid bool     `json:"maxFinalizedBlockNumberValid"`
+	} `json:"fields"`
+	Observation hexBytes `json:"observation"`
+}
+
+// ReportsV1 returns the golden vectors of v1 reports.
+func ReportsV1() (rs []ReportV1) {
+	for _, r := range load[jsonReportV1, jsonObservationV1]("v1.json").Reports {
+		rs = append(rs, ReportV1{
+			Name:   r.Name,
+			FeedID: r.FeedID,
+			Fields: mercury_v1.ReportFields{
+				Timestamp:             r.Fields.Timestamp,
+				BenchmarkPrice:        r.Fields.BenchmarkPrice.orZero(),
+				Bid:                   r.Fields.Bid.orZero(),
+				Ask:                   r.Fields.Ask.orZero(),
+				CurrentBlockNum:       r.Fields.CurrentBlockNum,
+				CurrentBlockHash:      r.Fields.CurrentBlockHash,
+				ValidFromBlockNum:     r.Fields.ValidFromBlockNum,
+				CurrentBlockTimestamp: r.Fields.CurrentBlockTimestamp,
+			},
+			Report: ocrtypes.Report(r.Report),
+		})
+	}
+	return
+}
+
+// ObservationsV1 returns the golden vectors of v1 observations.
+func ObservationsV1() (obs []ObservationV1) {
+	for _, o := range load[jsonReportV1, jsonObservationV1]("v1.json").Observations {
+		obs = append(obs, ObservationV1{
+			Name:        o.Name,
+			Observation: ocrtypes.Observation(o.Observation),
+			Proto: &mercury_v1.MercuryObservationProto{
+				Timestamp:                    o.Fields.Timestamp,
+				BenchmarkPrice:               o.Fields.BenchmarkPrice.int192(),
+				Bid:                          o.Fields.Bid.int192(),
+				Ask:                          o.Fields.Ask.int192(),
+				PricesValid:                  o.Fields.PricesValid,
+				CurrentBlockNum:              o.Fields.CurrentBlockNum,
+				CurrentBlockHash:             o.Fields.CurrentBlockHash,
+				CurrentBlockTimestamp:        o.Fields.CurrentBlockTimestamp,
+				CurrentBlockValid:            o.Fields.CurrentBlockValid,
+				MaxFinalizedBlockNumber:      o.Fields.MaxFinalizedBlockNumber,
+				MaxFinalizedBlockNumberValid: o.Fields.MaxFinalizedBlockNumberValid,
+			},
+		})
+	}
+	return
+}
+
+// VerifyReportCodecV1 checks the codecs returned by newCodec against [ReportsV1]: each report must be built byte for
+// byte, respect MaxReportLength, and have its current block number decoded. All mismatches are returned together.
+func VerifyReportCodecV1(newCodec func(feedID [32]byte) (mercury_v1.ReportCodec, error)) error {
+	var errs []error
+	for _, v := range ReportsV1() {
+		errs = append(errs, verifyReportV1(newCodec, v))
+	}
+	return errors.Join(errs...)
+}
+
+func verifyReportV1(newCodec func(feedID [32]byte) (mercury_v1.ReportCodec, error), v ReportV1) error {
+	codec, err := newCodec(v.FeedID)
+	if err != nil {
+		return fmt.Errorf("%s: failed to create codec: %w", v.Name, err)
+	}
+	report, err := codec.BuildReport(v.Fields)
+	if err != nil {
+		return fmt.Errorf("%s: BuildReport failed: %w", v.Name, err)
+	}
+	if !bytes.Equal(v.Report, report) {
+		return fmt.Errorf("%s: BuildReport: expected %x but got %x", v.Name, []byte(v.Report), []byte(report))
+	}
+	if err = verifyMaxReportLength(codec, report); err != nil {
+		return fmt.Errorf("%s: %w", v.Name, err)
+	}
+	num, err := codec.CurrentBlockNumFromReport(report)
+	if err != nil {
+		return fmt.Errorf("%s: CurrentBlockNumFromReport failed: %w", v.Name, err)
+	}
+	if num != v.Fields.CurrentBlockNum {
+		return fmt.Errorf("%s: CurrentBlockNumFromReport: expected %d but got %d", v.Name, v.Fields.CurrentBlockNum, num)
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package vectors
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	mercury_v2 "github.com/smartcontractkit/chainlink-relay/pkg/reportingplugins/mercury/v2"
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+// ReportV2 is a golden vector of a v2 report.
+type ReportV2 struct {
+	Name   string
+	FeedID [32]byte
+	Fields mercury_v2.ReportFields
+	Report ocrtypes.Report
+}
+
+// ObservationV2 is a golden vector of a v2 observation.
+type ObservationV2 struct {
+	Name        string
+	Observation ocrtypes.Observation
+	Proto       *mercury_v2.MercuryObservationProto
+}
+
+type jsonReportV2 struct {
+	Name   string       `json:"name"`
+	FeedID types.FeedID `json:"feedID"`
+	Fields struct {
+		ValidFromTimestamp uint32  `json:"validFromTimestamp"`
+		Timestamp          uint32  `json:"timestamp"`
+		NativeFee          decimal `json:"nativeFee"`
+		LinkFee            decimal `json:"linkFee"`
+		ExpiresAt          uint32  `json:"expiresAt"`
+		BenchmarkPrice     decimal `json:"benchmarkPrice"`
+	} `json:"fields"`
+	Report hexBytes `json:"report"`
+}
+
+type jsonObservationV2 struct {
+	Name   string `json:"name"`
+	Fields struct {
+		Timestamp                  uint32  `json:"timestamp"`
+		BenchmarkPrice             decimal `json:"benchmarkPrice"`
+		PricesValid                bool    `json:"pricesValid"`
+		MaxFinalizedTimestamp      int64   `json:"maxFinalizedTimestamp,string"`
+		MaxFinalizedTimestampValid bool    `json:"maxFinalizedTimestampValid"`
+		LinkFee                    decimal `json:"linkFee"`
+		LinkFeeValid               bool    `json:"linkFeeValid"`
+		NativeFee                  decimal `json:"nativeFee"`
+		NativeFeeValid             bool    `json:"nativeFeeValid"`
+	} `json:"fields"`
+	Observation hexBytes `json:"observation"`
+}
+
+// ReportsV2 returns the golden vectors of v2 reports.
+func ReportsV2() (rs []ReportV2) {
+	for _, r := range load[jsonReportV2, jsonObservationV2]("v2.json").Reports {
+		rs = append(rs, ReportV2{
+			Name:   r.Name,
+			FeedID: r.FeedID,
+			Fields: mercury_v2.ReportFields{
+				ValidFromTimestamp: r.Fields.ValidFromTimestamp,
+				Timestamp:          r.Fields.Timestamp,
+				NativeFee:          r.Fields.NativeFee.orZero(),
+				LinkFee:            r.Fields.LinkFee.orZero(),
+				ExpiresAt:          r.Fields.ExpiresAt,
+				BenchmarkPrice:     r.Fields.BenchmarkPrice.orZero(),
+			},
+			Report: ocrtypes.Report(r.Report),
+		})
+	}
+	return
+}
+
+// ObservationsV2 returns the golden vectors of v2 observations.
+func ObservationsV2() (obs []ObservationV2) {
+	for _, o := range load[jsonReportV2, jsonObservationV2]("v2.json").Observations {
+		obs = append(obs, ObservationV2{
+			Name:        o.Name,
+			Observation: ocrtypes.Observation(o.Observation),
+			Proto: &mercury_v2.MercuryObservationProto{
+				Timestamp:                  o.Fields.Timestamp,
+				BenchmarkPrice:             o.Fields.BenchmarkPrice.int192(),
+				PricesValid:                o.Fields.PricesValid,
+				MaxFinalizedTimestamp:      o.Fields.MaxFinalizedTimestamp,
+				MaxFinalizedTimestampValid: o.Fields.MaxFinalizedTimestampValid,
+				LinkFee:                    o.Fields.LinkFee.int192(),
+				LinkFeeValid:               o.Fields.LinkFeeValid,
+				NativeFee:                  o.Fields.NativeFee.int192(),
+				NativeFeeValid:             o.Fields.NativeFeeValid,
+			},
+		})
+	}
+	return
+}
+
+// VerifyReportCodecV2 checks the codecs returned by newCodec against [ReportsV2]: each report must be built byte for
+// byte, respect MaxReportLength, and have its observation timestamp decoded. All mismatches are returned together.
+func VerifyReportCodecV2(newCodec func(feedID [32]byte) (mercury_v2.ReportCodec, error)) error {
+	var errs []error
+	for _, v := range ReportsV2() {
+		errs = append(errs, verifyReportV2(newCodec, v))
+	}
+	return errors.Join(errs...)
+}
+
+func verifyReportV2(newCodec func(feedID [32]byte) (mercury_v2.ReportCodec, error), v ReportV2) error {
+	codec, err := newCodec(v.FeedID)
+	if err != nil {
+		return fmt.Errorf("%s: failed to create codec: %w", v.Name, err)
+	}
+	report, err := codec.BuildReport(v.Fields)
+	if err != nil {
+		return fmt.Errorf("%s: BuildReport failed: %w", v.Name, err)
+	}
+	if !bytes.Equal(v.Report, report) {
+		return fmt.Errorf("%s: BuildReport: expected %x but got %x", v.Name, []byte(v.Report), []byte(report))
+	}
+	if err = verifyMaxReportLength(codec, report); err != nil {
+		return fmt.Errorf("%s: %w", v.Name, err)
+	}
+	ts, err := codec.ObservationTimestampFromReport(report)
+	if err != nil {
+		return fmt.Errorf("%s: ObservationTimestampFromReport failed: %w", v.Name, err)
+	}
+	if ts != v.Fields.Timestamp {
+		return fmt.Errorf("%s: ObservationTimestampFromReport: expected %d but got %d", v.Name, v.Fields.Timestamp, ts)
+	}
+	return nil
+}
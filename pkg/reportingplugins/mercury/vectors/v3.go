@@ -0,0 +1,142 @@
+package vectors
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	mercury_v3 "github.com/smartcontractkit/chainlink-relay/pkg/reportingplugins/mercury/v3"
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+// ReportV3 is a golden vector of a v3 report.
+type ReportV3 struct {
+	Name   string
+	FeedID [32]byte
+	Fields mercury_v3.ReportFields
+	Report ocrtypes.Report
+}
+
+// ObservationV3 is a golden vector of a v3 observation.
+type ObservationV3 struct {
+	Name        string
+	Observation ocrtypes.Observation
+	Proto       *mercury_v3.MercuryObservationProto
+}
+
+type jsonReportV3 struct {
+	Name   string       `json:"name"`
+	FeedID types.FeedID `json:"feedID"`
+	Fields struct {
+		ValidFromTimestamp uint32  `json:"validFromTimestamp"`
+		Timestamp          uint32  `json:"timestamp"`
+		NativeFee          decimal `json:"nativeFee"`
+		LinkFee            decimal `json:"linkFee"`
+		ExpiresAt          uint32  `json:"expiresAt"`
+		BenchmarkPrice     decimal `json:"benchmarkPrice"`
+		Bid                decimal `json:"bid"`
+		Ask                decimal `json:"ask"`
+	} `json:"fields"`
+	Report hexBytes `json:"report"`
+}
+
+type jsonObservationV3 struct {
+	Name   string `json:"name"`
+	Fields struct {
+		Timestamp                  uint32  `json:"timestamp"`
+		BenchmarkPrice             decimal `json:"benchmarkPrice"`
+		Bid                        decimal `json:"bid"`
+		Ask                        decimal `json:"ask"`
+		PricesValid                bool    `json:"pricesValid"`
+		MaxFinalizedTimestamp      int64   `json:"maxFinalizedTimestamp,string"`
+		MaxFinalizedTimestampValid bool    `json:"maxFinalizedTimestampValid"`
+		LinkFee                    decimal `json:"linkFee"`
+		LinkFeeValid               bool    `json:"linkFeeValid"`
+		NativeFee                  decimal `json:"nativeFee"`
+		NativeFeeValid             bool    `json:"nativeFeeValid"`
+	} `json:"fields"`
+	Observation hexBytes `json:"observation"`
+}
+
+// ReportsV3 returns the golden vectors of v3 reports.
+func ReportsV3() (rs []ReportV3) {
+	for _, r := range load[jsonReportV3, jsonObservationV3]("v3.json").Reports {
+		rs = append(rs, ReportV3{
+			Name:   r.Name,
+			FeedID: r.FeedID,
+			Fields: mercury_v3.ReportFields{
+				ValidFromTimestamp: r.Fields.ValidFromTimestamp,
+				Timestamp:          r.Fields.Timestamp,
+				NativeFee:          r.Fields.NativeFee.orZero(),
+				LinkFee:            r.Fields.LinkFee.orZero(),
+				ExpiresAt:          r.Fields.ExpiresAt,
+				BenchmarkPrice:     r.Fields.BenchmarkPrice.orZero(),
+				Bid:                r.Fields.Bid.orZero(),
+				Ask:                r.Fields.Ask.orZero(),
+			},
+			Report: ocrtypes.Report(r.Report),
+		})
+	}
+	return
+}
+
+// ObservationsV3 returns the golden vectors of v3 observations.
+func ObservationsV3() (obs []ObservationV3) {
+	for _, o := range load[jsonReportV3, jsonObservationV3]("v3.json").Observations {
+		obs = append(obs, ObservationV3{
+			Name:        o.Name,
+			Observation: ocrtypes.Observation(o.Observation),
+			Proto: &mercury_v3.MercuryObservationProto{
+				Timestamp:                  o.Fields.Timestamp,
+				BenchmarkPrice:             o.Fields.BenchmarkPrice.int192(),
+				Bid:                        o.Fields.Bid.int192(),
+				Ask:                        o.Fields.Ask.int192(),
+				PricesValid:                o.Fields.PricesValid,
+				MaxFinalizedTimestamp:      o.Fields.MaxFinalizedTimestamp,
+				MaxFinalizedTimestampValid: o.Fields.MaxFinalizedTimestampValid,
+				LinkFee:                    o.Fields.LinkFee.int192(),
+				LinkFeeValid:               o.Fields.LinkFeeValid,
+				NativeFee:                  o.Fields.NativeFee.int192(),
+				NativeFeeValid:             o.Fields.NativeFeeValid,
+			},
+		})
+	}
+	return
+}
+
+// VerifyReportCodecV3 checks the codecs returned by newCodec against [ReportsV3]: each report must be built byte for
+// byte, respect MaxReportLength, and have its observation timestamp decoded. All mismatches are returned together.
+func VerifyReportCodecV3(newCodec func(feedID [32]byte) (mercury_v3.ReportCodec, error)) error {
+	var errs []error
+	for _, v := range ReportsV3() {
+		errs = append(errs, verifyReportV3(newCodec, v))
+	}
+	return errors.Join(errs...)
+}
+
+func verifyReportV3(newCodec func(feedID [32]byte) (mercury_v3.ReportCodec, error), v ReportV3) error {
+	codec, err := newCodec(v.FeedID)
+	if err != nil {
+		return fmt.Errorf("%s: failed to create codec: %w", v.Name, err)
+	}
+	report, err := codec.BuildReport(v.Fields)
+	if err != nil {
+		return fmt.Errorf("%s: BuildReport failed: %w", v.Name, err)
+	}
+	if !bytes.Equal(v.Report, report) {
+		return fmt.Errorf("%s: BuildReport: expected %x but got %x", v.Name, []byte(v.Report), []byte(report))
+	}
+	if err = verifyMaxReportLength(codec, report); err != nil {
+		return fmt.Errorf("%s: %w", v.Name, err)
+	}
+	ts, err := codec.ObservationTimestampFromReport(report)
+	if err != nil {
+		return fmt.Errorf("%s: ObservationTimestampFromReport failed: %w", v.Name, err)
+	}
+	if ts != v.Fields.Timestamp {
+		return fmt.Errorf("%s: ObservationTimestampFromReport: expected %d but got %d", v.Name, v.Fields.Timestamp, ts)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+// Package vectors has golden test vectors for the serialization of mercury observations and reports, so that
+// independent implementations can check byte for byte compatibility with the mercury plugins.
+//
+// The vectors are embedded JSON files, v1.json, v2.json and v3.json, which can be used directly by implementations in
+// other languages. Byte strings are 0x-prefixed hex, and integers which may exceed 53 bits are decimal strings.
+// Reports are encoded as for EVM chains: the ABI encoding of the feed ID followed by the report fields, in the order
+// of the ReportFields of each version. Observations are encoded with the MercuryObservationProto of each version, with
+// prices as 24 byte, big endian two's complement values.
+package vectors
+
+import (
+	"embed"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/reportingplugins/mercury"
+)
+
+//go:embed v1.json v2.json v3.json
+var files embed.FS
+
+// file is the layout of each JSON file, with R and O being the report and observation vectors of one version.
+type file[R, O any] struct {
+	Reports      []R `json:"reports"`
+	Observations []O `json:"observations"`
+}
+
+func load[R, O any](name string) (f file[R, O]) {
+	b, err := files.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	if err = json.Unmarshal(b, &f); err != nil {
+		panic(fmt.Errorf("invalid vectors in %s: %w", name, err))
+	}
+	return
+}
+
+// hexBytes is a 0x-prefixed hex string in JSON.
+type hexBytes []byte
+
+func (h *hexBytes) UnmarshalText(text []byte) error {
+	s, ok := strings.CutPrefix(string(text), "0x")
+	if !ok {
+		return fmt.Errorf("missing 0x prefix: %q", text)
+	}
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return err
+	}
+	*h = b
+	return nil
+}
+
+// decimal is a decimal string in JSON.
+type decimal struct {
+	v *big.Int // nil if missing
+}
+
+func (d *decimal) UnmarshalText(text []byte) error {
+	v, ok := new(big.Int).SetString(string(text), 10)
+	if !ok {
+		return fmt.Errorf("invalid decimal: %q", text)
+	}
+	d.v = v
+	return nil
+}
+
+// int192 returns d as encoded in observations, or nil if it is missing.
+func (d decimal) int192() []byte {
+	if d.v == nil {
+		return nil
+	}
+	return mercury.MustEncodeValueInt192(d.v)
+}
+
+// orZero returns a copy of d, or zero if it is missing.
+func (d decimal) orZero() *big.Int {
+	if d.v == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(d.v)
+}
+
+// verifyOracles is the number of oracles for checking MaxReportLength.
+const verifyOracles = 31
+
+func verifyMaxReportLength(codec interface{ MaxReportLength(n int) (int, error) }, report []byte) error {
+	max, err := codec.MaxReportLength(verifyOracles)
+	if err != nil {
+		return fmt.Errorf("MaxReportLength failed: %w", err)
+	}
+	if len(report) > max {
+		return fmt.Errorf("report length %d exceeds MaxReportLength %d", len(report), max)
+	}
+	return nil
+}
@@ -0,0 +1,249 @@
+package vectors_test
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smartcontractkit/libocr/bigbigendian"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	mercury_v1 "github.com/smartcontractkit/chainlink-relay/pkg/reportingplugins/mercury/v1"
+	mercury_v2 "github.com/smartcontractkit/chainlink-relay/pkg/reportingplugins/mercury/v2"
+	mercury_v3 "github.com/smartcontractkit/chainlink-relay/pkg/reportingplugins/mercury/v3"
+	"github.com/smartcontractkit/chainlink-relay/pkg/reportingplugins/mercury/vectors"
+)
+
+func TestVerifyReportCodec(t *testing.T) {
+	t.Run("v1", func(t *testing.T) {
+		require.NotEmpty(t, vectors.ReportsV1())
+		require.NoError(t, vectors.VerifyReportCodecV1(func(feedID [32]byte) (mercury_v1.ReportCodec, error) {
+			return evmReportCodecV1{feedID}, nil
+		}))
+	})
+	t.Run("v2", func(t *testing.T) {
+		require.NotEmpty(t, vectors.ReportsV2())
+		require.NoError(t, vectors.VerifyReportCodecV2(func(feedID [32]byte) (mercury_v2.ReportCodec, error) {
+			return evmReportCodecV2{feedID}, nil
+		}))
+	})
+	t.Run("v3", func(t *testing.T) {
+		require.NotEmpty(t, vectors.ReportsV3())
+		require.NoError(t, vectors.VerifyReportCodecV3(func(feedID [32]byte) (mercury_v3.ReportCodec, error) {
+			return evmReportCodecV3{feedID}, nil
+		}))
+	})
+	t.Run("mismatch", func(t *testing.T) {
+		err := vectors.VerifyReportCodecV3(func(feedID [32]byte) (mercury_v3.ReportCodec, error) {
+			return swappedReportCodecV3{evmReportCodecV3{feedID}}, nil
+		})
+		assert.ErrorContains(t, err, "typical: BuildReport: expected")
+		assert.NotContains(t, err.Error(), "zero:", "bid and ask are equal")
+	})
+}
+
+// TestReportsV3_layout checks a vector against its encoding worked out by hand, independently of the reference codec.
+func TestReportsV3_layout(t *testing.T) {
+	for _, v := range vectors.ReportsV3() {
+		if v.Name != "negative prices" {
+			continue
+		}
+		word := func(s string) string { return fmt.Sprintf("%064s", s) }
+		ff := bytes.Repeat([]byte("f"), 64)
+		expected := fmt.Sprintf("%x", v.FeedID) +
+			word("6553f100") + // validFromTimestamp 1700000000
+			word("6553f100") + // timestamp
+			word("0") + // nativeFee
+			word("0") + // linkFee
+			word("6553f100") + // expiresAt
+			string(ff) + // benchmarkPrice -1
+			string(ff[:63]) + "e" + // bid -2
+			word("0") // ask
+		assert.Equal(t, expected, fmt.Sprintf("%x", []byte(v.Report)))
+		return
+	}
+	t.Fatal("missing vector")
+}
+
+func TestObservations(t *testing.T) {
+	t.Run("v1", func(t *testing.T) {
+		vs := vectors.ObservationsV1()
+		require.NotEmpty(t, vs)
+		for _, v := range vs {
+			testObservation(t, v.Name, v.Observation, v.Proto, &mercury_v1.MercuryObservationProto{})
+		}
+	})
+	t.Run("v2", func(t *testing.T) {
+		vs := vectors.ObservationsV2()
+		require.NotEmpty(t, vs)
+		for _, v := range vs {
+			testObservation(t, v.Name, v.Observation, v.Proto, &mercury_v2.MercuryObservationProto{})
+		}
+	})
+	t.Run("v3", func(t *testing.T) {
+		vs := vectors.ObservationsV3()
+		require.NotEmpty(t, vs)
+		for _, v := range vs {
+			testObservation(t, v.Name, v.Observation, v.Proto, &mercury_v3.MercuryObservationProto{})
+		}
+	})
+}
+
+// testObservation checks that expected marshals to obs, and that obs unmarshals to expected, via got.
+func testObservation(t *testing.T, name string, obs ocrtypes.Observation, expected, got proto.Message) {
+	t.Run(name, func(t *testing.T) {
+		b, err := proto.Marshal(expected)
+		require.NoError(t, err)
+		assert.True(t, bytes.Equal(obs, b), "expected %x but got %x", []byte(obs), b)
+
+		require.NoError(t, proto.Unmarshal(obs, got))
+		assert.True(t, proto.Equal(expected, got), "expected %v but got %v", expected, got)
+	})
+}
+
+// evmReportCodecV1 is a reference implementation of the EVM encoding of v1 reports.
+type evmReportCodecV1 struct {
+	feedID [32]byte
+}
+
+func (c evmReportCodecV1) BuildReport(rf mercury_v1.ReportFields) (ocrtypes.Report, error) {
+	var e abiEncoder
+	e.bytes32(c.feedID[:])
+	e.uint(big.NewInt(int64(rf.Timestamp)), 32)
+	e.int(rf.BenchmarkPrice, 192)
+	e.int(rf.Bid, 192)
+	e.int(rf.Ask, 192)
+	e.uint(big.NewInt(rf.CurrentBlockNum), 64)
+	e.bytes32(rf.CurrentBlockHash)
+	e.uint(big.NewInt(rf.ValidFromBlockNum), 64)
+	e.uint(new(big.Int).SetUint64(rf.CurrentBlockTimestamp), 64)
+	return e.b, e.err
+}
+
+func (c evmReportCodecV1) MaxReportLength(n int) (int, error) { return 9 * 32, nil }
+
+func (c evmReportCodecV1) CurrentBlockNumFromReport(report ocrtypes.Report) (int64, error) {
+	w, err := reportWord(report, 9, 5)
+	if err != nil {
+		return 0, err
+	}
+	if !w.IsInt64() {
+		return 0, fmt.Errorf("currentBlockNum overflows int64: %s", w)
+	}
+	return w.Int64(), nil
+}
+
+// evmReportCodecV2 is a reference implementation of the EVM encoding of v2 reports.
+type evmReportCodecV2 struct {
+	feedID [32]byte
+}
+
+func (c evmReportCodecV2) BuildReport(rf mercury_v2.ReportFields) (ocrtypes.Report, error) {
+	var e abiEncoder
+	e.bytes32(c.feedID[:])
+	e.uint(big.NewInt(int64(rf.ValidFromTimestamp)), 32)
+	e.uint(big.NewInt(int64(rf.Timestamp)), 32)
+	e.uint(rf.NativeFee, 192)
+	e.uint(rf.LinkFee, 192)
+	e.uint(big.NewInt(int64(rf.ExpiresAt)), 32)
+	e.int(rf.BenchmarkPrice, 192)
+	return e.b, e.err
+}
+
+func (c evmReportCodecV2) MaxReportLength(n int) (int, error) { return 7 * 32, nil }
+
+func (c evmReportCodecV2) ObservationTimestampFromReport(report ocrtypes.Report) (uint32, error) {
+	return observationTimestamp(report, 7)
+}
+
+// evmReportCodecV3 is a reference implementation of the EVM encoding of v3 reports.
+type evmReportCodecV3 struct {
+	feedID [32]byte
+}
+
+func (c evmReportCodecV3) BuildReport(rf mercury_v3.ReportFields) (ocrtypes.Report, error) {
+	var e abiEncoder
+	e.bytes32(c.feedID[:])
+	e.uint(big.NewInt(int64(rf.ValidFromTimestamp)), 32)
+	e.uint(big.NewInt(int64(rf.Timestamp)), 32)
+	e.uint(rf.NativeFee, 192)
+	e.uint(rf.LinkFee, 192)
+	e.uint(big.NewInt(int64(rf.ExpiresAt)), 32)
+	e.int(rf.BenchmarkPrice, 192)
+	e.int(rf.Bid, 192)
+	e.int(rf.Ask, 192)
+	return e.b, e.err
+}
+
+func (c evmReportCodecV3) MaxReportLength(n int) (int, error) { return 9 * 32, nil }
+
+func (c evmReportCodecV3) ObservationTimestampFromReport(report ocrtypes.Report) (uint32, error) {
+	return observationTimestamp(report, 9)
+}
+
+// swappedReportCodecV3 is a broken codec, which swaps bid and ask.
+type swappedReportCodecV3 struct {
+	evmReportCodecV3
+}
+
+func (c swappedReportCodecV3) BuildReport(rf mercury_v3.ReportFields) (ocrtypes.Report, error) {
+	rf.Bid, rf.Ask = rf.Ask, rf.Bid
+	return c.evmReportCodecV3.BuildReport(rf)
+}
+
+// abiEncoder appends ABI encoded static values, until the first error.
+type abiEncoder struct {
+	b   []byte
+	err error
+}
+
+func (e *abiEncoder) bytes32(b []byte) {
+	if e.err == nil && len(b) != 32 {
+		e.err = fmt.Errorf("expected 32 bytes but got %d", len(b))
+	}
+	e.b = append(e.b, b...)
+}
+
+func (e *abiEncoder) uint(i *big.Int, bits int) {
+	if e.err == nil && (i.Sign() < 0 || i.BitLen() > bits) {
+		e.err = fmt.Errorf("%s overflows uint%d", i, bits)
+	}
+	if e.err == nil {
+		e.b = append(e.b, i.FillBytes(make([]byte, 32))...)
+	}
+}
+
+func (e *abiEncoder) int(i *big.Int, bits int) {
+	if e.err == nil {
+		_, e.err = bigbigendian.SerializeSigned(bits/8, i) // range check
+	}
+	if e.err == nil {
+		var w []byte
+		w, e.err = bigbigendian.SerializeSigned(32, i)
+		e.b = append(e.b, w...)
+	}
+}
+
+// reportWord returns word i of a report with n words, as an unsigned integer.
+func reportWord(report ocrtypes.Report, n, i int) (*big.Int, error) {
+	if len(report) != n*32 {
+		return nil, fmt.Errorf("expected report length %d but got %d", n*32, len(report))
+	}
+	return new(big.Int).SetBytes(report[i*32 : (i+1)*32]), nil
+}
+
+func observationTimestamp(report ocrtypes.Report, n int) (uint32, error) {
+	w, err := reportWord(report, n, 2)
+	if err != nil {
+		return 0, err
+	}
+	if w.BitLen() > 32 {
+		return 0, fmt.Errorf("observationsTimestamp overflows uint32: %s", w)
+	}
+	return uint32(w.Uint64()), nil
+}
@@ -0,0 +1,155 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/exp/maps"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+)
+
+// HealthReporter is the health reporting subset of types.Service.
+type HealthReporter interface {
+	Name() string
+	HealthReport() map[string]error
+}
+
+// ErrUnchecked is reported for services which have been registered, but not yet checked.
+var ErrUnchecked = errors.New("health not yet checked")
+
+// HealthChecker polls the HealthReport of each registered service on a ticker, and caches the combined results, so
+// that health endpoints respond immediately and consistently. Changes in health are logged.
+type HealthChecker struct {
+	StateMachine
+	lggr     logger.Logger
+	interval time.Duration
+
+	servicesMu sync.Mutex
+	services   map[string]HealthReporter
+
+	reportMu sync.RWMutex
+	report   map[string]error
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+// NewHealthChecker returns a new [*HealthChecker] which checks every interval.
+func NewHealthChecker(lggr logger.Logger, interval time.Duration) *HealthChecker {
+	return &HealthChecker{
+		lggr:     logger.Named(lggr, "HealthChecker"),
+		interval: interval,
+		services: make(map[string]HealthReporter),
+		report:   make(map[string]error),
+		stopCh:   make(chan struct{}),
+	}
+}
+
+// Register adds a service to be checked. It is reported as [ErrUnchecked] until the next check.
+func (c *HealthChecker) Register(s HealthReporter) error {
+	name := s.Name()
+	c.servicesMu.Lock()
+	defer c.servicesMu.Unlock()
+	if _, ok := c.services[name]; ok {
+		return fmt.Errorf("service %q is already registered", name)
+	}
+	c.services[name] = s
+
+	c.reportMu.Lock()
+	defer c.reportMu.Unlock()
+	c.report[name] = ErrUnchecked
+	return nil
+}
+
+// Unregister removes a service, and any of its results.
+func (c *HealthChecker) Unregister(name string) {
+	c.servicesMu.Lock()
+	defer c.servicesMu.Unlock()
+	delete(c.services, name)
+	// Results are replaced wholesale by the next check.
+}
+
+func (c *HealthChecker) Name() string { return c.lggr.Name() }
+
+func (c *HealthChecker) Start(context.Context) error {
+	return c.StartOnce("HealthChecker", func() error {
+		c.wg.Add(1)
+		go c.run()
+		return nil
+	})
+}
+
+func (c *HealthChecker) Close() error {
+	return c.StopOnce("HealthChecker", func() error {
+		close(c.stopCh)
+		c.wg.Wait()
+		return nil
+	})
+}
+
+func (c *HealthChecker) run() {
+	defer c.wg.Done()
+	t := time.NewTicker(c.interval)
+	defer t.Stop()
+
+	c.check()
+	for {
+		select {
+		case <-c.stopCh:
+			return
+		case <-t.C:
+			c.check()
+		}
+	}
+}
+
+// check updates the cached report from every service.
+func (c *HealthChecker) check() {
+	// Hold the lock throughout, so that concurrently registered services are not dropped from the report.
+	c.servicesMu.Lock()
+	defer c.servicesMu.Unlock()
+
+	report := make(map[string]error)
+	for _, s := range c.services {
+		maps.Copy(report, s.HealthReport())
+	}
+
+	c.reportMu.Lock()
+	old := c.report
+	c.report = report
+	c.reportMu.Unlock()
+
+	for name, err := range report {
+		oldErr, ok := old[name]
+		switch {
+		case err != nil && (!ok || oldErr == nil || errors.Is(oldErr, ErrUnchecked)):
+			c.lggr.Errorw("Service became unhealthy", "service", name, "err", err)
+		case err == nil && ok && oldErr != nil && !errors.Is(oldErr, ErrUnchecked):
+			c.lggr.Infow("Service became healthy", "service", name)
+		}
+	}
+}
+
+// HealthReport returns a copy of the latest results, including the HealthChecker itself.
+func (c *HealthChecker) HealthReport() map[string]error {
+	c.reportMu.RLock()
+	defer c.reportMu.RUnlock()
+	hr := maps.Clone(c.report)
+	hr[c.Name()] = c.Healthy()
+	return hr
+}
+
+// IsHealthy returns true if every result from the latest check is healthy, along with the report.
+func (c *HealthChecker) IsHealthy() (healthy bool, report map[string]error) {
+	report = c.HealthReport()
+	for _, err := range report {
+		if err != nil {
+			return false, report
+		}
+	}
+	return true, report
+}
@@ -0,0 +1,70 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+)
+
+func TestHealthChecker(t *testing.T) {
+	c := NewHealthChecker(logger.Test(t), 10*time.Millisecond)
+	a, b := &fakeHealthReporter{name: "a"}, &fakeHealthReporter{name: "b"}
+	require.NoError(t, c.Register(a))
+	require.Error(t, c.Register(a), "duplicate")
+
+	healthy, report := c.IsHealthy()
+	require.False(t, healthy)
+	require.ErrorIs(t, report["a"], ErrUnchecked)
+	require.ErrorIs(t, report[c.Name()], ErrNotStarted)
+
+	require.NoError(t, c.Start(context.Background()))
+	t.Cleanup(func() { assert.NoError(t, c.Close()) })
+	require.Eventually(t, func() bool {
+		healthy, _ := c.IsHealthy()
+		return healthy
+	}, time.Second, 5*time.Millisecond)
+
+	errUnhealthy := errors.New("unhealthy")
+	b.set(errUnhealthy)
+	require.NoError(t, c.Register(b))
+	require.Eventually(t, func() bool {
+		return errors.Is(c.HealthReport()["b"], errUnhealthy)
+	}, time.Second, 5*time.Millisecond)
+	healthy, _ = c.IsHealthy()
+	require.False(t, healthy)
+
+	c.Unregister("b")
+	require.Eventually(t, func() bool {
+		_, ok := c.HealthReport()["b"]
+		return !ok
+	}, time.Second, 5*time.Millisecond)
+	healthy, _ = c.IsHealthy()
+	require.True(t, healthy)
+}
+
+type fakeHealthReporter struct {
+	name string
+	mu   sync.Mutex
+	err  error
+}
+
+func (f *fakeHealthReporter) set(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.err = err
+}
+
+func (f *fakeHealthReporter) Name() string { return f.name }
+
+func (f *fakeHealthReporter) HealthReport() map[string]error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return map[string]error{f.name: f.err}
+}
@@ -0,0 +1,168 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrNotStarted is wrapped by the errors returned from [StateMachine.Ready] and [StateMachine.Healthy].
+var ErrNotStarted = errors.New("not started")
+
+type errNotStarted struct {
+	state state
+}
+
+func (e *errNotStarted) Error() string {
+	return fmt.Sprintf("service is %q, not started", e.state)
+}
+
+func (e *errNotStarted) Unwrap() error { return ErrNotStarted }
+
+// state holds the state for StateMachine
+type state int32
+
+const (
+	stateUnstarted state = iota
+	stateStarted
+	stateStarting
+	stateStopping
+	stateStopped
+	stateUnreachable
+)
+
+func (s state) String() string {
+	switch s {
+	case stateUnstarted:
+		return "Unstarted"
+	case stateStarted:
+		return "Started"
+	case stateStarting:
+		return "Starting"
+	case stateStopping:
+		return "Stopping"
+	case stateStopped:
+		return "Stopped"
+	case stateUnreachable:
+		return "Unreachable"
+	default:
+		return fmt.Sprintf("unrecognized state: %d", s)
+	}
+}
+
+// StateMachine can be embedded in a struct to help implement types.Service. It transitions once through
+// Unstarted -> Starting -> Started -> Stopping -> Stopped. If the state is modified outside of the lock, it becomes
+// Unreachable, and all further transitions fail.
+type StateMachine struct {
+	state        atomic.Int32
+	sync.RWMutex // lock is held during startup/shutdown, RLock is held while executing functions dependent on a particular state
+}
+
+// StartOnce sets the state to Started. The state is Started even if fn returns an error, so that StopOnce can clean
+// up after a partial start.
+func (s *StateMachine) StartOnce(name string, fn func() error) error {
+	// SAFETY: We do this compare-and-swap outside of the lock so that
+	// concurrent StartOnce() calls return immediately.
+	success := s.state.CompareAndSwap(int32(stateUnstarted), int32(stateStarting))
+
+	if !success {
+		return fmt.Errorf("%v has already started once", name)
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	err := fn()
+
+	success = s.state.CompareAndSwap(int32(stateStarting), int32(stateStarted))
+
+	if !success {
+		// SAFETY: If this is reached, something must be very wrong: the state
+		// was tampered with outside of the lock.
+		s.state.Store(int32(stateUnreachable))
+		return errors.Join(err, fmt.Errorf("%v entered unreachable state, unable to set state to started", name))
+	}
+
+	return err
+}
+
+// StopOnce sets the state to Stopped
+func (s *StateMachine) StopOnce(name string, fn func() error) error {
+	// SAFETY: We hold the lock here so that Stop blocks until StartOnce
+	// executes. This ensures that a very fast call to Stop will wait for the
+	// code to finish starting up before teardown.
+	s.Lock()
+	defer s.Unlock()
+
+	success := s.state.CompareAndSwap(int32(stateStarted), int32(stateStopping))
+
+	if !success {
+		return fmt.Errorf("%v is unstarted or has already stopped once", name)
+	}
+
+	err := fn()
+
+	success = s.state.CompareAndSwap(int32(stateStopping), int32(stateStopped))
+
+	if !success {
+		// SAFETY: If this is reached, something must be very wrong: the state
+		// was tampered with outside of the lock.
+		s.state.Store(int32(stateUnreachable))
+		return errors.Join(err, fmt.Errorf("%v entered unreachable state, unable to set state to stopped", name))
+	}
+
+	return err
+}
+
+// State retrieves the current state
+func (s *StateMachine) State() string {
+	return s.loadState().String()
+}
+
+func (s *StateMachine) loadState() state {
+	return state(s.state.Load())
+}
+
+// IfStarted runs the func and returns true only if started, otherwise returns false
+func (s *StateMachine) IfStarted(f func()) (ok bool) {
+	s.RLock()
+	defer s.RUnlock()
+
+	if s.loadState() == stateStarted {
+		f()
+		return true
+	}
+	return false
+}
+
+// IfNotStopped runs the func and returns true if in any state other than Stopped
+func (s *StateMachine) IfNotStopped(f func()) (ok bool) {
+	s.RLock()
+	defer s.RUnlock()
+
+	if s.loadState() == stateStopped {
+		return false
+	}
+	f()
+	return true
+}
+
+// Ready returns an error wrapping [ErrNotStarted] if the state is not started.
+func (s *StateMachine) Ready() error {
+	st := s.loadState()
+	if st == stateStarted {
+		return nil
+	}
+	return &errNotStarted{state: st}
+}
+
+// Healthy returns an error wrapping [ErrNotStarted] if the state is not started.
+// Override this per-service with more specific implementations.
+func (s *StateMachine) Healthy() error {
+	st := s.loadState()
+	if st == stateStarted {
+		return nil
+	}
+	return &errNotStarted{state: st}
+}
@@ -0,0 +1,52 @@
+package services
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateMachine(t *testing.T) {
+	var sm StateMachine
+	require.ErrorIs(t, sm.Ready(), ErrNotStarted)
+	require.Equal(t, "Unstarted", sm.State())
+	require.False(t, sm.IfStarted(func() {}))
+	require.Error(t, sm.StopOnce("test", func() error { return nil }))
+
+	startErr := errors.New("start failed")
+	require.ErrorIs(t, sm.StartOnce("test", func() error {
+		assert.Equal(t, "Starting", sm.State())
+		return startErr
+	}), startErr)
+	require.Equal(t, "Started", sm.State(), "started even if fn fails, so that it can be stopped")
+	require.NoError(t, sm.Ready())
+	require.NoError(t, sm.Healthy())
+	require.Error(t, sm.StartOnce("test", func() error { return nil }))
+
+	var ran bool
+	require.True(t, sm.IfStarted(func() { ran = true }))
+	require.True(t, ran)
+
+	require.NoError(t, sm.StopOnce("test", func() error {
+		assert.Equal(t, "Stopping", sm.State())
+		return nil
+	}))
+	require.Equal(t, "Stopped", sm.State())
+	require.ErrorIs(t, sm.Healthy(), ErrNotStarted)
+	require.False(t, sm.IfNotStopped(func() {}))
+	require.Error(t, sm.StopOnce("test", func() error { return nil }))
+}
+
+func TestStateMachine_unreachable(t *testing.T) {
+	var sm StateMachine
+	err := sm.StartOnce("test", func() error {
+		sm.state.Store(int32(stateStopped)) // tamper outside of the lock
+		return nil
+	})
+	require.ErrorContains(t, err, "unreachable")
+	require.Equal(t, "Unreachable", sm.State())
+	require.ErrorIs(t, sm.Ready(), ErrNotStarted)
+	require.Error(t, sm.StopOnce("test", func() error { return nil }))
+}
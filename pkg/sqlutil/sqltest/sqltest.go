@@ -0,0 +1,53 @@
+// Package sqltest is a testing harness for code built on [sqlutil]. Tests run against a real database, configured by
+// [EnvDriver] and [EnvURL] (e.g. a dockerized postgres in CI), and are skipped when unset. Each test is isolated in a
+// transaction which is rolled back during cleanup.
+package sqltest
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/sqlutil"
+)
+
+// Environment variables which configure the test database. The driver must be registered by the test package, e.g.
+// via a blank import.
+const (
+	EnvDriver = "CL_SQLTEST_DRIVER"
+	EnvURL    = "CL_SQLTEST_URL"
+)
+
+// NewDB opens the test database, which is closed during cleanup. The test is skipped if [EnvURL] is unset.
+func NewDB(tb testing.TB) *sql.DB {
+	url := os.Getenv(EnvURL)
+	if url == "" {
+		tb.Skipf("%s is not set", EnvURL)
+	}
+	driver := os.Getenv(EnvDriver)
+	if driver == "" {
+		tb.Fatalf("%s is required with %s", EnvDriver, EnvURL)
+	}
+	db, err := sql.Open(driver, url)
+	require.NoError(tb, err)
+	tb.Cleanup(func() { require.NoError(tb, db.Close()) })
+	require.NoError(tb, db.PingContext(context.Background()))
+	return db
+}
+
+// NewTx begins a transaction on db, which is rolled back during cleanup. Code under test which calls
+// [sqlutil.Transact] with the returned DataSource joins the same transaction, so its writes are discarded too.
+func NewTx(tb testing.TB, db *sql.DB) sqlutil.DataSource {
+	tx, err := db.BeginTx(context.Background(), nil)
+	require.NoError(tb, err)
+	tb.Cleanup(func() { require.NoError(tb, tx.Rollback()) })
+	return tx
+}
+
+// NewDataSource is a convenience for NewTx(tb, NewDB(tb)).
+func NewDataSource(tb testing.TB) sqlutil.DataSource {
+	return NewTx(tb, NewDB(tb))
+}
@@ -0,0 +1,118 @@
+// Package sqlutil provides database plumbing shared by relayer implementations: query logging, and context-aware
+// transactions. Only database/sql is required; callers register their own drivers.
+package sqlutil
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+)
+
+// DataSource is the query subset of [*sql.DB], [*sql.Tx] and [*sql.Conn].
+type DataSource interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+var (
+	_ DataSource = (*sql.DB)(nil)
+	_ DataSource = (*sql.Tx)(nil)
+	_ DataSource = (*sql.Conn)(nil)
+)
+
+// txBeginner is implemented by [*sql.DB] and [*sql.Conn].
+type txBeginner interface {
+	BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error)
+}
+
+// Transact runs fn in a transaction on ds, which is committed if fn returns nil, and rolled back otherwise, including
+// if fn panics or ctx expires. If ds is already a transaction, fn runs in it instead, so that transactional funcs
+// compose.
+func Transact(ctx context.Context, ds DataSource, opts *sql.TxOptions, fn func(tx DataSource) error) (err error) {
+	switch db := ds.(type) {
+	case *sql.Tx:
+		return fn(db)
+	case *loggedDataSource:
+		return Transact(ctx, db.ds, opts, func(tx DataSource) error {
+			return fn(db.with(tx))
+		})
+	case txBeginner:
+		var tx *sql.Tx
+		tx, err = db.BeginTx(ctx, opts)
+		if err != nil {
+			return fmt.Errorf("failed to begin transaction: %w", err)
+		}
+		defer func() {
+			if p := recover(); p != nil {
+				_ = tx.Rollback()
+				panic(p)
+			}
+			if err != nil {
+				if rerr := tx.Rollback(); rerr != nil && !errors.Is(rerr, sql.ErrTxDone) {
+					err = errors.Join(err, fmt.Errorf("failed to roll back transaction: %w", rerr))
+				}
+				return
+			}
+			if cerr := tx.Commit(); cerr != nil {
+				err = fmt.Errorf("failed to commit transaction: %w", cerr)
+			}
+		}()
+		return fn(tx)
+	default:
+		return fmt.Errorf("transactions are not supported by %T", ds)
+	}
+}
+
+// WrapDataSource returns a DataSource which logs each query at debug level, and warns about queries which take
+// longer than slowThreshold. Arguments are not logged, since they may be sensitive. Zero disables the warnings.
+func WrapDataSource(ds DataSource, lggr logger.Logger, slowThreshold time.Duration) DataSource {
+	return &loggedDataSource{ds: ds, lggr: logger.Helper(lggr, 2), slowThreshold: slowThreshold}
+}
+
+type loggedDataSource struct {
+	ds            DataSource
+	lggr          logger.Logger
+	slowThreshold time.Duration
+}
+
+func (l *loggedDataSource) with(ds DataSource) *loggedDataSource {
+	return &loggedDataSource{ds: ds, lggr: l.lggr, slowThreshold: l.slowThreshold}
+}
+
+func (l *loggedDataSource) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	start := time.Now()
+	res, err := l.ds.ExecContext(ctx, query, args...)
+	l.log(query, len(args), time.Since(start), err)
+	return res, err
+}
+
+func (l *loggedDataSource) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	start := time.Now()
+	rows, err := l.ds.QueryContext(ctx, query, args...)
+	l.log(query, len(args), time.Since(start), err)
+	return rows, err
+}
+
+func (l *loggedDataSource) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	start := time.Now()
+	row := l.ds.QueryRowContext(ctx, query, args...)
+	l.log(query, len(args), time.Since(start), row.Err())
+	return row
+}
+
+func (l *loggedDataSource) log(query string, args int, elapsed time.Duration, err error) {
+	kvs := []any{"sql", query, "args", args, "elapsed", elapsed}
+	if err != nil {
+		kvs = append(kvs, "err", err)
+	}
+	if l.slowThreshold > 0 && elapsed > l.slowThreshold {
+		l.lggr.Warnw("SQL query exceeded slow threshold", append(kvs, "threshold", l.slowThreshold)...)
+		return
+	}
+	l.lggr.Debugw("SQL query", kvs...)
+}
@@ -0,0 +1,167 @@
+package sqlutil
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+)
+
+func TestTransact(t *testing.T) {
+	ctx := context.Background()
+	errFn := errors.New("fn failed")
+	for _, tt := range []struct {
+		name     string
+		fn       func(DataSource) error
+		err      error
+		panics   bool
+		commits  int
+		rollback int
+	}{
+		{"commit", func(tx DataSource) error {
+			_, err := tx.ExecContext(ctx, "INSERT")
+			return err
+		}, nil, false, 1, 0},
+		{"rollback", func(DataSource) error { return errFn }, errFn, false, 0, 1},
+		{"panic", func(DataSource) error { panic("fn panicked") }, nil, true, 0, 1},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			db, d := newFakeDB(t)
+			run := func() error { return Transact(ctx, db, nil, tt.fn) }
+			if tt.panics {
+				require.Panics(t, func() { _ = run() })
+			} else {
+				require.ErrorIs(t, run(), tt.err)
+			}
+			assert.Equal(t, tt.commits, d.count("commit"))
+			assert.Equal(t, tt.rollback, d.count("rollback"))
+		})
+	}
+
+	t.Run("nested", func(t *testing.T) {
+		db, d := newFakeDB(t)
+		require.NoError(t, Transact(ctx, db, nil, func(tx DataSource) error {
+			return Transact(ctx, tx, nil, func(nested DataSource) error {
+				assert.Same(t, tx, nested)
+				return nil
+			})
+		}))
+		assert.Equal(t, 1, d.count("begin"))
+		assert.Equal(t, 1, d.count("commit"))
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		require.ErrorContains(t, Transact(ctx, fakeDataSource{}, nil, func(DataSource) error { return nil }), "not supported")
+	})
+}
+
+func TestWrapDataSource(t *testing.T) {
+	ctx := context.Background()
+	db, d := newFakeDB(t)
+	lggr, logs := logger.TestObserved(t, zapcore.DebugLevel)
+	ds := WrapDataSource(db, lggr, time.Hour)
+
+	_, err := ds.ExecContext(ctx, "INSERT secret", "password")
+	require.NoError(t, err)
+	require.Equal(t, 1, logs.FilterMessage("SQL query").FilterField(zapcore.Field{Key: "args", Type: zapcore.Int64Type, Integer: 1}).Len())
+	for _, entry := range logs.All() {
+		for _, f := range entry.Context {
+			assert.NotEqual(t, "password", f.String)
+		}
+	}
+
+	require.NoError(t, Transact(ctx, ds, nil, func(tx DataSource) error {
+		_, err := tx.ExecContext(ctx, "UPDATE")
+		return err
+	}))
+	assert.Equal(t, 1, d.count("commit"))
+	require.Equal(t, 1, logs.FilterMessage("SQL query").FilterField(zapcore.Field{Key: "sql", Type: zapcore.StringType, String: "UPDATE"}).Len(),
+		"queries in transactions should be logged")
+
+	slow := WrapDataSource(db, lggr, time.Nanosecond)
+	_, err = slow.ExecContext(ctx, "SLOW")
+	require.NoError(t, err)
+	require.Equal(t, 1, logs.FilterMessage("SQL query exceeded slow threshold").Len())
+}
+
+type fakeDataSource struct{ DataSource }
+
+// fakeDriver records transaction events. Statements succeed without effect.
+type fakeDriver struct {
+	mu     sync.Mutex
+	events []string
+}
+
+func newFakeDB(t *testing.T) (*sql.DB, *fakeDriver) {
+	d := &fakeDriver{}
+	name := "fake-" + t.Name()
+	sql.Register(name, d)
+	db, err := sql.Open(name, "")
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, db.Close()) })
+	return db, d
+}
+
+func (d *fakeDriver) record(event string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.events = append(d.events, event)
+}
+
+func (d *fakeDriver) count(event string) (n int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, e := range d.events {
+		if e == event {
+			n++
+		}
+	}
+	return
+}
+
+func (d *fakeDriver) Open(string) (driver.Conn, error) { return &fakeConn{d}, nil }
+
+type fakeConn struct{ d *fakeDriver }
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) { return fakeStmt{}, nil }
+func (c *fakeConn) Close() error                        { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	c.d.record("begin")
+	return fakeTx{c.d}, nil
+}
+
+type fakeTx struct{ d *fakeDriver }
+
+func (t fakeTx) Commit() error {
+	t.d.record("commit")
+	return nil
+}
+
+func (t fakeTx) Rollback() error {
+	t.d.record("rollback")
+	return nil
+}
+
+type fakeStmt struct{}
+
+func (fakeStmt) Close() error                               { return nil }
+func (fakeStmt) NumInput() int                              { return -1 }
+func (fakeStmt) Exec([]driver.Value) (driver.Result, error) { return driver.RowsAffected(1), nil }
+func (fakeStmt) Query([]driver.Value) (driver.Rows, error)  { return fakeRows{}, nil }
+
+type fakeRows struct{}
+
+func (fakeRows) Columns() []string         { return nil }
+func (fakeRows) Close() error              { return nil }
+func (fakeRows) Next([]driver.Value) error { return io.EOF }
@@ -0,0 +1,104 @@
+package types
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+)
+
+// Account is an on-chain account or address, in the canonical string format of its chain family, like a
+// 0x-prefixed hex address for EVM chains. Unlike [ocrtypes.Account], it is meant to be validated with [NewAccount] or
+// [Account.Validate] where it enters the system, so that malformed accounts are caught at the boundary.
+type Account string
+
+// AccountFromOCR converts an [ocrtypes.Account], like one returned by [ocrtypes.ContractTransmitter.FromAccount],
+// without validating it.
+func AccountFromOCR(a ocrtypes.Account) Account { return Account(a) }
+
+// NewAccount returns account as an Account, if it is valid for family. See [Account.Validate].
+func NewAccount(family, account string) (Account, error) {
+	a := Account(account)
+	if err := a.Validate(family); err != nil {
+		return "", err
+	}
+	return a, nil
+}
+
+// OCR converts a to an [ocrtypes.Account].
+func (a Account) OCR() ocrtypes.Account { return ocrtypes.Account(a) }
+
+func (a Account) String() string { return string(a) }
+
+// Validate returns an error if a is empty, or rejected by the [AccountValidator] registered for family. Unregistered
+// families are an error too, so that a misspelled family does not disable validation.
+func (a Account) Validate(family string) error {
+	if a == "" {
+		return errors.New("account must not be empty")
+	}
+	accountValidatorsMu.RLock()
+	validate, ok := accountValidators[family]
+	accountValidatorsMu.RUnlock()
+	if !ok {
+		return fmt.Errorf("no account validator registered for %q", family)
+	}
+	if err := validate(string(a)); err != nil {
+		return fmt.Errorf("invalid %s account %q: %w", family, string(a), err)
+	}
+	return nil
+}
+
+// AccountValidator returns an error if account is malformed.
+type AccountValidator func(account string) error
+
+var (
+	accountValidatorsMu sync.RWMutex
+	accountValidators   = map[string]AccountValidator{}
+)
+
+// RegisterAccountValidator registers validate for the accounts of a chain family, like "evm" or "solana", typically
+// from the init function of the relayer implementing it. Each family may only be registered once.
+func RegisterAccountValidator(family string, validate AccountValidator) error {
+	if family == "" {
+		return errors.New("family must not be empty")
+	}
+	if validate == nil {
+		return errors.New("validator must not be nil")
+	}
+	accountValidatorsMu.Lock()
+	defer accountValidatorsMu.Unlock()
+	if _, ok := accountValidators[family]; ok {
+		return fmt.Errorf("account validator already registered for %q", family)
+	}
+	accountValidators[family] = validate
+	return nil
+}
+
+// HasAccountValidator returns true if an [AccountValidator] is registered for family.
+func HasAccountValidator(family string) bool {
+	accountValidatorsMu.RLock()
+	defer accountValidatorsMu.RUnlock()
+	_, ok := accountValidators[family]
+	return ok
+}
+
+// HexAccountValidator returns an AccountValidator for 0x-prefixed hex accounts of n bytes, like 20 for EVM addresses.
+func HexAccountValidator(n int) AccountValidator {
+	return func(account string) error {
+		digits, ok := strings.CutPrefix(account, "0x")
+		if !ok {
+			return errors.New("missing 0x prefix")
+		}
+		b, err := hex.DecodeString(digits)
+		if err != nil {
+			return err
+		}
+		if len(b) != n {
+			return fmt.Errorf("expected %d bytes but got %d", n, len(b))
+		}
+		return nil
+	}
+}
@@ -0,0 +1,46 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+func TestAccount(t *testing.T) {
+	const family = "account-test"
+	require.NoError(t, types.RegisterAccountValidator(family, types.HexAccountValidator(2)))
+	require.Error(t, types.RegisterAccountValidator(family, types.HexAccountValidator(2)), "duplicate")
+	require.Error(t, types.RegisterAccountValidator("", types.HexAccountValidator(2)))
+	require.Error(t, types.RegisterAccountValidator("account-test-nil", nil))
+	assert.True(t, types.HasAccountValidator(family))
+	assert.False(t, types.HasAccountValidator("account-test-unregistered"))
+
+	for _, tt := range []struct {
+		family  string
+		account string
+		valid   bool
+	}{
+		{family, "0x0102", true},
+		{family, "0x01", false},
+		{family, "0x010g", false},
+		{family, "0102", false},
+		{family, "", false},
+		{"account-test-unregistered", "anything", false},
+		{"account-test-unregistered", "", false},
+	} {
+		a, err := types.NewAccount(tt.family, tt.account)
+		if !tt.valid {
+			assert.Error(t, err, "%s account %q", tt.family, tt.account)
+			continue
+		}
+		if assert.NoError(t, err, "%s account %q", tt.family, tt.account) {
+			assert.Equal(t, ocrtypes.Account(tt.account), a.OCR())
+			assert.Equal(t, a, types.AccountFromOCR(a.OCR()))
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"context"
+	"time"
+)
+
+// Event is a chain agnostic contract event, or log.
+type Event struct {
+	// Address of the emitting contract.
+	Address string
+	// Topics are the indexed fields. The first is normally the event signature.
+	Topics [][]byte
+	// Data holds the non-indexed fields.
+	Data []byte
+
+	BlockNumber    uint64
+	BlockHash      []byte
+	BlockTimestamp time.Time
+	TxHash         []byte
+	// Index of the event within the block.
+	Index uint64
+
+	// Cursor identifies the position of this event, for resuming queries and subscriptions after it.
+	Cursor string
+}
+
+// EventFilter selects events for [EventQuerier] methods.
+type EventFilter struct {
+	// Addresses of contracts to include. Empty matches all.
+	Addresses []string
+	// EventSigs to include, matched against the first topic. Empty matches all.
+	EventSigs [][]byte
+	// FromBlock is the first block to include, unless Cursor is set.
+	FromBlock uint64
+	// Cursor optionally resumes after an [Event.Cursor], or an [EventPage.NextCursor].
+	Cursor string
+	// Limit is the maximum number of events per page. Zero selects the implementation default.
+	Limit int
+}
+
+// EventPage is a page of events returned by [EventQuerier.FilteredLogs].
+type EventPage struct {
+	Events []Event
+	// NextCursor resumes after the last event in this page, or is empty if there are no more events.
+	NextCursor string
+}
+
+// EventQuerier queries and subscribes to contract events, so that plugins can consume events without chain-specific
+// APIs.
+type EventQuerier interface {
+	// FilteredLogs returns a page of events matching filter, in order.
+	FilteredLogs(ctx context.Context, filter EventFilter) (EventPage, error)
+	// SubscribeLogs calls fn, in order, with each event matching filter, including new events as they arrive. It blocks
+	// until ctx is done, and returns ctx.Err(), or until fn returns an error.
+	SubscribeLogs(ctx context.Context, filter EventFilter, fn func(Event) error) error
+}
+
+// EventQuerierProvider is optionally implemented by providers which support event queries.
+type EventQuerierProvider interface {
+	EventQuerier() EventQuerier
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// FeatureFlags are set by the host to toggle experimental plugin behaviors (e.g. batch observe, caching), without
+// requiring new plugin binaries. Plugins must ignore unknown flags, and fall back to a default for missing ones.
+type FeatureFlags map[string]string
+
+// String returns the value of the named flag, or def if it is not set.
+func (f FeatureFlags) String(name, def string) string {
+	if v, ok := f[name]; ok {
+		return v
+	}
+	return def
+}
+
+// Bool returns the value of the named flag parsed by [strconv.ParseBool], or def if it is not set.
+func (f FeatureFlags) Bool(name string, def bool) (bool, error) {
+	v, ok := f[name]
+	if !ok {
+		return def, nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return def, fmt.Errorf("invalid bool feature flag %s: %w", name, err)
+	}
+	return b, nil
+}
+
+// Int returns the value of the named flag parsed by [strconv.ParseInt], or def if it is not set.
+func (f FeatureFlags) Int(name string, def int64) (int64, error) {
+	v, ok := f[name]
+	if !ok {
+		return def, nil
+	}
+	i, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return def, fmt.Errorf("invalid int feature flag %s: %w", name, err)
+	}
+	return i, nil
+}
+
+// Duration returns the value of the named flag parsed by [time.ParseDuration], or def if it is not set.
+func (f FeatureFlags) Duration(name string, def time.Duration) (time.Duration, error) {
+	v, ok := f[name]
+	if !ok {
+		return def, nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return def, fmt.Errorf("invalid duration feature flag %s: %w", name, err)
+	}
+	return d, nil
+}
+
+type featureFlagsCtxKey struct{}
+
+// ContextWithFeatureFlags returns a copy of ctx carrying flags. Hosts use it to pass flags to NewRelayer and
+// NewMedianFactory, which deliver them to the plugin.
+func ContextWithFeatureFlags(ctx context.Context, flags FeatureFlags) context.Context {
+	return context.WithValue(ctx, featureFlagsCtxKey{}, flags)
+}
+
+// FeatureFlagsFromContext returns the flags set on ctx by [ContextWithFeatureFlags], or nil if none were set.
+// Plugins read them from the ctx passed to NewRelayer and NewMedianFactory.
+func FeatureFlagsFromContext(ctx context.Context) FeatureFlags {
+	flags, _ := ctx.Value(featureFlagsCtxKey{}).(FeatureFlags)
+	return flags
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"context"
+	"math/big"
+
+	feetypes "github.com/smartcontractkit/chainlink-relay/pkg/fee/types"
+)
+
+// Fee is a chain agnostic transaction fee, in the native units of the chain (e.g. wei and gas).
+type Fee struct {
+	// Price is the price per unit.
+	Price *big.Int
+	// Limit is the maximum number of units.
+	Limit uint64
+}
+
+// GasEstimator estimates transaction fees, so that plugins can make fee-aware decisions, e.g. skipping transmission
+// while fees are spiking.
+type GasEstimator interface {
+	// GetFee returns the estimated fee for a transaction with calldata and the given limit, with the price capped at
+	// maxPrice.
+	GetFee(ctx context.Context, calldata []byte, limit uint64, maxPrice *big.Int, opts ...feetypes.Opt) (Fee, error)
+	// BumpFee returns a higher fee to replace a transaction which was sent with original, with the price capped at
+	// maxPrice.
+	BumpFee(ctx context.Context, original Fee, maxPrice *big.Int) (Fee, error)
+}
+
+// GasEstimatorProvider is optionally implemented by providers which support fee estimation.
+type GasEstimatorProvider interface {
+	GasEstimator() GasEstimator
+}
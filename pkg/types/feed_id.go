@@ -0,0 +1,75 @@
+package types
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// FeedVersion is the version of the report schema of a feed.
+type FeedVersion uint16
+
+const (
+	FeedV1 FeedVersion = 1
+	FeedV2 FeedVersion = 2
+	FeedV3 FeedVersion = 3
+)
+
+// FeedID identifies a mercury feed. Its first two bytes are the big endian [FeedVersion] of its report schema.
+// It implements encoding.TextMarshaler and encoding.TextUnmarshaler as a 0x-prefixed hex string, for JSON and TOML.
+type FeedID [32]byte
+
+// ParseFeedID parses a 0x-prefixed hex feed ID, and validates it.
+func ParseFeedID(s string) (id FeedID, err error) {
+	h, ok := strings.CutPrefix(s, "0x")
+	if !ok {
+		return id, fmt.Errorf("invalid feed ID %q: missing 0x prefix", s)
+	}
+	b, err := hex.DecodeString(h)
+	if err != nil {
+		return id, fmt.Errorf("invalid feed ID %q: %w", s, err)
+	}
+	if len(b) != len(id) {
+		return id, fmt.Errorf("invalid feed ID %q: expected %d bytes but got %d", s, len(id), len(b))
+	}
+	copy(id[:], b)
+	return id, id.Validate()
+}
+
+// MustParseFeedID is like [ParseFeedID], but panics on error.
+func MustParseFeedID(s string) FeedID {
+	id, err := ParseFeedID(s)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// Version returns the version of the report schema of the feed.
+func (f FeedID) Version() FeedVersion { return FeedVersion(binary.BigEndian.Uint16(f[:2])) }
+
+// Validate returns an error if the feed does not have a supported version.
+func (f FeedID) Validate() error {
+	switch v := f.Version(); v {
+	case FeedV1, FeedV2, FeedV3:
+		return nil
+	default:
+		return fmt.Errorf("invalid feed ID %s: unsupported version %d", f, v)
+	}
+}
+
+func (f FeedID) String() string { return "0x" + hex.EncodeToString(f[:]) }
+
+func (f FeedID) MarshalText() ([]byte, error) {
+	return []byte(f.String()), nil
+}
+
+func (f *FeedID) UnmarshalText(input []byte) error {
+	id, err := ParseFeedID(string(input))
+	if err != nil {
+		return err
+	}
+	*f = id
+	return nil
+}
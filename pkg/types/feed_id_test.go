@@ -0,0 +1,76 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pelletier/go-toml/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+const feedV3 = "0x0003333333333333333333333333333333333333333333333333333333333333"
+
+func TestParseFeedID(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		s       string
+		version types.FeedVersion
+		err     string
+	}{
+		{"v1", "0x0001111111111111111111111111111111111111111111111111111111111111", types.FeedV1, ""},
+		{"v2", "0x0002222222222222222222222222222222222222222222222222222222222222", types.FeedV2, ""},
+		{"v3", feedV3, types.FeedV3, ""},
+		{"no prefix", feedV3[2:], 0, "missing 0x prefix"},
+		{"not hex", "0x0003zz", 0, "invalid byte"},
+		{"short", feedV3[:64], 0, "expected 32 bytes but got 31"},
+		{"long", feedV3 + "00", 0, "expected 32 bytes but got 33"},
+		{"v0", "0x0000333333333333333333333333333333333333333333333333333333333333", 0, "unsupported version 0"},
+		{"v4", "0x0004333333333333333333333333333333333333333333333333333333333333", 0, "unsupported version 4"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := types.ParseFeedID(tt.s)
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.version, id.Version())
+			assert.Equal(t, tt.s, id.String())
+		})
+	}
+}
+
+func TestFeedID_text(t *testing.T) {
+	type config struct {
+		FeedID types.FeedID
+	}
+	expected := config{types.MustParseFeedID(feedV3)}
+
+	t.Run("json", func(t *testing.T) {
+		b, err := json.Marshal(expected)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"FeedID":"`+feedV3+`"}`, string(b))
+
+		var got config
+		require.NoError(t, json.Unmarshal(b, &got))
+		assert.Equal(t, expected, got)
+
+		err = json.Unmarshal([]byte(`{"FeedID":"0x0004"}`), &got)
+		assert.ErrorContains(t, err, "invalid feed ID")
+	})
+	t.Run("toml", func(t *testing.T) {
+		b, err := toml.Marshal(expected)
+		require.NoError(t, err)
+		assert.Equal(t, "FeedID = '"+feedV3+"'\n", string(b))
+
+		var got config
+		require.NoError(t, toml.Unmarshal(b, &got))
+		assert.Equal(t, expected, got)
+
+		err = toml.Unmarshal([]byte(`FeedID = '`+feedV3[:64]+`'`), &got)
+		assert.ErrorContains(t, err, "expected 32 bytes")
+	})
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"context"
+	"time"
+)
+
+// Head is a chain agnostic block header.
+type Head struct {
+	// Height is the block number.
+	Height     uint64
+	Hash       []byte
+	ParentHash []byte
+	Timestamp  time.Time
+}
+
+// HeadTracker tracks the head of the chain, so that plugins can make time or height aware decisions, e.g. staleness
+// checks and expiry, without bundling their own RPC clients.
+type HeadTracker interface {
+	// LatestHead returns the most recent head.
+	LatestHead(ctx context.Context) (Head, error)
+	// SubscribeNewHead calls fn, in order, with the latest head and then with each new head as it arrives. Heads may
+	// repeat a Height after a re-org. It blocks until ctx is done, or fn returns an error.
+	SubscribeNewHead(ctx context.Context, fn func(Head) error) error
+}
+
+// HeadTrackerProvider is optionally implemented by providers which support head tracking.
+type HeadTrackerProvider interface {
+	HeadTracker() HeadTracker
+}
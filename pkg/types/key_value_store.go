@@ -0,0 +1,32 @@
+package types
+
+import (
+	"context"
+)
+
+// KeyValueStore persists small amounts of plugin state on the host, e.g. the last transmitted round, so that it
+// survives restarts of the plugin, and rescheduling of the node, unlike local files. Hosts back it with the node's
+// database. Keys are scoped by namespace, e.g. per job, and values should be kept small.
+type KeyValueStore interface {
+	// Get returns the value of key in namespace, and whether it was found.
+	Get(ctx context.Context, namespace, key string) (value []byte, found bool, err error)
+	// Set sets the value of key in namespace, replacing any existing value.
+	Set(ctx context.Context, namespace, key string, value []byte) error
+	// Delete deletes key from namespace. Deleting a missing key is not an error.
+	Delete(ctx context.Context, namespace, key string) error
+}
+
+type keyValueStoreCtxKey struct{}
+
+// ContextWithKeyValueStore returns a copy of ctx carrying store. Hosts use it to pass a store to NewRelayer and
+// NewMedianFactory, which serve it to the plugin.
+func ContextWithKeyValueStore(ctx context.Context, store KeyValueStore) context.Context {
+	return context.WithValue(ctx, keyValueStoreCtxKey{}, store)
+}
+
+// KeyValueStoreFromContext returns the store set on ctx by [ContextWithKeyValueStore], or nil if none was set.
+// Plugins read it from the ctx passed to NewRelayer and NewMedianFactory, and may retain it for their lifetime.
+func KeyValueStoreFromContext(ctx context.Context) KeyValueStore {
+	store, _ := ctx.Value(keyValueStoreCtxKey{}).(KeyValueStore)
+	return store
+}
@@ -0,0 +1,109 @@
+package types
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+var _ ResolvableErrorLog = (*MemErrorLog)(nil)
+
+// ErrorLogEntry is an error saved in a [MemErrorLog].
+type ErrorLogEntry struct {
+	Key      string // empty for errors saved by SaveError
+	Message  string
+	Count    int // times saved since first seen, or resolved
+	LastSeen time.Time
+}
+
+// MemErrorLog is a reference, in-memory [ResolvableErrorLog], for tests and hosts without persistence. Errors saved
+// by SaveError are kept in order, while keyed errors are deduplicated, each up to a limit.
+type MemErrorLog struct {
+	limit int
+
+	mu       sync.Mutex
+	unkeyed  []ErrorLogEntry
+	keyed    map[string]*ErrorLogEntry
+	sequence map[string]uint64 // order of keyed entries
+	saved    map[string]uint64 // order of the last save of keyed entries
+	next     uint64
+}
+
+// NewMemErrorLog returns a new [*MemErrorLog], which keeps up to limit of the most recent errors saved by SaveError,
+// and up to limit of the most recently saved unresolved keyed errors, or all of them if limit is not positive.
+func NewMemErrorLog(limit int) *MemErrorLog {
+	return &MemErrorLog{limit: limit, keyed: map[string]*ErrorLogEntry{},
+		sequence: map[string]uint64{}, saved: map[string]uint64{}}
+}
+
+func (m *MemErrorLog) SaveError(ctx context.Context, msg string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.unkeyed = append(m.unkeyed, ErrorLogEntry{Message: msg, Count: 1, LastSeen: time.Now()})
+	if m.limit > 0 && len(m.unkeyed) > m.limit {
+		m.unkeyed = append(m.unkeyed[:0], m.unkeyed[len(m.unkeyed)-m.limit:]...)
+	}
+	return nil
+}
+
+func (m *MemErrorLog) SaveKeyedError(ctx context.Context, key, msg string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e, ok := m.keyed[key]
+	if !ok {
+		e = &ErrorLogEntry{Key: key}
+		m.keyed[key] = e
+		m.sequence[key] = m.next
+	}
+	m.saved[key] = m.next
+	m.next++
+	e.Message = msg
+	e.Count++
+	e.LastSeen = time.Now()
+	if m.limit > 0 && len(m.keyed) > m.limit {
+		m.evictKeyed()
+	}
+	return nil
+}
+
+// evictKeyed removes the least recently saved keyed error.
+func (m *MemErrorLog) evictKeyed() {
+	var oldest string
+	first := true
+	for k := range m.keyed {
+		if first || m.saved[k] < m.saved[oldest] {
+			oldest, first = k, false
+		}
+	}
+	m.delete(oldest)
+}
+
+func (m *MemErrorLog) delete(key string) {
+	delete(m.keyed, key)
+	delete(m.sequence, key)
+	delete(m.saved, key)
+}
+
+func (m *MemErrorLog) ResolveError(ctx context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.delete(key)
+	return nil
+}
+
+// Errors returns the unresolved errors, unkeyed first, each in the order first saved.
+func (m *MemErrorLog) Errors() []ErrorLogEntry {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entries := append([]ErrorLogEntry(nil), m.unkeyed...)
+	keys := make([]string, 0, len(m.keyed))
+	for k := range m.keyed {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return m.sequence[keys[i]] < m.sequence[keys[j]] })
+	for _, k := range keys {
+		entries = append(entries, *m.keyed[k])
+	}
+	return entries
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemErrorLog(t *testing.T) {
+	ctx := context.Background()
+	m := NewMemErrorLog(2)
+	for _, msg := range []string{"a", "b", "c"} {
+		require.NoError(t, m.SaveError(ctx, msg))
+	}
+	require.NoError(t, m.SaveKeyedError(ctx, "x", "x1"))
+	require.NoError(t, m.SaveKeyedError(ctx, "y", "y1"))
+	require.NoError(t, m.SaveKeyedError(ctx, "x", "x2"))
+	require.NoError(t, m.SaveKeyedError(ctx, "z", "z1")) // evicts y, the least recently saved
+	require.NoError(t, m.ResolveError(ctx, "z"))
+	require.NoError(t, m.ResolveError(ctx, "missing"))
+
+	var got []string
+	for _, e := range m.Errors() {
+		got = append(got, e.Key+":"+e.Message)
+	}
+	assert.Equal(t, []string{":b", ":c", "x:x2"}, got)
+	assert.Equal(t, 2, m.Errors()[2].Count)
+}
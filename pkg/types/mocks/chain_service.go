@@ -0,0 +1,116 @@
+// Code generated by ./internal/gen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+// ChainService is a mock of [types.ChainService].
+type ChainService struct {
+	mock.Mock
+}
+
+var _ types.ChainService = (*ChainService)(nil)
+
+// NewChainService returns a new ChainService, which asserts its expectations during cleanup.
+func NewChainService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ChainService {
+	m := &ChainService{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// Close provides a mock function with given fields:
+func (_m *ChainService) Close() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// HealthReport provides a mock function with given fields:
+func (_m *ChainService) HealthReport() map[string]error {
+	ret := _m.Called()
+
+	var r0 map[string]error
+	if rf, ok := ret.Get(0).(func() map[string]error); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]error)
+		}
+	}
+
+	return r0
+}
+
+// Name provides a mock function with given fields:
+func (_m *ChainService) Name() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Ready provides a mock function with given fields:
+func (_m *ChainService) Ready() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SendTx provides a mock function with given fields: ctx, from, to, amount, balanceCheck
+func (_m *ChainService) SendTx(ctx context.Context, from string, to string, amount *big.Int, balanceCheck bool) error {
+	ret := _m.Called(ctx, from, to, amount, balanceCheck)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *big.Int, bool) error); ok {
+		r0 = rf(ctx, from, to, amount, balanceCheck)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Start provides a mock function with given fields: _a0
+func (_m *ChainService) Start(_a0 context.Context) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,231 @@
+// Code generated by ./internal/gen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+// ChainSet is a mock of [types.ChainSet].
+type ChainSet[I any, C types.ChainService] struct {
+	mock.Mock
+}
+
+// NewChainSet returns a new ChainSet, which asserts its expectations during cleanup.
+func NewChainSet[I any, C types.ChainService](t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ChainSet[I, C] {
+	m := &ChainSet[I, C]{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// Chain provides a mock function with given fields: ctx, id
+func (_m *ChainSet[I, C]) Chain(ctx context.Context, id I) (C, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 C
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, I) (C, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, I) C); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(C)
+		}
+	}
+	if rf, ok := ret.Get(1).(func(context.Context, I) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChainStatus provides a mock function with given fields: ctx, id
+func (_m *ChainSet[I, C]) ChainStatus(ctx context.Context, id string) (types.ChainStatus, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 types.ChainStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (types.ChainStatus, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) types.ChainStatus); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(types.ChainStatus)
+	}
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChainStatuses provides a mock function with given fields: ctx, offset, limit
+func (_m *ChainSet[I, C]) ChainStatuses(ctx context.Context, offset int, limit int) ([]types.ChainStatus, int, error) {
+	ret := _m.Called(ctx, offset, limit)
+
+	var r0 []types.ChainStatus
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) ([]types.ChainStatus, int, error)); ok {
+		return rf(ctx, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) []types.ChainStatus); ok {
+		r0 = rf(ctx, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.ChainStatus)
+		}
+	}
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) int); ok {
+		r1 = rf(ctx, offset, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+	if rf, ok := ret.Get(2).(func(context.Context, int, int) error); ok {
+		r2 = rf(ctx, offset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Close provides a mock function with given fields:
+func (_m *ChainSet[I, C]) Close() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// HealthReport provides a mock function with given fields:
+func (_m *ChainSet[I, C]) HealthReport() map[string]error {
+	ret := _m.Called()
+
+	var r0 map[string]error
+	if rf, ok := ret.Get(0).(func() map[string]error); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]error)
+		}
+	}
+
+	return r0
+}
+
+// Name provides a mock function with given fields:
+func (_m *ChainSet[I, C]) Name() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// NodeStatuses provides a mock function with given fields: ctx, offset, limit, chainIDs
+func (_m *ChainSet[I, C]) NodeStatuses(ctx context.Context, offset int, limit int, chainIDs ...string) ([]types.NodeStatus, int, error) {
+	_va := make([]interface{}, len(chainIDs))
+	for _i := range chainIDs {
+		_va[_i] = chainIDs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, offset, limit)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []types.NodeStatus
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, ...string) ([]types.NodeStatus, int, error)); ok {
+		return rf(ctx, offset, limit, chainIDs...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, ...string) []types.NodeStatus); ok {
+		r0 = rf(ctx, offset, limit, chainIDs...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.NodeStatus)
+		}
+	}
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, ...string) int); ok {
+		r1 = rf(ctx, offset, limit, chainIDs...)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+	if rf, ok := ret.Get(2).(func(context.Context, int, int, ...string) error); ok {
+		r2 = rf(ctx, offset, limit, chainIDs...)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Ready provides a mock function with given fields:
+func (_m *ChainSet[I, C]) Ready() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SendTx provides a mock function with given fields: ctx, chainID, from, to, amount, balanceCheck
+func (_m *ChainSet[I, C]) SendTx(ctx context.Context, chainID string, from string, to string, amount *big.Int, balanceCheck bool) error {
+	ret := _m.Called(ctx, chainID, from, to, amount, balanceCheck)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, *big.Int, bool) error); ok {
+		r0 = rf(ctx, chainID, from, to, amount, balanceCheck)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Start provides a mock function with given fields: _a0
+func (_m *ChainSet[I, C]) Start(_a0 context.Context) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,134 @@
+// Code generated by ./internal/gen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	offchainreporting2plustypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+// ConfigProvider is a mock of [types.ConfigProvider].
+type ConfigProvider struct {
+	mock.Mock
+}
+
+var _ types.ConfigProvider = (*ConfigProvider)(nil)
+
+// NewConfigProvider returns a new ConfigProvider, which asserts its expectations during cleanup.
+func NewConfigProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ConfigProvider {
+	m := &ConfigProvider{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// Close provides a mock function with given fields:
+func (_m *ConfigProvider) Close() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ContractConfigTracker provides a mock function with given fields:
+func (_m *ConfigProvider) ContractConfigTracker() offchainreporting2plustypes.ContractConfigTracker {
+	ret := _m.Called()
+
+	var r0 offchainreporting2plustypes.ContractConfigTracker
+	if rf, ok := ret.Get(0).(func() offchainreporting2plustypes.ContractConfigTracker); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(offchainreporting2plustypes.ContractConfigTracker)
+		}
+	}
+
+	return r0
+}
+
+// HealthReport provides a mock function with given fields:
+func (_m *ConfigProvider) HealthReport() map[string]error {
+	ret := _m.Called()
+
+	var r0 map[string]error
+	if rf, ok := ret.Get(0).(func() map[string]error); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]error)
+		}
+	}
+
+	return r0
+}
+
+// Name provides a mock function with given fields:
+func (_m *ConfigProvider) Name() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// OffchainConfigDigester provides a mock function with given fields:
+func (_m *ConfigProvider) OffchainConfigDigester() offchainreporting2plustypes.OffchainConfigDigester {
+	ret := _m.Called()
+
+	var r0 offchainreporting2plustypes.OffchainConfigDigester
+	if rf, ok := ret.Get(0).(func() offchainreporting2plustypes.OffchainConfigDigester); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(offchainreporting2plustypes.OffchainConfigDigester)
+		}
+	}
+
+	return r0
+}
+
+// Ready provides a mock function with given fields:
+func (_m *ConfigProvider) Ready() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Start provides a mock function with given fields: _a0
+func (_m *ConfigProvider) Start(_a0 context.Context) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,66 @@
+// Code generated by ./internal/gen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+// EventQuerier is a mock of [types.EventQuerier].
+type EventQuerier struct {
+	mock.Mock
+}
+
+var _ types.EventQuerier = (*EventQuerier)(nil)
+
+// NewEventQuerier returns a new EventQuerier, which asserts its expectations during cleanup.
+func NewEventQuerier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EventQuerier {
+	m := &EventQuerier{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// FilteredLogs provides a mock function with given fields: ctx, filter
+func (_m *EventQuerier) FilteredLogs(ctx context.Context, filter types.EventFilter) (types.EventPage, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 types.EventPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.EventFilter) (types.EventPage, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, types.EventFilter) types.EventPage); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(types.EventPage)
+	}
+	if rf, ok := ret.Get(1).(func(context.Context, types.EventFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubscribeLogs provides a mock function with given fields: ctx, filter, fn
+func (_m *EventQuerier) SubscribeLogs(ctx context.Context, filter types.EventFilter, fn func(types.Event) error) error {
+	ret := _m.Called(ctx, filter, fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.EventFilter, func(types.Event) error) error); ok {
+		r0 = rf(ctx, filter, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,43 @@
+// Code generated by ./internal/gen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+// EventQuerierProvider is a mock of [types.EventQuerierProvider].
+type EventQuerierProvider struct {
+	mock.Mock
+}
+
+var _ types.EventQuerierProvider = (*EventQuerierProvider)(nil)
+
+// NewEventQuerierProvider returns a new EventQuerierProvider, which asserts its expectations during cleanup.
+func NewEventQuerierProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EventQuerierProvider {
+	m := &EventQuerierProvider{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// EventQuerier provides a mock function with given fields:
+func (_m *EventQuerierProvider) EventQuerier() types.EventQuerier {
+	ret := _m.Called()
+
+	var r0 types.EventQuerier
+	if rf, ok := ret.Get(0).(func() types.EventQuerier); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.EventQuerier)
+		}
+	}
+
+	return r0
+}
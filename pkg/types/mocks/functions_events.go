@@ -0,0 +1,134 @@
+// Code generated by ./internal/gen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+// FunctionsEvents is a mock of [types.FunctionsEvents].
+type FunctionsEvents struct {
+	mock.Mock
+}
+
+var _ types.FunctionsEvents = (*FunctionsEvents)(nil)
+
+// NewFunctionsEvents returns a new FunctionsEvents, which asserts its expectations during cleanup.
+func NewFunctionsEvents(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FunctionsEvents {
+	m := &FunctionsEvents{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// Close provides a mock function with given fields:
+func (_m *FunctionsEvents) Close() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// HealthReport provides a mock function with given fields:
+func (_m *FunctionsEvents) HealthReport() map[string]error {
+	ret := _m.Called()
+
+	var r0 map[string]error
+	if rf, ok := ret.Get(0).(func() map[string]error); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]error)
+		}
+	}
+
+	return r0
+}
+
+// LatestEvents provides a mock function with given fields:
+func (_m *FunctionsEvents) LatestEvents() ([]types.OracleRequest, []types.OracleResponse, error) {
+	ret := _m.Called()
+
+	var r0 []types.OracleRequest
+	var r1 []types.OracleResponse
+	var r2 error
+	if rf, ok := ret.Get(0).(func() ([]types.OracleRequest, []types.OracleResponse, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []types.OracleRequest); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.OracleRequest)
+		}
+	}
+	if rf, ok := ret.Get(1).(func() []types.OracleResponse); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]types.OracleResponse)
+		}
+	}
+	if rf, ok := ret.Get(2).(func() error); ok {
+		r2 = rf()
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Name provides a mock function with given fields:
+func (_m *FunctionsEvents) Name() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Ready provides a mock function with given fields:
+func (_m *FunctionsEvents) Ready() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Start provides a mock function with given fields: _a0
+func (_m *FunctionsEvents) Start(_a0 context.Context) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,166 @@
+// Code generated by ./internal/gen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	offchainreporting2plustypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+// FunctionsProvider is a mock of [types.FunctionsProvider].
+type FunctionsProvider struct {
+	mock.Mock
+}
+
+var _ types.FunctionsProvider = (*FunctionsProvider)(nil)
+
+// NewFunctionsProvider returns a new FunctionsProvider, which asserts its expectations during cleanup.
+func NewFunctionsProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FunctionsProvider {
+	m := &FunctionsProvider{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// Close provides a mock function with given fields:
+func (_m *FunctionsProvider) Close() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ContractConfigTracker provides a mock function with given fields:
+func (_m *FunctionsProvider) ContractConfigTracker() offchainreporting2plustypes.ContractConfigTracker {
+	ret := _m.Called()
+
+	var r0 offchainreporting2plustypes.ContractConfigTracker
+	if rf, ok := ret.Get(0).(func() offchainreporting2plustypes.ContractConfigTracker); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(offchainreporting2plustypes.ContractConfigTracker)
+		}
+	}
+
+	return r0
+}
+
+// ContractTransmitter provides a mock function with given fields:
+func (_m *FunctionsProvider) ContractTransmitter() offchainreporting2plustypes.ContractTransmitter {
+	ret := _m.Called()
+
+	var r0 offchainreporting2plustypes.ContractTransmitter
+	if rf, ok := ret.Get(0).(func() offchainreporting2plustypes.ContractTransmitter); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(offchainreporting2plustypes.ContractTransmitter)
+		}
+	}
+
+	return r0
+}
+
+// FunctionsEvents provides a mock function with given fields:
+func (_m *FunctionsProvider) FunctionsEvents() types.FunctionsEvents {
+	ret := _m.Called()
+
+	var r0 types.FunctionsEvents
+	if rf, ok := ret.Get(0).(func() types.FunctionsEvents); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.FunctionsEvents)
+		}
+	}
+
+	return r0
+}
+
+// HealthReport provides a mock function with given fields:
+func (_m *FunctionsProvider) HealthReport() map[string]error {
+	ret := _m.Called()
+
+	var r0 map[string]error
+	if rf, ok := ret.Get(0).(func() map[string]error); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]error)
+		}
+	}
+
+	return r0
+}
+
+// Name provides a mock function with given fields:
+func (_m *FunctionsProvider) Name() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// OffchainConfigDigester provides a mock function with given fields:
+func (_m *FunctionsProvider) OffchainConfigDigester() offchainreporting2plustypes.OffchainConfigDigester {
+	ret := _m.Called()
+
+	var r0 offchainreporting2plustypes.OffchainConfigDigester
+	if rf, ok := ret.Get(0).(func() offchainreporting2plustypes.OffchainConfigDigester); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(offchainreporting2plustypes.OffchainConfigDigester)
+		}
+	}
+
+	return r0
+}
+
+// Ready provides a mock function with given fields:
+func (_m *FunctionsProvider) Ready() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Start provides a mock function with given fields: _a0
+func (_m *FunctionsProvider) Start(_a0 context.Context) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
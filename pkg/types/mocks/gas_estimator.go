@@ -0,0 +1,84 @@
+// Code generated by ./internal/gen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/stretchr/testify/mock"
+
+	feetypes "github.com/smartcontractkit/chainlink-relay/pkg/fee/types"
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+// GasEstimator is a mock of [types.GasEstimator].
+type GasEstimator struct {
+	mock.Mock
+}
+
+var _ types.GasEstimator = (*GasEstimator)(nil)
+
+// NewGasEstimator returns a new GasEstimator, which asserts its expectations during cleanup.
+func NewGasEstimator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *GasEstimator {
+	m := &GasEstimator{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// BumpFee provides a mock function with given fields: ctx, original, maxPrice
+func (_m *GasEstimator) BumpFee(ctx context.Context, original types.Fee, maxPrice *big.Int) (types.Fee, error) {
+	ret := _m.Called(ctx, original, maxPrice)
+
+	var r0 types.Fee
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.Fee, *big.Int) (types.Fee, error)); ok {
+		return rf(ctx, original, maxPrice)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, types.Fee, *big.Int) types.Fee); ok {
+		r0 = rf(ctx, original, maxPrice)
+	} else {
+		r0 = ret.Get(0).(types.Fee)
+	}
+	if rf, ok := ret.Get(1).(func(context.Context, types.Fee, *big.Int) error); ok {
+		r1 = rf(ctx, original, maxPrice)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFee provides a mock function with given fields: ctx, calldata, limit, maxPrice, opts
+func (_m *GasEstimator) GetFee(ctx context.Context, calldata []byte, limit uint64, maxPrice *big.Int, opts ...feetypes.Opt) (types.Fee, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, calldata, limit, maxPrice)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 types.Fee
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, uint64, *big.Int, ...feetypes.Opt) (types.Fee, error)); ok {
+		return rf(ctx, calldata, limit, maxPrice, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, uint64, *big.Int, ...feetypes.Opt) types.Fee); ok {
+		r0 = rf(ctx, calldata, limit, maxPrice, opts...)
+	} else {
+		r0 = ret.Get(0).(types.Fee)
+	}
+	if rf, ok := ret.Get(1).(func(context.Context, []byte, uint64, *big.Int, ...feetypes.Opt) error); ok {
+		r1 = rf(ctx, calldata, limit, maxPrice, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,43 @@
+// Code generated by ./internal/gen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+// GasEstimatorProvider is a mock of [types.GasEstimatorProvider].
+type GasEstimatorProvider struct {
+	mock.Mock
+}
+
+var _ types.GasEstimatorProvider = (*GasEstimatorProvider)(nil)
+
+// NewGasEstimatorProvider returns a new GasEstimatorProvider, which asserts its expectations during cleanup.
+func NewGasEstimatorProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *GasEstimatorProvider {
+	m := &GasEstimatorProvider{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// GasEstimator provides a mock function with given fields:
+func (_m *GasEstimatorProvider) GasEstimator() types.GasEstimator {
+	ret := _m.Called()
+
+	var r0 types.GasEstimator
+	if rf, ok := ret.Get(0).(func() types.GasEstimator); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.GasEstimator)
+		}
+	}
+
+	return r0
+}
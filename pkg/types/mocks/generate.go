@@ -0,0 +1,5 @@
+// Package mocks provides testify mocks of the interfaces of package types, for use by tests of relayer and plugin
+// implementations.
+package mocks
+
+//go:generate go run ./internal/gen
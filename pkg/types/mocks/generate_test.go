@@ -0,0 +1,48 @@
+package mocks
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// header marks generated files, see ./internal/gen.
+const header = "// Code generated by ./internal/gen. DO NOT EDIT."
+
+// TestGenerated checks that the mocks are up to date, by generating them again.
+func TestGenerated(t *testing.T) {
+	gen := t.TempDir()
+	out, err := exec.Command("go", "run", "./internal/gen", "-out", gen).CombinedOutput()
+	require.NoError(t, err, string(out))
+
+	generated, err := filepath.Glob(filepath.Join(gen, "*.go"))
+	require.NoError(t, err)
+	var names []string
+	for _, g := range generated {
+		names = append(names, filepath.Base(g))
+	}
+	files, err := filepath.Glob("*.go")
+	require.NoError(t, err)
+	var committed []string
+	for _, f := range files {
+		b, err := os.ReadFile(f)
+		require.NoError(t, err)
+		if bytes.HasPrefix(b, []byte(header)) {
+			committed = append(committed, f)
+		}
+	}
+	require.ElementsMatch(t, names, committed, "generated files do not match: run go generate ./pkg/types/mocks")
+
+	for _, name := range names {
+		want, err := os.ReadFile(filepath.Join(gen, name))
+		require.NoError(t, err)
+		got, err := os.ReadFile(name)
+		require.NoError(t, err)
+		assert.True(t, bytes.Equal(want, got), "%s is stale: run go generate ./pkg/types/mocks", name)
+	}
+}
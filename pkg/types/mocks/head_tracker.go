@@ -0,0 +1,66 @@
+// Code generated by ./internal/gen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+// HeadTracker is a mock of [types.HeadTracker].
+type HeadTracker struct {
+	mock.Mock
+}
+
+var _ types.HeadTracker = (*HeadTracker)(nil)
+
+// NewHeadTracker returns a new HeadTracker, which asserts its expectations during cleanup.
+func NewHeadTracker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *HeadTracker {
+	m := &HeadTracker{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// LatestHead provides a mock function with given fields: ctx
+func (_m *HeadTracker) LatestHead(ctx context.Context) (types.Head, error) {
+	ret := _m.Called(ctx)
+
+	var r0 types.Head
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (types.Head, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) types.Head); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(types.Head)
+	}
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubscribeNewHead provides a mock function with given fields: ctx, fn
+func (_m *HeadTracker) SubscribeNewHead(ctx context.Context, fn func(types.Head) error) error {
+	ret := _m.Called(ctx, fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(types.Head) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
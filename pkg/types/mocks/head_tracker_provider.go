@@ -0,0 +1,43 @@
+// Code generated by ./internal/gen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+// HeadTrackerProvider is a mock of [types.HeadTrackerProvider].
+type HeadTrackerProvider struct {
+	mock.Mock
+}
+
+var _ types.HeadTrackerProvider = (*HeadTrackerProvider)(nil)
+
+// NewHeadTrackerProvider returns a new HeadTrackerProvider, which asserts its expectations during cleanup.
+func NewHeadTrackerProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *HeadTrackerProvider {
+	m := &HeadTrackerProvider{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// HeadTracker provides a mock function with given fields:
+func (_m *HeadTrackerProvider) HeadTracker() types.HeadTracker {
+	ret := _m.Called()
+
+	var r0 types.HeadTracker
+	if rf, ok := ret.Get(0).(func() types.HeadTracker); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(types.HeadTracker)
+		}
+	}
+
+	return r0
+}
@@ -0,0 +1,329 @@
+// Command gen generates a testify mock for each interface of package types, in a file per interface.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/build"
+	"go/format"
+	"go/importer"
+	"go/token"
+	"go/types"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	typesPkg = "github.com/smartcontractkit/chainlink-relay/pkg/types"
+	module   = "github.com/smartcontractkit/chainlink-relay"
+	mockPkg  = "github.com/stretchr/testify/mock"
+
+	// header marks generated files.
+	header = "// Code generated by ./internal/gen. DO NOT EDIT."
+)
+
+func main() {
+	out := flag.String("out", ".", "output directory")
+	flag.Parse()
+
+	files, err := generate()
+	if err != nil {
+		log.Fatal(err)
+	}
+	for name, src := range files {
+		if err := os.WriteFile(filepath.Join(*out, name), src, 0644); err != nil { //nolint:gosec
+			log.Fatal(err)
+		}
+	}
+}
+
+// generate returns the source of each mock, keyed by file name.
+func generate() (map[string][]byte, error) {
+	// Type check from source, without cgo, so only the go toolchain is required.
+	build.Default.CgoEnabled = false
+	pkg, err := importer.ForCompiler(token.NewFileSet(), "source", nil).Import(typesPkg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s: %w", typesPkg, err)
+	}
+	files := map[string][]byte{}
+	scope := pkg.Scope()
+	for _, name := range scope.Names() {
+		tn, ok := scope.Lookup(name).(*types.TypeName)
+		if !ok || !tn.Exported() || tn.IsAlias() {
+			continue
+		}
+		named := tn.Type().(*types.Named)
+		iface, ok := named.Underlying().(*types.Interface)
+		if !ok {
+			continue
+		}
+		src, err := newGenerator(pkg, named, iface).source()
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate mock of %s: %w", name, err)
+		}
+		files[fileName(name)] = src
+	}
+	return files, nil
+}
+
+// fileName returns name in snake case, e.g. median_provider.go for MedianProvider.
+func fileName(name string) string {
+	var b strings.Builder
+	runes := []rune(name)
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) && (unicode.IsLower(runes[i-1]) || i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String() + ".go"
+}
+
+type generator struct {
+	pkg   *types.Package
+	named *types.Named
+	iface *types.Interface
+
+	imports map[string]string // alias by path
+	aliases map[string]bool
+}
+
+func newGenerator(pkg *types.Package, named *types.Named, iface *types.Interface) *generator {
+	m := &generator{pkg: pkg, named: named, iface: iface, imports: map[string]string{}, aliases: map[string]bool{}}
+	m.imports[mockPkg] = "mock"
+	m.aliases["mock"] = true
+	m.imports[pkg.Path()] = pkg.Name()
+	m.aliases[pkg.Name()] = true
+	return m
+}
+
+// qualifier records the import of p, with a unique alias.
+func (m *generator) qualifier(p *types.Package) string {
+	if alias, ok := m.imports[p.Path()]; ok {
+		return alias
+	}
+	alias := p.Name()
+	for dir := path.Dir(p.Path()); m.aliases[alias]; dir = path.Dir(dir) {
+		alias = sanitize(path.Base(dir)) + alias
+	}
+	m.imports[p.Path()] = alias
+	m.aliases[alias] = true
+	return alias
+}
+
+func sanitize(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, s)
+}
+
+func (m *generator) typeString(t types.Type) string {
+	return types.TypeString(t, m.qualifier)
+}
+
+func (m *generator) source() ([]byte, error) {
+	name := m.named.Obj().Name()
+	typeParams, typeArgs := m.typeParams()
+	// Record all imports first, so that parameters can be renamed when they shadow them.
+	for i := 0; i < m.iface.NumMethods(); i++ {
+		m.typeString(m.iface.Method(i).Type())
+	}
+
+	var body bytes.Buffer
+	fmt.Fprintf(&body, "// %s is a mock of [%s.%s].\n", name, m.pkg.Name(), name)
+	fmt.Fprintf(&body, "type %s%s struct {\n\tmock.Mock\n}\n\n", name, typeParams)
+	if typeParams == "" {
+		fmt.Fprintf(&body, "var _ %s.%s = (*%s)(nil)\n\n", m.pkg.Name(), name, name)
+	}
+	fmt.Fprintf(&body, "// New%s returns a new %s, which asserts its expectations during cleanup.\n", name, name)
+	fmt.Fprintf(&body, "func New%s%s(t interface {\n\tmock.TestingT\n\tCleanup(func())\n}) *%s%s {\n", name, typeParams, name, typeArgs)
+	fmt.Fprintf(&body, "\tm := &%s%s{}\n\tm.Mock.Test(t)\n\tt.Cleanup(func() { m.AssertExpectations(t) })\n\treturn m\n}\n", name, typeArgs)
+	for i := 0; i < m.iface.NumMethods(); i++ {
+		body.WriteString("\n")
+		m.method(&body, name+typeArgs, m.iface.Method(i))
+	}
+
+	var src bytes.Buffer
+	src.WriteString(header + "\n\npackage mocks\n\n")
+	m.writeImports(&src)
+	src.Write(body.Bytes())
+	return format.Source(src.Bytes())
+}
+
+// typeParams returns the type parameter list and arguments of a generic interface, or empty strings.
+func (m *generator) typeParams() (params, args string) {
+	tps := m.named.TypeParams()
+	if tps.Len() == 0 {
+		return "", ""
+	}
+	var ps, as []string
+	for i := 0; i < tps.Len(); i++ {
+		tp := tps.At(i)
+		ps = append(ps, tp.Obj().Name()+" "+m.typeString(tp.Constraint()))
+		as = append(as, tp.Obj().Name())
+	}
+	return "[" + strings.Join(ps, ", ") + "]", "[" + strings.Join(as, ", ") + "]"
+}
+
+// writeImports writes the imports in groups of standard library, third party, and this module.
+func (m *generator) writeImports(w *bytes.Buffer) {
+	var groups [3][]string
+	for p, alias := range m.imports {
+		spec := fmt.Sprintf("%q", p)
+		if alias != path.Base(p) {
+			spec = alias + " " + spec
+		}
+		switch {
+		case !strings.Contains(strings.Split(p, "/")[0], "."):
+			groups[0] = append(groups[0], spec)
+		case p == module || strings.HasPrefix(p, module+"/"):
+			groups[2] = append(groups[2], spec)
+		default:
+			groups[1] = append(groups[1], spec)
+		}
+	}
+	w.WriteString("import (\n")
+	first := true
+	for _, group := range groups {
+		if len(group) == 0 {
+			continue
+		}
+		if !first {
+			w.WriteString("\n")
+		}
+		first = false
+		sort.Slice(group, func(i, j int) bool { return importPath(group[i]) < importPath(group[j]) })
+		for _, spec := range group {
+			w.WriteString("\t" + spec + "\n")
+		}
+	}
+	w.WriteString(")\n\n")
+}
+
+func importPath(spec string) string {
+	return spec[strings.Index(spec, `"`):]
+}
+
+var reserved = map[string]bool{"_m": true, "ret": true, "rf": true, "ok": true, "_va": true, "_ca": true}
+
+func (m *generator) method(w *bytes.Buffer, recv string, fn *types.Func) {
+	sig := fn.Type().(*types.Signature)
+	params, results := sig.Params(), sig.Results()
+
+	var names, decls, paramTypes []string
+	for i := 0; i < params.Len(); i++ {
+		p := params.At(i)
+		typ := m.typeString(p.Type())
+		if sig.Variadic() && i == params.Len()-1 {
+			typ = "..." + m.typeString(p.Type().(*types.Slice).Elem())
+		}
+		name := p.Name()
+		if name == "" || name == "_" || reserved[name] || m.aliases[name] || strings.HasPrefix(name, "r") && isDigits(name[1:]) {
+			name = fmt.Sprintf("_a%d", i)
+		}
+		names = append(names, name)
+		decls = append(decls, name+" "+typ)
+		paramTypes = append(paramTypes, typ)
+	}
+	var resultTypes []string
+	for i := 0; i < results.Len(); i++ {
+		resultTypes = append(resultTypes, m.typeString(results.At(i).Type()))
+	}
+	funcType := func(results []string) string {
+		s := "func(" + strings.Join(paramTypes, ", ") + ")"
+		switch len(results) {
+		case 0:
+		case 1:
+			s += " " + results[0]
+		default:
+			s += " (" + strings.Join(results, ", ") + ")"
+		}
+		return s
+	}
+	callArgs := strings.Join(names, ", ")
+	if sig.Variadic() {
+		callArgs += "..."
+	}
+
+	fmt.Fprintf(w, "// %s provides a mock function with given fields: %s\n", fn.Name(), strings.Join(names, ", "))
+	fmt.Fprintf(w, "func (_m *%s) %s(%s)", recv, fn.Name(), strings.Join(decls, ", "))
+	switch len(resultTypes) {
+	case 0:
+		w.WriteString(" {\n")
+	case 1:
+		fmt.Fprintf(w, " %s {\n", resultTypes[0])
+	default:
+		fmt.Fprintf(w, " (%s) {\n", strings.Join(resultTypes, ", "))
+	}
+
+	called := strings.Join(names, ", ")
+	if sig.Variadic() {
+		last := names[len(names)-1]
+		fmt.Fprintf(w, "\t_va := make([]interface{}, len(%s))\n", last)
+		fmt.Fprintf(w, "\tfor _i := range %s {\n\t\t_va[_i] = %s[_i]\n\t}\n", last, last)
+		w.WriteString("\tvar _ca []interface{}\n")
+		if len(names) > 1 {
+			fmt.Fprintf(w, "\t_ca = append(_ca, %s)\n", strings.Join(names[:len(names)-1], ", "))
+		}
+		w.WriteString("\t_ca = append(_ca, _va...)\n")
+		called = "_ca..."
+	}
+	if len(resultTypes) == 0 {
+		fmt.Fprintf(w, "\t_m.Called(%s)\n}\n", called)
+		return
+	}
+	fmt.Fprintf(w, "\tret := _m.Called(%s)\n\n", called)
+
+	var rs []string
+	for i, typ := range resultTypes {
+		fmt.Fprintf(w, "\tvar r%d %s\n", i, typ)
+		rs = append(rs, fmt.Sprintf("r%d", i))
+	}
+	if len(resultTypes) > 1 {
+		fmt.Fprintf(w, "\tif rf, ok := ret.Get(0).(%s); ok {\n\t\treturn rf(%s)\n\t}\n", funcType(resultTypes), callArgs)
+	}
+	for i, typ := range resultTypes {
+		fmt.Fprintf(w, "\tif rf, ok := ret.Get(%d).(%s); ok {\n\t\tr%d = rf(%s)\n\t} else {\n", i, funcType([]string{typ}), i, callArgs)
+		t := results.At(i).Type()
+		switch {
+		case types.Identical(t, types.Universe.Lookup("error").Type()):
+			fmt.Fprintf(w, "\t\tr%d = ret.Error(%d)\n", i, i)
+		case nillable(t):
+			fmt.Fprintf(w, "\t\tif ret.Get(%d) != nil {\n\t\t\tr%d = ret.Get(%d).(%s)\n\t\t}\n", i, i, i, typ)
+		default:
+			fmt.Fprintf(w, "\t\tr%d = ret.Get(%d).(%s)\n", i, i, typ)
+		}
+		w.WriteString("\t}\n")
+	}
+	fmt.Fprintf(w, "\n\treturn %s\n}\n", strings.Join(rs, ", "))
+}
+
+// nillable returns true if values of t may be nil, and so may be returned as an untyped nil.
+func nillable(t types.Type) bool {
+	switch t.Underlying().(type) {
+	case *types.Pointer, *types.Slice, *types.Map, *types.Chan, *types.Signature, *types.Interface:
+		return true
+	}
+	return false
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
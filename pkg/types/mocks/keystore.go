@@ -0,0 +1,79 @@
+// Code generated by ./internal/gen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+// Keystore is a mock of [types.Keystore].
+type Keystore struct {
+	mock.Mock
+}
+
+var _ types.Keystore = (*Keystore)(nil)
+
+// NewKeystore returns a new Keystore, which asserts its expectations during cleanup.
+func NewKeystore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Keystore {
+	m := &Keystore{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// Accounts provides a mock function with given fields: ctx
+func (_m *Keystore) Accounts(ctx context.Context) ([]string, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Sign provides a mock function with given fields: ctx, account, data
+func (_m *Keystore) Sign(ctx context.Context, account string, data []byte) ([]byte, error) {
+	ret := _m.Called(ctx, account, data)
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte) ([]byte, error)); ok {
+		return rf(ctx, account, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte) []byte); ok {
+		r0 = rf(ctx, account, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+	if rf, ok := ret.Get(1).(func(context.Context, string, []byte) error); ok {
+		r1 = rf(ctx, account, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
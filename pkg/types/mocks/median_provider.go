@@ -0,0 +1,199 @@
+// Code generated by ./internal/gen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	offchainreporting2plustypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+// MedianProvider is a mock of [types.MedianProvider].
+type MedianProvider struct {
+	mock.Mock
+}
+
+var _ types.MedianProvider = (*MedianProvider)(nil)
+
+// NewMedianProvider returns a new MedianProvider, which asserts its expectations during cleanup.
+func NewMedianProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MedianProvider {
+	m := &MedianProvider{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// Close provides a mock function with given fields:
+func (_m *MedianProvider) Close() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ContractConfigTracker provides a mock function with given fields:
+func (_m *MedianProvider) ContractConfigTracker() offchainreporting2plustypes.ContractConfigTracker {
+	ret := _m.Called()
+
+	var r0 offchainreporting2plustypes.ContractConfigTracker
+	if rf, ok := ret.Get(0).(func() offchainreporting2plustypes.ContractConfigTracker); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(offchainreporting2plustypes.ContractConfigTracker)
+		}
+	}
+
+	return r0
+}
+
+// ContractTransmitter provides a mock function with given fields:
+func (_m *MedianProvider) ContractTransmitter() offchainreporting2plustypes.ContractTransmitter {
+	ret := _m.Called()
+
+	var r0 offchainreporting2plustypes.ContractTransmitter
+	if rf, ok := ret.Get(0).(func() offchainreporting2plustypes.ContractTransmitter); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(offchainreporting2plustypes.ContractTransmitter)
+		}
+	}
+
+	return r0
+}
+
+// HealthReport provides a mock function with given fields:
+func (_m *MedianProvider) HealthReport() map[string]error {
+	ret := _m.Called()
+
+	var r0 map[string]error
+	if rf, ok := ret.Get(0).(func() map[string]error); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]error)
+		}
+	}
+
+	return r0
+}
+
+// MedianContract provides a mock function with given fields:
+func (_m *MedianProvider) MedianContract() median.MedianContract {
+	ret := _m.Called()
+
+	var r0 median.MedianContract
+	if rf, ok := ret.Get(0).(func() median.MedianContract); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(median.MedianContract)
+		}
+	}
+
+	return r0
+}
+
+// Name provides a mock function with given fields:
+func (_m *MedianProvider) Name() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// OffchainConfigDigester provides a mock function with given fields:
+func (_m *MedianProvider) OffchainConfigDigester() offchainreporting2plustypes.OffchainConfigDigester {
+	ret := _m.Called()
+
+	var r0 offchainreporting2plustypes.OffchainConfigDigester
+	if rf, ok := ret.Get(0).(func() offchainreporting2plustypes.OffchainConfigDigester); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(offchainreporting2plustypes.OffchainConfigDigester)
+		}
+	}
+
+	return r0
+}
+
+// OnchainConfigCodec provides a mock function with given fields:
+func (_m *MedianProvider) OnchainConfigCodec() median.OnchainConfigCodec {
+	ret := _m.Called()
+
+	var r0 median.OnchainConfigCodec
+	if rf, ok := ret.Get(0).(func() median.OnchainConfigCodec); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(median.OnchainConfigCodec)
+		}
+	}
+
+	return r0
+}
+
+// Ready provides a mock function with given fields:
+func (_m *MedianProvider) Ready() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReportCodec provides a mock function with given fields:
+func (_m *MedianProvider) ReportCodec() median.ReportCodec {
+	ret := _m.Called()
+
+	var r0 median.ReportCodec
+	if rf, ok := ret.Get(0).(func() median.ReportCodec); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(median.ReportCodec)
+		}
+	}
+
+	return r0
+}
+
+// Start provides a mock function with given fields: _a0
+func (_m *MedianProvider) Start(_a0 context.Context) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,218 @@
+// Code generated by ./internal/gen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	offchainreporting2plustypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/reportingplugins/mercury"
+	mercury_v1 "github.com/smartcontractkit/chainlink-relay/pkg/reportingplugins/mercury/v1"
+	mercury_v2 "github.com/smartcontractkit/chainlink-relay/pkg/reportingplugins/mercury/v2"
+	mercury_v3 "github.com/smartcontractkit/chainlink-relay/pkg/reportingplugins/mercury/v3"
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+// MercuryProvider is a mock of [types.MercuryProvider].
+type MercuryProvider struct {
+	mock.Mock
+}
+
+var _ types.MercuryProvider = (*MercuryProvider)(nil)
+
+// NewMercuryProvider returns a new MercuryProvider, which asserts its expectations during cleanup.
+func NewMercuryProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MercuryProvider {
+	m := &MercuryProvider{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// Close provides a mock function with given fields:
+func (_m *MercuryProvider) Close() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ContractConfigTracker provides a mock function with given fields:
+func (_m *MercuryProvider) ContractConfigTracker() offchainreporting2plustypes.ContractConfigTracker {
+	ret := _m.Called()
+
+	var r0 offchainreporting2plustypes.ContractConfigTracker
+	if rf, ok := ret.Get(0).(func() offchainreporting2plustypes.ContractConfigTracker); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(offchainreporting2plustypes.ContractConfigTracker)
+		}
+	}
+
+	return r0
+}
+
+// ContractTransmitter provides a mock function with given fields:
+func (_m *MercuryProvider) ContractTransmitter() mercury.Transmitter {
+	ret := _m.Called()
+
+	var r0 mercury.Transmitter
+	if rf, ok := ret.Get(0).(func() mercury.Transmitter); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(mercury.Transmitter)
+		}
+	}
+
+	return r0
+}
+
+// HealthReport provides a mock function with given fields:
+func (_m *MercuryProvider) HealthReport() map[string]error {
+	ret := _m.Called()
+
+	var r0 map[string]error
+	if rf, ok := ret.Get(0).(func() map[string]error); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]error)
+		}
+	}
+
+	return r0
+}
+
+// Name provides a mock function with given fields:
+func (_m *MercuryProvider) Name() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// OffchainConfigDigester provides a mock function with given fields:
+func (_m *MercuryProvider) OffchainConfigDigester() offchainreporting2plustypes.OffchainConfigDigester {
+	ret := _m.Called()
+
+	var r0 offchainreporting2plustypes.OffchainConfigDigester
+	if rf, ok := ret.Get(0).(func() offchainreporting2plustypes.OffchainConfigDigester); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(offchainreporting2plustypes.OffchainConfigDigester)
+		}
+	}
+
+	return r0
+}
+
+// OnchainConfigCodec provides a mock function with given fields:
+func (_m *MercuryProvider) OnchainConfigCodec() mercury.OnchainConfigCodec {
+	ret := _m.Called()
+
+	var r0 mercury.OnchainConfigCodec
+	if rf, ok := ret.Get(0).(func() mercury.OnchainConfigCodec); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(mercury.OnchainConfigCodec)
+		}
+	}
+
+	return r0
+}
+
+// Ready provides a mock function with given fields:
+func (_m *MercuryProvider) Ready() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReportCodecV1 provides a mock function with given fields:
+func (_m *MercuryProvider) ReportCodecV1() mercury_v1.ReportCodec {
+	ret := _m.Called()
+
+	var r0 mercury_v1.ReportCodec
+	if rf, ok := ret.Get(0).(func() mercury_v1.ReportCodec); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(mercury_v1.ReportCodec)
+		}
+	}
+
+	return r0
+}
+
+// ReportCodecV2 provides a mock function with given fields:
+func (_m *MercuryProvider) ReportCodecV2() mercury_v2.ReportCodec {
+	ret := _m.Called()
+
+	var r0 mercury_v2.ReportCodec
+	if rf, ok := ret.Get(0).(func() mercury_v2.ReportCodec); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(mercury_v2.ReportCodec)
+		}
+	}
+
+	return r0
+}
+
+// ReportCodecV3 provides a mock function with given fields:
+func (_m *MercuryProvider) ReportCodecV3() mercury_v3.ReportCodec {
+	ret := _m.Called()
+
+	var r0 mercury_v3.ReportCodec
+	if rf, ok := ret.Get(0).(func() mercury_v3.ReportCodec); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(mercury_v3.ReportCodec)
+		}
+	}
+
+	return r0
+}
+
+// Start provides a mock function with given fields: _a0
+func (_m *MercuryProvider) Start(_a0 context.Context) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,99 @@
+// Code generated by ./internal/gen. DO NOT EDIT.
+
+package mocks
+
+import (
+	offchainreporting2plustypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+// MultiPrefixConfigDigester is a mock of [types.MultiPrefixConfigDigester].
+type MultiPrefixConfigDigester struct {
+	mock.Mock
+}
+
+var _ types.MultiPrefixConfigDigester = (*MultiPrefixConfigDigester)(nil)
+
+// NewMultiPrefixConfigDigester returns a new MultiPrefixConfigDigester, which asserts its expectations during cleanup.
+func NewMultiPrefixConfigDigester(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MultiPrefixConfigDigester {
+	m := &MultiPrefixConfigDigester{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// ConfigDigest provides a mock function with given fields: _a0
+func (_m *MultiPrefixConfigDigester) ConfigDigest(_a0 offchainreporting2plustypes.ContractConfig) (offchainreporting2plustypes.ConfigDigest, error) {
+	ret := _m.Called(_a0)
+
+	var r0 offchainreporting2plustypes.ConfigDigest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(offchainreporting2plustypes.ContractConfig) (offchainreporting2plustypes.ConfigDigest, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(offchainreporting2plustypes.ContractConfig) offchainreporting2plustypes.ConfigDigest); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(offchainreporting2plustypes.ConfigDigest)
+	}
+	if rf, ok := ret.Get(1).(func(offchainreporting2plustypes.ContractConfig) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConfigDigestPrefix provides a mock function with given fields:
+func (_m *MultiPrefixConfigDigester) ConfigDigestPrefix() (offchainreporting2plustypes.ConfigDigestPrefix, error) {
+	ret := _m.Called()
+
+	var r0 offchainreporting2plustypes.ConfigDigestPrefix
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (offchainreporting2plustypes.ConfigDigestPrefix, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() offchainreporting2plustypes.ConfigDigestPrefix); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(offchainreporting2plustypes.ConfigDigestPrefix)
+	}
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConfigDigestPrefixes provides a mock function with given fields:
+func (_m *MultiPrefixConfigDigester) ConfigDigestPrefixes() ([]offchainreporting2plustypes.ConfigDigestPrefix, error) {
+	ret := _m.Called()
+
+	var r0 []offchainreporting2plustypes.ConfigDigestPrefix
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]offchainreporting2plustypes.ConfigDigestPrefix, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []offchainreporting2plustypes.ConfigDigestPrefix); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]offchainreporting2plustypes.ConfigDigestPrefix)
+		}
+	}
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
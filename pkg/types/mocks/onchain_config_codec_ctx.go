@@ -0,0 +1,78 @@
+// Code generated by ./internal/gen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+// OnchainConfigCodecCtx is a mock of [types.OnchainConfigCodecCtx].
+type OnchainConfigCodecCtx struct {
+	mock.Mock
+}
+
+var _ types.OnchainConfigCodecCtx = (*OnchainConfigCodecCtx)(nil)
+
+// NewOnchainConfigCodecCtx returns a new OnchainConfigCodecCtx, which asserts its expectations during cleanup.
+func NewOnchainConfigCodecCtx(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OnchainConfigCodecCtx {
+	m := &OnchainConfigCodecCtx{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// DecodeCtx provides a mock function with given fields: ctx, encoded
+func (_m *OnchainConfigCodecCtx) DecodeCtx(ctx context.Context, encoded []byte) (median.OnchainConfig, error) {
+	ret := _m.Called(ctx, encoded)
+
+	var r0 median.OnchainConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) (median.OnchainConfig, error)); ok {
+		return rf(ctx, encoded)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) median.OnchainConfig); ok {
+		r0 = rf(ctx, encoded)
+	} else {
+		r0 = ret.Get(0).(median.OnchainConfig)
+	}
+	if rf, ok := ret.Get(1).(func(context.Context, []byte) error); ok {
+		r1 = rf(ctx, encoded)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EncodeCtx provides a mock function with given fields: ctx, config
+func (_m *OnchainConfigCodecCtx) EncodeCtx(ctx context.Context, config median.OnchainConfig) ([]byte, error) {
+	ret := _m.Called(ctx, config)
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, median.OnchainConfig) ([]byte, error)); ok {
+		return rf(ctx, config)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, median.OnchainConfig) []byte); ok {
+		r0 = rf(ctx, config)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+	if rf, ok := ret.Get(1).(func(context.Context, median.OnchainConfig) error); ok {
+		r1 = rf(ctx, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
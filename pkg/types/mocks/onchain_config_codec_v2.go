@@ -0,0 +1,126 @@
+// Code generated by ./internal/gen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+// OnchainConfigCodecV2 is a mock of [types.OnchainConfigCodecV2].
+type OnchainConfigCodecV2 struct {
+	mock.Mock
+}
+
+var _ types.OnchainConfigCodecV2 = (*OnchainConfigCodecV2)(nil)
+
+// NewOnchainConfigCodecV2 returns a new OnchainConfigCodecV2, which asserts its expectations during cleanup.
+func NewOnchainConfigCodecV2(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OnchainConfigCodecV2 {
+	m := &OnchainConfigCodecV2{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// Decode provides a mock function with given fields: _a0
+func (_m *OnchainConfigCodecV2) Decode(_a0 []byte) (median.OnchainConfig, error) {
+	ret := _m.Called(_a0)
+
+	var r0 median.OnchainConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]byte) (median.OnchainConfig, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func([]byte) median.OnchainConfig); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(median.OnchainConfig)
+	}
+	if rf, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DecodeCtx provides a mock function with given fields: ctx, encoded
+func (_m *OnchainConfigCodecV2) DecodeCtx(ctx context.Context, encoded []byte) (median.OnchainConfig, error) {
+	ret := _m.Called(ctx, encoded)
+
+	var r0 median.OnchainConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) (median.OnchainConfig, error)); ok {
+		return rf(ctx, encoded)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) median.OnchainConfig); ok {
+		r0 = rf(ctx, encoded)
+	} else {
+		r0 = ret.Get(0).(median.OnchainConfig)
+	}
+	if rf, ok := ret.Get(1).(func(context.Context, []byte) error); ok {
+		r1 = rf(ctx, encoded)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Encode provides a mock function with given fields: _a0
+func (_m *OnchainConfigCodecV2) Encode(_a0 median.OnchainConfig) ([]byte, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(median.OnchainConfig) ([]byte, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(median.OnchainConfig) []byte); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+	if rf, ok := ret.Get(1).(func(median.OnchainConfig) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EncodeCtx provides a mock function with given fields: ctx, config
+func (_m *OnchainConfigCodecV2) EncodeCtx(ctx context.Context, config median.OnchainConfig) ([]byte, error) {
+	ret := _m.Called(ctx, config)
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, median.OnchainConfig) ([]byte, error)); ok {
+		return rf(ctx, config)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, median.OnchainConfig) []byte); ok {
+		r0 = rf(ctx, config)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+	if rf, ok := ret.Get(1).(func(context.Context, median.OnchainConfig) error); ok {
+		r1 = rf(ctx, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
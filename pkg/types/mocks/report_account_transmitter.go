@@ -0,0 +1,119 @@
+// Code generated by ./internal/gen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	offchainreporting2plustypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+// ReportAccountTransmitter is a mock of [types.ReportAccountTransmitter].
+type ReportAccountTransmitter struct {
+	mock.Mock
+}
+
+var _ types.ReportAccountTransmitter = (*ReportAccountTransmitter)(nil)
+
+// NewReportAccountTransmitter returns a new ReportAccountTransmitter, which asserts its expectations during cleanup.
+func NewReportAccountTransmitter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReportAccountTransmitter {
+	m := &ReportAccountTransmitter{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// FromAccount provides a mock function with given fields:
+func (_m *ReportAccountTransmitter) FromAccount() (offchainreporting2plustypes.Account, error) {
+	ret := _m.Called()
+
+	var r0 offchainreporting2plustypes.Account
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (offchainreporting2plustypes.Account, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() offchainreporting2plustypes.Account); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(offchainreporting2plustypes.Account)
+	}
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FromAccountForReport provides a mock function with given fields: ctx, reportContext
+func (_m *ReportAccountTransmitter) FromAccountForReport(ctx context.Context, reportContext offchainreporting2plustypes.ReportContext) (offchainreporting2plustypes.Account, error) {
+	ret := _m.Called(ctx, reportContext)
+
+	var r0 offchainreporting2plustypes.Account
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, offchainreporting2plustypes.ReportContext) (offchainreporting2plustypes.Account, error)); ok {
+		return rf(ctx, reportContext)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, offchainreporting2plustypes.ReportContext) offchainreporting2plustypes.Account); ok {
+		r0 = rf(ctx, reportContext)
+	} else {
+		r0 = ret.Get(0).(offchainreporting2plustypes.Account)
+	}
+	if rf, ok := ret.Get(1).(func(context.Context, offchainreporting2plustypes.ReportContext) error); ok {
+		r1 = rf(ctx, reportContext)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LatestConfigDigestAndEpoch provides a mock function with given fields: ctx
+func (_m *ReportAccountTransmitter) LatestConfigDigestAndEpoch(ctx context.Context) (offchainreporting2plustypes.ConfigDigest, uint32, error) {
+	ret := _m.Called(ctx)
+
+	var r0 offchainreporting2plustypes.ConfigDigest
+	var r1 uint32
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context) (offchainreporting2plustypes.ConfigDigest, uint32, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) offchainreporting2plustypes.ConfigDigest); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(offchainreporting2plustypes.ConfigDigest)
+	}
+	if rf, ok := ret.Get(1).(func(context.Context) uint32); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Get(1).(uint32)
+	}
+	if rf, ok := ret.Get(2).(func(context.Context) error); ok {
+		r2 = rf(ctx)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Transmit provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *ReportAccountTransmitter) Transmit(_a0 context.Context, _a1 offchainreporting2plustypes.ReportContext, _a2 offchainreporting2plustypes.Report, _a3 []offchainreporting2plustypes.AttributedOnchainSignature) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, offchainreporting2plustypes.ReportContext, offchainreporting2plustypes.Report, []offchainreporting2plustypes.AttributedOnchainSignature) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,105 @@
+// Code generated by ./internal/gen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	offchainreporting2plustypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+// ReportCodecCtx is a mock of [types.ReportCodecCtx].
+type ReportCodecCtx struct {
+	mock.Mock
+}
+
+var _ types.ReportCodecCtx = (*ReportCodecCtx)(nil)
+
+// NewReportCodecCtx returns a new ReportCodecCtx, which asserts its expectations during cleanup.
+func NewReportCodecCtx(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReportCodecCtx {
+	m := &ReportCodecCtx{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// BuildReportCtx provides a mock function with given fields: ctx, observations
+func (_m *ReportCodecCtx) BuildReportCtx(ctx context.Context, observations []median.ParsedAttributedObservation) (offchainreporting2plustypes.Report, error) {
+	ret := _m.Called(ctx, observations)
+
+	var r0 offchainreporting2plustypes.Report
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []median.ParsedAttributedObservation) (offchainreporting2plustypes.Report, error)); ok {
+		return rf(ctx, observations)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []median.ParsedAttributedObservation) offchainreporting2plustypes.Report); ok {
+		r0 = rf(ctx, observations)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(offchainreporting2plustypes.Report)
+		}
+	}
+	if rf, ok := ret.Get(1).(func(context.Context, []median.ParsedAttributedObservation) error); ok {
+		r1 = rf(ctx, observations)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MaxReportLengthCtx provides a mock function with given fields: ctx, n
+func (_m *ReportCodecCtx) MaxReportLengthCtx(ctx context.Context, n int) (int, error) {
+	ret := _m.Called(ctx, n)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (int, error)); ok {
+		return rf(ctx, n)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) int); ok {
+		r0 = rf(ctx, n)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, n)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MedianFromReportCtx provides a mock function with given fields: ctx, report
+func (_m *ReportCodecCtx) MedianFromReportCtx(ctx context.Context, report offchainreporting2plustypes.Report) (*big.Int, error) {
+	ret := _m.Called(ctx, report)
+
+	var r0 *big.Int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, offchainreporting2plustypes.Report) (*big.Int, error)); ok {
+		return rf(ctx, report)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, offchainreporting2plustypes.Report) *big.Int); ok {
+		r0 = rf(ctx, report)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+	if rf, ok := ret.Get(1).(func(context.Context, offchainreporting2plustypes.Report) error); ok {
+		r1 = rf(ctx, report)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
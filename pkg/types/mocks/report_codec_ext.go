@@ -0,0 +1,55 @@
+// Code generated by ./internal/gen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	offchainreporting2plustypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+// ReportCodecExt is a mock of [types.ReportCodecExt].
+type ReportCodecExt struct {
+	mock.Mock
+}
+
+var _ types.ReportCodecExt = (*ReportCodecExt)(nil)
+
+// NewReportCodecExt returns a new ReportCodecExt, which asserts its expectations during cleanup.
+func NewReportCodecExt(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReportCodecExt {
+	m := &ReportCodecExt{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// BuildReportExt provides a mock function with given fields: ctx, observations
+func (_m *ReportCodecExt) BuildReportExt(ctx context.Context, observations []types.ParsedAttributedObservationExt) (offchainreporting2plustypes.Report, error) {
+	ret := _m.Called(ctx, observations)
+
+	var r0 offchainreporting2plustypes.Report
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []types.ParsedAttributedObservationExt) (offchainreporting2plustypes.Report, error)); ok {
+		return rf(ctx, observations)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []types.ParsedAttributedObservationExt) offchainreporting2plustypes.Report); ok {
+		r0 = rf(ctx, observations)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(offchainreporting2plustypes.Report)
+		}
+	}
+	if rf, ok := ret.Get(1).(func(context.Context, []types.ParsedAttributedObservationExt) error); ok {
+		r1 = rf(ctx, observations)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,178 @@
+// Code generated by ./internal/gen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	offchainreporting2plustypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+// ReportCodecV2 is a mock of [types.ReportCodecV2].
+type ReportCodecV2 struct {
+	mock.Mock
+}
+
+var _ types.ReportCodecV2 = (*ReportCodecV2)(nil)
+
+// NewReportCodecV2 returns a new ReportCodecV2, which asserts its expectations during cleanup.
+func NewReportCodecV2(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReportCodecV2 {
+	m := &ReportCodecV2{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// BuildReport provides a mock function with given fields: _a0
+func (_m *ReportCodecV2) BuildReport(_a0 []median.ParsedAttributedObservation) (offchainreporting2plustypes.Report, error) {
+	ret := _m.Called(_a0)
+
+	var r0 offchainreporting2plustypes.Report
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]median.ParsedAttributedObservation) (offchainreporting2plustypes.Report, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func([]median.ParsedAttributedObservation) offchainreporting2plustypes.Report); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(offchainreporting2plustypes.Report)
+		}
+	}
+	if rf, ok := ret.Get(1).(func([]median.ParsedAttributedObservation) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BuildReportCtx provides a mock function with given fields: ctx, observations
+func (_m *ReportCodecV2) BuildReportCtx(ctx context.Context, observations []median.ParsedAttributedObservation) (offchainreporting2plustypes.Report, error) {
+	ret := _m.Called(ctx, observations)
+
+	var r0 offchainreporting2plustypes.Report
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []median.ParsedAttributedObservation) (offchainreporting2plustypes.Report, error)); ok {
+		return rf(ctx, observations)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []median.ParsedAttributedObservation) offchainreporting2plustypes.Report); ok {
+		r0 = rf(ctx, observations)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(offchainreporting2plustypes.Report)
+		}
+	}
+	if rf, ok := ret.Get(1).(func(context.Context, []median.ParsedAttributedObservation) error); ok {
+		r1 = rf(ctx, observations)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MaxReportLength provides a mock function with given fields: n
+func (_m *ReportCodecV2) MaxReportLength(n int) (int, error) {
+	ret := _m.Called(n)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (int, error)); ok {
+		return rf(n)
+	}
+	if rf, ok := ret.Get(0).(func(int) int); ok {
+		r0 = rf(n)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(n)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MaxReportLengthCtx provides a mock function with given fields: ctx, n
+func (_m *ReportCodecV2) MaxReportLengthCtx(ctx context.Context, n int) (int, error) {
+	ret := _m.Called(ctx, n)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (int, error)); ok {
+		return rf(ctx, n)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) int); ok {
+		r0 = rf(ctx, n)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, n)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MedianFromReport provides a mock function with given fields: _a0
+func (_m *ReportCodecV2) MedianFromReport(_a0 offchainreporting2plustypes.Report) (*big.Int, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *big.Int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(offchainreporting2plustypes.Report) (*big.Int, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(offchainreporting2plustypes.Report) *big.Int); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+	if rf, ok := ret.Get(1).(func(offchainreporting2plustypes.Report) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MedianFromReportCtx provides a mock function with given fields: ctx, report
+func (_m *ReportCodecV2) MedianFromReportCtx(ctx context.Context, report offchainreporting2plustypes.Report) (*big.Int, error) {
+	ret := _m.Called(ctx, report)
+
+	var r0 *big.Int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, offchainreporting2plustypes.Report) (*big.Int, error)); ok {
+		return rf(ctx, report)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, offchainreporting2plustypes.Report) *big.Int); ok {
+		r0 = rf(ctx, report)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+	if rf, ok := ret.Get(1).(func(context.Context, offchainreporting2plustypes.Report) error); ok {
+		r1 = rf(ctx, report)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,133 @@
+// Code generated by ./internal/gen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	offchainreporting2plustypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+// ReportingPluginFactory is a mock of [types.ReportingPluginFactory].
+type ReportingPluginFactory struct {
+	mock.Mock
+}
+
+var _ types.ReportingPluginFactory = (*ReportingPluginFactory)(nil)
+
+// NewReportingPluginFactory returns a new ReportingPluginFactory, which asserts its expectations during cleanup.
+func NewReportingPluginFactory(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReportingPluginFactory {
+	m := &ReportingPluginFactory{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// Close provides a mock function with given fields:
+func (_m *ReportingPluginFactory) Close() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// HealthReport provides a mock function with given fields:
+func (_m *ReportingPluginFactory) HealthReport() map[string]error {
+	ret := _m.Called()
+
+	var r0 map[string]error
+	if rf, ok := ret.Get(0).(func() map[string]error); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]error)
+		}
+	}
+
+	return r0
+}
+
+// Name provides a mock function with given fields:
+func (_m *ReportingPluginFactory) Name() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// NewReportingPlugin provides a mock function with given fields: _a0
+func (_m *ReportingPluginFactory) NewReportingPlugin(_a0 offchainreporting2plustypes.ReportingPluginConfig) (offchainreporting2plustypes.ReportingPlugin, offchainreporting2plustypes.ReportingPluginInfo, error) {
+	ret := _m.Called(_a0)
+
+	var r0 offchainreporting2plustypes.ReportingPlugin
+	var r1 offchainreporting2plustypes.ReportingPluginInfo
+	var r2 error
+	if rf, ok := ret.Get(0).(func(offchainreporting2plustypes.ReportingPluginConfig) (offchainreporting2plustypes.ReportingPlugin, offchainreporting2plustypes.ReportingPluginInfo, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(offchainreporting2plustypes.ReportingPluginConfig) offchainreporting2plustypes.ReportingPlugin); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(offchainreporting2plustypes.ReportingPlugin)
+		}
+	}
+	if rf, ok := ret.Get(1).(func(offchainreporting2plustypes.ReportingPluginConfig) offchainreporting2plustypes.ReportingPluginInfo); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Get(1).(offchainreporting2plustypes.ReportingPluginInfo)
+	}
+	if rf, ok := ret.Get(2).(func(offchainreporting2plustypes.ReportingPluginConfig) error); ok {
+		r2 = rf(_a0)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Ready provides a mock function with given fields:
+func (_m *ReportingPluginFactory) Ready() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Start provides a mock function with given fields: _a0
+func (_m *ReportingPluginFactory) Start(_a0 context.Context) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
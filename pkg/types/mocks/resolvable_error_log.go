@@ -0,0 +1,71 @@
+// Code generated by ./internal/gen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+// ResolvableErrorLog is a mock of [types.ResolvableErrorLog].
+type ResolvableErrorLog struct {
+	mock.Mock
+}
+
+var _ types.ResolvableErrorLog = (*ResolvableErrorLog)(nil)
+
+// NewResolvableErrorLog returns a new ResolvableErrorLog, which asserts its expectations during cleanup.
+func NewResolvableErrorLog(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ResolvableErrorLog {
+	m := &ResolvableErrorLog{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// ResolveError provides a mock function with given fields: ctx, key
+func (_m *ResolvableErrorLog) ResolveError(ctx context.Context, key string) error {
+	ret := _m.Called(ctx, key)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SaveError provides a mock function with given fields: ctx, msg
+func (_m *ResolvableErrorLog) SaveError(ctx context.Context, msg string) error {
+	ret := _m.Called(ctx, msg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SaveKeyedError provides a mock function with given fields: ctx, key, msg
+func (_m *ResolvableErrorLog) SaveKeyedError(ctx context.Context, key string, msg string) error {
+	ret := _m.Called(ctx, key, msg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, key, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
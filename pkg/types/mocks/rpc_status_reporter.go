@@ -0,0 +1,61 @@
+// Code generated by ./internal/gen. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+// RPCStatusReporter is a mock of [types.RPCStatusReporter].
+type RPCStatusReporter struct {
+	mock.Mock
+}
+
+var _ types.RPCStatusReporter = (*RPCStatusReporter)(nil)
+
+// NewRPCStatusReporter returns a new RPCStatusReporter, which asserts its expectations during cleanup.
+func NewRPCStatusReporter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RPCStatusReporter {
+	m := &RPCStatusReporter{}
+	m.Mock.Test(t)
+	t.Cleanup(func() { m.AssertExpectations(t) })
+	return m
+}
+
+// RPCStatuses provides a mock function with given fields: ctx, chainIDs
+func (_m *RPCStatusReporter) RPCStatuses(ctx context.Context, chainIDs ...string) ([]types.RPCStatus, error) {
+	_va := make([]interface{}, len(chainIDs))
+	for _i := range chainIDs {
+		_va[_i] = chainIDs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []types.RPCStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ...string) ([]types.RPCStatus, error)); ok {
+		return rf(ctx, chainIDs...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ...string) []types.RPCStatus); ok {
+		r0 = rf(ctx, chainIDs...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.RPCStatus)
+		}
+	}
+	if rf, ok := ret.Get(1).(func(context.Context, ...string) error); ok {
+		r1 = rf(ctx, chainIDs...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
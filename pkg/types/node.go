@@ -11,6 +11,20 @@ type Keystore interface {
 	Sign(ctx context.Context, account string, data []byte) (signed []byte, err error)
 }
 
+// ErrorLog persists errors reported by plugins on the host, e.g. for display to node operators.
 type ErrorLog interface {
 	SaveError(ctx context.Context, msg string) error
 }
+
+// ResolvableErrorLog is an optional extension of [ErrorLog], for hosts which dedupe errors by key, so that plugins can
+// report a recurring condition once, and clear it when the condition recovers. Check for it with a type assertion.
+// Over LOOP, the ErrorLog passed to plugins always implements it, and falls back to SaveError for older hosts, or
+// hosts which do not implement it, in which case ResolveError is a no-op.
+type ResolvableErrorLog interface {
+	ErrorLog
+	// SaveKeyedError saves msg under key, replacing any unresolved error with the same key, rather than adding a
+	// duplicate.
+	SaveKeyedError(ctx context.Context, key, msg string) error
+	// ResolveError clears the error saved under key. Resolving a missing key is not an error.
+	ResolveError(ctx context.Context, key string) error
+}
@@ -1,6 +1,10 @@
 package types
 
-import ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+import (
+	"context"
+
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+)
 
 // The bootstrap jobs only watch config.
 type ConfigProvider interface {
@@ -9,6 +13,14 @@ type ConfigProvider interface {
 	ContractConfigTracker() ocrtypes.ContractConfigTracker
 }
 
+// MultiPrefixConfigDigester is an optional extension of [ocrtypes.OffchainConfigDigester] for digesters which produce
+// digests with one of several prefixes, e.g. while migrating between digest formats. ConfigDigestPrefix returns the
+// prefix of new digests, which must be included in ConfigDigestPrefixes.
+type MultiPrefixConfigDigester interface {
+	ocrtypes.OffchainConfigDigester
+	ConfigDigestPrefixes() ([]ocrtypes.ConfigDigestPrefix, error)
+}
+
 // Plugin is an alias for PluginProvider, for compatibility.
 // Deprecated
 type Plugin = PluginProvider
@@ -19,3 +31,44 @@ type PluginProvider interface {
 	ConfigProvider
 	ContractTransmitter() ocrtypes.ContractTransmitter
 }
+
+// ReportAccountTransmitter is an optional extension of [ocrtypes.ContractTransmitter] for transmitters which send each
+// report from one of several accounts, e.g. round-robin, for chains which limit pending transactions per account.
+// FromAccount returns the account included in the contract config.
+type ReportAccountTransmitter interface {
+	ocrtypes.ContractTransmitter
+	// FromAccountForReport returns the account which transmits the report for reportContext.
+	FromAccountForReport(ctx context.Context, reportContext ocrtypes.ReportContext) (ocrtypes.Account, error)
+}
+
+// TransmitState is the outcome of a transmission.
+type TransmitState int32
+
+const (
+	// TransmitConfirmed indicates that the report was included on chain.
+	TransmitConfirmed TransmitState = iota + 1
+	// TransmitFailed indicates that the report will not be included on chain, e.g. because the transaction reverted
+	// or was dropped.
+	TransmitFailed
+)
+
+// TransmitStatus is the outcome of a report passed to [ocrtypes.ContractTransmitter.Transmit].
+type TransmitStatus struct {
+	ReportContext ocrtypes.ReportContext
+	State         TransmitState
+	// TxHash of the last transaction sent with the report, if any.
+	TxHash []byte
+	// BlockNumber including the transaction, if TransmitConfirmed.
+	BlockNumber uint64
+	// Err describes why the transmission failed, if TransmitFailed.
+	Err error
+}
+
+// TransmitStatusTransmitter is an optional extension of [ocrtypes.ContractTransmitter] for transmitters which report
+// the outcome of each transmission, so that plugins can retry or alert without polling LatestTransmissionDetails.
+type TransmitStatusTransmitter interface {
+	ocrtypes.ContractTransmitter
+	// SubscribeTransmitStatus calls fn, in order of outcome, with the status of each report transmitted after the call.
+	// It blocks until ctx is done, or fn returns an error.
+	SubscribeTransmitStatus(ctx context.Context, fn func(TransmitStatus) error) error
+}
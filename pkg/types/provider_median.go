@@ -2,9 +2,16 @@ package types
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"time"
 
 	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
 	libocr "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/utils"
 )
 
 // MedianProvider provides all components needed for a median OCR2 plugin.
@@ -15,8 +22,48 @@ type MedianProvider interface {
 	OnchainConfigCodec() median.OnchainConfigCodec
 }
 
+// ReportCodecCtx is an optional extension of [median.ReportCodec], with context-accepting variants of its methods.
+// LOOP adapters call these instead when implemented, so that cancellation and deadlines are respected end to end.
+type ReportCodecCtx interface {
+	BuildReportCtx(ctx context.Context, observations []median.ParsedAttributedObservation) (libocr.Report, error)
+	MedianFromReportCtx(ctx context.Context, report libocr.Report) (*big.Int, error)
+	MaxReportLengthCtx(ctx context.Context, n int) (int, error)
+}
+
+// FeatureFlagExtendedObservations is a [FeatureFlags] bool, which hosts set for NewMedianFactory when the ReportCodec of
+// their MedianProvider implements [ReportCodecExt]. Without it, the ReportCodec of the provider delivered to the
+// plugin returns [ErrExtendedObservationsUnsupported] from BuildReportExt.
+const FeatureFlagExtendedObservations = "median.extendedObservations"
+
+// ErrExtendedObservationsUnsupported is returned by [ReportCodecExt.BuildReportExt] when the underlying ReportCodec
+// does not support extended observations.
+var ErrExtendedObservationsUnsupported = errors.New("extended median observations are not supported")
+
+// ParsedAttributedObservationExt extends [median.ParsedAttributedObservation] with the fields of fee-aware median
+// variants, which are used on some chains.
+type ParsedAttributedObservationExt struct {
+	median.ParsedAttributedObservation
+	GasPriceSubunits *big.Int          // optional
+	Metadata         map[string][]byte // optional, variant specific
+}
+
+// ReportCodecExt is an optional extension of [median.ReportCodec], for fee-aware median variants which build reports
+// from extended observations. Check [FeatureFlagExtendedObservations] before relying on it over LOOP.
+type ReportCodecExt interface {
+	BuildReportExt(ctx context.Context, observations []ParsedAttributedObservationExt) (libocr.Report, error)
+}
+
+// OnchainConfigCodecCtx is an optional extension of [median.OnchainConfigCodec], with context-accepting variants of
+// its methods. LOOP adapters call these instead when implemented, so that cancellation and deadlines are respected end
+// to end.
+type OnchainConfigCodecCtx interface {
+	EncodeCtx(ctx context.Context, config median.OnchainConfig) ([]byte, error)
+	DecodeCtx(ctx context.Context, encoded []byte) (median.OnchainConfig, error)
+}
+
 type PluginMedian interface {
 	// NewMedianFactory returns a new ReportingPluginFactory. If provider implements GRPCClientConn, it can be forwarded efficiently via proxy.
+	// If juelsPerFeeCoin was built from a JuelsPerFeeCoinConfig, the strategy is applied on the plugin side.
 	NewMedianFactory(ctx context.Context, provider MedianProvider, dataSource, juelsPerFeeCoin median.DataSource, errorLog ErrorLog) (ReportingPluginFactory, error)
 }
 
@@ -24,3 +71,118 @@ type ReportingPluginFactory interface {
 	Service
 	libocr.ReportingPluginFactory
 }
+
+// JuelsPerFeeCoinStrategy selects how juelsPerFeeCoin observations are made.
+type JuelsPerFeeCoinStrategy string
+
+const (
+	// JuelsPerFeeCoinPipeline observes the pipeline on every call. This is the default.
+	JuelsPerFeeCoinPipeline JuelsPerFeeCoinStrategy = "pipeline"
+	// JuelsPerFeeCoinFixed always returns a fixed value, for chains without a LINK/native feed.
+	JuelsPerFeeCoinFixed JuelsPerFeeCoinStrategy = "fixed"
+	// JuelsPerFeeCoinCached observes the pipeline, and re-uses the result until it is older than MaxStaleness.
+	JuelsPerFeeCoinCached JuelsPerFeeCoinStrategy = "cached"
+)
+
+// JuelsPerFeeCoinConfig configures the juelsPerFeeCoin strategy of a median plugin.
+// It is intended to be embedded in plugin config.
+type JuelsPerFeeCoinConfig struct {
+	Strategy JuelsPerFeeCoinStrategy `json:"strategy,omitempty"`
+	// FixedValue is required for JuelsPerFeeCoinFixed.
+	FixedValue *big.Int `json:"fixedValue,omitempty"`
+	// MaxStaleness is required for JuelsPerFeeCoinCached.
+	MaxStaleness utils.Duration `json:"maxStaleness,omitempty"`
+}
+
+// Validate returns an error if the config is incomplete or inconsistent.
+func (c JuelsPerFeeCoinConfig) Validate() error {
+	switch c.Strategy {
+	case "", JuelsPerFeeCoinPipeline:
+	case JuelsPerFeeCoinFixed:
+		if c.FixedValue == nil {
+			return errors.New("juelsPerFeeCoin: fixedValue is required for fixed strategy")
+		}
+		if c.FixedValue.Sign() < 0 {
+			return fmt.Errorf("juelsPerFeeCoin: fixedValue must not be negative: %s", c.FixedValue)
+		}
+	case JuelsPerFeeCoinCached:
+		if c.MaxStaleness.Duration() <= 0 {
+			return errors.New("juelsPerFeeCoin: maxStaleness is required for cached strategy")
+		}
+	default:
+		return fmt.Errorf("juelsPerFeeCoin: unknown strategy %q", c.Strategy)
+	}
+	return nil
+}
+
+// ObservationBounds restricts the values which a median DataSource may observe, so that clearly invalid observations
+// are rejected before reaching the OCR protocol. Nil bounds are unlimited. Missing (nil) observations are always rejected.
+type ObservationBounds struct {
+	// Min is the smallest valid observation, e.g. one to reject zero and negative values.
+	Min *big.Int `json:"min,omitempty"`
+	// Max is the largest valid observation.
+	Max *big.Int `json:"max,omitempty"`
+}
+
+// Validate returns an error if the bounds are inconsistent.
+func (b ObservationBounds) Validate() error {
+	if b.Min != nil && b.Max != nil && b.Min.Cmp(b.Max) > 0 {
+		return fmt.Errorf("observationBounds: min must not be greater than max: %s > %s", b.Min, b.Max)
+	}
+	return nil
+}
+
+// Contains returns true if val is within the bounds.
+func (b ObservationBounds) Contains(val *big.Int) bool {
+	if val == nil {
+		return false
+	}
+	if b.Min != nil && val.Cmp(b.Min) < 0 {
+		return false
+	}
+	if b.Max != nil && val.Cmp(b.Max) > 0 {
+		return false
+	}
+	return true
+}
+
+// TimestampSkew restricts median observation timestamps to within an allowed skew of the local clock, so that one
+// node with a misconfigured clock cannot propagate bad timestamps into reports. Zero durations are unlimited.
+type TimestampSkew struct {
+	// MaxPast is how far observation timestamps may lag behind the local clock.
+	MaxPast time.Duration `json:"maxPast,omitempty"`
+	// MaxFuture is how far observation timestamps may run ahead of the local clock.
+	MaxFuture time.Duration `json:"maxFuture,omitempty"`
+	// Clamp out of range timestamps to the nearest allowed value, instead of rejecting them.
+	Clamp bool `json:"clamp,omitempty"`
+}
+
+// Validate returns an error if the skew is negative.
+func (s TimestampSkew) Validate() error {
+	if s.MaxPast < 0 {
+		return fmt.Errorf("timestampSkew: maxPast must not be negative: %s", s.MaxPast)
+	}
+	if s.MaxFuture < 0 {
+		return fmt.Errorf("timestampSkew: maxFuture must not be negative: %s", s.MaxFuture)
+	}
+	return nil
+}
+
+// Enabled returns true if either direction is limited.
+func (s TimestampSkew) Enabled() bool { return s.MaxPast > 0 || s.MaxFuture > 0 }
+
+// Range returns the allowed range of unix timestamps, relative to now. Unlimited ends are 0 and [math.MaxUint32].
+func (s TimestampSkew) Range(now time.Time) (min, max uint32) {
+	min, max = 0, math.MaxUint32
+	if s.MaxPast > 0 {
+		if t := now.Add(-s.MaxPast).Unix(); t > 0 {
+			min = uint32(t)
+		}
+	}
+	if s.MaxFuture > 0 {
+		if t := now.Add(s.MaxFuture).Unix(); t < math.MaxUint32 {
+			max = uint32(t)
+		}
+	}
+	return
+}
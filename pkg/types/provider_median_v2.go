@@ -0,0 +1,117 @@
+package types
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	libocr "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+)
+
+// MedianProviderV2 extends [MedianProvider] with codecs which accept a context, so that cancellation and deadlines are
+// respected end to end. Use [UpgradeMedianProvider] to accept either version.
+//
+// Provider interfaces are implemented by every chain relayer, so they are never changed in place. Instead, a new
+// version embeds the previous one and adds methods, and consumers upgrade providers of the previous version with a
+// shim, until all relayers implement the new version. Only then is the previous version deprecated.
+type MedianProviderV2 interface {
+	MedianProvider
+	// ReportCodecV2 returns the same codec as ReportCodec, with context-accepting methods.
+	ReportCodecV2() ReportCodecV2
+	// OnchainConfigCodecV2 returns the same codec as OnchainConfigCodec, with context-accepting methods.
+	OnchainConfigCodecV2() OnchainConfigCodecV2
+}
+
+// ReportCodecV2 is a [median.ReportCodec] which implements [ReportCodecCtx].
+type ReportCodecV2 interface {
+	median.ReportCodec
+	ReportCodecCtx
+}
+
+// OnchainConfigCodecV2 is a [median.OnchainConfigCodec] which implements [OnchainConfigCodecCtx].
+type OnchainConfigCodecV2 interface {
+	median.OnchainConfigCodec
+	OnchainConfigCodecCtx
+}
+
+// UpgradeMedianProvider returns p if it implements [MedianProviderV2], or otherwise a shim which upgrades its codecs
+// with [UpgradeReportCodec] and [UpgradeOnchainConfigCodec].
+func UpgradeMedianProvider(p MedianProvider) MedianProviderV2 {
+	if v2, ok := p.(MedianProviderV2); ok {
+		return v2
+	}
+	return &medianProviderShim{p}
+}
+
+type medianProviderShim struct {
+	MedianProvider
+}
+
+func (p *medianProviderShim) ReportCodecV2() ReportCodecV2 {
+	return UpgradeReportCodec(p.ReportCodec())
+}
+
+func (p *medianProviderShim) OnchainConfigCodecV2() OnchainConfigCodecV2 {
+	return UpgradeOnchainConfigCodec(p.OnchainConfigCodec())
+}
+
+// UpgradeReportCodec returns c if it implements [ReportCodecV2], or otherwise a shim which checks the context before
+// calling the methods of c.
+func UpgradeReportCodec(c median.ReportCodec) ReportCodecV2 {
+	if v2, ok := c.(ReportCodecV2); ok {
+		return v2
+	}
+	return reportCodecShim{c}
+}
+
+type reportCodecShim struct {
+	median.ReportCodec
+}
+
+func (c reportCodecShim) BuildReportCtx(ctx context.Context, observations []median.ParsedAttributedObservation) (libocr.Report, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return c.BuildReport(observations)
+}
+
+func (c reportCodecShim) MedianFromReportCtx(ctx context.Context, report libocr.Report) (*big.Int, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return c.MedianFromReport(report)
+}
+
+func (c reportCodecShim) MaxReportLengthCtx(ctx context.Context, n int) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	return c.MaxReportLength(n)
+}
+
+// UpgradeOnchainConfigCodec returns c if it implements [OnchainConfigCodecV2], or otherwise a shim which checks the
+// context before calling the methods of c.
+func UpgradeOnchainConfigCodec(c median.OnchainConfigCodec) OnchainConfigCodecV2 {
+	if v2, ok := c.(OnchainConfigCodecV2); ok {
+		return v2
+	}
+	return onchainConfigCodecShim{c}
+}
+
+type onchainConfigCodecShim struct {
+	median.OnchainConfigCodec
+}
+
+func (c onchainConfigCodecShim) EncodeCtx(ctx context.Context, config median.OnchainConfig) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return c.Encode(config)
+}
+
+func (c onchainConfigCodecShim) DecodeCtx(ctx context.Context, encoded []byte) (median.OnchainConfig, error) {
+	if err := ctx.Err(); err != nil {
+		return median.OnchainConfig{}, err
+	}
+	return c.Decode(encoded)
+}
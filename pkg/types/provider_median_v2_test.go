@@ -0,0 +1,133 @@
+package types_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	libocr "github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+// TestUpgradeMedianProvider checks that consumers of each version work with providers of each version.
+func TestUpgradeMedianProvider(t *testing.T) {
+	for _, provider := range []struct {
+		name     string
+		provider types.MedianProvider
+		ctx      bool // whether the context-accepting methods are implemented natively
+	}{
+		{"v1", medianProviderV1{reportCodec{}, onchainConfigCodec{}}, false},
+		{"v1 with ctx codecs", medianProviderV1{reportCodecCtx{}, onchainConfigCodecCtx{}}, true},
+		{"v2", medianProviderV2{medianProviderV1{reportCodecCtx{}, onchainConfigCodecCtx{}}}, true},
+	} {
+		provider := provider
+		t.Run(provider.name, func(t *testing.T) {
+			ctx := context.Background()
+			t.Run("v1 consumer", func(t *testing.T) {
+				n, err := provider.provider.ReportCodec().MaxReportLength(1)
+				require.NoError(t, err)
+				assert.Equal(t, 1, n)
+				_, err = provider.provider.OnchainConfigCodec().Encode(median.OnchainConfig{})
+				require.NoError(t, err)
+			})
+			t.Run("v2 consumer", func(t *testing.T) {
+				p := types.UpgradeMedianProvider(provider.provider)
+				if v2, ok := provider.provider.(types.MedianProviderV2); ok {
+					assert.Equal(t, v2, p, "no shim for v2")
+				}
+
+				exp := 1
+				if provider.ctx {
+					exp = ctxLength
+				}
+				n, err := p.ReportCodecV2().MaxReportLengthCtx(ctx, 1)
+				require.NoError(t, err)
+				assert.Equal(t, exp, n)
+				n, err = p.ReportCodec().MaxReportLength(1)
+				require.NoError(t, err)
+				assert.Equal(t, 1, n, "ReportCodec is unchanged")
+
+				encoded, err := p.OnchainConfigCodecV2().EncodeCtx(ctx, median.OnchainConfig{})
+				require.NoError(t, err)
+				assert.Equal(t, provider.ctx, string(encoded) == "ctx")
+
+				canceled, cancel := context.WithCancel(ctx)
+				cancel()
+				_, err = p.ReportCodecV2().MaxReportLengthCtx(canceled, 1)
+				assert.ErrorIs(t, err, context.Canceled)
+				_, err = p.OnchainConfigCodecV2().DecodeCtx(canceled, nil)
+				assert.ErrorIs(t, err, context.Canceled)
+			})
+		})
+	}
+}
+
+type medianProviderV1 struct {
+	rc  median.ReportCodec
+	occ median.OnchainConfigCodec
+}
+
+func (p medianProviderV1) Name() string                                          { return "test" }
+func (p medianProviderV1) Start(context.Context) error                           { return nil }
+func (p medianProviderV1) Close() error                                          { return nil }
+func (p medianProviderV1) Ready() error                                          { return nil }
+func (p medianProviderV1) HealthReport() map[string]error                        { return nil }
+func (p medianProviderV1) OffchainConfigDigester() libocr.OffchainConfigDigester { return nil }
+func (p medianProviderV1) ContractConfigTracker() libocr.ContractConfigTracker   { return nil }
+func (p medianProviderV1) ContractTransmitter() libocr.ContractTransmitter       { return nil }
+func (p medianProviderV1) MedianContract() median.MedianContract                 { return nil }
+func (p medianProviderV1) ReportCodec() median.ReportCodec                       { return p.rc }
+func (p medianProviderV1) OnchainConfigCodec() median.OnchainConfigCodec         { return p.occ }
+
+type medianProviderV2 struct {
+	medianProviderV1
+}
+
+func (p medianProviderV2) ReportCodecV2() types.ReportCodecV2 { return p.rc.(types.ReportCodecV2) }
+func (p medianProviderV2) OnchainConfigCodecV2() types.OnchainConfigCodecV2 {
+	return p.occ.(types.OnchainConfigCodecV2)
+}
+
+// ctxLength is returned by reportCodecCtx.MaxReportLengthCtx, to tell it apart from MaxReportLength.
+const ctxLength = 100
+
+type reportCodec struct{}
+
+func (reportCodec) BuildReport([]median.ParsedAttributedObservation) (libocr.Report, error) {
+	return libocr.Report{}, nil
+}
+func (reportCodec) MedianFromReport(libocr.Report) (*big.Int, error) { return big.NewInt(0), nil }
+func (reportCodec) MaxReportLength(n int) (int, error)               { return n, nil }
+
+type reportCodecCtx struct{ reportCodec }
+
+func (reportCodecCtx) BuildReportCtx(context.Context, []median.ParsedAttributedObservation) (libocr.Report, error) {
+	return libocr.Report{}, nil
+}
+func (reportCodecCtx) MedianFromReportCtx(context.Context, libocr.Report) (*big.Int, error) {
+	return big.NewInt(0), nil
+}
+func (reportCodecCtx) MaxReportLengthCtx(ctx context.Context, _ int) (int, error) {
+	return ctxLength, ctx.Err()
+}
+
+type onchainConfigCodec struct{}
+
+func (onchainConfigCodec) Encode(median.OnchainConfig) ([]byte, error) { return []byte("v1"), nil }
+func (onchainConfigCodec) Decode([]byte) (median.OnchainConfig, error) {
+	return median.OnchainConfig{}, nil
+}
+
+type onchainConfigCodecCtx struct{ onchainConfigCodec }
+
+func (onchainConfigCodecCtx) EncodeCtx(context.Context, median.OnchainConfig) ([]byte, error) {
+	return []byte("ctx"), nil
+}
+func (onchainConfigCodecCtx) DecodeCtx(ctx context.Context, _ []byte) (median.OnchainConfig, error) {
+	return median.OnchainConfig{}, ctx.Err()
+}
@@ -1,6 +1,10 @@
 package types
 
 import (
+	"context"
+	"fmt"
+	"math"
+
 	"github.com/smartcontractkit/chainlink-relay/pkg/reportingplugins/mercury"
 	v1 "github.com/smartcontractkit/chainlink-relay/pkg/reportingplugins/mercury/v1"
 	v2 "github.com/smartcontractkit/chainlink-relay/pkg/reportingplugins/mercury/v2"
@@ -17,3 +21,31 @@ type MercuryProvider interface {
 	OnchainConfigCodec() mercury.OnchainConfigCodec
 	ContractTransmitter() mercury.Transmitter
 }
+
+// NewMercuryChainHeadProvider returns a [v1.ChainHeadProvider] backed by the latest head of h, for
+// [v1.NewChainHeadDataSource].
+func NewMercuryChainHeadProvider(h HeadTracker) v1.ChainHeadProvider {
+	return mercuryChainHeadProvider{h}
+}
+
+type mercuryChainHeadProvider struct {
+	HeadTracker
+}
+
+func (m mercuryChainHeadProvider) LatestChainHead(ctx context.Context) (v1.ChainHead, error) {
+	head, err := m.LatestHead(ctx)
+	if err != nil {
+		return v1.ChainHead{}, err
+	}
+	if head.Height > math.MaxInt64 {
+		return v1.ChainHead{}, fmt.Errorf("head height %d overflows int64", head.Height)
+	}
+	if head.Timestamp.Unix() < 0 {
+		return v1.ChainHead{}, fmt.Errorf("head timestamp %s is before the unix epoch", head.Timestamp)
+	}
+	return v1.ChainHead{
+		Number:    int64(head.Height),
+		Hash:      head.Hash,
+		Timestamp: uint64(head.Timestamp.Unix()),
+	}, nil
+}
@@ -0,0 +1,44 @@
+package types_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/smartcontractkit/chainlink-relay/pkg/reportingplugins/mercury/v1"
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+type staticHeadTracker struct {
+	types.HeadTracker
+	head types.Head
+	err  error
+}
+
+func (s staticHeadTracker) LatestHead(context.Context) (types.Head, error) {
+	return s.head, s.err
+}
+
+func TestNewMercuryChainHeadProvider(t *testing.T) {
+	ctx := context.Background()
+	ts := time.Unix(1700000000, 500)
+
+	head, err := types.NewMercuryChainHeadProvider(staticHeadTracker{head: types.Head{Height: 10, Hash: []byte{0xab}, Timestamp: ts}}).LatestChainHead(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, v1.ChainHead{Number: 10, Hash: []byte{0xab}, Timestamp: 1700000000}, head)
+
+	headErr := errors.New("no head")
+	_, err = types.NewMercuryChainHeadProvider(staticHeadTracker{err: headErr}).LatestChainHead(ctx)
+	assert.ErrorIs(t, err, headErr)
+
+	_, err = types.NewMercuryChainHeadProvider(staticHeadTracker{head: types.Head{Height: math.MaxInt64 + 1, Timestamp: ts}}).LatestChainHead(ctx)
+	assert.ErrorContains(t, err, "overflows int64")
+
+	_, err = types.NewMercuryChainHeadProvider(staticHeadTracker{head: types.Head{Height: 1}}).LatestChainHead(ctx)
+	assert.ErrorContains(t, err, "before the unix epoch")
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 
 	"github.com/google/uuid"
@@ -21,6 +22,60 @@ type RelayArgs struct {
 	ContractID    string
 	New           bool // Whether this is a first time job add.
 	RelayConfig   []byte
+	// ProviderType is the kind of provider requested. It defaults to the kind returned by the method called, so that
+	// relayers may reject kinds which they do not support.
+	ProviderType ProviderType
+}
+
+// ProviderType is the kind of provider requested via [RelayArgs].
+type ProviderType int32
+
+const (
+	// ProviderTypeUnspecified is sent by older hosts, and defaults to the kind returned by the method called.
+	ProviderTypeUnspecified ProviderType = iota
+	ProviderTypeConfig
+	ProviderTypeMedian
+	ProviderTypeMercury
+	ProviderTypeFunctions
+	// ProviderTypePlugin is a [PluginProvider] for a product without a dedicated provider.
+	ProviderTypePlugin
+)
+
+func (t ProviderType) String() string {
+	switch t {
+	case ProviderTypeUnspecified:
+		return "unspecified"
+	case ProviderTypeConfig:
+		return "config"
+	case ProviderTypeMedian:
+		return "median"
+	case ProviderTypeMercury:
+		return "mercury"
+	case ProviderTypeFunctions:
+		return "functions"
+	case ProviderTypePlugin:
+		return "plugin"
+	}
+	return fmt.Sprintf("ProviderType(%d)", int32(t))
+}
+
+// Validate returns an error if t is unknown.
+func (t ProviderType) Validate() error {
+	if t < ProviderTypeUnspecified || t > ProviderTypePlugin {
+		return fmt.Errorf("unknown ProviderType %d", int32(t))
+	}
+	return nil
+}
+
+// ErrUnsupportedProviderType is returned when a provider is requested with a [ProviderType] which is not supported,
+// either by the method called or by the relayer.
+type ErrUnsupportedProviderType struct {
+	Type   ProviderType
+	Method string // e.g. NewMedianProvider
+}
+
+func (e ErrUnsupportedProviderType) Error() string {
+	return fmt.Sprintf("unsupported ProviderType %s for %s", e.Type, e.Method)
 }
 
 type ChainStatus struct {
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"context"
+)
+
+// RPCStatus is the status of one RPC endpoint of a chain, as configured by
+// [github.com/smartcontractkit/chainlink-relay/pkg/config.RPCNode].
+type RPCStatus struct {
+	ChainID string
+	// Name of the node. URLs are omitted, since they often include credentials.
+	Name     string
+	Priority uint32
+	Weight   uint32
+	// Active is true if the relayer is currently sending requests to the node.
+	Active bool
+	// Err is nil if the node is healthy, otherwise it describes why not.
+	Err error
+}
+
+// RPCStatusReporter is optionally implemented by relayers with multiple RPC endpoints per chain, so that operators can
+// see which endpoints are healthy, and which are in use.
+type RPCStatusReporter interface {
+	// RPCStatuses returns the status of each node of the chains with chainIDs, or of all chains if none are given.
+	RPCStatuses(ctx context.Context, chainIDs ...string) ([]RPCStatus, error)
+}
+
+// RPCHealthReport returns the health of each node in statuses, keyed by "<name>.RPC.<ChainID>.<Name>", for merging
+// into the HealthReport of the relayer named name.
+func RPCHealthReport(name string, statuses []RPCStatus) map[string]error {
+	hr := make(map[string]error, len(statuses))
+	for _, s := range statuses {
+		hr[name+".RPC."+s.ChainID+"."+s.Name] = s.Err
+	}
+	return hr
+}
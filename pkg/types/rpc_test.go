@@ -0,0 +1,23 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/types"
+)
+
+func TestRPCHealthReport(t *testing.T) {
+	refused := errors.New("connection refused")
+	assert.Equal(t, map[string]error{
+		"EVM.RPC.1.primary": nil,
+		"EVM.RPC.1.backup":  refused,
+		"EVM.RPC.5.primary": nil,
+	}, types.RPCHealthReport("EVM", []types.RPCStatus{
+		{ChainID: "1", Name: "primary", Active: true},
+		{ChainID: "1", Name: "backup", Err: refused},
+		{ChainID: "5", Name: "primary", Active: true},
+	}))
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"context"
+)
+
+// TransactRequest has the arguments of [Transactor.Transact].
+type TransactRequest struct {
+	// ChainID of the chain to send the transaction to.
+	ChainID string
+	// IdempotencyKey identifies the request, so that it can be retried safely. A request with the key of an earlier
+	// request returns the ID of the earlier transaction, without sending it again.
+	IdempotencyKey string
+	// SignedTx is the signed transaction, in the encoding of the chain. It is opaque to the caller.
+	SignedTx []byte
+}
+
+// TxState is the state of a transaction sent with [Transactor.Transact].
+type TxState int32
+
+const (
+	// TxPending indicates that the transaction was accepted, but is not yet included on chain.
+	TxPending TxState = iota + 1
+	// TxConfirmed indicates that the transaction was included on chain.
+	TxConfirmed
+	// TxFailed indicates that the transaction will not be included on chain, e.g. because it reverted or was dropped.
+	TxFailed
+)
+
+// Final returns true if the state will not change again.
+func (s TxState) Final() bool {
+	return s == TxConfirmed || s == TxFailed
+}
+
+// TxStatus is the status of a transaction sent with [Transactor.Transact].
+type TxStatus struct {
+	// ID returned by Transact.
+	ID    string
+	State TxState
+	// TxHash of the transaction, once it is known.
+	TxHash []byte
+	// BlockNumber including the transaction, if TxConfirmed.
+	BlockNumber uint64
+	// Err describes why the transaction failed, if TxFailed.
+	Err error
+}
+
+// Transactor is optionally implemented by relayers which send arbitrary signed transactions, for jobs which do not run
+// OCR, e.g. keepers or direct requests.
+type Transactor interface {
+	// Transact sends the transaction in request, and returns its ID.
+	Transact(ctx context.Context, request TransactRequest) (id string, err error)
+	// TxStatus returns the current status of the transaction with id.
+	TxStatus(ctx context.Context, id string) (TxStatus, error)
+	// SubscribeTxStatus calls fn with the current status of the transaction with id, and then with each change, in
+	// order. It blocks until the transaction reaches a final state, ctx is done, or fn returns an error.
+	SubscribeTxStatus(ctx context.Context, id string, fn func(TxStatus) error) error
+}
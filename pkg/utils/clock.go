@@ -0,0 +1,94 @@
+package utils
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// A Clock tells the time, and schedules tickers and timeouts. Use [RealClock] in production, and a [FakeClock] to test
+// time dependent behavior deterministically, without sleeping.
+type Clock interface {
+	Now() time.Time
+	NewTicker(d time.Duration) Ticker
+	NewTimer(d time.Duration) Timer
+	// After is like [time.After]. The timeout is not released until it fires, so prefer NewTimer unless it always does.
+	After(d time.Duration) <-chan time.Time
+}
+
+// A Ticker delivers ticks on C at intervals, like [time.Ticker].
+type Ticker interface {
+	C() <-chan time.Time
+	Stop()
+}
+
+// A Timer delivers a single tick on C once expired, like [time.Timer].
+type Timer interface {
+	C() <-chan time.Time
+	// Stop prevents the Timer from firing, and returns false if it already expired or was stopped.
+	Stop() bool
+	// Reset changes the Timer to expire after d, and returns true if it had been active. Like [time.Timer.Reset], it
+	// should only be called on stopped or expired Timers with drained channels.
+	Reset(d time.Duration) bool
+}
+
+// RealClock is the [Clock] of the time package.
+var RealClock Clock = realClock{}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time { return time.Now() }
+
+func (realClock) NewTicker(d time.Duration) Ticker { return realTicker{time.NewTicker(d)} }
+
+func (realClock) NewTimer(d time.Duration) Timer { return realTimer{time.NewTimer(d)} }
+
+func (realClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+type realTicker struct{ *time.Ticker }
+
+func (t realTicker) C() <-chan time.Time { return t.Ticker.C }
+
+type realTimer struct{ *time.Timer }
+
+func (t realTimer) C() <-chan time.Time { return t.Timer.C }
+
+// WithTimeout is like [context.WithTimeout], except that timeout is measured by clock.
+// Contexts from a [FakeClock] report no deadline, since it would be meaningless to the time package.
+func WithTimeout(ctx context.Context, clock Clock, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if _, ok := clock.(realClock); ok || clock == nil {
+		return context.WithTimeout(ctx, timeout)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	tc := &timeoutCtx{Context: ctx}
+	timer := clock.NewTimer(timeout)
+	go func() {
+		defer timer.Stop()
+		select {
+		case <-timer.C():
+			tc.mu.Lock()
+			tc.timedOut = true
+			tc.mu.Unlock()
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return tc, cancel
+}
+
+// timeoutCtx reports [context.DeadlineExceeded] once timed out.
+type timeoutCtx struct {
+	context.Context
+
+	mu       sync.Mutex
+	timedOut bool
+}
+
+func (t *timeoutCtx) Err() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.timedOut {
+		return context.DeadlineExceeded
+	}
+	return t.Context.Err()
+}
@@ -0,0 +1,124 @@
+package utils
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+)
+
+func TestFakeClock(t *testing.T) {
+	start := time.Unix(1000, 0)
+
+	t.Run("After", func(t *testing.T) {
+		clock := NewFakeClock(start)
+		after := clock.After(time.Minute)
+		clock.Advance(time.Minute - time.Nanosecond)
+		select {
+		case <-after:
+			t.Fatal("fired early")
+		default:
+		}
+		clock.Advance(time.Nanosecond)
+		assert.Equal(t, start.Add(time.Minute), <-after)
+		assert.Equal(t, start.Add(time.Minute), clock.Now())
+	})
+
+	t.Run("NewTicker", func(t *testing.T) {
+		clock := NewFakeClock(start)
+		ticker := clock.NewTicker(time.Second)
+		clock.Advance(time.Second)
+		assert.Equal(t, start.Add(time.Second), <-ticker.C())
+		clock.Advance(3 * time.Second) // slow receiver
+		assert.Equal(t, start.Add(2*time.Second), <-ticker.C())
+		select {
+		case <-ticker.C():
+			t.Fatal("ticks were not dropped")
+		default:
+		}
+		ticker.Stop()
+		clock.Advance(time.Second)
+		select {
+		case <-ticker.C():
+			t.Fatal("ticked after Stop")
+		default:
+		}
+	})
+
+	t.Run("NewTimer", func(t *testing.T) {
+		clock := NewFakeClock(start)
+		timer := clock.NewTimer(time.Second)
+		clock.Advance(time.Second)
+		assert.Equal(t, start.Add(time.Second), <-timer.C())
+		assert.False(t, timer.Stop(), "already fired")
+
+		assert.False(t, timer.Reset(time.Second))
+		assert.True(t, timer.Stop())
+		clock.Advance(time.Second)
+		select {
+		case <-timer.C():
+			t.Fatal("fired after Stop")
+		default:
+		}
+		assert.Empty(t, clock.waiters)
+
+		assert.False(t, timer.Reset(time.Minute))
+		assert.True(t, timer.Reset(time.Second))
+		clock.Advance(time.Second)
+		assert.Equal(t, start.Add(3*time.Second), <-timer.C())
+		assert.Empty(t, clock.waiters)
+	})
+
+	t.Run("BlockUntil", func(t *testing.T) {
+		clock := NewFakeClock(start)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			<-clock.After(time.Second)
+		}()
+		clock.BlockUntil(1)
+		clock.Advance(time.Second)
+		<-done
+	})
+}
+
+func TestWithTimeout(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	t.Run("fake", func(t *testing.T) {
+		clock := NewFakeClock(time.Unix(0, 0))
+		ctx, cancel := WithTimeout(context.Background(), clock, time.Minute)
+		defer cancel()
+		_, ok := ctx.Deadline()
+		assert.False(t, ok)
+		require.NoError(t, ctx.Err())
+		clock.Advance(time.Minute)
+		<-ctx.Done()
+		assert.ErrorIs(t, ctx.Err(), context.DeadlineExceeded)
+	})
+
+	t.Run("fake cancelled", func(t *testing.T) {
+		clock := NewFakeClock(time.Unix(0, 0))
+		ctx, cancel := WithTimeout(context.Background(), clock, time.Minute)
+		cancel()
+		<-ctx.Done()
+		assert.ErrorIs(t, ctx.Err(), context.Canceled)
+
+		// the timeout is released
+		clock.mu.Lock()
+		for len(clock.waiters) > 0 {
+			clock.cond.Wait()
+		}
+		clock.mu.Unlock()
+	})
+
+	t.Run("real", func(t *testing.T) {
+		ctx, cancel := WithTimeout(context.Background(), RealClock, time.Minute)
+		defer cancel()
+		_, ok := ctx.Deadline()
+		assert.True(t, ok)
+	})
+}
@@ -0,0 +1,170 @@
+package utils
+
+import (
+	"sync"
+	"time"
+)
+
+var _ Clock = (*FakeClock)(nil)
+
+// FakeClock is a [Clock] for tests, which only moves when advanced. Tickers and timeouts fire synchronously during
+// Advance, so tests of time dependent behavior do not have to sleep.
+type FakeClock struct {
+	mu      sync.Mutex
+	cond    *sync.Cond // broadcast when waiters change
+	now     time.Time
+	waiters []*fakeWaiter
+}
+
+type fakeWaiter struct {
+	at     time.Time
+	period time.Duration // zero for one-shot waiters from After and NewTimer
+	c      chan time.Time
+}
+
+// NewFakeClock returns a new [*FakeClock] starting at now.
+func NewFakeClock(now time.Time) *FakeClock {
+	f := &FakeClock{now: now}
+	f.cond = sync.NewCond(&f.mu)
+	return f
+}
+
+func (f *FakeClock) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+func (f *FakeClock) After(d time.Duration) <-chan time.Time {
+	return f.NewTimer(d).C()
+}
+
+func (f *FakeClock) NewTimer(d time.Duration) Timer {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	t := &fakeTimer{f: f, w: &fakeWaiter{c: make(chan time.Time, 1)}}
+	t.start(d)
+	return t
+}
+
+func (f *FakeClock) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic("non-positive interval for FakeClock.NewTicker")
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	w := &fakeWaiter{at: f.now.Add(d), period: d, c: make(chan time.Time, 1)}
+	f.addWaiter(w)
+	return &fakeTicker{f: f, w: w}
+}
+
+// Advance moves the clock forward by d, firing any tickers and timeouts which come due, in order.
+func (f *FakeClock) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	end := f.now.Add(d)
+	for {
+		next := f.nextDue(end)
+		if next == nil {
+			break
+		}
+		f.now = next.at
+		select {
+		case next.c <- next.at:
+		default: // like time.Ticker, drop ticks for slow receivers
+		}
+		if next.period > 0 {
+			next.at = next.at.Add(next.period)
+		} else {
+			f.removeWaiter(next)
+		}
+	}
+	f.now = end
+}
+
+// BlockUntil blocks until at least n tickers and timeouts are waiting on the clock, e.g. to ensure that a goroutine
+// has scheduled its next tick before calling Advance.
+func (f *FakeClock) BlockUntil(n int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for len(f.waiters) < n {
+		f.cond.Wait()
+	}
+}
+
+// nextDue returns the earliest waiter due by end, or nil if there are none.
+func (f *FakeClock) nextDue(end time.Time) (next *fakeWaiter) {
+	for _, w := range f.waiters {
+		if w.at.After(end) {
+			continue
+		}
+		if next == nil || w.at.Before(next.at) {
+			next = w
+		}
+	}
+	return
+}
+
+func (f *FakeClock) addWaiter(w *fakeWaiter) {
+	f.waiters = append(f.waiters, w)
+	f.cond.Broadcast()
+}
+
+// removeWaiter returns true if w was waiting.
+func (f *FakeClock) removeWaiter(w *fakeWaiter) bool {
+	for i := range f.waiters {
+		if f.waiters[i] == w {
+			f.waiters = append(f.waiters[:i], f.waiters[i+1:]...)
+			f.cond.Broadcast()
+			return true
+		}
+	}
+	return false
+}
+
+type fakeTicker struct {
+	f *FakeClock
+	w *fakeWaiter
+}
+
+func (t *fakeTicker) C() <-chan time.Time { return t.w.c }
+
+func (t *fakeTicker) Stop() {
+	t.f.mu.Lock()
+	defer t.f.mu.Unlock()
+	t.f.removeWaiter(t.w)
+}
+
+type fakeTimer struct {
+	f *FakeClock
+	w *fakeWaiter
+}
+
+// start schedules t to fire after d. f.mu must be held.
+func (t *fakeTimer) start(d time.Duration) {
+	t.w.at = t.f.now.Add(d)
+	if d <= 0 {
+		select {
+		case t.w.c <- t.f.now:
+		default:
+		}
+		return
+	}
+	t.f.addWaiter(t.w)
+}
+
+func (t *fakeTimer) C() <-chan time.Time { return t.w.c }
+
+func (t *fakeTimer) Stop() bool {
+	t.f.mu.Lock()
+	defer t.f.mu.Unlock()
+	return t.f.removeWaiter(t.w)
+}
+
+func (t *fakeTimer) Reset(d time.Duration) bool {
+	t.f.mu.Lock()
+	defer t.f.mu.Unlock()
+	active := t.f.removeWaiter(t.w)
+	t.start(d)
+	return active
+}
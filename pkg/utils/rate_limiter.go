@@ -0,0 +1,204 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// RateLimit configures a [TokenBucket], or the buckets of a [RateLimiter].
+type RateLimit struct {
+	// Rate is the number of events allowed per second, on average. Zero is unlimited.
+	Rate float64
+	// Burst is the maximum number of events allowed at once, after being idle. Defaults to one.
+	Burst int
+}
+
+// Validate returns an error if l is invalid.
+func (l RateLimit) Validate() error {
+	if l.Rate < 0 || math.IsNaN(l.Rate) || math.IsInf(l.Rate, 0) {
+		return fmt.Errorf("Rate must be a non-negative number: %v", l.Rate)
+	}
+	if l.Burst < 0 {
+		return fmt.Errorf("Burst must not be negative: %d", l.Burst)
+	}
+	return nil
+}
+
+func (l RateLimit) burst() float64 {
+	if l.Burst <= 0 {
+		return 1
+	}
+	return float64(l.Burst)
+}
+
+// A TokenBucket allows events at up to a [RateLimit]. The bucket holds up to Burst tokens, and is refilled at Rate
+// tokens per second. Each event takes a token. A nil *TokenBucket is unlimited.
+type TokenBucket struct {
+	clock Clock
+	rate  float64
+	burst float64
+
+	mu     sync.Mutex
+	tokens float64 // negative while events are waiting for tokens
+	last   time.Time
+}
+
+// NewTokenBucket returns a new full [*TokenBucket] which enforces limit, as measured by clock, or nil if limit.Rate
+// is zero. The limit must be valid.
+func NewTokenBucket(limit RateLimit, clock Clock) *TokenBucket {
+	if limit.Rate == 0 {
+		return nil
+	}
+	if clock == nil {
+		clock = RealClock
+	}
+	return &TokenBucket{clock: clock, rate: limit.Rate, burst: limit.burst(), tokens: limit.burst(), last: clock.Now()}
+}
+
+// Allow takes a token if one is available, and returns false otherwise.
+func (b *TokenBucket) Allow() bool {
+	if b == nil {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refill()
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// Wait takes a token, waiting for one to become available if necessary, or returns an error if ctx is done first.
+func (b *TokenBucket) Wait(ctx context.Context) error {
+	if b == nil {
+		return nil
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	b.mu.Lock()
+	b.refill()
+	b.tokens-- // reserve a token, even if it is not available yet
+	delay := time.Duration((-b.tokens / b.rate) * float64(time.Second))
+	b.mu.Unlock()
+	if delay <= 0 {
+		return nil
+	}
+	if deadline, ok := ctx.Deadline(); ok && b.clock.Now().Add(delay).After(deadline) {
+		b.cancel()
+		return fmt.Errorf("rate limit wait of %s would exceed context deadline: %w", delay, context.DeadlineExceeded)
+	}
+	select {
+	case <-b.clock.After(delay):
+		return nil
+	case <-ctx.Done():
+		b.cancel()
+		return ctx.Err()
+	}
+}
+
+// cancel returns a reserved token.
+func (b *TokenBucket) cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.tokens++
+}
+
+// refill adds the tokens earned since the last refill. b.mu must be held.
+func (b *TokenBucket) refill() {
+	now := b.clock.Now()
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens = math.Min(b.burst, b.tokens+elapsed.Seconds()*b.rate)
+	}
+	b.last = now
+}
+
+// full returns true if b has been idle long enough to be refilled completely.
+func (b *TokenBucket) full() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refill()
+	return b.tokens >= b.burst
+}
+
+// A RateLimiter enforces a [RateLimit] independently per key, e.g. per feed or per host, with a [TokenBucket] for
+// each. Buckets are created on demand, and should be removed once their key is no longer used. A nil *RateLimiter is
+// unlimited.
+type RateLimiter struct {
+	limit RateLimit
+	clock Clock
+
+	mu      sync.Mutex
+	buckets map[string]*TokenBucket
+}
+
+// NewRateLimiter returns a new [*RateLimiter] which enforces limit per key, as measured by clock, or nil if
+// limit.Rate is zero. The limit must be valid.
+func NewRateLimiter(limit RateLimit, clock Clock) *RateLimiter {
+	if limit.Rate == 0 {
+		return nil
+	}
+	if clock == nil {
+		clock = RealClock
+	}
+	return &RateLimiter{limit: limit, clock: clock, buckets: map[string]*TokenBucket{}}
+}
+
+// Allow takes a token from the bucket of key if one is available, and returns false otherwise.
+func (l *RateLimiter) Allow(key string) bool {
+	if l == nil {
+		return true
+	}
+	return l.bucket(key).Allow()
+}
+
+// Wait takes a token from the bucket of key, waiting for one to become available if necessary, or returns an error if
+// ctx is done first.
+func (l *RateLimiter) Wait(ctx context.Context, key string) error {
+	if l == nil {
+		return nil
+	}
+	return l.bucket(key).Wait(ctx)
+}
+
+// Remove removes the bucket of key, e.g. once the feed it limits is closed. A later event for key starts with a full
+// bucket.
+func (l *RateLimiter) Remove(key string) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.buckets, key)
+}
+
+// Prune removes the buckets of keys which have been idle long enough to be full, and so behave like new buckets. It
+// may be called periodically to bound the number of buckets when keys are not removed explicitly.
+func (l *RateLimiter) Prune() {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for key, b := range l.buckets {
+		if b.full() {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+func (l *RateLimiter) bucket(key string) *TokenBucket {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	b, ok := l.buckets[key]
+	if !ok {
+		b = NewTokenBucket(l.limit, l.clock)
+		l.buckets[key] = b
+	}
+	return b
+}
@@ -0,0 +1,120 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimit_Validate(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		limit RateLimit
+		ok    bool
+	}{
+		{"zero", RateLimit{}, true},
+		{"valid", RateLimit{Rate: 0.5, Burst: 3}, true},
+		{"negative rate", RateLimit{Rate: -1}, false},
+		{"negative burst", RateLimit{Rate: 1, Burst: -1}, false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.limit.Validate()
+			if tt.ok {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTokenBucket_Allow(t *testing.T) {
+	clock := NewFakeClock(time.Unix(1000, 0))
+	b := NewTokenBucket(RateLimit{Rate: 2, Burst: 3}, clock)
+	for i := 0; i < 3; i++ {
+		assert.True(t, b.Allow(), "burst %d", i)
+	}
+	assert.False(t, b.Allow())
+
+	clock.Advance(499 * time.Millisecond)
+	assert.False(t, b.Allow())
+	clock.Advance(time.Millisecond)
+	assert.True(t, b.Allow())
+	assert.False(t, b.Allow())
+
+	clock.Advance(time.Hour) // refills up to the burst only
+	for i := 0; i < 3; i++ {
+		assert.True(t, b.Allow(), "burst %d", i)
+	}
+	assert.False(t, b.Allow())
+}
+
+func TestTokenBucket_Wait(t *testing.T) {
+	ctx := context.Background()
+	clock := NewFakeClock(time.Unix(1000, 0))
+	b := NewTokenBucket(RateLimit{Rate: 1}, clock)
+	require.NoError(t, b.Wait(ctx))
+
+	done := make(chan error)
+	go func() { done <- b.Wait(ctx) }()
+	clock.BlockUntil(1)
+	select {
+	case <-done:
+		t.Fatal("returned before a token was available")
+	default:
+	}
+	clock.Advance(time.Second)
+	require.NoError(t, <-done)
+
+	t.Run("cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		go func() { done <- b.Wait(ctx) }()
+		clock.BlockUntil(1)
+		cancel()
+		require.ErrorIs(t, <-done, context.Canceled)
+
+		clock.Advance(time.Second)
+		assert.True(t, b.Allow(), "cancelled wait returned its token")
+	})
+
+	t.Run("deadline", func(t *testing.T) {
+		b := NewTokenBucket(RateLimit{Rate: 0.001}, nil)
+		require.True(t, b.Allow())
+		ctx, cancel := context.WithTimeout(ctx, time.Second)
+		defer cancel()
+		err := b.Wait(ctx)
+		assert.True(t, errors.Is(err, context.DeadlineExceeded), err)
+		assert.NoError(t, ctx.Err(), "fails fast")
+	})
+}
+
+func TestRateLimiter(t *testing.T) {
+	clock := NewFakeClock(time.Unix(1000, 0))
+	l := NewRateLimiter(RateLimit{Rate: 1}, clock)
+	assert.True(t, l.Allow("a"))
+	assert.False(t, l.Allow("a"))
+	assert.True(t, l.Allow("b"), "keys are limited independently")
+
+	l.Remove("a")
+	assert.True(t, l.Allow("a"), "removed key starts full")
+
+	clock.Advance(time.Second)
+	assert.True(t, l.Allow("b"))
+	l.Prune()
+	assert.Len(t, l.buckets, 1, "idle key pruned")
+}
+
+func TestRateLimiter_nil(t *testing.T) {
+	l := NewRateLimiter(RateLimit{}, nil)
+	require.Nil(t, l)
+	for i := 0; i < 10; i++ {
+		assert.True(t, l.Allow("a"))
+		assert.NoError(t, l.Wait(context.Background(), "a"))
+	}
+	l.Remove("a")
+	l.Prune()
+}
@@ -0,0 +1,52 @@
+package utils
+
+import "context"
+
+// A StopChan signals when some work should stop, by being closed.
+// Use StopRChan to wrap an existing receive-only channel.
+//
+// The contexts and cancel funcs returned by its methods are tied to a goroutine, which only exits once the context is
+// done, so the cancel func must always be called. TestStopChanCancels enforces that it is not discarded.
+type StopChan chan struct{}
+
+// NewCtx returns a background context.Context, which is cancelled when the StopChan is closed.
+func (s StopChan) NewCtx() (context.Context, context.CancelFunc) {
+	return StopRChan((<-chan struct{})(s)).NewCtx()
+}
+
+// Ctx returns a copy of ctx, which is also cancelled when the StopChan is closed.
+func (s StopChan) Ctx(ctx context.Context) (context.Context, context.CancelFunc) {
+	return StopRChan((<-chan struct{})(s)).Ctx(ctx)
+}
+
+// CtxCancel calls cancel when the StopChan is closed, and returns ctx and cancel unmodified, so that it can wrap calls
+// like context.WithTimeout.
+func (s StopChan) CtxCancel(ctx context.Context, cancel context.CancelFunc) (context.Context, context.CancelFunc) {
+	return StopRChan((<-chan struct{})(s)).CtxCancel(ctx, cancel)
+}
+
+// A StopRChan is the receive-only version of StopChan.
+type StopRChan <-chan struct{}
+
+// NewCtx returns a background context.Context, which is cancelled when the StopRChan is closed.
+func (s StopRChan) NewCtx() (context.Context, context.CancelFunc) {
+	return s.Ctx(context.Background())
+}
+
+// Ctx returns a copy of ctx, which is also cancelled when the StopRChan is closed.
+func (s StopRChan) Ctx(ctx context.Context) (context.Context, context.CancelFunc) {
+	return s.CtxCancel(context.WithCancel(ctx))
+}
+
+// CtxCancel calls cancel when the StopRChan is closed, and returns ctx and cancel unmodified, so that it can wrap calls
+// like context.WithTimeout.
+func (s StopRChan) CtxCancel(ctx context.Context, cancel context.CancelFunc) (context.Context, context.CancelFunc) {
+	go func() {
+		select {
+		case <-s:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
@@ -0,0 +1,146 @@
+package utils
+
+import (
+	"context"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+)
+
+func TestStopChan(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	t.Run("closed", func(t *testing.T) {
+		stopCh := make(StopChan)
+		ctx, cancel := stopCh.NewCtx()
+		defer cancel()
+		withParent, cancelParent := stopCh.Ctx(context.Background())
+		defer cancelParent()
+		withTimeout, cancelTimeout := stopCh.CtxCancel(context.WithTimeout(context.Background(), time.Hour))
+		defer cancelTimeout()
+
+		close(stopCh)
+		for _, ctx := range []context.Context{ctx, withParent, withTimeout} {
+			select {
+			case <-ctx.Done():
+			case <-time.After(time.Second):
+				t.Fatal("context not cancelled")
+			}
+			assert.ErrorIs(t, ctx.Err(), context.Canceled)
+		}
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		stopCh := make(StopChan)
+		defer close(stopCh)
+		ctx, cancel := StopRChan((<-chan struct{})(stopCh)).NewCtx()
+		cancel()
+		<-ctx.Done()
+
+		parent, cancelParent := context.WithCancel(context.Background())
+		ctx, cancel = stopCh.Ctx(parent)
+		defer cancel()
+		cancelParent()
+		<-ctx.Done()
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		stopCh := make(StopChan)
+		defer close(stopCh)
+		ctx, cancel := stopCh.CtxCancel(context.WithTimeout(context.Background(), time.Millisecond))
+		defer cancel()
+		<-ctx.Done()
+		assert.ErrorIs(t, ctx.Err(), context.DeadlineExceeded)
+	})
+}
+
+// stopCtxFuncs are the functions returning a context.CancelFunc, which leak a goroutine unless it is called.
+var stopCtxFuncs = map[string]bool{"NewCtx": true, "Ctx": true, "CtxCancel": true, "ContextFromChan": true}
+
+// discardedCancels returns the positions of calls in file, which discard the context.CancelFunc returned by one of
+// stopCtxFuncs. Like the lostcancel vet check, which only knows about the context package.
+func discardedCancels(fset *token.FileSet, file *ast.File) (found []string) {
+	isStopCtxCall := func(expr ast.Expr) bool {
+		call, ok := expr.(*ast.CallExpr)
+		if !ok {
+			return false
+		}
+		switch fn := call.Fun.(type) {
+		case *ast.SelectorExpr:
+			return stopCtxFuncs[fn.Sel.Name]
+		case *ast.Ident:
+			return stopCtxFuncs[fn.Name]
+		}
+		return false
+	}
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			if len(n.Lhs) != 2 || len(n.Rhs) != 1 || !isStopCtxCall(n.Rhs[0]) {
+				return true
+			}
+			if ident, ok := n.Lhs[1].(*ast.Ident); ok && ident.Name == "_" {
+				found = append(found, fset.Position(n.Pos()).String())
+			}
+		case *ast.ExprStmt:
+			if isStopCtxCall(n.X) {
+				found = append(found, fset.Position(n.Pos()).String())
+			}
+		}
+		return true
+	})
+	return
+}
+
+func TestStopChanCancels(t *testing.T) {
+	t.Run("detected", func(t *testing.T) {
+		fset := token.NewFileSet()
+		file, err := parser.ParseFile(fset, "example.go", `package example
+
+func f(stopCh StopChan) {
+	ctx, _ := stopCh.NewCtx()
+	stopCh.Ctx(ctx)
+	ctx, cancel := stopCh.CtxCancel(context.WithCancel(ctx))
+	defer cancel()
+}
+`, 0)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"example.go:4:2", "example.go:5:2"}, discardedCancels(fset, file))
+	})
+
+	t.Run("pkg", func(t *testing.T) {
+		root := ".." // pkg
+		fset := token.NewFileSet()
+		require.NoError(t, filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				if d.Name() == "testdata" {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if !strings.HasSuffix(path, ".go") {
+				return nil
+			}
+			file, err := parser.ParseFile(fset, path, nil, 0)
+			if err != nil {
+				return err
+			}
+			for _, pos := range discardedCancels(fset, file) {
+				t.Errorf("%s: the context.CancelFunc must be called, or the goroutine waiting on the stop channel leaks", pos)
+			}
+			return nil
+		}))
+	})
+}
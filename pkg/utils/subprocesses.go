@@ -1,6 +1,14 @@
 package utils
 
-import "sync"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"sync"
+
+	"github.com/smartcontractkit/chainlink-relay/pkg/logger"
+)
 
 // Subprocesses is an abstraction over the following pattern of sync.WaitGroup:
 //
@@ -18,6 +26,10 @@ import "sync"
 // ...
 // })
 //
+// Panics in functions started with `Go()` crash the process as usual. Panics in functions started with `GoNamed()` are
+// recovered, logged if Logger is set, and recorded as a PanicError, which marks the group as failed. The owner of the
+// group must watch Failed or check Err to handle them.
+//
 // Note that it's important to not call Subprocesses.Wait() when there are
 // no `Go()`ed functions in progress. This will panic.
 // There are two cases when this can happen:
@@ -28,7 +40,29 @@ import "sync"
 // Reusing a Subprocesses instance is discouraged.
 // See mode details here https://pkg.go.dev/sync#WaitGroup.Add)
 type Subprocesses struct {
+	// Logger optionally logs panics recovered from GoNamed.
+	Logger logger.Logger
+
 	wg sync.WaitGroup
+
+	mu       sync.Mutex
+	panics   []error
+	failedCh chan struct{} // closed on the first panic
+}
+
+// PanicError is a panic recovered from a subprocess.
+type PanicError struct {
+	// Name of the subprocess, as passed to GoNamed.
+	Name  string
+	Value interface{}
+	Stack []byte
+}
+
+func (p *PanicError) Error() string {
+	if p.Name == "" {
+		return fmt.Sprintf("subprocess panicked: %v", p.Value)
+	}
+	return fmt.Sprintf("subprocess %q panicked: %v", p.Name, p.Value)
 }
 
 // Wait blocks until all function calls from the Go method have returned.
@@ -36,6 +70,22 @@ func (s *Subprocesses) Wait() {
 	s.wg.Wait()
 }
 
+// WaitContext is like Wait, but returns ctx.Err() if ctx is done first, in which case the functions are left running.
+// Otherwise, it returns Err.
+func (s *Subprocesses) WaitContext(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return s.Err()
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // Go calls the given function in a new goroutine.
 func (s *Subprocesses) Go(f func()) {
 	s.wg.Add(1)
@@ -44,3 +94,52 @@ func (s *Subprocesses) Go(f func()) {
 		f()
 	}()
 }
+
+// GoNamed is like Go, but recovers from panics and records them as a [PanicError] attributed to name, instead of
+// crashing the process. See Failed and Err.
+func (s *Subprocesses) GoNamed(name string, f func()) {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer func() {
+			if r := recover(); r != nil {
+				err := &PanicError{name, r, debug.Stack()}
+				if s.Logger != nil {
+					s.Logger.Errorw("Recovered from panic in subprocess", "subprocess", name, "err", err, "stack", string(err.Stack))
+				}
+				s.fail(err)
+			}
+		}()
+		f()
+	}()
+}
+
+func (s *Subprocesses) fail(err *PanicError) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.panics = append(s.panics, err)
+	if len(s.panics) == 1 {
+		close(s.failedChLocked())
+	}
+}
+
+func (s *Subprocesses) failedChLocked() chan struct{} {
+	if s.failedCh == nil {
+		s.failedCh = make(chan struct{})
+	}
+	return s.failedCh
+}
+
+// Failed returns a channel which is closed once any function started with GoNamed panics.
+func (s *Subprocesses) Failed() <-chan struct{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.failedChLocked()
+}
+
+// Err returns the panics recovered from GoNamed so far, joined, or nil if none of the functions has panicked.
+func (s *Subprocesses) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return errors.Join(s.panics...)
+}
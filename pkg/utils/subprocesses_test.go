@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubprocesses(t *testing.T) {
+	t.Run("wait", func(t *testing.T) {
+		var subs Subprocesses
+		var ran [2]bool
+		subs.Go(func() { ran[0] = true })
+		subs.GoNamed("second", func() { ran[1] = true })
+		subs.Wait()
+		assert.Equal(t, [2]bool{true, true}, ran)
+		assert.NoError(t, subs.Err())
+		select {
+		case <-subs.Failed():
+			t.Fatal("unexpected failure")
+		default:
+		}
+	})
+
+	t.Run("named panics are recovered", func(t *testing.T) {
+		var subs Subprocesses
+		failed := subs.Failed()
+		subs.GoNamed("poller", func() { panic("boom") })
+		subs.GoNamed("", func() { panic(errors.New("bang")) })
+		subs.Go(func() {})
+
+		err := subs.WaitContext(context.Background())
+		require.Error(t, err)
+		select {
+		case <-failed:
+		default:
+			t.Fatal("expected failure")
+		}
+		assert.ErrorContains(t, err, `subprocess "poller" panicked: boom`)
+		assert.ErrorContains(t, err, "subprocess panicked: bang")
+
+		var panicErr *PanicError
+		require.ErrorAs(t, err, &panicErr)
+		assert.NotEmpty(t, panicErr.Stack)
+	})
+
+	t.Run("wait context", func(t *testing.T) {
+		var subs Subprocesses
+		release := make(chan struct{})
+		subs.Go(func() { <-release })
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		require.ErrorIs(t, subs.WaitContext(ctx), context.DeadlineExceeded)
+
+		close(release)
+		require.NoError(t, subs.WaitContext(context.Background()))
+	})
+}
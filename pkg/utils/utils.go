@@ -26,16 +26,10 @@ func WithJitter(d time.Duration) time.Duration {
 // When channel closes, the ctx.Err() will always be context.Canceled
 // NOTE: Spins up a goroutine that exits on cancellation.
 // REMEMBER TO CALL CANCEL OTHERWISE IT CAN LEAD TO MEMORY LEAKS
+//
+// Deprecated: use StopChan.NewCtx or StopRChan.NewCtx.
 func ContextFromChan(chStop <-chan struct{}) (context.Context, context.CancelFunc) {
-	ctx, cancel := context.WithCancel(context.Background())
-	go func() {
-		select {
-		case <-chStop:
-			cancel()
-		case <-ctx.Done():
-		}
-	}()
-	return ctx, cancel
+	return StopRChan(chStop).NewCtx()
 }
 
 // ContextWithDeadlineFn returns a copy of the parent context with the deadline modified by deadlineFn.